@@ -11,6 +11,8 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/kubestellar/console/pkg/api"
+	"github.com/kubestellar/console/pkg/api/middleware"
+	"github.com/kubestellar/console/pkg/logging"
 )
 
 func main() {
@@ -18,13 +20,14 @@ func main() {
 	_ = godotenv.Load()
 
 	// Set up structured logging — JSON for production, human-readable text for dev.
-	var logHandler slog.Handler
-	if os.Getenv("DEV_MODE") == "true" {
-		logHandler = slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
-	} else {
-		logHandler = slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelInfo})
+	// LOG_FORMAT (json|text) and LOG_LEVEL (debug|info|warn|error) override the defaults.
+	logOpts, logErr := logging.OptionsFromEnv(os.Getenv("DEV_MODE") == "true")
+	logHandler := logging.NewHandler(os.Stderr, logOpts)
+	// Attach the per-request correlation ID to logs emitted with a request context.
+	slog.SetDefault(slog.New(middleware.NewRequestIDLogHandler(logHandler)))
+	if logErr != nil {
+		slog.Warn("[Logging] ignoring invalid logging configuration", "error", logErr)
 	}
-	slog.SetDefault(slog.New(logHandler))
 
 	// Parse flags
 	devMode := flag.Bool("dev", false, "Run in development mode")
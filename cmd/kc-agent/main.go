@@ -10,6 +10,7 @@ import (
 	"syscall"
 
 	"github.com/kubestellar/console/pkg/agent"
+	"github.com/kubestellar/console/pkg/logging"
 
 	// Blank-import federation providers so their init() funcs register them.
 	_ "github.com/kubestellar/console/pkg/agent/federation/providers"
@@ -17,13 +18,13 @@ import (
 
 func main() {
 	// Set up structured logging — JSON for production, human-readable text for dev.
-	var logHandler slog.Handler
-	if os.Getenv("DEV_MODE") == "true" {
-		logHandler = slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
-	} else {
-		logHandler = slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelInfo})
-	}
+	// LOG_FORMAT (json|text) and LOG_LEVEL (debug|info|warn|error) override the defaults.
+	logOpts, logErr := logging.OptionsFromEnv(os.Getenv("DEV_MODE") == "true")
+	logHandler := logging.NewHandler(os.Stderr, logOpts)
 	slog.SetDefault(slog.New(logHandler))
+	if logErr != nil {
+		slog.Warn("[Logging] ignoring invalid logging configuration", "error", logErr)
+	}
 
 	port := flag.Int("port", 8585, "Port to listen on")
 	kubeconfig := flag.String("kubeconfig", "", "Path to kubeconfig file")
@@ -0,0 +1,264 @@
+package agent
+
+import (
+	"bufio"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kubestellar/console/pkg/api/v1alpha1"
+)
+
+// Audit actions for the mutations kc-agent serves (#7993), named
+// like the backend's audit actions (pkg/api/audit).
+const (
+	auditActionScaleWorkload      = "scale_workload"
+	auditActionDeployWorkload     = "deploy_workload"
+	auditActionDeleteWorkload     = "delete_workload"
+	auditActionBatchWorkload      = "batch_workload"
+	auditActionDrainClusterGroup  = "drain_cluster_group"
+	auditActionCreateFromTemplate = "create_from_template"
+)
+
+// Audit outcomes, the same values the backend records.
+const (
+	auditOutcomeSuccess = "success"
+	auditOutcomeFailure = "failure"
+)
+
+// agentAuditEntry is one audited mutation. kc-agent has no database, so
+// entries go to the structured log and to auditTrail rather than the
+// backend's audit table. JSON names match the backend's store.AuditEntry.
+type agentAuditEntry struct {
+	Time      time.Time `json:"timestamp"`
+	Action    string    `json:"action"`
+	Cluster   string    `json:"cluster,omitempty"`
+	Namespace string    `json:"namespace,omitempty"`
+	Kind      string    `json:"kind,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Outcome   string    `json:"outcome"`
+	Error     string    `json:"detail,omitempty"`
+}
+
+// auditSink receives every audit entry kc-agent produces. Tests replace it
+// to capture entries.
+var auditSink = func(e agentAuditEntry) {
+	agentAuditTrail.add(e)
+	attrs := []any{
+		"action", e.Action,
+		"cluster", e.Cluster,
+		"namespace", e.Namespace,
+		"target_type", e.Kind,
+		"target_id", e.Name,
+		"outcome", e.Outcome,
+		"time", e.Time,
+	}
+	if e.Error != "" {
+		attrs = append(attrs, "error", e.Error)
+	}
+	slog.Info("audit", attrs...)
+}
+
+// recordAudit sends one entry to auditSink. A non-empty errMsg marks the
+// operation as failed.
+func recordAudit(action, cluster, namespace, kind, name, errMsg string) {
+	outcome := auditOutcomeSuccess
+	if errMsg != "" {
+		outcome = auditOutcomeFailure
+	}
+	auditSink(agentAuditEntry{
+		Time:      time.Now(),
+		Action:    action,
+		Cluster:   cluster,
+		Namespace: namespace,
+		Kind:      kind,
+		Name:      name,
+		Outcome:   outcome,
+		Error:     errMsg,
+	})
+}
+
+// recordClusterAudit records one entry per target cluster of a multi-cluster
+// deploy or scale. Every cluster fails when err is set; otherwise the
+// clusters in result.FailedClusters fail with result's message.
+func recordClusterAudit(action, namespace, kind, name string, clusters []string, result *v1alpha1.DeployResponse, err error) {
+	failed := make(map[string]bool)
+	if result != nil {
+		for _, c := range result.FailedClusters {
+			failed[c] = true
+		}
+	}
+	for _, cluster := range clusters {
+		errMsg := ""
+		switch {
+		case err != nil:
+			errMsg = err.Error()
+		case result == nil:
+			errMsg = "no result"
+		case failed[cluster]:
+			errMsg = result.Message
+		}
+		recordAudit(action, cluster, namespace, kind, name, errMsg)
+	}
+}
+
+// maxAuditTrailEntries bounds the audit entries kc-agent keeps, in memory and
+// on disk, and serves at GET /audit.
+const maxAuditTrailEntries = 1000
+
+// auditTrailFile is the JSON-lines file under the agent data dir (~/.kc)
+// that keeps the audit trail across restarts.
+const auditTrailFile = "audit.jsonl"
+
+// auditTrail holds the most recent audit entries, oldest first. Once
+// persistTo has been called every entry is also appended to a file.
+type auditTrail struct {
+	mu      sync.Mutex
+	entries []agentAuditEntry
+	path    string
+}
+
+// agentAuditTrail is the trail auditSink records to.
+var agentAuditTrail = &auditTrail{}
+
+// persistTo loads the entries already in dataDir's audit file and appends
+// new ones to it from now on. A file grown past maxAuditTrailEntries is
+// rewritten with just the most recent entries.
+func (t *auditTrail) persistTo(dataDir string) error {
+	if err := os.MkdirAll(dataDir, configDirMode); err != nil {
+		return err
+	}
+	path := filepath.Join(dataDir, auditTrailFile)
+
+	var loaded []agentAuditEntry
+	if f, err := os.Open(path); err == nil {
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var e agentAuditEntry
+			if json.Unmarshal(scanner.Bytes(), &e) == nil {
+				loaded = append(loaded, e)
+			}
+		}
+		f.Close()
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	trimmed := len(loaded) > maxAuditTrailEntries
+	if trimmed {
+		loaded = loaded[len(loaded)-maxAuditTrailEntries:]
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries = append(loaded, t.entries...)
+	if len(t.entries) > maxAuditTrailEntries {
+		t.entries = t.entries[len(t.entries)-maxAuditTrailEntries:]
+	}
+	t.path = path
+	if trimmed {
+		return t.rewriteLocked()
+	}
+	return nil
+}
+
+// add records e, dropping the oldest entry once the trail is full.
+func (t *auditTrail) add(e agentAuditEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries = append(t.entries, e)
+	if len(t.entries) > maxAuditTrailEntries {
+		t.entries = t.entries[len(t.entries)-maxAuditTrailEntries:]
+	}
+	if t.path == "" {
+		return
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	f, err := os.OpenFile(t.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, agentFileMode)
+	if err != nil {
+		slog.Warn("[Audit] failed to persist audit entry", "error", err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		slog.Warn("[Audit] failed to persist audit entry", "error", err)
+	}
+}
+
+// rewriteLocked replaces the audit file with the in-memory entries.
+func (t *auditTrail) rewriteLocked() error {
+	var buf []byte
+	for _, e := range t.entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf = append(append(buf, line...), '\n')
+	}
+	return os.WriteFile(t.path, buf, agentFileMode)
+}
+
+// list returns up to limit entries, newest first, after skipping offset
+// matching entries. Empty action or cluster disable that filter.
+func (t *auditTrail) list(action, cluster string, limit, offset int) []agentAuditEntry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	result := make([]agentAuditEntry, 0)
+	for i := len(t.entries) - 1; i >= 0 && len(result) < limit; i-- {
+		e := t.entries[i]
+		if (action != "" && e.Action != action) || (cluster != "" && e.Cluster != cluster) {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+// defaultAuditListLimit is how many entries GET /audit returns without a limit.
+const defaultAuditListLimit = 100
+
+// handleAuditHTTP returns kc-agent's audit trail, newest first. It accepts
+// the same limit, offset, action and cluster filters as the backend's
+// /api/admin/audit-log.
+func (s *Server) handleAuditHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !s.validateToken(r) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	limit := defaultAuditListLimit
+	if l, err := strconv.Atoi(q.Get("limit")); err == nil && l > 0 {
+		limit = min(l, maxAuditTrailEntries)
+	}
+	offset := 0
+	if o, err := strconv.Atoi(q.Get("offset")); err == nil && o > 0 {
+		offset = o
+	}
+
+	writeJSON(w, agentAuditTrail.list(q.Get("action"), q.Get("cluster"), limit, offset))
+}
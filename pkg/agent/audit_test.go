@@ -0,0 +1,96 @@
+package agent
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAuditTrail_PersistsAcrossRestarts(t *testing.T) {
+	dir := t.TempDir()
+
+	trail := &auditTrail{}
+	if err := trail.persistTo(dir); err != nil {
+		t.Fatalf("persistTo: %v", err)
+	}
+	trail.add(agentAuditEntry{Time: time.Now(), Action: auditActionScaleWorkload, Cluster: "c1", Outcome: auditOutcomeSuccess})
+	trail.add(agentAuditEntry{Time: time.Now(), Action: auditActionDeleteWorkload, Cluster: "c2", Outcome: auditOutcomeFailure, Error: "forbidden"})
+
+	restarted := &auditTrail{}
+	if err := restarted.persistTo(dir); err != nil {
+		t.Fatalf("persistTo after restart: %v", err)
+	}
+	got := restarted.list("", "", maxAuditTrailEntries, 0)
+	if len(got) != 2 || got[0].Action != auditActionDeleteWorkload || got[0].Error != "forbidden" || got[1].Cluster != "c1" {
+		t.Errorf("Unexpected reloaded entries: %+v", got)
+	}
+}
+
+func TestAuditTrail_KeepsMostRecentEntries(t *testing.T) {
+	trail := &auditTrail{}
+	for i := 0; i < maxAuditTrailEntries+5; i++ {
+		trail.add(agentAuditEntry{Action: auditActionScaleWorkload, Name: string(rune('a' + i%26))})
+	}
+	if got := trail.list("", "", maxAuditTrailEntries+5, 0); len(got) != maxAuditTrailEntries {
+		t.Errorf("Expected %d entries, got %d", maxAuditTrailEntries, len(got))
+	}
+}
+
+func TestServer_HandleAuditHTTP(t *testing.T) {
+	origTrail := agentAuditTrail
+	agentAuditTrail = &auditTrail{}
+	t.Cleanup(func() { agentAuditTrail = origTrail })
+
+	agentAuditTrail.add(agentAuditEntry{Action: auditActionScaleWorkload, Cluster: "c1", Name: "first"})
+	agentAuditTrail.add(agentAuditEntry{Action: auditActionDeleteWorkload, Cluster: "c1", Name: "second"})
+	agentAuditTrail.add(agentAuditEntry{Action: auditActionScaleWorkload, Cluster: "c2", Name: "third"})
+	agentAuditTrail.add(agentAuditEntry{Action: auditActionScaleWorkload, Cluster: "c1", Name: "fourth"})
+
+	s := &Server{allowedOrigins: []string{"*"}}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"fourth", "third", "second", "first"}},
+		{"?cluster=c1", []string{"fourth", "second", "first"}},
+		{"?action=" + auditActionScaleWorkload + "&cluster=c1", []string{"fourth", "first"}},
+		{"?limit=1&offset=1", []string{"third"}},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/audit"+tt.query, nil)
+		w := httptest.NewRecorder()
+		s.handleAuditHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("%q: expected 200, got %d", tt.query, w.Code)
+		}
+		var entries []agentAuditEntry
+		if err := json.NewDecoder(w.Body).Decode(&entries); err != nil {
+			t.Fatalf("%q: decode: %v", tt.query, err)
+		}
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name)
+		}
+		if len(names) != len(tt.want) {
+			t.Errorf("%q: expected %v, got %v", tt.query, tt.want, names)
+			continue
+		}
+		for i := range names {
+			if names[i] != tt.want[i] {
+				t.Errorf("%q: expected %v, got %v", tt.query, tt.want, names)
+				break
+			}
+		}
+	}
+
+	req := httptest.NewRequest("POST", "/audit", nil)
+	w := httptest.NewRecorder()
+	s.handleAuditHTTP(w, req)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405 for POST, got %d", w.Code)
+	}
+}
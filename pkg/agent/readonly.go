@@ -0,0 +1,82 @@
+package agent
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+const (
+	// readOnlyEnvVar turns on read-only mode when set to "true".
+	readOnlyEnvVar = "KC_READ_ONLY"
+
+	// readOnlyForbiddenMsg is returned for mutations rejected in read-only mode.
+	readOnlyForbiddenMsg = "kc-agent is in read-only mode"
+)
+
+// readOnlyProtectedRoutes are the cluster-affecting route patterns rejected
+// for state-changing methods when KC_READ_ONLY=true. A pattern matches a path
+// that starts with the same segments; "*" matches any single segment.
+// Mirrors middleware.ReadOnlyProtectedRoutes in pkg/api/middleware.
+var readOnlyProtectedRoutes = []string{
+	"/scale",                // workload scale
+	"/workloads",            // workload deploy / delete / batch
+	"/cluster-groups/drain", // drains every node in a group
+	"/serviceexports",       // MCS ServiceExport create / delete
+	"/resource-yaml",        // raw manifest apply
+	"/resources",            // label / annotation edits and generic delete
+	"/bundles/apply",        // multi-cluster bundle apply
+	"/templates/*/create",   // creates pods and jobs from templates
+	"/helm",                 // helm rollback / uninstall / upgrade
+	"/console-cr",           // ConsoleResource CR writes
+	"/federation/action",    // provider management actions
+	"/gitops/sync",          // kubectl apply
+	"/argocd/sync",          // ArgoCD application sync
+	"/gpu-health-cronjob",   // CronJob install / uninstall
+	"/vcluster/create",      // creates a vCluster in a host cluster
+	"/vcluster/delete",      // deletes a vCluster from a host cluster
+}
+
+// requireWritable wraps an http.Handler and rejects state-changing requests
+// to paths matching any of protected with 403. Safe methods and unprotected
+// paths pass through. Only installed when read-only mode is on.
+func requireWritable(protected []string, next http.Handler) http.Handler {
+	patterns := make([][]string, 0, len(protected))
+	for _, p := range protected {
+		patterns = append(patterns, splitRoutePath(p))
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if csrfSafeMethods[r.Method] {
+			next.ServeHTTP(w, r)
+			return
+		}
+		path := splitRoutePath(r.URL.Path)
+		for _, pattern := range patterns {
+			if matchRoutePattern(pattern, path) {
+				slog.Warn("[ReadOnly] mutation rejected",
+					"ip", r.RemoteAddr, "method", r.Method, "path", r.URL.Path)
+				http.Error(w, readOnlyForbiddenMsg, http.StatusForbidden)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// splitRoutePath splits p into its path segments.
+func splitRoutePath(p string) []string {
+	return strings.Split(strings.Trim(p, "/"), "/")
+}
+
+// matchRoutePattern reports whether path starts with pattern's segments.
+func matchRoutePattern(pattern, path []string) bool {
+	if len(path) < len(pattern) {
+		return false
+	}
+	for i, seg := range pattern {
+		if seg != "*" && seg != path[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package agent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubestellar/console/pkg/agent/protocol"
+)
+
+func TestRequireWritable(t *testing.T) {
+	t.Parallel()
+	inner := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := requireWritable(readOnlyProtectedRoutes, inner)
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{http.MethodPost, "/workloads/deploy", http.StatusForbidden},
+		{http.MethodPost, "/workloads/batch", http.StatusForbidden},
+		{http.MethodPost, "/scale", http.StatusForbidden},
+		{http.MethodDelete, "/resources/c1/default/apps~v1~deployments/web", http.StatusForbidden},
+		{http.MethodPost, "/bundles/apply", http.StatusForbidden},
+		{http.MethodPost, "/templates/debug-pod/create", http.StatusForbidden},
+		{http.MethodPost, "/helm/uninstall", http.StatusForbidden},
+		{http.MethodGet, "/namespaces/c1/default/export", http.StatusOK},
+		{http.MethodGet, "/deployments", http.StatusOK},
+		{http.MethodPost, "/rbac/can-i", http.StatusOK},
+		{http.MethodPut, "/settings", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, nil))
+			if rr.Code != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, rr.Code)
+			}
+		})
+	}
+}
+
+func TestHandleKubectlMessage_ReadOnlyRejectsMutations(t *testing.T) {
+	s := &Server{readOnly: true}
+	resp := s.handleKubectlMessage(context.Background(), protocol.Message{
+		ID:      "1",
+		Type:    protocol.TypeKubectl,
+		Payload: protocol.KubectlRequest{Args: []string{"delete", "pod", "web"}, Confirmed: true},
+	})
+	if resp.Type != protocol.TypeError {
+		t.Fatalf("expected error response, got %+v", resp)
+	}
+	if p, ok := resp.Payload.(protocol.ErrorPayload); !ok || p.Code != "read_only_rejected" {
+		t.Errorf("unexpected payload: %+v", resp.Payload)
+	}
+}
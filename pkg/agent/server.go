@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -50,6 +51,7 @@ const (
 
 	maxQueryLimit       = 1000    // Upper bound for client-supplied limit query parameter
 	maxRequestBodyBytes = 1 << 20 // 1MB upper bound for request body reads
+	maxBundleBodyBytes  = 8 << 20 // 8MB upper bound for multi-document YAML bundles
 
 	// autoGeneratedTokenBytes is the number of random bytes used to generate
 	// an agent token when KC_AGENT_TOKEN is not set (#9480). 32 bytes yields
@@ -82,6 +84,12 @@ const (
 	// to override the per-mission execution timeout.
 	missionTimeoutEnvVar = "KC_MISSION_TIMEOUT"
 
+	// genericResourceAllowEnvVar and genericResourceDenyEnvVar configure
+	// resourcePolicy with the backend's "resource.group" list syntax
+	// (GENERIC_RESOURCE_ALLOW / GENERIC_RESOURCE_DENY).
+	genericResourceAllowEnvVar = "KC_GENERIC_RESOURCE_ALLOW"
+	genericResourceDenyEnvVar  = "KC_GENERIC_RESOURCE_DENY"
+
 	// missionHeartbeatInterval is how often the backend sends a heartbeat
 	// progress event during mission execution.  This prevents the frontend's
 	// stream-inactivity timer (90s) from firing during legitimate long-running
@@ -152,8 +160,14 @@ type Server struct {
 	clients        map[*websocket.Conn]*wsClient
 	clientsMux     sync.RWMutex
 	allowedOrigins []string
-	agentToken     string // Optional shared secret for authentication
-	tokenExplicit  bool   // true when KC_AGENT_TOKEN was explicitly set (not auto-generated)
+	originsMu      sync.RWMutex // guards allowedOrigins, which SetAllowedOrigins replaces at runtime
+	agentToken     string       // Optional shared secret for authentication
+	tokenExplicit  bool         // true when KC_AGENT_TOKEN was explicitly set (not auto-generated)
+	readOnly       bool         // KC_READ_ONLY=true: reject cluster mutations (see readonly.go)
+	// resourcePolicy limits the resources the generic resource delete and
+	// namespace export serve (KC_GENERIC_RESOURCE_ALLOW / _DENY). Secrets
+	// are allowed: kc-agent reads them under the user's own kubeconfig.
+	resourcePolicy k8s.GenericResourcePolicy
 
 	// Token tracking
 	tokenMux          sync.RWMutex
@@ -298,6 +312,9 @@ func NewServer(cfg Config) (*Server, error) {
 		}
 	}
 
+	resourcePolicy := k8s.NewGenericResourcePolicy(os.Getenv(genericResourceAllowEnvVar),
+		os.Getenv(genericResourceDenyEnvVar), true)
+
 	now := time.Now()
 	server := &Server{
 		config:            cfg,
@@ -308,6 +325,8 @@ func NewServer(cfg Config) (*Server, error) {
 		allowedOrigins:    allowedOrigins,
 		agentToken:        agentToken,
 		tokenExplicit:     tokenExplicit,
+		readOnly:          os.Getenv(readOnlyEnvVar) == "true",
+		resourcePolicy:    resourcePolicy,
 		sessionStart:      now,
 		todayDate:         now.Format("2006-01-02"),
 		activeChatCtxs:    make(map[string]activeChatEntry),
@@ -325,6 +344,11 @@ func NewServer(cfg Config) (*Server, error) {
 	// Initialize prediction system
 	server.predictionWorker = NewPredictionWorker(k8sClient, server.registry, server.BroadcastToClients, server.addTokenUsage)
 	server.metricsHistory = NewMetricsHistory(k8sClient, "")
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		if err := agentAuditTrail.persistTo(filepath.Join(homeDir, configDirName)); err != nil {
+			slog.Warn("[Audit] audit trail will not persist across restarts", "error", err)
+		}
+	}
 
 	// Initialize insight enrichment
 	server.insightWorker = NewInsightWorker(server.registry, server.BroadcastToClients)
@@ -364,10 +388,8 @@ func (s *Server) checkOrigin(r *http.Request) bool {
 	}
 
 	// Check against allowed origins (supports wildcards like "https://*.ibm.com")
-	for _, allowed := range s.allowedOrigins {
-		if matchOrigin(origin, allowed) {
-			return true
-		}
+	if s.isAllowedOrigin(origin) {
+		return true
 	}
 
 	slog.Warn("SECURITY: rejected WebSocket connection from unauthorized origin", "origin", origin)
@@ -483,6 +505,7 @@ func (s *Server) Start() error {
 	mux.HandleFunc("/networkpolicies", s.handleNetworkPoliciesHTTP)
 	mux.HandleFunc("/services", s.handleServicesHTTP)
 	mux.HandleFunc("/configmaps", s.handleConfigMapsHTTP)
+	mux.HandleFunc("/configmaps/{cluster}/{namespace}/{name}/data", s.handleConfigMapDataHTTP)
 	mux.HandleFunc("/secrets", s.handleSecretsHTTP)
 	mux.HandleFunc("/serviceaccounts", s.handleServiceAccountsHTTP)
 	mux.HandleFunc("/jobs", s.handleJobsHTTP)
@@ -500,7 +523,11 @@ func (s *Server) Start() error {
 	// Workload deploy and delete routes moved to kc-agent (#7993 Phase 1 PR B).
 	// These run under the user's kubeconfig instead of the backend pod SA.
 	mux.HandleFunc("/workloads/deploy", s.handleDeployWorkloadHTTP)
+	mux.HandleFunc("/workloads/deploy/stream", s.handleDeployWorkloadStreamSSE)
 	mux.HandleFunc("/workloads/delete", s.handleDeleteWorkloadHTTP)
+	mux.HandleFunc("/workloads/batch", s.handleBatchWorkloadsHTTP)
+	// Cordon and drain every node of a cluster group's member clusters.
+	mux.HandleFunc("/cluster-groups/drain", s.handleDrainClusterGroupHTTP)
 
 	// MCS ServiceExport create/delete moved to kc-agent (#7993 Phase 1.5 PR B).
 	// The backend had Create/DeleteServiceExport handlers with no frontend
@@ -508,6 +535,23 @@ func (s *Server) Start() error {
 	// available for future MCS-export UI work.
 	mux.HandleFunc("/serviceexports", s.handleServiceExportsHTTP)
 
+	// Raw manifest apply for the YAML editor (server-side apply under the
+	// user's kubeconfig). The read side is the backend's /api/mcp/resource-yaml.
+	mux.HandleFunc("/resource-yaml", s.handleResourceYAMLHTTP)
+	// Label / annotation edits on any resource, same identity model.
+	mux.HandleFunc("/resources/{cluster}/{namespace}/{gvr}/{name}/labels", s.handleResourceLabelsHTTP)
+	mux.HandleFunc("/resources/{cluster}/{namespace}/{gvr}/{name}/annotations", s.handleResourceLabelsHTTP)
+	// Generic delete of any resource with kubectl-style cascade options.
+	mux.HandleFunc("/resources/{cluster}/{namespace}/{gvr}/{name}", s.handleDeleteResourceHTTP)
+	// Namespace backup: workloads plus resolved dependencies as re-appliable
+	// YAML. Served here so Secrets are read under the user's kubeconfig.
+	mux.HandleFunc("/namespaces/{cluster}/{namespace}/export", s.handleExportNamespaceHTTP)
+	// Re-apply such a bundle (or any multi-document YAML) to several clusters.
+	mux.HandleFunc("/bundles/apply", s.handleApplyBundleHTTP)
+	// Create a debug pod or probe job from a built-in template. The template
+	// list itself is static and served by the backend at /api/templates.
+	mux.HandleFunc("/templates/{name}/create", s.handleCreateFromTemplateHTTP)
+
 	// Cilium status — aggregated eBPF networking health across all clusters (#9400)
 	mux.HandleFunc("/cilium-status", s.handleCiliumStatus)
 
@@ -585,6 +629,7 @@ func (s *Server) Start() error {
 	// reflects the pod SA's permissions instead of the user's. Routes in
 	// pkg/agent/server_rbac.go. Backend handlers are deleted in the same PR.
 	mux.HandleFunc("/rbac/can-i", s.handleCanIHTTP)
+	mux.HandleFunc("/clusters/{cluster}/can-i", s.handleClusterCanIHTTP)
 	mux.HandleFunc("/rbac/permissions", s.handleClusterPermissionsHTTP)
 	mux.HandleFunc("/permissions/summary", s.handlePermissionsSummaryHTTP)
 
@@ -606,6 +651,7 @@ func (s *Server) Start() error {
 	mux.HandleFunc("/settings", s.handleSettingsAll)
 	mux.HandleFunc("/settings/export", s.handleSettingsExport)
 	mux.HandleFunc("/settings/import", s.handleSettingsImport)
+	mux.HandleFunc("/settings/allowed-origins", s.handleSettingsAllowedOrigins)
 
 	// Provider health check (proxies status page checks server-side to avoid CORS)
 	mux.HandleFunc("/providers/health", s.handleProvidersHealth)
@@ -628,6 +674,7 @@ func (s *Server) Start() error {
 	mux.HandleFunc("/devices/alerts/clear", s.handleDeviceAlertsClear)
 	mux.HandleFunc("/devices/inventory", s.handleDeviceInventory)
 	mux.HandleFunc("/metrics/history", s.handleMetricsHistory)
+	mux.HandleFunc("/audit", s.handleAuditHTTP)
 
 	// Kagenti AI agent platform endpoints
 	mux.HandleFunc("/kagenti/agents", s.handleKagentiAgents)
@@ -712,7 +759,15 @@ func (s *Server) Start() error {
 	// browser reports an opaque CORS error instead of showing the 403.
 	// corsMiddleware also short-circuits OPTIONS preflight so it never
 	// reaches requireCSRF (preflight must not carry X-Requested-With).
-	handler := s.corsMiddleware(requireCSRF(mux))
+	var inner http.Handler = mux
+	if s.readOnly {
+		// KC_READ_ONLY=true: cluster mutations are rejected with 403, same
+		// as the backend's READ_ONLY mode. kubectl over the WebSocket is
+		// limited to read-only verbs in handleKubectlMessage.
+		slog.Info("read-only mode enabled: cluster mutations are rejected")
+		inner = requireWritable(readOnlyProtectedRoutes, mux)
+	}
+	handler := s.corsMiddleware(requireCSRF(inner))
 
 	addr := fmt.Sprintf("127.0.0.1:%d", s.config.Port)
 	slog.Info("KC Agent starting", "version", Version, "addr", addr)
@@ -914,6 +969,8 @@ func (s *Server) isAllowedOrigin(origin string) bool {
 	if origin == "" {
 		return false
 	}
+	s.originsMu.RLock()
+	defer s.originsMu.RUnlock()
 	for _, allowed := range s.allowedOrigins {
 		if matchOrigin(origin, allowed) {
 			return true
@@ -922,24 +979,85 @@ func (s *Server) isAllowedOrigin(origin string) bool {
 	return false
 }
 
+// AllowedOrigins returns a copy of the origins currently accepted by
+// isAllowedOrigin, defaults first.
+func (s *Server) AllowedOrigins() []string {
+	s.originsMu.RLock()
+	defer s.originsMu.RUnlock()
+	return append([]string{}, s.allowedOrigins...)
+}
+
+// SetAllowedOrigins replaces the custom allowed origins at runtime. The
+// built-in defaultAllowedOrigins are always kept so the console itself can
+// never be locked out. Returns an error, leaving the list unchanged, if any
+// entry is not a valid origin pattern.
+func (s *Server) SetAllowedOrigins(custom []string) error {
+	origins := append([]string{}, defaultAllowedOrigins...)
+	seen := make(map[string]bool, len(origins)+len(custom))
+	for _, o := range origins {
+		seen[o] = true
+	}
+	for _, o := range custom {
+		o = strings.TrimSpace(o)
+		if o == "" || seen[o] {
+			continue
+		}
+		if err := validateOriginPattern(o); err != nil {
+			return err
+		}
+		seen[o] = true
+		origins = append(origins, o)
+	}
+
+	s.originsMu.Lock()
+	s.allowedOrigins = origins
+	s.originsMu.Unlock()
+	slog.Info("allowed origins updated", "origins", origins[len(defaultAllowedOrigins):])
+	return nil
+}
+
+// validateOriginPattern checks that an allowed-origin entry is
+// "scheme://host[:port]", optionally with a single leading "*." wildcard
+// label in the host. Paths, queries and embedded wildcards are rejected.
+func validateOriginPattern(pattern string) error {
+	scheme, host, ok := strings.Cut(pattern, "://")
+	if !ok || (scheme != "http" && scheme != "https") {
+		return fmt.Errorf("origin %q must start with http:// or https://", pattern)
+	}
+	host = strings.TrimPrefix(host, "*.")
+	if host == "" || strings.ContainsAny(host, "/?#@*") {
+		return fmt.Errorf("origin %q must be scheme://host[:port] with at most one leading *. wildcard", pattern)
+	}
+	return nil
+}
+
 // matchOrigin checks if an origin matches an allowed pattern.
 // For non-wildcard origins, requires an exact match or a match with an additional port
 // (e.g. "http://localhost" matches "http://localhost" and "http://localhost:5174" but NOT "http://localhost.attacker.com").
-// For wildcard patterns like "https://*.ibm.com", matches any subdomain depth
-// (e.g. "https://kc.ibm.com" and "https://deep.sub.ibm.com" both match).
+// For wildcard patterns like "https://*.ibm.com", the "*" stands for one or
+// more whole DNS labels, so "https://kc.ibm.com" and "https://deep.sub.ibm.com"
+// match while "https://ibm.com", "https://notibm.com", "https://evil-ibm.com"
+// and "https://ibm.com.evil.com" do not. Wildcard origins must not carry a
+// port. A "*" anywhere other than directly after "scheme://" never matches.
 func matchOrigin(origin, allowed string) bool {
-	// Wildcard matching: "https://*.ibm.com" matches any subdomain depth
-	// e.g. "https://*.ibm.com" matches "https://kc.ibm.com" and "https://kc.apps.example.ibm.com"
-	if idx := strings.Index(allowed, "*."); idx != -1 {
+	if idx := strings.Index(allowed, "*"); idx != -1 {
 		scheme := allowed[:idx]   // e.g. "https://"
 		suffix := allowed[idx+1:] // e.g. ".ibm.com"
-		if !strings.HasPrefix(origin, scheme) || !strings.HasSuffix(origin, suffix) {
+		if !strings.HasSuffix(scheme, "://") || !strings.HasPrefix(suffix, ".") || strings.Contains(suffix, "*") {
 			return false
 		}
-		// Extract the subdomain part between the scheme and the suffix
-		middle := origin[len(scheme) : len(origin)-len(suffix)]
-		// Must be non-empty (at least one subdomain level)
-		return len(middle) > 0
+		if !strings.HasPrefix(origin, scheme) {
+			return false
+		}
+		host := strings.ToLower(origin[len(scheme):])
+		suffix = strings.ToLower(suffix)
+		if !strings.HasSuffix(host, suffix) {
+			return false
+		}
+		// The part covered by "*" must be whole, well-formed DNS labels —
+		// this rejects empty labels ("https://.ibm.com") and smuggled
+		// separators such as "@", "/", ":" or "#".
+		return validDNSLabels(host[:len(host)-len(suffix)])
 	}
 	// Exact match
 	if origin == allowed {
@@ -952,6 +1070,26 @@ func matchOrigin(origin, allowed string) bool {
 	return false
 }
 
+// validDNSLabels reports whether s is one or more dot-separated DNS labels
+// (letters, digits and inner hyphens, 1-63 characters each).
+func validDNSLabels(s string) bool {
+	if s == "" {
+		return false
+	}
+	const maxDNSLabelLen = 63
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || len(label) > maxDNSLabelLen || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // generateRandomToken produces a cryptographically random hex-encoded token
 // of the given byte length. Used to auto-generate KC_AGENT_TOKEN when the
 // operator does not set one (#9480).
@@ -463,6 +463,18 @@ func (s *Server) handleKubectlMessage(ctx context.Context, msg protocol.Message)
 		}
 	}
 
+	// KC_READ_ONLY=true: only read-only kubectl verbs run, whatever the session.
+	if s.readOnly && !isReadOnlyKubectlCommand(req.Args) {
+		return protocol.Message{
+			ID:   msg.ID,
+			Type: protocol.TypeError,
+			Payload: protocol.ErrorPayload{
+				Code:    "read_only_rejected",
+				Message: fmt.Sprintf("read-only mode: mutating command %q not allowed", strings.Join(req.Args, " ")),
+			},
+		}
+	}
+
 	// Check for destructive commands that require confirmation
 	if isDestructiveKubectlCommand(req.Args) && !req.Confirmed {
 		return protocol.Message{
@@ -0,0 +1,212 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+// Cluster group drain (#7993). Draining evicts pods on every node of every
+// member cluster, so it runs under the user's kubeconfig here rather than
+// the backend pod ServiceAccount. Group membership lives in the backend;
+// the frontend resolves it and sends the member clusters in the body.
+
+const (
+	// clusterGroupDrainTimeout bounds a whole group drain. Nodes are drained
+	// sequentially within a cluster, so this must cover several per-node
+	// timeouts on the largest cluster in the group.
+	clusterGroupDrainTimeout = 2 * time.Hour
+	// maxDrainNodeTimeoutSeconds caps the caller-supplied per-node timeout.
+	maxDrainNodeTimeoutSeconds = 60 * 60
+	// allClustersGroupName is the backend's built-in group of every healthy
+	// cluster. Draining it would take capacity out of the whole fleet, so
+	// it is refused outright.
+	allClustersGroupName = "all-healthy-clusters"
+)
+
+// Drain SSE event names
+const (
+	sseEventDrainNode = "node"
+	sseEventDrainDone = "done"
+)
+
+// drainClusterGroupRequest is the body of POST /cluster-groups/drain.
+type drainClusterGroupRequest struct {
+	Group                 string   `json:"group"`
+	Clusters              []string `json:"clusters"`
+	PerNodeTimeoutSeconds int      `json:"perNodeTimeoutSeconds,omitempty"`
+	GracePeriodSeconds    *int64   `json:"gracePeriodSeconds,omitempty"`
+	CordonOnly            bool     `json:"cordonOnly,omitempty"`
+}
+
+// validateDrainClusterGroupRequest returns a client-facing error for a
+// malformed drain request.
+func validateDrainClusterGroupRequest(req *drainClusterGroupRequest) error {
+	if req.Group == "" {
+		return fmt.Errorf("group is required")
+	}
+	if req.Group == allClustersGroupName {
+		return fmt.Errorf("the built-in %s group cannot be drained", allClustersGroupName)
+	}
+	if len(req.Clusters) == 0 {
+		return fmt.Errorf("cluster group has no member clusters")
+	}
+	for _, cluster := range req.Clusters {
+		if err := validateKubeContext(cluster); err != nil {
+			return fmt.Errorf("cluster: %v", err)
+		}
+	}
+	if req.PerNodeTimeoutSeconds < 0 || req.PerNodeTimeoutSeconds > maxDrainNodeTimeoutSeconds {
+		return fmt.Errorf("perNodeTimeoutSeconds must be between 0 and %d", maxDrainNodeTimeoutSeconds)
+	}
+	if req.GracePeriodSeconds != nil && *req.GracePeriodSeconds < 0 {
+		return fmt.Errorf("gracePeriodSeconds must not be negative")
+	}
+	return nil
+}
+
+// handleDrainClusterGroupHTTP cordons and drains every node of every cluster
+// in a group.
+// POST /cluster-groups/drain[?stream=true]
+//
+// With stream=true the response is an SSE stream emitting a "node" event per
+// node as it finishes and a final "done" event carrying the full result.
+func (s *Server) handleDrainClusterGroupHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
+	// Errors before a stream starts are plain JSON responses.
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// SECURITY: Require auth — draining takes capacity out of every cluster
+	// in the group.
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "POST required"})
+		return
+	}
+
+	var req drainClusterGroupRequest
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "invalid request body"})
+		return
+	}
+	if err := validateDrainClusterGroupRequest(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	opts := k8s.DrainOptions{
+		Clusters:           req.Clusters,
+		PerNodeTimeout:     time.Duration(req.PerNodeTimeoutSeconds) * time.Second,
+		GracePeriodSeconds: req.GracePeriodSeconds,
+		CordonOnly:         req.CordonOnly,
+	}
+
+	// The drain outlives the server's default write deadline.
+	rc := http.NewResponseController(w)
+	rc.SetWriteDeadline(time.Now().Add(clusterGroupDrainTimeout))
+
+	ctx, cancel := context.WithTimeout(r.Context(), clusterGroupDrainTimeout)
+	defer cancel()
+
+	if r.URL.Query().Get("stream") != "true" {
+		result, err := s.k8sClient.DrainClusterGroup(ctx, req.Group, opts)
+		recordDrainAudit(req, result, err)
+		if err != nil {
+			status, msg := mapK8sErrorToHTTP(err)
+			w.WriteHeader(status)
+			writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+			return
+		}
+		writeJSON(w, result)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// Progress calls are serialized by DrainClusterGroup, so the writer
+	// needs no extra locking. A failed write means the client went away;
+	// stop draining further nodes rather than working unobserved.
+	writeEvent := func(event string, payload interface{}) error {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			slog.Error("[SSE] failed to marshal drain event", "event", event, "error", err)
+			return nil
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	opts.Progress = func(res k8s.NodeDrainResult) {
+		if err := writeEvent(sseEventDrainNode, res); err != nil {
+			slog.Info("[Drain] stream write failed, cancelling drain", "group", req.Group, "error", err)
+			cancel()
+		}
+	}
+
+	result, err := s.k8sClient.DrainClusterGroup(ctx, req.Group, opts)
+	recordDrainAudit(req, result, err)
+	if err != nil {
+		_ = writeEvent(sseEventDrainDone, map[string]string{"error": err.Error()})
+		return
+	}
+	_ = writeEvent(sseEventDrainDone, result)
+}
+
+// recordDrainAudit records one entry per member cluster of a group drain.
+// A cluster fails when the drain errored, the cluster was skipped, or any
+// of its nodes did not drain.
+func recordDrainAudit(req drainClusterGroupRequest, result *k8s.DrainGroupResult, err error) {
+	failed := make(map[string]string)
+	if result != nil {
+		for cluster, reason := range result.SkippedClusters {
+			failed[cluster] = reason
+		}
+		for _, node := range result.Nodes {
+			if node.Status == k8s.DrainStatusFailed || node.Status == k8s.DrainStatusTimeout {
+				failed[node.Cluster] = fmt.Sprintf("node %s: %s", node.Node, node.Error)
+			}
+		}
+	}
+	for _, cluster := range req.Clusters {
+		errMsg := failed[cluster]
+		if err != nil {
+			errMsg = err.Error()
+		}
+		recordAudit(auditActionDrainClusterGroup, cluster, "", "cluster_group", req.Group, errMsg)
+	}
+}
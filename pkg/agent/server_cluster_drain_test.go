@@ -0,0 +1,92 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubestellar/console/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestServer_HandleDrainClusterGroupHTTP_CordonOnly(t *testing.T) {
+	var entries []agentAuditEntry
+	origSink := auditSink
+	auditSink = func(e agentAuditEntry) { entries = append(entries, e) }
+	t.Cleanup(func() { auditSink = origSink })
+
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	fakeCS := fakek8s.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1"}})
+	k8sClient.SetClient("edge-1", fakeCS)
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"group":      "edge",
+		"clusters":   []string{"edge-1"},
+		"cordonOnly": true,
+	})
+	req := httptest.NewRequest("POST", "/cluster-groups/drain", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.handleDrainClusterGroupHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var result k8s.DrainGroupResult
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if result.Succeeded != 1 || len(result.Nodes) != 1 || result.Nodes[0].Status != k8s.DrainStatusCordoned {
+		t.Errorf("Unexpected drain result: %+v", result)
+	}
+	node, err := fakeCS.CoreV1().Nodes().Get(context.Background(), "n1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	if !node.Spec.Unschedulable {
+		t.Error("Expected node to be cordoned")
+	}
+	if len(entries) != 1 || entries[0].Action != auditActionDrainClusterGroup ||
+		entries[0].Cluster != "edge-1" || entries[0].Name != "edge" || entries[0].Outcome != auditOutcomeSuccess {
+		t.Errorf("Unexpected audit entries: %+v", entries)
+	}
+}
+
+func TestServer_HandleDrainClusterGroupHTTP_Validation(t *testing.T) {
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+
+	tests := []struct {
+		name string
+		body map[string]interface{}
+	}{
+		{"built-in all clusters group", map[string]interface{}{"group": allClustersGroupName, "clusters": []string{"c1"}}},
+		{"missing group", map[string]interface{}{"clusters": []string{"c1"}}},
+		{"no clusters", map[string]interface{}{"group": "edge"}},
+		{"invalid cluster", map[string]interface{}{"group": "edge", "clusters": []string{"../etc"}}},
+		{"per-node timeout too long", map[string]interface{}{"group": "edge", "clusters": []string{"c1"}, "perNodeTimeoutSeconds": maxDrainNodeTimeoutSeconds + 1}},
+		{"negative grace period", map[string]interface{}{"group": "edge", "clusters": []string{"c1"}, "gracePeriodSeconds": -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest("POST", "/cluster-groups/drain", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+			s.handleDrainClusterGroupHTTP(w, req)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("Expected 400, got %d: %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
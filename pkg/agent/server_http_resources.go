@@ -3,7 +3,9 @@ package agent
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -372,7 +374,8 @@ func (s *Server) createNamespaceHTTP(w http.ResponseWriter, r *http.Request) {
 // deleteNamespaceHTTP handles DELETE /namespaces. Takes `cluster` and `name`
 // query parameters — kc-agent uses net/http mux so path params are not
 // available (matches the legacy `DELETE /api/namespaces/:name?cluster=<c>`
-// shape otherwise).
+// shape otherwise). Protected namespaces (default, kube-system, ...) are
+// rejected with 403 unless `force=true` is also passed.
 func (s *Server) deleteNamespaceHTTP(w http.ResponseWriter, r *http.Request) {
 	cluster := r.URL.Query().Get("cluster")
 	name := r.URL.Query().Get("name")
@@ -382,10 +385,17 @@ func (s *Server) deleteNamespaceHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	force := r.URL.Query().Get("force") == "true"
+
 	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
 	defer cancel()
 
-	if err := s.k8sClient.DeleteNamespace(ctx, cluster, name); err != nil {
+	if err := s.k8sClient.DeleteNamespace(ctx, cluster, name, force); err != nil {
+		if errors.Is(err, k8s.ErrProtectedNamespace) {
+			w.WriteHeader(http.StatusForbidden)
+			writeJSON(w, map[string]interface{}{"success": false, "error": err.Error(), "source": "agent"})
+			return
+		}
 		slog.Warn("error deleting namespace", "cluster", cluster, "name", name, "error", err)
 		status, msg := mapK8sErrorToHTTP(err)
 		w.WriteHeader(status)
@@ -710,6 +720,94 @@ func (s *Server) handleConfigMapsHTTP(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, map[string]interface{}{"configmaps": configmaps, "source": "agent"})
 }
 
+// handleConfigMapDataHTTP reads (GET) or replaces (PUT) the data and
+// binaryData of one ConfigMap under the user's kubeconfig. binaryData values
+// are base64 in both directions; see k8s.ConfigMapData.
+func (s *Server) handleConfigMapDataHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodGet, http.MethodPut, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodPut {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "GET or PUT required"})
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	cluster := r.PathValue("cluster")
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+	if err := validateKubeContext(cluster); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if err := validateDNS1123Label("namespace", namespace); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if name == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "name must not be empty"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	if r.Method == http.MethodGet {
+		data, err := s.k8sClient.GetConfigMapData(ctx, cluster, namespace, name)
+		if err != nil {
+			slog.Warn("error fetching configmap data", "cluster", cluster, "namespace", namespace, "name", name, "error", err)
+			status, msg := mapK8sErrorToHTTP(err)
+			w.WriteHeader(status)
+			writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+			return
+		}
+		writeJSON(w, map[string]interface{}{"configmap": data, "source": "agent"})
+		return
+	}
+
+	var req k8s.ConfigMapData
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "invalid request body"})
+		return
+	}
+
+	if err := s.k8sClient.UpdateConfigMapData(ctx, cluster, namespace, name, &req); err != nil {
+		if errors.Is(err, k8s.ErrInvalidConfigMapData) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": err.Error(), "source": "agent"})
+			return
+		}
+		slog.Warn("error updating configmap data", "cluster", cluster, "namespace", namespace, "name", name, "error", err)
+		status, msg := mapK8sErrorToHTTP(err)
+		w.WriteHeader(status)
+		writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+		return
+	}
+	writeJSON(w, map[string]interface{}{"success": true, "cluster": cluster, "namespace": namespace, "name": name, "source": "agent"})
+}
+
 // handleSecretsHTTP returns secrets for a cluster/namespace
 func (s *Server) handleSecretsHTTP(w http.ResponseWriter, r *http.Request) {
 	s.setCORSHeaders(w, r)
@@ -1453,3 +1551,509 @@ func (s *Server) handleResolveDepsHTTP(w http.ResponseWriter, r *http.Request) {
 		"source":       "agent",
 	})
 }
+
+// handleResourceYAMLHTTP handles POST /resource-yaml: server-side applies a
+// single edited manifest, typically one fetched from the backend's
+// GET /api/mcp/resource-yaml. Body: {"cluster": "...", "yaml": "..."}.
+// Runs under the user's kubeconfig like every other agent mutation (#7993).
+func (s *Server) handleResourceYAMLHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "POST required"})
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	var req struct {
+		Cluster string `json:"cluster"`
+		YAML    string `json:"yaml"`
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "invalid request body"})
+		return
+	}
+	if err := validateKubeContext(req.Cluster); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	if err := s.k8sClient.ApplyResourceYAML(ctx, req.Cluster, []byte(req.YAML)); err != nil {
+		if errors.Is(err, k8s.ErrInvalidManifest) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": err.Error(), "source": "agent"})
+			return
+		}
+		slog.Warn("error applying resource YAML", "cluster", req.Cluster, "error", err)
+		status, msg := mapK8sErrorToHTTP(err)
+		w.WriteHeader(status)
+		writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+		return
+	}
+	writeJSON(w, map[string]interface{}{"success": true, "cluster": req.Cluster, "source": "agent"})
+}
+
+// clusterScopedNamespace is the namespace path segment used for
+// cluster-scoped resources in /resources/... routes.
+const clusterScopedNamespace = "_"
+
+// handleResourceLabelsHTTP handles
+// PATCH /resources/{cluster}/{namespace}/{gvr}/{name}/labels and the
+// matching .../annotations route. The body is {"labels": {"key": "value",
+// "removed": null}} (or "annotations"); a null value deletes the key and
+// keys not mentioned are left alone. {gvr} uses the dotted form
+// (deployments.v1.apps, pods.v1) and {namespace} is "_" for cluster-scoped
+// resources. Invalid keys or values are rejected with 400 before the
+// cluster is contacted.
+func (s *Server) handleResourceLabelsHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodPatch, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodPatch {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "PATCH required"})
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	cluster := r.PathValue("cluster")
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+	if err := validateKubeContext(cluster); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if namespace == clusterScopedNamespace {
+		namespace = ""
+	} else if err := validateDNS1123Label("namespace", namespace); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if name == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "name must not be empty"})
+		return
+	}
+	gvr, err := k8s.ParseGVR(r.PathValue("gvr"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+
+	var req struct {
+		Labels      map[string]*string `json:"labels"`
+		Annotations map[string]*string `json:"annotations"`
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "invalid request body"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	if strings.HasSuffix(r.URL.Path, "/annotations") {
+		err = s.k8sClient.PatchAnnotations(ctx, cluster, namespace, gvr, name, req.Annotations)
+	} else {
+		err = s.k8sClient.PatchLabels(ctx, cluster, namespace, gvr, name, req.Labels)
+	}
+	if err != nil {
+		if errors.Is(err, k8s.ErrInvalidMetadata) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": err.Error(), "source": "agent"})
+			return
+		}
+		slog.Warn("error patching resource metadata", "cluster", cluster, "resource", gvr.String(), "name", name, "error", err)
+		status, msg := mapK8sErrorToHTTP(err)
+		w.WriteHeader(status)
+		writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+		return
+	}
+	writeJSON(w, map[string]interface{}{"success": true, "cluster": cluster, "name": name, "source": "agent"})
+}
+
+// handleDeleteResourceHTTP handles
+// DELETE /resources/{cluster}/{namespace}/{gvr}/{name}?cascade=foreground.
+// cascade is foreground, background (the default) or orphan, as with
+// `kubectl delete --cascade`; any other value is rejected with 400. Path
+// segments follow handleResourceLabelsHTTP: {gvr} in dotted form and "_" as
+// the namespace of cluster-scoped resources.
+func (s *Server) handleDeleteResourceHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodDelete, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodDelete {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "DELETE required"})
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	cluster := r.PathValue("cluster")
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+	if err := validateKubeContext(cluster); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if namespace == clusterScopedNamespace {
+		namespace = ""
+	} else if err := validateDNS1123Label("namespace", namespace); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if name == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "name must not be empty"})
+		return
+	}
+	gvr, err := k8s.ParseGVR(r.PathValue("gvr"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if !s.resourcePolicy.Allowed(gvr) {
+		w.WriteHeader(http.StatusForbidden)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "resource is not available through this endpoint"})
+		return
+	}
+	propagation, err := k8s.ParseDeletionPropagation(r.URL.Query().Get("cascade"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	if err := s.k8sClient.DeleteResource(ctx, cluster, namespace, gvr, name, propagation); err != nil {
+		slog.Warn("error deleting resource", "cluster", cluster, "resource", gvr.String(), "name", name, "error", err)
+		status, msg := mapK8sErrorToHTTP(err)
+		w.WriteHeader(status)
+		writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"success":     true,
+		"cluster":     cluster,
+		"name":        name,
+		"propagation": string(propagation),
+		"source":      "agent",
+	})
+}
+
+// handleExportNamespaceHTTP handles
+// GET /namespaces/{cluster}/{namespace}/export?includeSecrets=true and
+// returns the namespace's workloads and their dependencies as a
+// multi-document YAML bundle. Secrets are only included when asked for.
+func (s *Server) handleExportNamespaceHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodGet, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "GET required"})
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	cluster := r.PathValue("cluster")
+	namespace := r.PathValue("namespace")
+	if err := validateKubeContext(cluster); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	if err := validateDNS1123Label("namespace", namespace); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+	opts := k8s.ExportOptions{Policy: &s.resourcePolicy}
+	if v := r.URL.Query().Get("includeSecrets"); v != "" {
+		include, err := strconv.ParseBool(v)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": "includeSecrets must be a boolean"})
+			return
+		}
+		opts.IncludeSecrets = include
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	bundle, err := s.k8sClient.ExportNamespace(ctx, cluster, namespace, opts)
+	if err != nil {
+		slog.Warn("error exporting namespace", "cluster", cluster, "namespace", namespace, "error", err)
+		status, msg := mapK8sErrorToHTTP(err)
+		w.WriteHeader(status)
+		writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", cluster+"-"+namespace+".yaml"))
+	_, _ = w.Write(bundle)
+}
+
+// handleApplyBundleHTTP handles
+// POST /bundles/apply?clusters=a,b&namespace=ns&dryRun=true with a
+// multi-document YAML body, typically one produced by
+// /namespaces/{cluster}/{namespace}/export, and server-side applies it to
+// every listed cluster. namespace fills in documents without one.
+func (s *Server) handleApplyBundleHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "POST required"})
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	query := r.URL.Query()
+	var clusters []string
+	for _, cluster := range strings.Split(query.Get("clusters"), ",") {
+		if cluster = strings.TrimSpace(cluster); cluster == "" {
+			continue
+		}
+		if err := validateKubeContext(cluster); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+			return
+		}
+		clusters = append(clusters, cluster)
+	}
+	if len(clusters) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "clusters is required"})
+		return
+	}
+	opts := k8s.ApplyOptions{Namespace: query.Get("namespace")}
+	if opts.Namespace != "" {
+		if err := validateDNS1123Label("namespace", opts.Namespace); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+			return
+		}
+	}
+	if v := query.Get("dryRun"); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": "dryRun must be a boolean"})
+			return
+		}
+		opts.DryRun = dryRun
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBundleBodyBytes))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "invalid request body"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	result, err := s.k8sClient.ApplyBundle(ctx, clusters, body, opts)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error(), "source": "agent"})
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"success":   result.Failed == 0,
+		"applied":   result.Applied,
+		"failed":    result.Failed,
+		"resources": result.Resources,
+		"dryRun":    opts.DryRun,
+		"source":    "agent",
+	})
+}
+
+// handleCreateFromTemplateHTTP handles POST /templates/{name}/create and
+// creates a resource from a built-in template under the user's kubeconfig.
+// The body is {"cluster": "...", "params": {"name": "...", ...}}; the
+// template's params are listed by the backend's GET /api/templates.
+func (s *Server) handleCreateFromTemplateHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "POST required"})
+		return
+	}
+
+	var req struct {
+		Cluster string            `json:"cluster"`
+		Params  map[string]string `json:"params"`
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "invalid request body"})
+		return
+	}
+	if err := validateKubeContext(req.Cluster); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{"success": false, "error": fmt.Sprintf("cluster: %v", err)})
+		return
+	}
+	// Validate before touching the cluster so bad input is a 400, not a
+	// connection error.
+	templateName := r.PathValue("name")
+	if _, err := k8s.RenderResourceTemplate(templateName, req.Params); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, k8s.ErrUnknownTemplate) {
+			status = http.StatusNotFound
+		}
+		w.WriteHeader(status)
+		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	namespace, name := req.Params["namespace"], req.Params["name"]
+	err := s.k8sClient.CreateFromTemplate(ctx, req.Cluster, templateName, req.Params)
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	}
+	recordAudit(auditActionCreateFromTemplate, req.Cluster, namespace, templateName, name, errMsg)
+	if err != nil {
+		slog.Warn("error creating resource from template", "cluster", req.Cluster, "template", templateName, "name", name, "error", err)
+		status, msg := mapK8sErrorToHTTP(err)
+		w.WriteHeader(status)
+		writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+		return
+	}
+	w.WriteHeader(http.StatusCreated)
+	writeJSON(w, map[string]interface{}{
+		"success":   true,
+		"template":  templateName,
+		"cluster":   req.Cluster,
+		"namespace": namespace,
+		"name":      name,
+		"source":    "agent",
+	})
+}
@@ -4,10 +4,18 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/kubestellar/console/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 
@@ -59,3 +67,402 @@ func TestServer_HandleEventsHTTP_Limit(t *testing.T) {
 		t.Errorf("Expected 503 for unregistered cluster, got %d", w.Code)
 	}
 }
+
+func TestServer_HandleNamespacesHTTP_CreateAndProtectedDelete(t *testing.T) {
+	fakeClientset := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}})
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetClient("cluster1", fakeClientset)
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+
+	// Create a namespace
+	req := httptest.NewRequest("POST", "/namespaces", strings.NewReader(`{"cluster":"cluster1","name":"team-a","labels":{"team":"a"}}`))
+	w := httptest.NewRecorder()
+	s.handleNamespacesHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200 on create, got %d: %s", w.Code, w.Body.String())
+	}
+
+	// Protected namespace is refused without force
+	req = httptest.NewRequest("DELETE", "/namespaces?cluster=cluster1&name=kube-system", nil)
+	w = httptest.NewRecorder()
+	s.handleNamespacesHTTP(w, req)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected 403 for protected namespace, got %d", w.Code)
+	}
+
+	// Forced deletion of a protected namespace goes through to the cluster
+	req = httptest.NewRequest("DELETE", "/namespaces?cluster=cluster1&name=kube-system&force=true", nil)
+	w = httptest.NewRecorder()
+	s.handleNamespacesHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected 200 on forced delete, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestServer_HandleResourceYAMLHTTP_Validation(t *testing.T) {
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetClient("cluster1", fake.NewSimpleClientset())
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+
+	// Mutations must be POST
+	req := httptest.NewRequest("GET", "/resource-yaml", nil)
+	w := httptest.NewRecorder()
+	s.handleResourceYAMLHTTP(w, req)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405 for GET, got %d", w.Code)
+	}
+
+	// A manifest without a kind is a client error, not a 500
+	body := `{"cluster":"cluster1","yaml":"apiVersion: v1\nmetadata:\n  name: cm\n"}`
+	req = httptest.NewRequest("POST", "/resource-yaml", strings.NewReader(body))
+	w = httptest.NewRecorder()
+	s.handleResourceYAMLHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for incomplete manifest, got %d: %s", w.Code, w.Body.String())
+	}
+
+	// Missing cluster is rejected before touching the cluster
+	req = httptest.NewRequest("POST", "/resource-yaml", strings.NewReader(`{"yaml":"kind: ConfigMap"}`))
+	w = httptest.NewRecorder()
+	s.handleResourceYAMLHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for missing cluster, got %d", w.Code)
+	}
+}
+
+func TestServer_HandleResourceLabelsHTTP(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	cm := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "team-a", Labels: map[string]string{"stale": "yes"}},
+	}
+	dynClient := dynfake.NewSimpleDynamicClient(scheme, cm)
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("cluster1", dynClient)
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	patch := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("PATCH", "/resources/cluster1/team-a/configmaps.v1/settings/labels", strings.NewReader(body))
+		req.SetPathValue("cluster", "cluster1")
+		req.SetPathValue("namespace", "team-a")
+		req.SetPathValue("gvr", "configmaps.v1")
+		req.SetPathValue("name", "settings")
+		w := httptest.NewRecorder()
+		s.handleResourceLabelsHTTP(w, req)
+		return w
+	}
+
+	// Add one label and remove another in the same patch
+	w := patch(`{"labels":{"owner":"team-a","stale":null}}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	obj, err := dynClient.Resource(gvr).Namespace("team-a").Get(t.Context(), "settings", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get configmap: %v", err)
+	}
+	labels := obj.GetLabels()
+	if labels["owner"] != "team-a" {
+		t.Errorf("Expected owner label to be added, got %v", labels)
+	}
+	if _, ok := labels["stale"]; ok {
+		t.Errorf("Expected stale label to be removed, got %v", labels)
+	}
+
+	// Invalid label values are a client error
+	if w := patch(`{"labels":{"owner":"not a valid value"}}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for invalid label value, got %d", w.Code)
+	}
+}
+
+func TestServer_HandleDeleteResourceHTTP(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	cm := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "team-a"},
+	}
+	dynClient := dynfake.NewSimpleDynamicClient(scheme, cm)
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("cluster1", dynClient)
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	del := func(cascade string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("DELETE", "/resources/cluster1/team-a/configmaps.v1/settings?cascade="+cascade, nil)
+		req.SetPathValue("cluster", "cluster1")
+		req.SetPathValue("namespace", "team-a")
+		req.SetPathValue("gvr", "configmaps.v1")
+		req.SetPathValue("name", "settings")
+		w := httptest.NewRecorder()
+		s.handleDeleteResourceHTTP(w, req)
+		return w
+	}
+
+	// Unknown cascade values are a client error and delete nothing
+	if w := del("sideways"); w.Code != http.StatusBadRequest {
+		t.Fatalf("Expected 400 for invalid cascade, got %d", w.Code)
+	}
+
+	// Resources the policy denies are refused before anything is deleted
+	s.resourcePolicy = k8s.NewGenericResourcePolicy("", "configmaps", true)
+	if w := del("foreground"); w.Code != http.StatusForbidden {
+		t.Fatalf("Expected 403 for a denied resource, got %d", w.Code)
+	}
+	s.resourcePolicy = k8s.GenericResourcePolicy{}
+
+	w := del("foreground")
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), `"propagation":"Foreground"`) {
+		t.Errorf("Expected Foreground propagation in response, got %s", w.Body.String())
+	}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	if _, err := dynClient.Resource(gvr).Namespace("team-a").Get(t.Context(), "settings", metav1.GetOptions{}); err == nil {
+		t.Error("Expected configmap to be deleted")
+	}
+
+	// Deleting it again surfaces the NotFound
+	if w := del(""); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for missing resource, got %d", w.Code)
+	}
+}
+
+func TestServer_HandleExportNamespaceHTTP(t *testing.T) {
+	deployObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "shop"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "c", "image": "nginx"}},
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "cfg", "configMap": map[string]interface{}{"name": "web-config"}},
+					},
+				},
+			},
+		},
+	}}
+	cmObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "web-config", "namespace": "shop"},
+	}}
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("cluster1", dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), deployTestListKinds(), deployObj, cmObj))
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	export := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/namespaces/cluster1/shop/export"+query, nil)
+		req.SetPathValue("cluster", "cluster1")
+		req.SetPathValue("namespace", "shop")
+		w := httptest.NewRecorder()
+		s.handleExportNamespaceHTTP(w, req)
+		return w
+	}
+
+	if w := export("?includeSecrets=maybe"); w.Code != http.StatusBadRequest {
+		t.Fatalf("Expected 400 for invalid includeSecrets, got %d", w.Code)
+	}
+
+	w := export("")
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/yaml" {
+		t.Errorf("Expected application/yaml, got %q", ct)
+	}
+	for _, want := range []string{"kind: Deployment", "kind: ConfigMap", "name: web-config"} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Errorf("Expected bundle to contain %q, got %s", want, w.Body.String())
+		}
+	}
+}
+
+func TestServer_HandleApplyBundleHTTP(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}},
+	}}
+	dynClient := dynfake.NewSimpleDynamicClient(runtime.NewScheme())
+	// The fake tracker cannot apply unstructured objects; create them instead.
+	dynClient.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+		return true, obj, dynClient.Tracker().Create(patch.GetResource(), obj, patch.GetNamespace())
+	})
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetClient("cluster1", clientset)
+	k8sClient.SetDynamicClient("cluster1", dynClient)
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	bundle := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n"
+	apply := func(query, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/bundles/apply"+query, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		s.handleApplyBundleHTTP(w, req)
+		return w
+	}
+
+	if w := apply("?namespace=team-a", bundle); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 without clusters, got %d", w.Code)
+	}
+	if w := apply("?clusters=cluster1", "kind: [\n"); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for malformed bundle, got %d", w.Code)
+	}
+
+	w := apply("?clusters=cluster1&namespace=team-a", bundle)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Success bool `json:"success"`
+		Applied int  `json:"applied"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !resp.Success || resp.Applied != 2 {
+		t.Errorf("Expected 2 applied resources, got %s", w.Body.String())
+	}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	for _, name := range []string{"a", "b"} {
+		if _, err := dynClient.Resource(gvr).Namespace("team-a").Get(t.Context(), name, metav1.GetOptions{}); err != nil {
+			t.Errorf("Expected configmap %s to be created: %v", name, err)
+		}
+	}
+}
+
+func TestServer_HandleCreateFromTemplateHTTP(t *testing.T) {
+	var entries []agentAuditEntry
+	origSink := auditSink
+	auditSink = func(e agentAuditEntry) { entries = append(entries, e) }
+	t.Cleanup(func() { auditSink = origSink })
+
+	jobsGVR := schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+	dynClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{jobsGVR: "JobList"})
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("cluster1", dynClient)
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	create := func(template, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/templates/"+template+"/create", strings.NewReader(body))
+		req.SetPathValue("name", template)
+		w := httptest.NewRecorder()
+		s.handleCreateFromTemplateHTTP(w, req)
+		return w
+	}
+
+	probe := `{"cluster":"cluster1","params":{"name":"probe","namespace":"ops","url":"http://web.ops"}}`
+	if w := create("curl-job", probe); w.Code != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d: %s", w.Code, w.Body.String())
+	}
+	job, err := dynClient.Resource(jobsGVR).Namespace("ops").Get(t.Context(), "probe", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected job to be created: %v", err)
+	}
+	if got := job.GetLabels()["kubestellar.io/template"]; got != "curl-job" {
+		t.Errorf("Expected template label curl-job, got %q", got)
+	}
+	if len(entries) != 1 || entries[0].Action != auditActionCreateFromTemplate || entries[0].Outcome != auditOutcomeSuccess {
+		t.Errorf("Expected one successful create_from_template audit entry, got %+v", entries)
+	}
+
+	if w := create("curl-job", probe); w.Code != http.StatusConflict {
+		t.Errorf("Expected 409 for an existing job, got %d", w.Code)
+	}
+	if w := create("curl-job", `{"cluster":"cluster1","params":{"name":"probe","namespace":"kube-system","url":"http://web"}}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for a system namespace, got %d", w.Code)
+	}
+	if w := create("crypto-miner", `{"cluster":"cluster1","params":{"name":"probe","namespace":"ops"}}`); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown template, got %d", w.Code)
+	}
+}
+
+func TestServer_HandleConfigMapDataHTTP(t *testing.T) {
+	fakeClientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "team-a"},
+		Data:       map[string]string{"mode": "dev"},
+		BinaryData: map[string][]byte{"cert.der": {0x30, 0x82}},
+	})
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetClient("cluster1", fakeClientset)
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	do := func(method, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/configmaps/cluster1/team-a/settings/data", strings.NewReader(body))
+		req.SetPathValue("cluster", "cluster1")
+		req.SetPathValue("namespace", "team-a")
+		req.SetPathValue("name", "settings")
+		w := httptest.NewRecorder()
+		s.handleConfigMapDataHTTP(w, req)
+		return w
+	}
+
+	w := do("GET", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var got struct {
+		ConfigMap k8s.ConfigMapData `json:"configmap"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.ConfigMap.Data["mode"] != "dev" || got.ConfigMap.BinaryData["cert.der"] != "MII=" {
+		t.Errorf("unexpected configmap data: %+v", got.ConfigMap)
+	}
+
+	w = do("PUT", `{"data":{"mode":"prod"},"binaryData":{"cert.der":"AQI="}}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	cm, err := fakeClientset.CoreV1().ConfigMaps("team-a").Get(t.Context(), "settings", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get configmap: %v", err)
+	}
+	if cm.Data["mode"] != "prod" || string(cm.BinaryData["cert.der"]) != "\x01\x02" {
+		t.Errorf("configmap not updated: data=%v binaryData=%v", cm.Data, cm.BinaryData)
+	}
+
+	if w := do("PUT", `{"binaryData":{"cert.der":"%%%"}}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for invalid base64, got %d", w.Code)
+	}
+	if w := do("DELETE", ""); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405 for DELETE, got %d", w.Code)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/kubestellar/console/pkg/agent/protocol"
+	"github.com/kubestellar/console/pkg/api/v1alpha1"
 	"github.com/kubestellar/console/pkg/k8s"
 )
 
@@ -148,6 +150,7 @@ func (s *Server) handleScaleHTTP(w http.ResponseWriter, r *http.Request) {
 	defer cancel()
 
 	result, err := s.k8sClient.ScaleWorkload(ctx, namespace, name, targetClusters, replicas)
+	recordClusterAudit(auditActionScaleWorkload, namespace, "workload", name, targetClusters, result, err)
 	if err != nil {
 		slog.Warn("error scaling resource", "namespace", namespace, "name", name, "targetClusters", targetClusters, "error", err)
 		writeJSON(w, map[string]interface{}{
@@ -167,55 +170,65 @@ func (s *Server) handleScaleHTTP(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
-// handleDeployWorkloadHTTP deploys a workload from a source cluster to one or
-// more target clusters via the shared pkg/k8s MultiClusterClient.DeployWorkload
-// method. The agent uses the user's kubeconfig rather than the backend's pod
-// ServiceAccount, so this endpoint is the user-kubeconfig path for
-// `/api/workloads/deploy` (#7993 Phase 1 PR B).
-//
-// Only POST with a JSON body is accepted; GET-based mutations are rejected to
-// prevent CSRF-style attacks (#4150 pattern, same as handleScaleHTTP).
-func (s *Server) handleDeployWorkloadHTTP(w http.ResponseWriter, r *http.Request) {
-	// POST-only deploy endpoint — preflight must advertise POST (#8021, #8201).
-	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
-	w.Header().Set("Content-Type", "application/json")
-	if r.Method == "OPTIONS" {
-		w.WriteHeader(http.StatusNoContent)
-		return
-	}
+// deployWorkloadRequest matches the backend's DeployWorkload request shape so
+// the frontend can send the same payload to either endpoint during migration.
+type deployWorkloadRequest struct {
+	WorkloadName   string   `json:"workloadName"`
+	Namespace      string   `json:"namespace"`
+	SourceCluster  string   `json:"sourceCluster"`
+	TargetClusters []string `json:"targetClusters"`
+	Replicas       int32    `json:"replicas,omitempty"`
+	GroupName      string   `json:"groupName,omitempty"`
+	// Optional informational annotation. The agent runs under the user's
+	// own kubeconfig so the "deployedBy" label is not security-relevant;
+	// it's only used to annotate created resources. If unset, falls back
+	// to the anonymous marker used by MultiClusterClient.DeployWorkload.
+	DeployedBy string `json:"deployedBy,omitempty"`
+	// Atomic rolls back everything the deploy created if any target
+	// cluster fails (see k8s.DeployOptions.Atomic).
+	Atomic bool `json:"atomic,omitempty"`
+	// ImageOverrides maps container name to replacement image (see
+	// k8s.DeployOptions.ImageOverrides).
+	ImageOverrides map[string]string `json:"imageOverrides,omitempty"`
+	// TargetNamespace and CreateNamespace deploy into a different namespace
+	// on the targets (see k8s.DeployOptions).
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+	CreateNamespace bool   `json:"createNamespace,omitempty"`
+	// StripAnnotations, ClearNodeName, StripNodeSelector, StripFinalizers
+	// and KeepStatus adjust how the source manifests are sanitized (see
+	// k8s.DeployOptions).
+	StripAnnotations  []string `json:"stripAnnotations,omitempty"`
+	ClearNodeName     bool     `json:"clearNodeName,omitempty"`
+	StripNodeSelector bool     `json:"stripNodeSelector,omitempty"`
+	StripFinalizers   bool     `json:"stripFinalizers,omitempty"`
+	KeepStatus        bool     `json:"keepStatus,omitempty"`
+}
 
-	// SECURITY: Require auth — deploying is a mutating operation.
-	if !s.validateToken(r) {
-		w.WriteHeader(http.StatusUnauthorized)
-		writeJSON(w, map[string]string{"error": "unauthorized"})
-		return
+// deployOptions returns the k8s.DeployOptions the request asks for.
+func (req *deployWorkloadRequest) deployOptions() *k8s.DeployOptions {
+	opts := &k8s.DeployOptions{
+		DeployedBy:        req.DeployedBy,
+		GroupName:         req.GroupName,
+		Atomic:            req.Atomic,
+		ImageOverrides:    req.ImageOverrides,
+		TargetNamespace:   req.TargetNamespace,
+		CreateNamespace:   req.CreateNamespace,
+		StripAnnotations:  req.StripAnnotations,
+		ClearNodeName:     req.ClearNodeName,
+		StripNodeSelector: req.StripNodeSelector,
+		StripFinalizers:   req.StripFinalizers,
+		KeepStatus:        req.KeepStatus,
 	}
-
-	// SECURITY: Only allow POST — GET mutations enable CSRF.
-	if r.Method != "POST" {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		writeJSON(w, map[string]interface{}{
-			"success": false,
-			"error":   "POST required",
-		})
-		return
+	if opts.DeployedBy == "" {
+		opts.DeployedBy = deployedByAnonymousMarker
 	}
+	return opts
+}
 
-	// Matches the backend's DeployWorkload request shape so the frontend can
-	// send the same payload to either endpoint during migration.
-	var req struct {
-		WorkloadName   string   `json:"workloadName"`
-		Namespace      string   `json:"namespace"`
-		SourceCluster  string   `json:"sourceCluster"`
-		TargetClusters []string `json:"targetClusters"`
-		Replicas       int32    `json:"replicas,omitempty"`
-		GroupName      string   `json:"groupName,omitempty"`
-		// Optional informational annotation. The agent runs under the user's
-		// own kubeconfig so the "deployedBy" label is not security-relevant;
-		// it's only used to annotate created resources. If unset, falls back
-		// to the anonymous marker used by MultiClusterClient.DeployWorkload.
-		DeployedBy string `json:"deployedBy,omitempty"`
-	}
+// decodeDeployWorkloadRequest reads and validates a deploy request body. On
+// failure it writes the JSON error response and returns false.
+func decodeDeployWorkloadRequest(w http.ResponseWriter, r *http.Request) (*deployWorkloadRequest, bool) {
+	var req deployWorkloadRequest
 	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -223,53 +236,110 @@ func (s *Server) handleDeployWorkloadHTTP(w http.ResponseWriter, r *http.Request
 			"success": false,
 			"error":   "invalid request body",
 		})
-		return
+		return nil, false
 	}
 
 	if req.WorkloadName == "" {
 		w.WriteHeader(http.StatusBadRequest)
 		writeJSON(w, map[string]interface{}{"success": false, "error": "workloadName is required"})
-		return
+		return nil, false
 	}
 	if req.Namespace == "" {
 		w.WriteHeader(http.StatusBadRequest)
 		writeJSON(w, map[string]interface{}{"success": false, "error": "namespace is required"})
-		return
+		return nil, false
 	}
 	if req.SourceCluster == "" {
 		w.WriteHeader(http.StatusBadRequest)
 		writeJSON(w, map[string]interface{}{"success": false, "error": "sourceCluster is required"})
-		return
+		return nil, false
 	}
 	if len(req.TargetClusters) == 0 {
 		w.WriteHeader(http.StatusBadRequest)
 		writeJSON(w, map[string]interface{}{"success": false, "error": "at least one targetCluster is required"})
-		return
+		return nil, false
 	}
 
 	if err := validateDNS1123Label("workloadName", req.WorkloadName); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
-		return
+		return nil, false
 	}
 	if err := validateDNS1123Label("namespace", req.Namespace); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
-		return
+		return nil, false
+	}
+	if req.TargetNamespace != "" {
+		if err := validateDNS1123Label("targetNamespace", req.TargetNamespace); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": err.Error()})
+			return nil, false
+		}
 	}
 	if err := validateKubeContext(req.SourceCluster); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		writeJSON(w, map[string]interface{}{"success": false, "error": fmt.Sprintf("sourceCluster: %v", err)})
-		return
+		return nil, false
 	}
 	for _, tc := range req.TargetClusters {
 		if err := validateKubeContext(tc); err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			writeJSON(w, map[string]interface{}{"success": false, "error": fmt.Sprintf("targetCluster: %v", err)})
-			return
+			return nil, false
 		}
 	}
 
+	return &req, true
+}
+
+// deployTargetNamespace is the namespace a deploy writes to on the targets.
+func deployTargetNamespace(req *deployWorkloadRequest) string {
+	if req.TargetNamespace != "" {
+		return req.TargetNamespace
+	}
+	return req.Namespace
+}
+
+// handleDeployWorkloadHTTP deploys a workload from a source cluster to one or
+// more target clusters via the shared pkg/k8s MultiClusterClient.DeployWorkload
+// method. The agent uses the user's kubeconfig rather than the backend's pod
+// ServiceAccount, so this endpoint is the user-kubeconfig path for
+// `/api/workloads/deploy` (#7993 Phase 1 PR B).
+//
+// Only POST with a JSON body is accepted; GET-based mutations are rejected to
+// prevent CSRF-style attacks (#4150 pattern, same as handleScaleHTTP).
+func (s *Server) handleDeployWorkloadHTTP(w http.ResponseWriter, r *http.Request) {
+	// POST-only deploy endpoint — preflight must advertise POST (#8021, #8201).
+	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// SECURITY: Require auth — deploying is a mutating operation.
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	// SECURITY: Only allow POST — GET mutations enable CSRF.
+	if r.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{
+			"success": false,
+			"error":   "POST required",
+		})
+		return
+	}
+
+	req, ok := decodeDeployWorkloadRequest(w, r)
+	if !ok {
+		return
+	}
+
 	if s.k8sClient == nil {
 		w.WriteHeader(http.StatusServiceUnavailable)
 		writeJSON(w, map[string]interface{}{
@@ -279,21 +349,20 @@ func (s *Server) handleDeployWorkloadHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	opts := &k8s.DeployOptions{
-		DeployedBy: req.DeployedBy,
-		GroupName:  req.GroupName,
-	}
-	if opts.DeployedBy == "" {
-		opts.DeployedBy = deployedByAnonymousMarker
-	}
+	opts := req.deployOptions()
 
 	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
 	defer cancel()
 
 	result, err := s.k8sClient.DeployWorkload(ctx, req.SourceCluster, req.Namespace, req.WorkloadName, req.TargetClusters, req.Replicas, opts)
+	recordClusterAudit(auditActionDeployWorkload, deployTargetNamespace(req), "workload", req.WorkloadName, req.TargetClusters, result, err)
 	if err != nil {
 		slog.Warn("error deploying workload", "namespace", req.Namespace, "name", req.WorkloadName, "sourceCluster", req.SourceCluster, "targetClusters", req.TargetClusters, "error", err)
-		w.WriteHeader(http.StatusInternalServerError)
+		status := http.StatusInternalServerError
+		if errors.Is(err, k8s.ErrInvalidImageOverride) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
 		writeJSON(w, map[string]interface{}{
 			"success": false,
 			"error":   err.Error(),
@@ -311,6 +380,109 @@ func (s *Server) handleDeployWorkloadHTTP(w http.ResponseWriter, r *http.Request
 		"failedClusters": result.FailedClusters,
 		"dependencies":   result.Dependencies,
 		"warnings":       result.Warnings,
+		"rollback":       result.Rollback,
+		"source":         "agent",
+	})
+}
+
+// deployStreamSSETimeout bounds the write deadline of a streamed deploy. It
+// is longer than agentExtendedTimeout so the final "done" event still gets
+// out after a deploy that used its whole budget.
+const deployStreamSSETimeout = 2 * time.Minute
+
+// handleDeployWorkloadStreamSSE is the streaming variant of
+// handleDeployWorkloadHTTP. It accepts the same POST body and emits one
+// "progress" SSE event (v1alpha1.DeployProgress) each time a dependency or
+// the workload starts applying, is applied, or fails on a target cluster,
+// followed by a terminal "done" event carrying the same summary as the
+// non-streaming endpoint. A failed item does not stop the remaining items
+// on other clusters.
+func (s *Server) handleDeployWorkloadStreamSSE(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
+	// Errors before the stream starts are plain JSON responses.
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// SECURITY: Require auth — deploying is a mutating operation.
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "POST required"})
+		return
+	}
+
+	req, ok := decodeDeployWorkloadRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{"success": false, "error": "k8s client not initialized"})
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	rc := http.NewResponseController(w)
+	rc.SetWriteDeadline(time.Now().Add(deployStreamSSETimeout))
+
+	// Target clusters deploy in parallel, so progress callbacks race.
+	var mu sync.Mutex
+	writeEvent := func(event string, payload interface{}) {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			slog.Error("[SSE] failed to marshal deploy event", "event", event, "error", err)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+		flusher.Flush()
+	}
+
+	opts := req.deployOptions()
+	opts.OnProgress = func(p v1alpha1.DeployProgress) { writeEvent("progress", p) }
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	result, err := s.k8sClient.DeployWorkload(ctx, req.SourceCluster, req.Namespace, req.WorkloadName, req.TargetClusters, req.Replicas, opts)
+	recordClusterAudit(auditActionDeployWorkload, deployTargetNamespace(req), "workload", req.WorkloadName, req.TargetClusters, result, err)
+	if err != nil {
+		slog.Warn("error deploying workload", "namespace", req.Namespace, "name", req.WorkloadName, "sourceCluster", req.SourceCluster, "targetClusters", req.TargetClusters, "error", err)
+		mu.Lock()
+		sseWriteError(w, flusher, err.Error())
+		mu.Unlock()
+		return
+	}
+
+	writeEvent("done", map[string]interface{}{
+		"success":        result.Success,
+		"message":        result.Message,
+		"deployedTo":     result.DeployedTo,
+		"failedClusters": result.FailedClusters,
+		"dependencies":   result.Dependencies,
+		"warnings":       result.Warnings,
+		"rollback":       result.Rollback,
 		"source":         "agent",
 	})
 }
@@ -402,7 +574,13 @@ func (s *Server) handleDeleteWorkloadHTTP(w http.ResponseWriter, r *http.Request
 	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
 	defer cancel()
 
-	if err := s.k8sClient.DeleteWorkload(ctx, req.Cluster, req.Namespace, req.Name); err != nil {
+	err := s.k8sClient.DeleteWorkload(ctx, req.Cluster, req.Namespace, req.Name)
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	}
+	recordAudit(auditActionDeleteWorkload, req.Cluster, req.Namespace, "workload", req.Name, errMsg)
+	if err != nil {
 		slog.Warn("error deleting workload", "cluster", req.Cluster, "namespace", req.Namespace, "name", req.Name, "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		writeJSON(w, map[string]interface{}{
@@ -423,6 +601,112 @@ func (s *Server) handleDeleteWorkloadHTTP(w http.ResponseWriter, r *http.Request
 	})
 }
 
+// handleBatchWorkloadsHTTP runs scale / restart / delete on several
+// workloads at once via MultiClusterClient.BatchOperate, under the user's
+// kubeconfig like the single-workload endpoints (#7993). The body is
+// {"operations": [{cluster, namespace, kind, name, action, replicas?}]}.
+// The response carries one result per operation in request order; a
+// failing operation does not abort the rest, so the status is 200 unless
+// the batch itself is malformed.
+func (s *Server) handleBatchWorkloadsHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// SECURITY: Require auth — batches can scale and delete workloads.
+	if !s.validateToken(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	if r.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		writeJSON(w, map[string]interface{}{
+			"success": false,
+			"error":   "POST required",
+		})
+		return
+	}
+
+	var req struct {
+		Operations []k8s.BatchOp `json:"operations"`
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]interface{}{
+			"success": false,
+			"error":   "invalid request body",
+		})
+		return
+	}
+
+	for i, op := range req.Operations {
+		if err := validateKubeContext(op.Cluster); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": fmt.Sprintf("operation %d: cluster: %v", i, err)})
+			return
+		}
+		if err := validateDNS1123Label("namespace", op.Namespace); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": fmt.Sprintf("operation %d: %v", i, err)})
+			return
+		}
+		if err := validateDNS1123Label("name", op.Name); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": fmt.Sprintf("operation %d: %v", i, err)})
+			return
+		}
+	}
+
+	if s.k8sClient == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		writeJSON(w, map[string]interface{}{
+			"success": false,
+			"error":   "k8s client not initialized",
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	results, err := s.k8sClient.BatchOperate(ctx, req.Operations)
+	if err != nil {
+		if errors.Is(err, k8s.ErrInvalidBatchOp) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]interface{}{"success": false, "error": err.Error(), "source": "agent"})
+			return
+		}
+		status, msg := mapK8sErrorToHTTP(err)
+		w.WriteHeader(status)
+		writeJSON(w, map[string]interface{}{"success": false, "error": msg, "source": "agent"})
+		return
+	}
+
+	succeeded := 0
+	for _, res := range results {
+		recordAudit(auditActionBatchWorkload, res.Op.Cluster, res.Op.Namespace, res.Op.Kind, res.Op.Name, res.Error)
+		if res.Success {
+			succeeded++
+		} else {
+			slog.Warn("batch workload operation failed", "cluster", res.Op.Cluster, "namespace", res.Op.Namespace,
+				"name", res.Op.Name, "action", res.Op.Action, "error", res.Error)
+		}
+	}
+	writeJSON(w, map[string]interface{}{
+		"success":   succeeded == len(results),
+		"succeeded": succeeded,
+		"failed":    len(results) - succeeded,
+		"results":   results,
+		"source":    "agent",
+	})
+}
+
 // handlePodsHTTP returns pods for a cluster/namespace
 func (s *Server) handlePodsHTTP(w http.ResponseWriter, r *http.Request) {
 	s.setCORSHeaders(w, r)
@@ -2,12 +2,20 @@ package agent
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/kubestellar/console/pkg/api/v1alpha1"
 	"github.com/kubestellar/console/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynfake "k8s.io/client-go/dynamic/fake"
 )
 
 func TestServer_HandleScaleHTTP(t *testing.T) {
@@ -47,6 +55,54 @@ func TestServer_HandleScaleHTTP(t *testing.T) {
 	}
 }
 
+func TestServer_HandleScaleHTTP_RecordsAudit(t *testing.T) {
+	var entries []agentAuditEntry
+	origSink := auditSink
+	auditSink = func(e agentAuditEntry) { entries = append(entries, e) }
+	t.Cleanup(func() { auditSink = origSink })
+
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("east", dynfake.NewSimpleDynamicClient(runtime.NewScheme(), &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "api", "namespace": "default"},
+		"spec":       map[string]interface{}{"replicas": int64(1)},
+	}}))
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"workloadName":   "api",
+		"namespace":      "default",
+		"targetClusters": []string{"east", "missing"},
+		"replicas":       3,
+	})
+	req := httptest.NewRequest("POST", "/workloads/scale", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.handleScaleHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected one audit entry per target cluster, got %+v", entries)
+	}
+	byCluster := map[string]agentAuditEntry{}
+	for _, e := range entries {
+		byCluster[e.Cluster] = e
+	}
+	east := byCluster["east"]
+	if east.Action != auditActionScaleWorkload || east.Namespace != "default" || east.Kind != "workload" ||
+		east.Name != "api" || east.Outcome != auditOutcomeSuccess || east.Time.IsZero() {
+		t.Errorf("Unexpected audit entry for east: %+v", east)
+	}
+	if missing := byCluster["missing"]; missing.Outcome != auditOutcomeFailure || missing.Error == "" {
+		t.Errorf("Expected a failed audit entry for the unregistered cluster, got %+v", missing)
+	}
+}
+
 func TestServer_HandleDeployWorkloadHTTP_Validation(t *testing.T) {
 	s := &Server{
 		allowedOrigins: []string{"*"},
@@ -85,3 +141,285 @@ func TestServer_HandlePodsHTTP(t *testing.T) {
 		t.Errorf("Expected 503 for unregistered cluster, got %d", w.Code)
 	}
 }
+
+// deployTestListKinds registers the list kinds DeployWorkload's dependency
+// resolution lists on the fake dynamic clients.
+func deployTestListKinds() map[schema.GroupVersionResource]string {
+	return map[schema.GroupVersionResource]string{
+		{Group: "apps", Version: "v1", Resource: "deployments"}:                       "DeploymentList",
+		{Group: "apps", Version: "v1", Resource: "statefulsets"}:                      "StatefulSetList",
+		{Group: "apps", Version: "v1", Resource: "daemonsets"}:                        "DaemonSetList",
+		{Version: "v1", Resource: "services"}:                                         "ServiceList",
+		{Version: "v1", Resource: "configmaps"}:                                       "ConfigMapList",
+		{Version: "v1", Resource: "secrets"}:                                          "SecretList",
+		{Version: "v1", Resource: "serviceaccounts"}:                                  "ServiceAccountList",
+		{Version: "v1", Resource: "persistentvolumeclaims"}:                           "PersistentVolumeClaimList",
+		{Version: "v1", Resource: "namespaces"}:                                       "NamespaceList",
+		{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}:            "IngressList",
+		{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}:      "NetworkPolicyList",
+		{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}:   "HorizontalPodAutoscalerList",
+		{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}:            "PodDisruptionBudgetList",
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}:        "RoleList",
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}: "RoleBindingList",
+	}
+}
+
+func TestServer_HandleDeployWorkloadStreamSSE(t *testing.T) {
+	listKinds := deployTestListKinds()
+	deployObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "c", "image": "nginx"}},
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "cfg", "configMap": map[string]interface{}{"name": "web-config"}},
+					},
+				},
+			},
+		},
+	}}
+	cmObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "web-config", "namespace": "default"},
+	}}
+
+	scheme := runtime.NewScheme()
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("src", dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, deployObj, cmObj))
+	k8sClient.SetDynamicClient("tgt", dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds))
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"workloadName":   "web",
+		"namespace":      "default",
+		"sourceCluster":  "src",
+		"targetClusters": []string{"tgt"},
+	})
+	req := httptest.NewRequest("POST", "/workloads/deploy/stream", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.handleDeployWorkloadStreamSSE(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Expected text/event-stream, got %q", ct)
+	}
+
+	var progress []v1alpha1.DeployProgress
+	var done map[string]interface{}
+	for _, block := range strings.Split(strings.TrimSpace(w.Body.String()), "\n\n") {
+		lines := strings.SplitN(block, "\n", 2)
+		if len(lines) != 2 {
+			t.Fatalf("malformed SSE block: %q", block)
+		}
+		data := strings.TrimPrefix(lines[1], "data: ")
+		switch lines[0] {
+		case "event: progress":
+			var p v1alpha1.DeployProgress
+			if err := json.Unmarshal([]byte(data), &p); err != nil {
+				t.Fatalf("decode progress: %v", err)
+			}
+			progress = append(progress, p)
+		case "event: done":
+			if err := json.Unmarshal([]byte(data), &done); err != nil {
+				t.Fatalf("decode done: %v", err)
+			}
+		default:
+			t.Errorf("unexpected event %q", lines[0])
+		}
+	}
+
+	want := []string{
+		"ConfigMap/web-config applying",
+		"ConfigMap/web-config applied",
+		"Deployment/web applying",
+		"Deployment/web applied",
+	}
+	got := make([]string, 0, len(progress))
+	for _, p := range progress {
+		if p.Cluster != "tgt" {
+			t.Errorf("unexpected cluster in %+v", p)
+		}
+		got = append(got, p.Kind+"/"+p.Name+" "+p.Status)
+	}
+	if strings.Join(got, "; ") != strings.Join(want, "; ") {
+		t.Errorf("progress = %v, want %v", got, want)
+	}
+	if done == nil || done["success"] != true {
+		t.Errorf("Expected successful done event, got %v", done)
+	}
+}
+
+func TestServer_HandleDeployWorkloadHTTP_UnknownImageOverride(t *testing.T) {
+	deployObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "app", "image": "nginx"}},
+				},
+			},
+		},
+	}}
+
+	scheme := runtime.NewScheme()
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("src", dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, deployTestListKinds(), deployObj))
+	k8sClient.SetDynamicClient("tgt", dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, deployTestListKinds()))
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"workloadName":   "web",
+		"namespace":      "default",
+		"sourceCluster":  "src",
+		"targetClusters": []string{"tgt"},
+		"imageOverrides": map[string]string{"worker": "registry.example.com/worker:2"},
+	})
+	req := httptest.NewRequest("POST", "/workloads/deploy", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.handleDeployWorkloadHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("Expected 400 for unknown container, got %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "worker") {
+		t.Errorf("Expected error to name the container, got %s", w.Body.String())
+	}
+}
+
+func TestServer_HandleDeployWorkloadHTTP_SanitizeOptions(t *testing.T) {
+	deployObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":       "web",
+			"namespace":  "default",
+			"finalizers": []interface{}{"example.com/protect"},
+			"annotations": map[string]interface{}{
+				"example.com/owner": "team-a",
+				"keep.io/note":      "kept",
+			},
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"nodeName":     "src-node-1",
+					"nodeSelector": map[string]interface{}{"pool": "gpu"},
+					"containers":   []interface{}{map[string]interface{}{"name": "app", "image": "nginx"}},
+				},
+			},
+		},
+	}}
+
+	scheme := runtime.NewScheme()
+	tgt := dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, deployTestListKinds())
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("src", dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, deployTestListKinds(), deployObj))
+	k8sClient.SetDynamicClient("tgt", tgt)
+
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"workloadName":      "web",
+		"namespace":         "default",
+		"sourceCluster":     "src",
+		"targetClusters":    []string{"tgt"},
+		"stripAnnotations":  []string{"example.com/"},
+		"clearNodeName":     true,
+		"stripNodeSelector": true,
+		"stripFinalizers":   true,
+	})
+	req := httptest.NewRequest("POST", "/workloads/deploy", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.handleDeployWorkloadHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	deployed, err := tgt.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).
+		Namespace("default").Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected web to be deployed to tgt: %v", err)
+	}
+	if _, ok := deployed.GetAnnotations()["example.com/owner"]; ok {
+		t.Errorf("Expected example.com/ annotations to be stripped, got %v", deployed.GetAnnotations())
+	}
+	if deployed.GetAnnotations()["keep.io/note"] != "kept" {
+		t.Errorf("Expected other annotations to be kept, got %v", deployed.GetAnnotations())
+	}
+	if len(deployed.GetFinalizers()) != 0 {
+		t.Errorf("Expected finalizers to be stripped, got %v", deployed.GetFinalizers())
+	}
+	podSpec, _, _ := unstructured.NestedMap(deployed.Object, "spec", "template", "spec")
+	if _, ok := podSpec["nodeName"]; ok {
+		t.Errorf("Expected nodeName to be cleared, got %v", podSpec["nodeName"])
+	}
+	if _, ok := podSpec["nodeSelector"]; ok {
+		t.Errorf("Expected nodeSelector to be stripped, got %v", podSpec["nodeSelector"])
+	}
+}
+
+func TestServer_HandleBatchWorkloadsHTTP(t *testing.T) {
+	deployment := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+			"spec":       map[string]interface{}{"replicas": int64(1)},
+		}}
+	}
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetDynamicClient("east", dynfake.NewSimpleDynamicClient(runtime.NewScheme(), deployment("api")))
+	k8sClient.SetDynamicClient("west", dynfake.NewSimpleDynamicClient(runtime.NewScheme(), deployment("worker")))
+	s := &Server{
+		k8sClient:      k8sClient,
+		allowedOrigins: []string{"*"},
+	}
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/workloads/batch", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		s.handleBatchWorkloadsHTTP(w, req)
+		return w
+	}
+
+	w := post(`{"operations":[
+		{"cluster":"east","namespace":"default","kind":"Deployment","name":"api","action":"scale","replicas":3},
+		{"cluster":"west","namespace":"default","kind":"Deployment","name":"worker","action":"delete"}
+	]}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Success bool              `json:"success"`
+		Results []k8s.BatchResult `json:"results"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !resp.Success || len(resp.Results) != 2 {
+		t.Fatalf("Expected two successful results, got %+v", resp)
+	}
+	if resp.Results[0].Op.Action != "scale" || resp.Results[1].Op.Cluster != "west" {
+		t.Errorf("Results out of order: %+v", resp.Results)
+	}
+
+	// An unknown action rejects the whole batch before anything runs
+	if w := post(`{"operations":[{"cluster":"east","namespace":"default","kind":"Deployment","name":"api","action":"pause"}]}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unknown action, got %d", w.Code)
+	}
+}
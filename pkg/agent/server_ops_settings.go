@@ -161,6 +161,67 @@ func (s *Server) handleSettingsAll(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// allowedOriginsRequest is the PUT body for /settings/allowed-origins.
+type allowedOriginsRequest struct {
+	Origins []string `json:"origins"`
+}
+
+// handleSettingsAllowedOrigins handles GET and PUT for /settings/allowed-origins.
+// PUT replaces the custom CORS/WebSocket origins in memory; the built-in
+// defaults are always kept. Changes last until restart, after which
+// KC_ALLOWED_ORIGINS and --allowed-origins apply again.
+func (s *Server) handleSettingsAllowedOrigins(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodGet, http.MethodPut, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// SECURITY: Validate token — this endpoint widens who may call the agent
+	if !s.validateToken(r) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+	case "PUT":
+		defer r.Body.Close()
+		var req allowedOriginsRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestBodyBytes)).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(protocol.ErrorPayload{Code: "invalid_body", Message: "Invalid request body"})
+			return
+		}
+		if err := s.SetAllowedOrigins(req.Origins); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(protocol.ErrorPayload{Code: "invalid_origin", Message: err.Error()})
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		json.NewEncoder(w).Encode(protocol.ErrorPayload{Code: "method_not_allowed", Message: "GET or PUT required"})
+		return
+	}
+
+	isDefault := make(map[string]bool, len(defaultAllowedOrigins))
+	for _, o := range defaultAllowedOrigins {
+		isDefault[o] = true
+	}
+	custom := []string{}
+	for _, o := range s.AllowedOrigins() {
+		if !isDefault[o] {
+			custom = append(custom, o)
+		}
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"defaults": defaultAllowedOrigins,
+		"origins":  custom,
+	})
+}
+
 // handleSettingsExport handles POST for /settings/export (returns encrypted backup)
 func (s *Server) handleSettingsExport(w http.ResponseWriter, r *http.Request) {
 	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
@@ -98,3 +98,77 @@ func TestServer_HandleGetKeysStatus(t *testing.T) {
 		t.Errorf("Expected config path %s, got %s", tmpConfig.Name(), resp.ConfigPath)
 	}
 }
+
+func TestServer_SetAllowedOrigins_Runtime(t *testing.T) {
+	s := &Server{allowedOrigins: append([]string{}, defaultAllowedOrigins...)}
+
+	if s.isAllowedOrigin("https://console.example.com") {
+		t.Fatal("origin should not be allowed before it is configured")
+	}
+
+	if err := s.SetAllowedOrigins([]string{"https://console.example.com", "https://*.corp.example"}); err != nil {
+		t.Fatalf("SetAllowedOrigins: %v", err)
+	}
+	if !s.isAllowedOrigin("https://console.example.com") {
+		t.Error("exact origin should be allowed after update")
+	}
+	if !s.isAllowedOrigin("https://app.corp.example") {
+		t.Error("wildcard origin should be allowed after update")
+	}
+	if !s.isAllowedOrigin("http://localhost:5174") {
+		t.Error("default origins must survive an update")
+	}
+
+	// Replacing the list drops origins that are no longer present.
+	if err := s.SetAllowedOrigins(nil); err != nil {
+		t.Fatalf("SetAllowedOrigins(nil): %v", err)
+	}
+	if s.isAllowedOrigin("https://console.example.com") {
+		t.Error("removed origin should no longer be allowed")
+	}
+
+	// Invalid entries are rejected without touching the current list.
+	if err := s.SetAllowedOrigins([]string{"https://ok.example", "console.example.com"}); err == nil {
+		t.Error("expected an error for an origin without a scheme")
+	}
+	if s.isAllowedOrigin("https://ok.example") {
+		t.Error("a rejected update must not be partially applied")
+	}
+}
+
+func TestServer_HandleSettingsAllowedOrigins(t *testing.T) {
+	s := &Server{allowedOrigins: append([]string{}, defaultAllowedOrigins...)}
+
+	body, _ := json.Marshal(allowedOriginsRequest{Origins: []string{"https://console.example.com"}})
+	req := httptest.NewRequest("PUT", "/settings/allowed-origins", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.handleSettingsAllowedOrigins(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("PUT: expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	req = httptest.NewRequest("GET", "/settings/allowed-origins", nil)
+	w = httptest.NewRecorder()
+	s.handleSettingsAllowedOrigins(w, req)
+	var resp struct {
+		Defaults []string `json:"defaults"`
+		Origins  []string `json:"origins"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(resp.Origins) != 1 || resp.Origins[0] != "https://console.example.com" {
+		t.Errorf("origins = %v, want [https://console.example.com]", resp.Origins)
+	}
+	if len(resp.Defaults) != len(defaultAllowedOrigins) {
+		t.Errorf("defaults = %v", resp.Defaults)
+	}
+
+	body, _ = json.Marshal(allowedOriginsRequest{Origins: []string{"https://evil.example/path"}})
+	req = httptest.NewRequest("PUT", "/settings/allowed-origins", bytes.NewReader(body))
+	w = httptest.NewRecorder()
+	s.handleSettingsAllowedOrigins(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("invalid origin: expected 400, got %d", w.Code)
+	}
+}
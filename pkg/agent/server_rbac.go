@@ -178,3 +178,102 @@ func (s *Server) handlePermissionsSummaryHTTP(w http.ResponseWriter, r *http.Req
 	}
 	writeJSON(w, response)
 }
+
+// clusterCanIRequest is the body of POST /clusters/{cluster}/can-i. Either
+// a single check (Verb + Resource) or a deploy preflight (SourceCluster +
+// Workload): the workload's dependency bundle is resolved on SourceCluster
+// and every resource in it is checked for create in Namespace on the target.
+type clusterCanIRequest struct {
+	Namespace     string `json:"namespace"`
+	Verb          string `json:"verb,omitempty"`
+	Group         string `json:"group,omitempty"`
+	Resource      string `json:"resource,omitempty"`
+	SourceCluster string `json:"sourceCluster,omitempty"`
+	Workload      string `json:"workload,omitempty"`
+}
+
+// handleClusterCanIHTTP answers namespace-scoped "can I" questions for the
+// caller on a single cluster. A bundle check returns the full list of
+// denied permissions so the UI can explain why a deploy would fail before
+// anything is applied.
+func (s *Server) handleClusterCanIHTTP(w http.ResponseWriter, r *http.Request) {
+	s.setCORSHeaders(w, r, http.MethodPost, http.MethodOptions)
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if !s.validateToken(r) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if s.k8sClient == nil {
+		writeJSONError(w, http.StatusServiceUnavailable, "k8s client not initialized")
+		return
+	}
+
+	cluster := r.PathValue("cluster")
+	if err := validateKubeContext(cluster); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var req clusterCanIRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Namespace != "" {
+		if err := validateDNS1123Label("namespace", req.Namespace); err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	if req.Workload == "" {
+		if req.Verb == "" || req.Resource == "" {
+			writeJSONError(w, http.StatusBadRequest, "verb and resource, or sourceCluster and workload, are required")
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), k8s.RBACDefaultTimeout)
+		defer cancel()
+		allowed, err := s.k8sClient.CanI(ctx, cluster, req.Namespace, req.Verb, req.Group, req.Resource)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, map[string]interface{}{"allowed": allowed})
+		return
+	}
+
+	if err := validateKubeContext(req.SourceCluster); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "sourceCluster: "+err.Error())
+		return
+	}
+	if err := validateDNS1123Label("namespace", req.Namespace); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), agentExtendedTimeout)
+	defer cancel()
+
+	_, bundle, err := s.k8sClient.ResolveWorkloadDependencies(ctx, req.SourceCluster, req.Namespace, req.Workload)
+	if err != nil {
+		status, msg := mapK8sErrorToHTTP(err)
+		writeJSONError(w, status, msg)
+		return
+	}
+	denied, err := s.k8sClient.CanIDeployBundle(ctx, cluster, req.Namespace, bundle)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"allowed": len(denied) == 0,
+		"denied":  denied,
+	})
+}
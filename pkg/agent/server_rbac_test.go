@@ -1,9 +1,17 @@
 package agent
 
 import (
+	"encoding/json"
+	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"github.com/kubestellar/console/pkg/k8s"
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 // TestHandleCanIHTTP_CORSMethodsHeader verifies the POST-specific
@@ -87,3 +95,53 @@ func TestHandleServiceAccounts_CORSMethodsHeader(t *testing.T) {
 		}
 	}
 }
+
+// TestHandleClusterCanIHTTP_SingleCheck verifies the namespace-scoped check
+// passes the review result through and rejects bodies with nothing to check.
+func TestHandleClusterCanIHTTP_SingleCheck(t *testing.T) {
+	fakeCS := fake.NewSimpleClientset()
+	fakeCS.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authv1.SelfSubjectAccessReview)
+		allowed := review.Spec.ResourceAttributes.Resource != "secrets" || review.Spec.ResourceAttributes.Namespace != "prod"
+		return true, &authv1.SelfSubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: allowed}}, nil
+	})
+	k8sClient, _ := k8s.NewMultiClusterClient("")
+	k8sClient.SetClient("cluster1", fakeCS)
+	s := &Server{k8sClient: k8sClient, allowedOrigins: []string{"*"}}
+
+	tests := []struct {
+		name        string
+		body        string
+		wantStatus  int
+		wantAllowed bool
+	}{
+		{"allowed", `{"namespace":"dev","verb":"create","resource":"secrets"}`, http.StatusOK, true},
+		{"denied", `{"namespace":"prod","verb":"create","resource":"secrets"}`, http.StatusOK, false},
+		{"missing verb", `{"namespace":"prod","resource":"secrets"}`, http.StatusBadRequest, false},
+		{"bad namespace", `{"namespace":"Prod!","verb":"get","resource":"pods"}`, http.StatusBadRequest, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/clusters/cluster1/can-i", strings.NewReader(tt.body))
+			req.SetPathValue("cluster", "cluster1")
+			w := httptest.NewRecorder()
+			s.handleClusterCanIHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp struct {
+				Allowed bool `json:"allowed"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if resp.Allowed != tt.wantAllowed {
+				t.Errorf("allowed = %v, want %v", resp.Allowed, tt.wantAllowed)
+			}
+		})
+	}
+}
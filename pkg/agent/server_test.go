@@ -849,6 +849,63 @@ func TestMatchOrigin(t *testing.T) {
 	}
 }
 
+// TestMatchOrigin_WildcardBoundaries codifies exactly what a "*." rule
+// covers: one or more whole labels directly in front of the suffix, never a
+// bare suffix, a look-alike domain, or a string that only ends the same way.
+func TestMatchOrigin_WildcardBoundaries(t *testing.T) {
+	const rule = "https://*.ibm.com"
+	tests := []struct {
+		name   string
+		origin string
+		want   bool
+	}{
+		{"single label", "https://kc.ibm.com", true},
+		{"multiple labels", "https://deep.sub.ibm.com", true},
+		{"hyphenated label", "https://my-app.ibm.com", true},
+		{"uppercase host", "https://KC.IBM.com", true},
+		{"apex domain", "https://ibm.com", false},
+		{"suffix without dot boundary", "https://notibm.com", false},
+		{"hyphen look-alike", "https://evil-ibm.com", false},
+		{"rule domain as prefix", "https://ibm.com.evil.com", false},
+		{"subdomain of look-alike", "https://kc.ibm.com.evil.com", false},
+		{"empty label", "https://.ibm.com", false},
+		{"double dot", "https://a..ibm.com", false},
+		{"userinfo smuggling", "https://evil.com@kc.ibm.com", false},
+		{"path smuggling", "https://evil.com/.ibm.com", false},
+		{"fragment smuggling", "https://evil.com#.ibm.com", false},
+		{"leading hyphen label", "https://-kc.ibm.com", false},
+		{"port not allowed on wildcard", "https://kc.ibm.com:8443", false},
+		{"wrong scheme", "http://kc.ibm.com", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchOrigin(tt.origin, rule); got != tt.want {
+				t.Errorf("matchOrigin(%q, %q) = %v, want %v", tt.origin, rule, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchOrigin_MalformedWildcardRules(t *testing.T) {
+	tests := []struct {
+		origin  string
+		allowed string
+	}{
+		{"https://evil.kc.ibm.com", "https://evil.*.ibm.com"}, // wildcard not leftmost
+		{"https://kc.ibm.com", "https://*ibm.com"},            // no label boundary
+		{"https://kc.ibm.com", "*.ibm.com"},                   // missing scheme
+		{"https://a.b.ibm.com", "https://*.*.ibm.com"},        // more than one wildcard
+		{"https://anything.example", "*"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.allowed, func(t *testing.T) {
+			if matchOrigin(tt.origin, tt.allowed) {
+				t.Errorf("malformed rule %q must not match %q", tt.allowed, tt.origin)
+			}
+		})
+	}
+}
+
 func TestServer_HandleClustersHTTP_Unauthorized(t *testing.T) {
 	server := &Server{
 		kubectl:        &KubectlProxy{config: &api.Config{}},
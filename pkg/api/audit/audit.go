@@ -8,6 +8,7 @@ package audit
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"strings"
 	"sync"
@@ -31,6 +32,7 @@ const (
 	ActionCreateClusterGroup     = "create_cluster_group"
 	ActionUpdateClusterGroup     = "update_cluster_group"
 	ActionDeleteClusterGroup     = "delete_cluster_group"
+	ActionSetCurrentContext      = "set_current_context"
 	ActionSaveNotificationConfig = "save_notification_config"
 	ActionDeleteToken            = "delete_token"
 	ActionCreateResourceQuota    = "create_resource_quota"
@@ -46,6 +48,9 @@ const (
 	ActionUpdateGPUReservation = "update_gpu_reservation"
 	ActionDeleteGPUReservation = "delete_gpu_reservation"
 	ActionShareMissionGitHub   = "share_mission_github"
+
+	// Secret value reveal (single key, explicit reveal=true).
+	ActionRevealSecret = "reveal_secret"
 )
 
 // storeMu guards the package-level store reference.
@@ -69,13 +74,124 @@ func getStore() store.Store {
 	return auditStore
 }
 
+// Outcomes recorded for audited operations.
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// pendingLocalsKey holds the *pendingEntry of a request wrapped by Wrap.
+const pendingLocalsKey = "audit.pending"
+
+// Target identifies the object an audited operation acted on.
+type Target struct {
+	Cluster   string
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+// pendingEntry collects what a wrapped handler reported through Log so Wrap
+// can record it once, together with the outcome.
+type pendingEntry struct {
+	logged  bool
+	target  Target
+	details string
+}
+
 // Log emits a structured audit log entry for a security-sensitive operation.
-// Optional detail strings are joined with a space and included in the entry.
+// Optional detail strings are joined with a space and included in the entry;
+// "cluster=" and "namespace=" details also fill in the entry's target.
 //
 // If a store has been set via SetStore, the entry is also persisted to SQLite.
 // Store write failures are logged but never propagated to the caller — audit
 // persistence is best-effort so it cannot break request handling.
+//
+// Inside a handler wrapped by Wrap, Log only records the target and details;
+// Wrap writes the entry with the outcome once the handler returns.
 func Log(c *fiber.Ctx, action, targetType, targetID string, details ...string) {
+	target := Target{Kind: targetType, Name: targetID}
+	for _, d := range details {
+		if v, ok := strings.CutPrefix(d, "cluster="); ok {
+			target.Cluster = v
+		} else if v, ok := strings.CutPrefix(d, "namespace="); ok {
+			target.Namespace = v
+		}
+	}
+	detailText := strings.Join(details, " ")
+
+	if p, ok := c.Locals(pendingLocalsKey).(*pendingEntry); ok {
+		p.logged = true
+		p.target = target
+		p.details = detailText
+		return
+	}
+	record(c, action, target, "", detailText)
+}
+
+// Wrap audits a mutating handler: once next returns, it records action with
+// a success or failure outcome, taken from the returned error or the response
+// status. The target is whatever next passed to Log, or else the cluster,
+// namespace and name found in the route params, query string or JSON body,
+// so rejected requests are still attributed to what they tried to change.
+func Wrap(action, kind string, next fiber.Handler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		p := &pendingEntry{}
+		c.Locals(pendingLocalsKey, p)
+		err := next(c)
+		c.Locals(pendingLocalsKey, nil)
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			var fe *fiber.Error
+			if errors.As(err, &fe) {
+				status = fe.Code
+			}
+		}
+		outcome := OutcomeSuccess
+		if status >= fiber.StatusBadRequest {
+			outcome = OutcomeFailure
+		}
+
+		target := p.target
+		if !p.logged {
+			target = requestTarget(c, kind)
+		}
+		record(c, action, target, outcome, p.details)
+		return err
+	}
+}
+
+// requestTarget reads a best-effort target from the request itself.
+func requestTarget(c *fiber.Ctx, kind string) Target {
+	var body struct {
+		Cluster   string `json:"cluster"`
+		Namespace string `json:"namespace"`
+		Name      string `json:"name"`
+	}
+	if strings.HasPrefix(string(c.Request().Header.ContentType()), fiber.MIMEApplicationJSON) {
+		_ = json.Unmarshal(c.Body(), &body)
+	}
+	pick := func(key, fromBody string) string {
+		if v := c.Params(key); v != "" {
+			return v
+		}
+		if v := c.Query(key); v != "" {
+			return v
+		}
+		return fromBody
+	}
+	return Target{
+		Cluster:   pick("cluster", body.Cluster),
+		Namespace: pick("namespace", body.Namespace),
+		Kind:      kind,
+		Name:      pick("name", body.Name),
+	}
+}
+
+// record writes one audit entry to slog and, when configured, the store.
+func record(c *fiber.Ctx, action string, target Target, outcome, detailText string) {
 	// Inline GetUserID logic to avoid circular dependency with middleware package
 	userID, _ := c.Locals("userID").(uuid.UUID)
 	ip := c.IP()
@@ -83,16 +199,22 @@ func Log(c *fiber.Ctx, action, targetType, targetID string, details ...string) {
 	attrs := []any{
 		"action", action,
 		"actor_id", userID,
-		"target_type", targetType,
-		"target_id", targetID,
+		"target_type", target.Kind,
+		"target_id", target.Name,
 		"ip", ip,
 		"path", c.Path(),
 		"method", c.Method(),
 	}
-
-	detailText := ""
-	if len(details) > 0 {
-		detailText = strings.Join(details, " ")
+	if target.Cluster != "" {
+		attrs = append(attrs, "cluster", target.Cluster)
+	}
+	if target.Namespace != "" {
+		attrs = append(attrs, "namespace", target.Namespace)
+	}
+	if outcome != "" {
+		attrs = append(attrs, "outcome", outcome)
+	}
+	if detailText != "" {
 		attrs = append(attrs, "details", detailText)
 	}
 
@@ -100,15 +222,28 @@ func Log(c *fiber.Ctx, action, targetType, targetID string, details ...string) {
 
 	// Persist to SQLite if a store is available.
 	if s := getStore(); s != nil {
+		if outcome == "" {
+			outcome = OutcomeSuccess
+		}
 		detail, _ := json.Marshal(map[string]string{
-			"target_type": targetType,
-			"target_id":   targetID,
+			"target_type": target.Kind,
+			"target_id":   target.Name,
 			"ip":          ip,
 			"path":        c.Path(),
 			"method":      c.Method(),
 			"details":     detailText,
 		})
-		if err := s.InsertAuditLog(c.UserContext(), userID.String(), action, string(detail)); err != nil {
+		entry := store.AuditEntry{
+			UserID:    userID.String(),
+			Action:    action,
+			Cluster:   target.Cluster,
+			Namespace: target.Namespace,
+			Kind:      target.Kind,
+			Name:      target.Name,
+			Outcome:   outcome,
+			Detail:    string(detail),
+		}
+		if err := s.InsertAuditEntry(c.UserContext(), entry); err != nil {
 			slog.Error("audit: failed to persist audit entry", "error", err, "action", action)
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"log/slog"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
@@ -111,3 +112,66 @@ func TestLogUnauthorizedAttempt(t *testing.T) {
 		t.Errorf("action = %v, want %v", entry["action"], ActionUnauthorizedAttempt)
 	}
 }
+
+func TestWrapRecordsOutcomeAndTarget(t *testing.T) {
+	app := fiber.New()
+	app.Delete("/api/mcp/resourcequotas", Wrap(ActionDeleteResourceQuota, "resource_quota", func(c *fiber.Ctx) error {
+		return fiber.NewError(fiber.StatusForbidden, "denied")
+	}))
+	app.Get("/api/mcp/secrets/value", Wrap(ActionRevealSecret, "secret", func(c *fiber.Ctx) error {
+		Log(c, ActionRevealSecret, "secret", "east/ops/db-creds", "cluster=east", "namespace=ops")
+		return c.SendStatus(fiber.StatusOK)
+	}))
+
+	tests := []struct {
+		name    string
+		req     *http.Request
+		want    map[string]any
+		outcome string
+	}{
+		{
+			name: "failure falls back to the request target",
+			req:  httptest.NewRequest("DELETE", "/api/mcp/resourcequotas?cluster=west&namespace=team-a&name=quota", nil),
+			want: map[string]any{
+				"action": ActionDeleteResourceQuota, "cluster": "west", "namespace": "team-a",
+				"target_type": "resource_quota", "target_id": "quota",
+			},
+			outcome: OutcomeFailure,
+		},
+		{
+			name: "success uses the target passed to Log",
+			req:  httptest.NewRequest("GET", "/api/mcp/secrets/value?cluster=west&name=other", nil),
+			want: map[string]any{
+				"action": ActionRevealSecret, "cluster": "east", "namespace": "ops",
+				"target_type": "secret", "target_id": "east/ops/db-creds",
+			},
+			outcome: OutcomeSuccess,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			captureLog(&buf, func() {
+				//nolint:errcheck // test-only; response body is irrelevant
+				app.Test(tt.req)
+			})
+
+			lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+			if len(lines) != 1 {
+				t.Fatalf("expected exactly one audit line, got %d: %s", len(lines), buf.String())
+			}
+			var entry map[string]any
+			if err := json.Unmarshal(lines[0], &entry); err != nil {
+				t.Fatalf("failed to parse log JSON: %v", err)
+			}
+			for k, v := range tt.want {
+				if entry[k] != v {
+					t.Errorf("%s = %v, want %v", k, entry[k], v)
+				}
+			}
+			if entry["outcome"] != tt.outcome {
+				t.Errorf("outcome = %v, want %v", entry["outcome"], tt.outcome)
+			}
+		})
+	}
+}
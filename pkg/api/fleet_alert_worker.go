@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kubestellar/console/pkg/k8s"
+	"github.com/kubestellar/console/pkg/notifications"
+)
+
+const (
+	// defaultFleetAlertInterval is how often the alert rules are evaluated.
+	defaultFleetAlertInterval = time.Minute
+	// defaultFleetAlertDebounce is the minimum time between two firing
+	// notifications of the same rule, so a flapping rule does not flood
+	// the receiver.
+	defaultFleetAlertDebounce = 15 * time.Minute
+)
+
+// Fleet alert webhook payload formats.
+const (
+	fleetAlertFormatGeneric = "generic"
+	fleetAlertFormatSlack   = "slack"
+)
+
+// Notification statuses sent by FleetAlertWorker.
+const (
+	fleetAlertStatusFiring   = "firing"
+	fleetAlertStatusResolved = "resolved"
+)
+
+// fleetAlertState is what FleetAlertWorker remembers about one rule.
+type fleetAlertState struct {
+	// notified is true while the receiver has been told the rule fires and
+	// has not yet been told it resolved.
+	notified    bool
+	lastFiredAt time.Time
+}
+
+// FleetAlertWorker evaluates the configured alert rules periodically and
+// notifies a webhook when a rule starts firing and when it resolves.
+type FleetAlertWorker struct {
+	rules    []k8s.AlertRule
+	evaluate func(ctx context.Context, rules []k8s.AlertRule) ([]k8s.AlertResult, error)
+	notifier notifications.Notifier
+	interval time.Duration
+	debounce time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	state map[string]*fleetAlertState
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewFleetAlertWorker creates a worker evaluating rules against k8sClient's
+// fleet summary every interval (defaultFleetAlertInterval when zero). A
+// rule is re-notified as firing at most once per debounce
+// (defaultFleetAlertDebounce when zero).
+func NewFleetAlertWorker(k8sClient *k8s.MultiClusterClient, rules []k8s.AlertRule, notifier notifications.Notifier, interval, debounce time.Duration) *FleetAlertWorker {
+	if interval <= 0 {
+		interval = defaultFleetAlertInterval
+	}
+	if debounce <= 0 {
+		debounce = defaultFleetAlertDebounce
+	}
+	return &FleetAlertWorker{
+		rules:    rules,
+		evaluate: k8sClient.EvaluateAlertRules,
+		notifier: notifier,
+		interval: interval,
+		debounce: debounce,
+		now:      time.Now,
+		state:    make(map[string]*fleetAlertState),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start begins the evaluation loop.
+func (w *FleetAlertWorker) Start() {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.runOnce(context.Background())
+			case <-w.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the evaluation loop.
+func (w *FleetAlertWorker) Stop() {
+	w.stopOnce.Do(func() { close(w.stopCh) })
+}
+
+// runOnce evaluates every rule and sends the notifications its transition
+// calls for: firing when a rule starts firing (unless it last fired within
+// the debounce), resolved when a notified rule stops firing.
+func (w *FleetAlertWorker) runOnce(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, w.interval)
+	defer cancel()
+	results, err := w.evaluate(ctx, w.rules)
+	if err != nil {
+		slog.Warn("[FleetAlerts] rule evaluation failed", "error", err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := w.now()
+	for _, r := range results {
+		if r.Error != "" {
+			continue
+		}
+		st, ok := w.state[r.Rule.Name]
+		if !ok {
+			st = &fleetAlertState{}
+			w.state[r.Rule.Name] = st
+		}
+		switch {
+		case r.Firing && !st.notified:
+			if !st.lastFiredAt.IsZero() && now.Sub(st.lastFiredAt) < w.debounce {
+				continue
+			}
+			if w.send(r, fleetAlertStatusFiring, now) {
+				st.notified = true
+				st.lastFiredAt = now
+			}
+		case !r.Firing && st.notified:
+			if w.send(r, fleetAlertStatusResolved, now) {
+				st.notified = false
+			}
+		}
+	}
+}
+
+// send delivers one notification, reporting whether it was accepted.
+func (w *FleetAlertWorker) send(r k8s.AlertResult, status string, at time.Time) bool {
+	severity := notifications.AlertSeverity(r.Rule.Severity)
+	if severity == "" {
+		severity = notifications.SeverityWarning
+	}
+	message := fmt.Sprintf("%s is %g (%s %g)", r.Rule.Metric, r.Value, r.Rule.Comparator, r.Rule.Threshold)
+	if status == fleetAlertStatusResolved {
+		message = fmt.Sprintf("%s is back to %g", r.Rule.Metric, r.Value)
+	}
+	err := w.notifier.Send(notifications.Alert{
+		ID:       fmt.Sprintf("fleet-%s-%d", r.Rule.Name, at.Unix()),
+		RuleID:   r.Rule.Name,
+		RuleName: r.Rule.Name,
+		Severity: severity,
+		Status:   status,
+		Message:  message,
+		Details:  map[string]interface{}{"metric": r.Rule.Metric, "value": r.Value, "threshold": r.Rule.Threshold},
+		FiredAt:  at,
+	})
+	if err != nil {
+		slog.Error("[FleetAlerts] webhook delivery failed", "rule", r.Rule.Name, "status", status, "error", err)
+		return false
+	}
+	return true
+}
+
+// newFleetAlertNotifier builds the webhook notifier configured by cfg: a
+// custom template when FleetAlertWebhookTemplateFile is set, otherwise the
+// Slack or generic JSON payload selected by FleetAlertWebhookFormat.
+func newFleetAlertNotifier(cfg Config) (notifications.Notifier, error) {
+	if cfg.FleetAlertWebhookTemplateFile != "" {
+		tmpl, err := os.ReadFile(cfg.FleetAlertWebhookTemplateFile)
+		if err != nil {
+			return nil, fmt.Errorf("read webhook template: %w", err)
+		}
+		return notifications.NewTemplateWebhookNotifier(cfg.FleetAlertWebhookURL, string(tmpl))
+	}
+	switch cfg.FleetAlertWebhookFormat {
+	case fleetAlertFormatSlack:
+		return notifications.NewSlackNotifier(cfg.FleetAlertWebhookURL, ""), nil
+	case "", fleetAlertFormatGeneric:
+		return notifications.NewWebhookNotifier(cfg.FleetAlertWebhookURL)
+	}
+	return nil, fmt.Errorf("unknown webhook format %q (want %s or %s)",
+		cfg.FleetAlertWebhookFormat, fleetAlertFormatGeneric, fleetAlertFormatSlack)
+}
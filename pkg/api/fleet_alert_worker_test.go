@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubestellar/console/pkg/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFleetAlertWorker_FireThenResolve(t *testing.T) {
+	var mu sync.Mutex
+	var received []map[string]string
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]string
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("payload is not JSON: %s", body)
+		}
+		mu.Lock()
+		received = append(received, payload)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	tmplFile := filepath.Join(t.TempDir(), "alert.tmpl")
+	require.NoError(t, os.WriteFile(tmplFile,
+		[]byte(`{"rule":"{{.RuleName}}","status":"{{.Status}}","text":"{{.Message}}"}`), 0o600))
+	notifier, err := newFleetAlertNotifier(Config{
+		FleetAlertWebhookURL:          receiver.URL,
+		FleetAlertWebhookTemplateFile: tmplFile,
+	})
+	require.NoError(t, err)
+
+	rule := k8s.AlertRule{Name: "pods failing", Metric: k8s.AlertMetricPodIssueRatio, Comparator: ">", Threshold: 0.05}
+	firing := []bool{true, true, false, true}
+	w := NewFleetAlertWorker(nil, []k8s.AlertRule{rule}, notifier, time.Minute, time.Hour)
+	w.evaluate = func(_ context.Context, rules []k8s.AlertRule) ([]k8s.AlertResult, error) {
+		f := firing[0]
+		firing = firing[1:]
+		return []k8s.AlertResult{{Rule: rules[0], Value: 0.25, Firing: f}}, nil
+	}
+	now := time.Now()
+	w.now = func() time.Time { return now }
+
+	for i := 0; i < 4; i++ {
+		w.runOnce(context.Background())
+		now = now.Add(time.Minute)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 2, "still firing sends nothing; re-firing within the debounce is suppressed")
+	assert.Equal(t, map[string]string{"rule": "pods failing", "status": "firing", "text": "pod-issue-ratio is 0.25 (> 0.05)"}, received[0])
+	assert.Equal(t, "resolved", received[1]["status"])
+	assert.Equal(t, "pods failing", received[1]["rule"])
+}
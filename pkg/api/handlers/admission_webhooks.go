@@ -43,6 +43,7 @@ var (
 // WebhookHandlers handles admission webhook API endpoints
 type WebhookHandlers struct {
 	k8sClient *k8s.MultiClusterClient
+	clusterAccess
 }
 
 // NewWebhookHandlers creates a new webhook handlers instance
@@ -91,14 +92,18 @@ func (h *WebhookHandlers) ListWebhooks(c *fiber.Ctx) error {
 			IsDemoData: true,
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), webhookListTimeout)
 	defer cancel()
 
-	clusters, err := h.k8sClient.DeduplicatedClusters(ctx)
+	clusters, err := client.DeduplicatedClusters(ctx)
 	if err != nil {
 		var listErr error
-		clusters, listErr = h.k8sClient.ListClusters(ctx)
+		clusters, listErr = client.ListClusters(ctx)
 		if listErr != nil {
 			return c.Status(statusServiceUnavailableWebhook).JSON(fiber.Map{"error": "cluster discovery failed", "isDemoData": false})
 		}
@@ -117,7 +122,7 @@ func (h *WebhookHandlers) ListWebhooks(c *fiber.Ctx) error {
 	for _, cluster := range clusters {
 		clusterName := cluster.Name
 		g.Go(func() error {
-			client, err := h.k8sClient.GetDynamicClient(clusterName)
+			dynClient, err := client.GetDynamicClient(clusterName)
 			if err != nil {
 				mu.Lock()
 				clusterErrors[clusterName] = err.Error()
@@ -129,7 +134,7 @@ func (h *WebhookHandlers) ListWebhooks(c *fiber.Ctx) error {
 
 			// Fetch validating webhooks — per-cluster errors are collected
 			// into clusterErrors (#7967) instead of silently swallowed.
-			valList, valErr := client.Resource(validatingWebhookGVR).List(gctx, metav1.ListOptions{})
+			valList, valErr := dynClient.Resource(validatingWebhookGVR).List(gctx, metav1.ListOptions{})
 			if valErr == nil {
 				for _, item := range valList.Items {
 					wh := parseWebhookFromUnstructured(&item, clusterName, "validating")
@@ -140,7 +145,7 @@ func (h *WebhookHandlers) ListWebhooks(c *fiber.Ctx) error {
 			}
 
 			// Fetch mutating webhooks
-			mutList, mutErr := client.Resource(mutatingWebhookGVR).List(gctx, metav1.ListOptions{})
+			mutList, mutErr := dynClient.Resource(mutatingWebhookGVR).List(gctx, metav1.ListOptions{})
 			if mutErr == nil {
 				for _, item := range mutList.Items {
 					wh := parseWebhookFromUnstructured(&item, clusterName, "mutating")
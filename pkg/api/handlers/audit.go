@@ -24,18 +24,25 @@ func NewAuditHandler(s store.Store) *AuditHandler {
 	return &AuditHandler{store: s}
 }
 
-// GetAuditLog returns recent audit entries, newest first.
+// GetAuditLog returns a page of the audit log, newest first, including the
+// target and outcome of audited mutations. Admin only, since it exposes
+// every user's activity.
 //
 // Query params:
-//   - limit  — max entries to return (default 50, capped at 200)
+//   - limit   — max entries to return (default 50, capped at 200)
+//   - offset  — entries to skip, for paging
 //   - user_id — optional filter by actor
 //   - action  — optional filter by action constant
+//   - cluster — optional filter by target cluster
 //
 // In demo mode, returns an empty JSON array.
 func (h *AuditHandler) GetAuditLog(c *fiber.Ctx) error {
 	if isDemoMode(c) {
 		return c.JSON(make([]store.AuditEntry, 0))
 	}
+	if err := requireAdmin(c, h.store); err != nil {
+		return err
+	}
 
 	limit := defaultAuditLimit
 	if q := c.Query("limit"); q != "" {
@@ -46,11 +53,22 @@ func (h *AuditHandler) GetAuditLog(c *fiber.Ctx) error {
 	if limit > maxAuditLimit {
 		limit = maxAuditLimit
 	}
+	offset := 0
+	if q := c.Query("offset"); q != "" {
+		v, err := strconv.Atoi(q)
+		if err != nil || v < 0 {
+			return fiber.NewError(fiber.StatusBadRequest, "offset must be a non-negative integer")
+		}
+		offset = v
+	}
 
-	userID := c.Query("user_id")
-	action := c.Query("action")
-
-	entries, err := h.store.QueryAuditLogs(c.Context(), limit, userID, action)
+	entries, err := h.store.ListAuditEntries(c.UserContext(), store.AuditFilter{
+		UserID:  c.Query("user_id"),
+		Action:  c.Query("action"),
+		Cluster: c.Query("cluster"),
+		Limit:   limit,
+		Offset:  offset,
+	})
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "failed to query audit log")
 	}
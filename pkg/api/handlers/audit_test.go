@@ -6,6 +6,9 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kubestellar/console/pkg/models"
 	"github.com/kubestellar/console/pkg/store"
 	"github.com/kubestellar/console/pkg/test"
 	"github.com/stretchr/testify/assert"
@@ -36,7 +39,7 @@ func TestGetAuditLog(t *testing.T) {
 		mockEntries := []store.AuditEntry{
 			{ID: 1, UserID: "user-1", Action: "test-action"},
 		}
-		env.Store.(*test.MockStore).On("QueryAuditLogs", 50, "", "").Return(mockEntries, nil)
+		env.Store.(*test.MockStore).On("ListAuditEntries", store.AuditFilter{Limit: 50}).Return(mockEntries, nil)
 
 		req := httptest.NewRequest("GET", "/api/audit", nil)
 		resp, _ := env.App.Test(req)
@@ -53,9 +56,11 @@ func TestGetAuditLog(t *testing.T) {
 		handler := NewAuditHandler(env.Store)
 		env.App.Get("/api/audit", handler.GetAuditLog)
 
-		env.Store.(*test.MockStore).On("QueryAuditLogs", 100, "user-123", "delete").Return([]store.AuditEntry{}, nil)
+		env.Store.(*test.MockStore).On("ListAuditEntries", store.AuditFilter{
+			UserID: "user-123", Action: "delete", Cluster: "prod", Limit: 100, Offset: 200,
+		}).Return([]store.AuditEntry{}, nil)
 
-		req := httptest.NewRequest("GET", "/api/audit?limit=100&user_id=user-123&action=delete", nil)
+		req := httptest.NewRequest("GET", "/api/audit?limit=100&offset=200&user_id=user-123&action=delete&cluster=prod", nil)
 		resp, _ := env.App.Test(req)
 
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -68,7 +73,7 @@ func TestGetAuditLog(t *testing.T) {
 		env.App.Get("/api/audit", handler.GetAuditLog)
 
 		// Max limit is 200
-		env.Store.(*test.MockStore).On("QueryAuditLogs", 200, "", "").Return([]store.AuditEntry{}, nil)
+		env.Store.(*test.MockStore).On("ListAuditEntries", store.AuditFilter{Limit: 200}).Return([]store.AuditEntry{}, nil)
 
 		req := httptest.NewRequest("GET", "/api/audit?limit=500", nil)
 		resp, _ := env.App.Test(req)
@@ -82,11 +87,41 @@ func TestGetAuditLog(t *testing.T) {
 		handler := NewAuditHandler(env.Store)
 		env.App.Get("/api/audit", handler.GetAuditLog)
 
-		env.Store.(*test.MockStore).On("QueryAuditLogs", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+		env.Store.(*test.MockStore).On("ListAuditEntries", mock.Anything).Return(nil, assert.AnError)
 
 		req := httptest.NewRequest("GET", "/api/audit", nil)
 		resp, _ := env.App.Test(req)
 
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 	})
+
+	t.Run("InvalidOffset", func(t *testing.T) {
+		env := setupTestEnv(t)
+		handler := NewAuditHandler(env.Store)
+		env.App.Get("/api/audit", handler.GetAuditLog)
+
+		req := httptest.NewRequest("GET", "/api/audit?offset=-1", nil)
+		resp, _ := env.App.Test(req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("NonAdminForbidden", func(t *testing.T) {
+		mockStore := new(test.MockStore)
+		userID := uuid.New()
+		mockStore.On("GetUser", userID).Return(&models.User{ID: userID, Role: models.UserRoleEditor}, nil)
+		handler := NewAuditHandler(mockStore)
+
+		app := fiber.New()
+		app.Get("/api/audit", func(c *fiber.Ctx) error {
+			c.Locals("userID", userID)
+			return handler.GetAuditLog(c)
+		})
+
+		req := httptest.NewRequest("GET", "/api/audit", nil)
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		mockStore.AssertNotCalled(t, "ListAuditEntries", mock.Anything)
+	})
 }
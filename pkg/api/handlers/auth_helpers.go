@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"slices"
+
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/kubestellar/console/pkg/api/middleware"
@@ -34,21 +36,14 @@ import (
 // privileges are not required. Called from gitops mutation handlers to gate
 // sync, helm upgrade/uninstall/rollback, and ArgoCD sync (#6022).
 func requireEditorOrAdmin(c *fiber.Ctx, s store.Store) error {
-	if s == nil {
-		return nil
-	}
-	userID := middleware.GetUserID(c)
-	user, err := s.GetUser(c.UserContext(), userID)
-	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, "Failed to verify user role")
-	}
-	if user == nil {
-		return fiber.NewError(fiber.StatusForbidden, "User not found")
-	}
-	if user.Role != models.UserRoleAdmin && user.Role != models.UserRoleEditor {
-		return fiber.NewError(fiber.StatusForbidden, "Editor or admin role required")
-	}
-	return nil
+	return requireRole(c, s, "Editor or admin role required", models.UserRoleAdmin, models.UserRoleEditor)
+}
+
+// requireAdmin verifies the current request's user has the admin role. Use
+// this for endpoints that expose other users' activity, such as the audit
+// log.
+func requireAdmin(c *fiber.Ctx, s store.Store) error {
+	return requireRole(c, s, "Admin role required", models.UserRoleAdmin)
 }
 
 // requireViewerOrAbove verifies the current request's user has at least the
@@ -57,6 +52,13 @@ func requireEditorOrAdmin(c *fiber.Ctx, s store.Store) error {
 // identity (not just a valid JWT). Drift detection is classified as read-only
 // but sensitive enough to warrant this check (#6022).
 func requireViewerOrAbove(c *fiber.Ctx, s store.Store) error {
+	return requireRole(c, s, "Valid console role required",
+		models.UserRoleAdmin, models.UserRoleEditor, models.UserRoleViewer)
+}
+
+// requireRole implements the error model above: it rejects the request with
+// a 403 carrying deniedMsg unless the current user holds one of allowed.
+func requireRole(c *fiber.Ctx, s store.Store, deniedMsg string, allowed ...models.UserRole) error {
 	if s == nil {
 		return nil
 	}
@@ -68,10 +70,8 @@ func requireViewerOrAbove(c *fiber.Ctx, s store.Store) error {
 	if user == nil {
 		return fiber.NewError(fiber.StatusForbidden, "User not found")
 	}
-	switch user.Role {
-	case models.UserRoleAdmin, models.UserRoleEditor, models.UserRoleViewer:
-		return nil
-	default:
-		return fiber.NewError(fiber.StatusForbidden, "Valid console role required")
+	if !slices.Contains(allowed, user.Role) {
+		return fiber.NewError(fiber.StatusForbidden, deniedMsg)
 	}
+	return nil
 }
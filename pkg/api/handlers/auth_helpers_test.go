@@ -62,6 +62,36 @@ func TestAuthHelpers(t *testing.T) {
 		}
 	})
 
+	t.Run("requireAdmin", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			role       models.UserRole
+			wantStatus int
+		}{
+			{"AdminAllowed", models.UserRoleAdmin, http.StatusOK},
+			{"EditorForbidden", models.UserRoleEditor, http.StatusForbidden},
+			{"ViewerForbidden", models.UserRoleViewer, http.StatusForbidden},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				app := fiber.New()
+				mockStore := new(test.MockStore)
+				userID := uuid.New()
+				mockStore.On("GetUser", userID).Return(&models.User{Role: tt.role}, nil)
+
+				app.Get("/test", func(c *fiber.Ctx) error {
+					c.Locals("userID", userID)
+					return requireAdmin(c, mockStore)
+				})
+
+				req := httptest.NewRequest("GET", "/test", nil)
+				resp, _ := app.Test(req)
+				assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			})
+		}
+	})
+
 	t.Run("requireViewerOrAbove", func(t *testing.T) {
 		tests := []struct {
 			name       string
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubestellar/console/pkg/api/middleware"
+)
+
+// maxBenchmarkUIDLen bounds the report UIDs accepted by the baseline and
+// compare endpoints.
+const maxBenchmarkUIDLen = 256
+
+// BenchmarkMetricDelta is how one metric of a report differs from the same
+// metric of its baseline. Values are the statistic means.
+type BenchmarkMetricDelta struct {
+	Metric   string  `json:"metric"`
+	Units    string  `json:"units"`
+	Baseline float64 `json:"baseline"`
+	Value    float64 `json:"value"`
+	// PercentChange is (Value-Baseline)/Baseline*100; nil when the baseline
+	// is zero.
+	PercentChange *float64 `json:"percent_change,omitempty"`
+	// Improved is true when the change is in the metric's good direction:
+	// up for throughput, down for latency.
+	Improved bool `json:"improved"`
+}
+
+// benchmarkCompareMetric is a metric compared by compareBenchmarkReports.
+type benchmarkCompareMetric struct {
+	name           string
+	higherIsBetter bool
+	get            func(r *BenchmarkReport) *BenchmarkStatistics
+}
+
+var benchmarkCompareMetrics = []benchmarkCompareMetric{
+	{"throughput.output_token_rate", true, func(r *BenchmarkReport) *BenchmarkStatistics {
+		return r.Results.RequestPerformance.Aggregate.Throughput.OutputTokenRate
+	}},
+	{"throughput.input_token_rate", true, func(r *BenchmarkReport) *BenchmarkStatistics {
+		return r.Results.RequestPerformance.Aggregate.Throughput.InputTokenRate
+	}},
+	{"throughput.total_token_rate", true, func(r *BenchmarkReport) *BenchmarkStatistics {
+		return r.Results.RequestPerformance.Aggregate.Throughput.TotalTokenRate
+	}},
+	{"throughput.request_rate", true, func(r *BenchmarkReport) *BenchmarkStatistics {
+		return r.Results.RequestPerformance.Aggregate.Throughput.RequestRate
+	}},
+	{"latency.time_to_first_token", false, func(r *BenchmarkReport) *BenchmarkStatistics {
+		return r.Results.RequestPerformance.Aggregate.Latency.TimeToFirstToken
+	}},
+	{"latency.time_per_output_token", false, func(r *BenchmarkReport) *BenchmarkStatistics {
+		return r.Results.RequestPerformance.Aggregate.Latency.TimePerOutputToken
+	}},
+	{"latency.inter_token_latency", false, func(r *BenchmarkReport) *BenchmarkStatistics {
+		return r.Results.RequestPerformance.Aggregate.Latency.InterTokenLatency
+	}},
+	{"latency.request_latency", false, func(r *BenchmarkReport) *BenchmarkStatistics {
+		return r.Results.RequestPerformance.Aggregate.Latency.RequestLatency
+	}},
+}
+
+// compareBenchmarkReports returns the deltas of report against baseline for
+// every metric both carry in the same units.
+func compareBenchmarkReports(report, baseline *BenchmarkReport) []BenchmarkMetricDelta {
+	deltas := make([]BenchmarkMetricDelta, 0, len(benchmarkCompareMetrics))
+	for _, m := range benchmarkCompareMetrics {
+		cur, base := m.get(report), m.get(baseline)
+		if cur == nil || base == nil || cur.Units != base.Units {
+			continue
+		}
+		d := BenchmarkMetricDelta{
+			Metric:   m.name,
+			Units:    cur.Units,
+			Baseline: base.Mean,
+			Value:    cur.Mean,
+			Improved: cur.Mean > base.Mean,
+		}
+		if !m.higherIsBetter {
+			d.Improved = cur.Mean < base.Mean
+		}
+		if base.Mean != 0 {
+			pct := (cur.Mean - base.Mean) / base.Mean * 100
+			d.PercentChange = &pct
+		}
+		deltas = append(deltas, d)
+	}
+	return deltas
+}
+
+// findReport returns the report with uid, or nil when there is none. It
+// looks in the listing cache first, then in every report, which is kept in
+// its own cache so a lookup never replaces a since-scoped listing and an
+// unknown uid is answered from that cache rather than a new Drive crawl.
+func (h *BenchmarkHandlers) findReport(ctx context.Context, uid string) (*BenchmarkReport, error) {
+	if r := h.cache.find(uid); r != nil {
+		return r, nil
+	}
+	all := normalizeSinceKey("")
+	if _, ok := h.cache.get(all); ok {
+		return nil, nil // the listing cache already holds every report
+	}
+	if _, err := h.cachedReportsIn(ctx, h.lookup, all); err != nil {
+		return nil, err
+	}
+	return h.lookup.find(uid), nil
+}
+
+// ListBaselines returns the benchmark reports marked as baselines.
+func (h *BenchmarkHandlers) ListBaselines(c *fiber.Ctx) error {
+	if h.store == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "baseline storage not configured")
+	}
+	baselines, err := h.store.ListBenchmarkBaselines(c.UserContext())
+	if err != nil {
+		slog.Error("[benchmarks] failed to list baselines", "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to list baselines")
+	}
+	return c.JSON(fiber.Map{"baselines": baselines})
+}
+
+// MarkBaseline marks the report {"uid": "..."} as a baseline.
+func (h *BenchmarkHandlers) MarkBaseline(c *fiber.Ctx) error {
+	if h.store == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "baseline storage not configured")
+	}
+	if err := requireEditorOrAdmin(c, h.store); err != nil {
+		return err
+	}
+	var body struct {
+		UID string `json:"uid"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+	if body.UID == "" || len(body.UID) > maxBenchmarkUIDLen {
+		return fiber.NewError(fiber.StatusBadRequest, "uid is required")
+	}
+	if h.apiKey == "" {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "benchmark data not configured — set GOOGLE_DRIVE_API_KEY")
+	}
+	report, err := h.findReport(c.UserContext(), body.UID)
+	if err != nil {
+		slog.Error("[benchmarks] Google Drive fetch error", "error", err)
+		return fiber.NewError(fiber.StatusBadGateway, "failed to fetch benchmark data")
+	}
+	if report == nil {
+		return fiber.NewError(fiber.StatusNotFound, "report not found: "+body.UID)
+	}
+	if err := h.store.SetBenchmarkBaseline(c.UserContext(), body.UID, middleware.GetGitHubLogin(c)); err != nil {
+		slog.Error("[benchmarks] failed to mark baseline", "uid", body.UID, "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to mark baseline")
+	}
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"uid": body.UID})
+}
+
+// UnmarkBaseline removes the baseline mark from the report :uid.
+func (h *BenchmarkHandlers) UnmarkBaseline(c *fiber.Ctx) error {
+	if h.store == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "baseline storage not configured")
+	}
+	if err := requireEditorOrAdmin(c, h.store); err != nil {
+		return err
+	}
+	uid := c.Params("uid")
+	if err := h.store.DeleteBenchmarkBaseline(c.UserContext(), uid); err != nil {
+		slog.Error("[benchmarks] failed to unmark baseline", "uid", uid, "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to unmark baseline")
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// CompareReports returns the per-metric deltas of the report ?uid= against
+// the report ?baseline=, or against the most recently marked baseline when
+// ?baseline= is omitted.
+func (h *BenchmarkHandlers) CompareReports(c *fiber.Ctx) error {
+	if isDemoMode(c) {
+		return c.JSON(fiber.Map{"deltas": []BenchmarkMetricDelta{}, "source": "demo"})
+	}
+	uid := c.Query("uid")
+	baselineUID := c.Query("baseline")
+	if uid == "" || len(uid) > maxBenchmarkUIDLen || len(baselineUID) > maxBenchmarkUIDLen {
+		return fiber.NewError(fiber.StatusBadRequest, "uid is required")
+	}
+	if h.apiKey == "" {
+		return c.Status(503).JSON(fiber.Map{
+			"error":  "benchmark data not configured — set GOOGLE_DRIVE_API_KEY",
+			"source": "unavailable",
+		})
+	}
+	if baselineUID == "" {
+		if h.store == nil {
+			return fiber.NewError(fiber.StatusBadRequest, "baseline is required")
+		}
+		baselines, err := h.store.ListBenchmarkBaselines(c.UserContext())
+		if err != nil {
+			slog.Error("[benchmarks] failed to list baselines", "error", err)
+			return fiber.NewError(fiber.StatusInternalServerError, "failed to list baselines")
+		}
+		if len(baselines) == 0 {
+			return fiber.NewError(fiber.StatusBadRequest, "baseline is required: no report is marked as a baseline")
+		}
+		baselineUID = baselines[0].UID
+	}
+
+	report, err := h.findReport(c.UserContext(), uid)
+	if err != nil {
+		slog.Error("[benchmarks] Google Drive fetch error", "error", err)
+		return c.Status(502).JSON(fiber.Map{"error": "failed to fetch benchmark data"})
+	}
+	if report == nil {
+		return fiber.NewError(fiber.StatusNotFound, "report not found: "+uid)
+	}
+	baseline, err := h.findReport(c.UserContext(), baselineUID)
+	if err != nil {
+		slog.Error("[benchmarks] Google Drive fetch error", "error", err)
+		return c.Status(502).JSON(fiber.Map{"error": "failed to fetch benchmark data"})
+	}
+	if baseline == nil {
+		return fiber.NewError(fiber.StatusNotFound, "baseline report not found: "+baselineUID)
+	}
+
+	return c.JSON(fiber.Map{
+		"uid":      uid,
+		"baseline": baselineUID,
+		"deltas":   compareBenchmarkReports(report, baseline),
+	})
+}
@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Benchmark export formats accepted by ExportReports' ?format=.
+const (
+	benchmarkExportCSV  = "csv"
+	benchmarkExportJSON = "json"
+)
+
+// benchmarkExportColumns is the CSV header, in BenchmarkExportRow field order.
+var benchmarkExportColumns = []string{
+	"uid", "eid", "start", "model", "tool", "accelerator", "accelerator_count",
+	"output_token_rate", "ttft_p50", "ttft_p95", "requests_total", "requests_failed",
+}
+
+// BenchmarkExportRow is a benchmark report flattened to one spreadsheet row.
+// Metrics a report lacks are nil.
+type BenchmarkExportRow struct {
+	UID              string   `json:"uid"`
+	EID              string   `json:"eid"`
+	Start            string   `json:"start"`
+	Model            string   `json:"model"`
+	Tool             string   `json:"tool"`
+	Accelerator      string   `json:"accelerator"`
+	AcceleratorCount int      `json:"accelerator_count"`
+	OutputTokenRate  *float64 `json:"output_token_rate"`
+	TTFTP50          *float64 `json:"ttft_p50"`
+	TTFTP95          *float64 `json:"ttft_p95"`
+	RequestsTotal    int      `json:"requests_total"`
+	RequestsFailed   int      `json:"requests_failed"`
+}
+
+// flattenBenchmarkReport builds the export row of r. Model and tool come from
+// the first stack component; accelerator models of every component are
+// joined with ";" and their counts summed.
+func flattenBenchmarkReport(r *BenchmarkReport) BenchmarkExportRow {
+	agg := &r.Results.RequestPerformance.Aggregate
+	row := BenchmarkExportRow{
+		UID:            r.Run.UID,
+		EID:            r.Run.EID,
+		Start:          r.Run.Time.Start,
+		RequestsTotal:  agg.Requests.Total,
+		RequestsFailed: agg.Requests.Failures,
+	}
+	var accelerators []string
+	for i, comp := range r.Scenario.Stack {
+		if i == 0 {
+			row.Tool = comp.Standardized.Tool
+			if comp.Standardized.Model != nil {
+				row.Model = comp.Standardized.Model.Name
+			}
+		}
+		if a := comp.Standardized.Accelerator; a != nil {
+			row.AcceleratorCount += a.Count
+			if a.Model != "" && !slices.Contains(accelerators, a.Model) {
+				accelerators = append(accelerators, a.Model)
+			}
+		}
+	}
+	row.Accelerator = strings.Join(accelerators, ";")
+	if s := agg.Throughput.OutputTokenRate; s != nil {
+		mean := s.Mean
+		row.OutputTokenRate = &mean
+	}
+	if s := agg.Latency.TimeToFirstToken; s != nil {
+		row.TTFTP50 = s.P50
+		row.TTFTP95 = s.P95
+	}
+	return row
+}
+
+// csvRecord renders row in benchmarkExportColumns order.
+func (row BenchmarkExportRow) csvRecord() []string {
+	return []string{
+		csvText(row.UID), csvText(row.EID), csvText(row.Start),
+		csvText(row.Model), csvText(row.Tool), csvText(row.Accelerator),
+		strconv.Itoa(row.AcceleratorCount),
+		formatExportFloat(row.OutputTokenRate),
+		formatExportFloat(row.TTFTP50),
+		formatExportFloat(row.TTFTP95),
+		strconv.Itoa(row.RequestsTotal),
+		strconv.Itoa(row.RequestsFailed),
+	}
+}
+
+// csvText renders a free-text cell for CSV. A cell starting with a formula
+// character is prefixed with ' so spreadsheets show it as text instead of
+// evaluating it.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// formatExportFloat renders v for CSV, empty when nil.
+func formatExportFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+// writeBenchmarkCSV renders rows as CSV with a header line.
+func writeBenchmarkCSV(rows []BenchmarkExportRow) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(benchmarkExportColumns); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if err := w.Write(row.csvRecord()); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// cachedReports returns the reports for since from the cache, fetching and
+// caching them on a miss.
+func (h *BenchmarkHandlers) cachedReports(ctx context.Context, since string) ([]BenchmarkReport, error) {
+	return h.cachedReportsIn(ctx, h.cache, since)
+}
+
+// cachedReportsIn is cachedReports against the given cache.
+func (h *BenchmarkHandlers) cachedReportsIn(ctx context.Context, cache *benchmarkCache, since string) ([]BenchmarkReport, error) {
+	if reports, ok := cache.get(since); ok {
+		return reports, nil
+	}
+	var cutoff time.Time
+	if d := parseSinceDuration(since); d > 0 {
+		cutoff = time.Now().Add(-d)
+	}
+	reports, _, err := h.fetchAllReports(ctx, cutoff)
+	if err != nil {
+		return nil, err
+	}
+	if reports == nil {
+		reports = []BenchmarkReport{} // cache an empty result too
+	}
+	cache.set(reports, since)
+	return reports, nil
+}
+
+// ExportReports returns the benchmark reports flattened to one row each, as
+// CSV (?format=csv, the default) or a JSON array (?format=json). ?since=
+// filters like GetReports.
+func (h *BenchmarkHandlers) ExportReports(c *fiber.Ctx) error {
+	format := c.Query("format", benchmarkExportCSV)
+	if format != benchmarkExportCSV && format != benchmarkExportJSON {
+		return fiber.NewError(fiber.StatusBadRequest, "format must be csv or json")
+	}
+
+	var reports []BenchmarkReport
+	if !isDemoMode(c) {
+		if h.apiKey == "" {
+			return c.Status(503).JSON(fiber.Map{
+				"error":  "benchmark data not configured — set GOOGLE_DRIVE_API_KEY",
+				"source": "unavailable",
+			})
+		}
+		var err error
+		reports, err = h.cachedReports(c.UserContext(), normalizeSinceKey(c.Query("since", "0")))
+		if err != nil {
+			slog.Error("[benchmarks] Google Drive fetch error", "error", err)
+			return c.Status(502).JSON(fiber.Map{"error": "failed to fetch benchmark data"})
+		}
+	}
+
+	rows := make([]BenchmarkExportRow, 0, len(reports))
+	for i := range reports {
+		rows = append(rows, flattenBenchmarkReport(&reports[i]))
+	}
+
+	if format == benchmarkExportJSON {
+		c.Set(fiber.HeaderContentDisposition, `attachment; filename="benchmark-reports.json"`)
+		return c.JSON(rows)
+	}
+	data, err := writeBenchmarkCSV(rows)
+	if err != nil {
+		slog.Error("[benchmarks] CSV export failed", "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to export benchmark data")
+	}
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="benchmark-reports.csv"`)
+	return c.Send(data)
+}
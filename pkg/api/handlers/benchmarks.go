@@ -15,6 +15,8 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"gopkg.in/yaml.v3"
+
+	"github.com/kubestellar/console/pkg/store"
 )
 
 // maxBenchmarkReportBytes caps the size of a single benchmark report we will
@@ -362,6 +364,20 @@ func (c *benchmarkCache) set(reports []BenchmarkReport, since string) {
 	c.fetchedAt = time.Now()
 }
 
+// find returns a copy of the cached report with uid, whatever since the
+// cache holds and however old it is, or nil.
+func (c *benchmarkCache) find(uid string) *BenchmarkReport {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for i := range c.reports {
+		if c.reports[i].Run.UID == uid {
+			r := c.reports[i]
+			return &r
+		}
+	}
+	return nil
+}
+
 // ---------------------------------------------------------------------------
 // Handler
 // ---------------------------------------------------------------------------
@@ -391,19 +407,26 @@ type BenchmarkHandlers struct {
 	apiKey   string
 	folderID string
 	cache    *benchmarkCache
+	lookup   *benchmarkCache // every report, for findReport only
 	client   *http.Client
 	lastReq  time.Time
 	reqMu    sync.Mutex
+	// store persists baseline marks; nil disables the baseline endpoints.
+	store store.Store
 }
 
 // NewBenchmarkHandlers creates a new benchmark data handler.
-func NewBenchmarkHandlers(apiKey, folderID string) *BenchmarkHandlers {
+func NewBenchmarkHandlers(apiKey, folderID string, s store.Store) *BenchmarkHandlers {
 	return &BenchmarkHandlers{
 		apiKey:   apiKey,
 		folderID: folderID,
+		store:    s,
 		cache: &benchmarkCache{
 			ttl: defaultCacheTTL,
 		},
+		lookup: &benchmarkCache{
+			ttl: defaultCacheTTL,
+		},
 		client: &http.Client{Timeout: 30 * time.Second},
 	}
 }
@@ -1,14 +1,21 @@
 package handlers
 
 import (
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"io"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kubestellar/console/pkg/test"
 )
 
 func TestParseSinceDuration(t *testing.T) {
@@ -187,7 +194,7 @@ func TestAdaptV1ToV2(t *testing.T) {
 
 func TestBenchmarkHandlers_GetReports_NoConfig(t *testing.T) {
 	app := fiber.New()
-	handler := NewBenchmarkHandlers("", "")
+	handler := NewBenchmarkHandlers("", "", nil)
 	app.Get("/benchmarks", handler.GetReports)
 
 	req := httptest.NewRequest("GET", "/benchmarks", nil)
@@ -206,7 +213,7 @@ func TestBenchmarkHandlers_GetReports_NoConfig(t *testing.T) {
 
 func TestBenchmarkHandlers_StreamReports_NoConfig(t *testing.T) {
 	app := fiber.New()
-	handler := NewBenchmarkHandlers("", "")
+	handler := NewBenchmarkHandlers("", "", nil)
 	app.Get("/benchmarks/stream", handler.StreamReports)
 
 	req := httptest.NewRequest("GET", "/benchmarks/stream", nil)
@@ -218,7 +225,7 @@ func TestBenchmarkHandlers_StreamReports_NoConfig(t *testing.T) {
 
 func TestBenchmarkHandlers_GetReports_DemoMode(t *testing.T) {
 	app := fiber.New()
-	handler := NewBenchmarkHandlers("", "")
+	handler := NewBenchmarkHandlers("", "", nil)
 	app.Get("/benchmarks", handler.GetReports)
 
 	req := httptest.NewRequest("GET", "/benchmarks", nil)
@@ -237,7 +244,7 @@ func TestBenchmarkHandlers_GetReports_DemoMode(t *testing.T) {
 
 func TestBenchmarkHandlers_StreamReports_DemoMode(t *testing.T) {
 	app := fiber.New()
-	handler := NewBenchmarkHandlers("", "")
+	handler := NewBenchmarkHandlers("", "", nil)
 	app.Get("/benchmarks/stream", handler.StreamReports)
 
 	req := httptest.NewRequest("GET", "/benchmarks/stream", nil)
@@ -253,3 +260,176 @@ func TestBenchmarkHandlers_StreamReports_DemoMode(t *testing.T) {
 
 	assert.Equal(t, "demo", result["source"])
 }
+
+func TestCompareBenchmarkReports(t *testing.T) {
+	stats := func(units string, mean float64) *BenchmarkStatistics {
+		return &BenchmarkStatistics{Units: units, Mean: mean}
+	}
+	var baseline, report BenchmarkReport
+	base := &baseline.Results.RequestPerformance.Aggregate
+	base.Throughput.OutputTokenRate = stats("tokens/s", 200)
+	base.Throughput.RequestRate = stats("queries/s", 0)
+	base.Latency.TimeToFirstToken = stats("s", 0.5)
+	base.Latency.RequestLatency = stats("s", 2)
+	cur := &report.Results.RequestPerformance.Aggregate
+	cur.Throughput.OutputTokenRate = stats("tokens/s", 250)
+	cur.Throughput.RequestRate = stats("queries/s", 3)
+	cur.Latency.TimeToFirstToken = stats("s", 0.6)
+	cur.Latency.RequestLatency = stats("ms", 1500) // different units: skipped
+
+	deltas := compareBenchmarkReports(&report, &baseline)
+	byMetric := make(map[string]BenchmarkMetricDelta, len(deltas))
+	for _, d := range deltas {
+		byMetric[d.Metric] = d
+	}
+	require.Len(t, byMetric, 3)
+
+	tput := byMetric["throughput.output_token_rate"]
+	require.NotNil(t, tput.PercentChange)
+	assert.InDelta(t, 25.0, *tput.PercentChange, 1e-9)
+	assert.True(t, tput.Improved)
+
+	ttft := byMetric["latency.time_to_first_token"]
+	require.NotNil(t, ttft.PercentChange)
+	assert.InDelta(t, 20.0, *ttft.PercentChange, 1e-9)
+	assert.False(t, ttft.Improved, "higher latency is a regression")
+
+	rate := byMetric["throughput.request_rate"]
+	assert.Nil(t, rate.PercentChange, "no percentage against a zero baseline")
+	assert.Equal(t, 3.0, rate.Value)
+}
+
+func TestBenchmarkHandlers_CompareReports_FromCache(t *testing.T) {
+	app := fiber.New()
+	handler := NewBenchmarkHandlers("key", "", nil)
+	var a, b BenchmarkReport
+	a.Run.UID = "run-a"
+	a.Results.RequestPerformance.Aggregate.Latency.TimeToFirstToken = &BenchmarkStatistics{Units: "s", Mean: 0.4}
+	b.Run.UID = "run-b"
+	b.Results.RequestPerformance.Aggregate.Latency.TimeToFirstToken = &BenchmarkStatistics{Units: "s", Mean: 0.5}
+	handler.cache.set([]BenchmarkReport{a, b}, "0")
+	app.Get("/benchmarks/compare", handler.CompareReports)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/benchmarks/compare?uid=run-a&baseline=run-b", nil))
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode)
+
+	var result struct {
+		Deltas []BenchmarkMetricDelta `json:"deltas"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	require.Len(t, result.Deltas, 1)
+	require.NotNil(t, result.Deltas[0].PercentChange)
+	assert.InDelta(t, -20.0, *result.Deltas[0].PercentChange, 1e-9)
+	assert.True(t, result.Deltas[0].Improved)
+}
+
+func TestBenchmarkHandlers_FindReport_KeepsListingCache(t *testing.T) {
+	handler := NewBenchmarkHandlers("key", "", nil)
+	var a, b BenchmarkReport
+	a.Run.UID = "run-a"
+	b.Run.UID = "run-b"
+	handler.cache.set([]BenchmarkReport{a}, "7d")
+	handler.lookup.set([]BenchmarkReport{a, b}, "0")
+
+	got, err := handler.findReport(context.Background(), "run-b")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "run-b", got.Run.UID)
+
+	got, err = handler.findReport(context.Background(), "no-such-run")
+	require.NoError(t, err, "an unknown uid is answered from the cached report set")
+	assert.Nil(t, got)
+
+	_, ok := handler.cache.get("7d")
+	assert.True(t, ok, "a lookup must not replace the since-scoped listing")
+}
+
+func TestBenchmarkHandlers_MarkBaseline_UnknownUID(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewBenchmarkHandlers("key", "", env.Store)
+	var a BenchmarkReport
+	a.Run.UID = "run-a"
+	handler.lookup.set([]BenchmarkReport{a}, "0")
+	env.App.Post("/benchmarks/baselines", handler.MarkBaseline)
+
+	req := httptest.NewRequest("POST", "/benchmarks/baselines", strings.NewReader(`{"uid":"no-such-run"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := env.App.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, 404, resp.StatusCode)
+	env.Store.(*test.MockStore).AssertNotCalled(t, "SetBenchmarkBaseline", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCSVText(t *testing.T) {
+	tests := map[string]string{
+		"llama-3-8b":        "llama-3-8b",
+		"=HYPERLINK(\"x\")": "'=HYPERLINK(\"x\")",
+		"+1":                "'+1",
+		"-1":                "'-1",
+		"@SUM(A1)":          "'@SUM(A1)",
+		"":                  "",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, csvText(in), in)
+	}
+}
+
+func TestBenchmarkHandlers_ExportReports(t *testing.T) {
+	p50, p95 := 0.12, 0.3
+	var r BenchmarkReport
+	r.Run.UID = "exp/run/stage-1"
+	r.Run.EID = "exp/run"
+	r.Run.Time.Start = "2026-01-02T03:04:05Z"
+	for _, role := range []string{"prefill", "decode"} {
+		var comp BenchmarkStackComponent
+		comp.Standardized.Tool = "vllm"
+		comp.Standardized.Role = role
+		comp.Standardized.Model = &BenchmarkModelRef{Name: "llama-3-8b"}
+		comp.Standardized.Accelerator = &BenchmarkAccelerator{Model: "H100", Count: 4}
+		r.Scenario.Stack = append(r.Scenario.Stack, comp)
+	}
+	agg := &r.Results.RequestPerformance.Aggregate
+	agg.Throughput.OutputTokenRate = &BenchmarkStatistics{Units: "tokens/s", Mean: 1234.5}
+	agg.Latency.TimeToFirstToken = &BenchmarkStatistics{Units: "s", Mean: 0.15, P50: &p50, P95: &p95}
+	agg.Requests.Total = 500
+	agg.Requests.Failures = 2
+
+	app := fiber.New()
+	handler := NewBenchmarkHandlers("key", "", nil)
+	handler.cache.set([]BenchmarkReport{r}, "0")
+	app.Get("/benchmarks/export", handler.ExportReports)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/benchmarks/export?format=csv", nil))
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/csv")
+
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{
+		"uid", "eid", "start", "model", "tool", "accelerator", "accelerator_count",
+		"output_token_rate", "ttft_p50", "ttft_p95", "requests_total", "requests_failed",
+	}, records[0])
+	assert.Equal(t, []string{
+		"exp/run/stage-1", "exp/run", "2026-01-02T03:04:05Z", "llama-3-8b", "vllm", "H100", "8",
+		"1234.5", "0.12", "0.3", "500", "2",
+	}, records[1])
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/benchmarks/export?format=json", nil))
+	require.NoError(t, err)
+	require.Equal(t, 200, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var rows []BenchmarkExportRow
+	require.NoError(t, json.Unmarshal(body, &rows))
+	require.Len(t, rows, 1)
+	assert.Equal(t, "H100", rows[0].Accelerator)
+	require.NotNil(t, rows[0].TTFTP95)
+	assert.Equal(t, 0.3, *rows[0].TTFTP95)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/benchmarks/export?format=xlsx", nil))
+	require.NoError(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+}
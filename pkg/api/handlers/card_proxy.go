@@ -41,27 +41,34 @@ var cardProxyClient = &http.Client{
 		return http.ErrUseLastResponse
 	},
 	Transport: &http.Transport{
-		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-			host, port, err := net.SplitHostPort(addr)
-			if err != nil {
-				return nil, err
-			}
-			ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
-			if err != nil {
-				return nil, err
-			}
-			for _, ip := range ips {
-				if isBlockedIP(ip.IP) {
-					return nil, fmt.Errorf("blocked: private IP %s for host %s", ip.IP, host)
-				}
-			}
-			// Connect to the first validated IP directly — no second DNS lookup
-			dialer := &net.Dialer{Timeout: cardProxyTimeout}
-			return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].IP.String(), port))
-		},
+		DialContext: dialPublicOnly(cardProxyTimeout),
 	},
 }
 
+// dialPublicOnly returns a DialContext that resolves the host, refuses to
+// connect if any resolved IP is private or reserved, and dials the first
+// validated IP directly so a second DNS lookup cannot be rebound.
+func dialPublicOnly(timeout time.Duration) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			if isBlockedIP(ip.IP) {
+				return nil, fmt.Errorf("blocked: private IP %s for host %s", ip.IP, host)
+			}
+		}
+		// Connect to the first validated IP directly — no second DNS lookup
+		dialer := &net.Dialer{Timeout: timeout}
+		return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].IP.String(), port))
+	}
+}
+
 // blockedCIDRs contains CIDR ranges that must never be proxied.
 // This prevents SSRF attacks against internal infrastructure.
 var blockedCIDRs = func() []*net.IPNet {
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubestellar/console/pkg/api/middleware"
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+// clusterAccess decides which identity a handler's cluster queries run as.
+// Every handler type that talks to clusters embeds it, so enabling
+// K8S_IMPERSONATE_USERS cannot be bypassed through a handler that still
+// uses the backend's own credentials.
+type clusterAccess struct {
+	// impersonateUsers makes cluster queries act as the signed-in user, see
+	// clusterClientFor.
+	impersonateUsers bool
+}
+
+// SetImpersonation enables acting as the signed-in user against clusters.
+func (a *clusterAccess) SetImpersonation(enabled bool) {
+	a.impersonateUsers = enabled
+}
+
+// clusterClientFor returns the cluster client for the request: with
+// impersonation enabled, a view of shared acting as the signed-in user's
+// GitHub login, with its own client and health caches; otherwise shared.
+// Requests without a login get a 401 rather than the console's own
+// credentials. Resolve it once per request, before spawning goroutines: the
+// fiber.Ctx is recycled once the handler returns.
+func (a *clusterAccess) clusterClientFor(c *fiber.Ctx, shared *k8s.MultiClusterClient) (*k8s.MultiClusterClient, error) {
+	if !a.impersonateUsers {
+		return shared, nil
+	}
+	client, err := shared.Impersonating(middleware.GetGitHubLogin(c))
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "sign in to query clusters as yourself")
+	}
+	return client, nil
+}
+
+// clusterCacheIdentity is the identity cached cluster data is keyed by: the
+// console user, plus the impersonated login when queries run as the user.
+func (a *clusterAccess) clusterCacheIdentity(c *fiber.Ctx) string {
+	identity := middleware.GetUserID(c).String()
+	if a.impersonateUsers {
+		identity += ":" + middleware.GetGitHubLogin(c)
+	}
+	return identity
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterHandlers_ImpersonationWithoutLoginReturns401(t *testing.T) {
+	env := setupTestEnv(t)
+
+	topology := NewTopologyHandlers(env.K8sClient, env.Hub)
+	topology.SetImpersonation(true)
+	env.App.Get("/api/topology", topology.GetTopology)
+
+	crds := NewCRDHandlers(env.K8sClient)
+	crds.SetImpersonation(true)
+	env.App.Get("/api/crds", crds.ListCRDs)
+
+	workloads := NewWorkloadHandlers(env.K8sClient, env.Hub, nil)
+	workloads.SetImpersonation(true)
+	env.App.Get("/api/workloads", workloads.ListWorkloads)
+
+	mcp := NewMCPHandlers(nil, env.K8sClient, nil)
+	mcp.SetImpersonation(true)
+	env.App.Get("/api/mcp/pods/stream", mcp.GetPodsStream)
+
+	for _, path := range []string{"/api/topology", "/api/crds", "/api/workloads", "/api/mcp/pods/stream"} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+
+		resp, err := env.App.Test(req, 5000)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, path)
+	}
+}
+
+func TestClusterCacheIdentity(t *testing.T) {
+	userID := uuid.New()
+	identity := func(impersonate bool, login string) string {
+		var got string
+		app := fiber.New()
+		app.Get("/", func(c *fiber.Ctx) error {
+			c.Locals("userID", userID)
+			c.Locals("githubLogin", login)
+			a := clusterAccess{impersonateUsers: impersonate}
+			got = a.clusterCacheIdentity(c)
+			return nil
+		})
+		_, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+		require.NoError(t, err)
+		return got
+	}
+
+	assert.Equal(t, identity(false, "alice"), identity(false, "bob"),
+		"without impersonation every login sees the console's own view")
+	assert.NotEqual(t, identity(true, "alice"), identity(true, "bob"),
+		"impersonated views must not share cached cluster data")
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubestellar/console/pkg/api/audit"
+	"github.com/kubestellar/console/pkg/k8s"
+	"github.com/kubestellar/console/pkg/store"
+)
+
+// ClusterContextHandlers edits the console's own kubeconfig. These are local
+// file edits, not Kubernetes API mutations, so they stay on the backend.
+type ClusterContextHandlers struct {
+	k8sClient *k8s.MultiClusterClient
+	store     store.Store
+}
+
+// NewClusterContextHandlers creates a new kubeconfig context handler.
+func NewClusterContextHandlers(k8sClient *k8s.MultiClusterClient, s store.Store) *ClusterContextHandlers {
+	return &ClusterContextHandlers{k8sClient: k8sClient, store: s}
+}
+
+// setCurrentContextRequest is the body of POST /api/clusters/current.
+type setCurrentContextRequest struct {
+	Context string `json:"context"`
+}
+
+// SetCurrentContext switches the kubeconfig current-context
+// POST /api/clusters/current
+func (h *ClusterContextHandlers) SetCurrentContext(c *fiber.Ctx) error {
+	// The current-context is shared by every console user.
+	if err := requireEditorOrAdmin(c, h.store); err != nil {
+		return err
+	}
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+
+	var req setCurrentContextRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.Context == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "context is required"})
+	}
+
+	if err := h.k8sClient.SetCurrentContext(req.Context); err != nil {
+		if errors.Is(err, k8s.ErrContextNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "context not found"})
+		}
+		slog.Error("[Clusters] failed to set current context", "context", req.Context, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to set current context"})
+	}
+
+	audit.Log(c, audit.ActionSetCurrentContext, "kubeconfig_context", req.Context)
+	return c.JSON(fiber.Map{"success": true, "context": req.Context})
+}
+
+// GetContextDetails returns a context's server, TLS and proxy settings for
+// debugging certificate and connectivity errors. Credentials and
+// certificate contents are never included.
+// GET /api/clusters/:cluster/details
+func (h *ClusterContextHandlers) GetContextDetails(c *fiber.Ctx) error {
+	if err := requireViewerOrAbove(c, h.store); err != nil {
+		return err
+	}
+	cluster := c.Params("cluster")
+	if err := mcpValidateName("cluster", cluster); err != nil {
+		return err
+	}
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+
+	details, err := h.k8sClient.GetContextDetails(cluster)
+	if err != nil {
+		if errors.Is(err, k8s.ErrContextNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "context not found"})
+		}
+		slog.Error("[Clusters] failed to read context details", "context", cluster, "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to read context details"})
+	}
+	return c.JSON(details)
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+func TestSetCurrentContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	const kubeconfig = `apiVersion: v1
+kind: Config
+current-context: prod
+contexts:
+  - name: prod
+    context: {cluster: prod, user: u}
+  - name: staging
+    context: {cluster: staging, user: u}
+clusters:
+  - name: prod
+    cluster: {server: https://prod.example.com}
+  - name: staging
+    cluster: {server: https://staging.example.com}
+users:
+  - name: u
+    user: {}
+`
+	require.NoError(t, os.WriteFile(path, []byte(kubeconfig), 0600))
+	k8sClient, err := k8s.NewMultiClusterClient(path)
+	require.NoError(t, err)
+
+	app := fiber.New()
+	h := NewClusterContextHandlers(k8sClient, nil)
+	app.Post("/api/clusters/current", h.SetCurrentContext)
+
+	post := func(body string) *http.Response {
+		req, _ := http.NewRequest(http.MethodPost, "/api/clusters/current", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, 5000)
+		require.NoError(t, err)
+		return resp
+	}
+
+	assert.Equal(t, http.StatusBadRequest, post(`{}`).StatusCode)
+	assert.Equal(t, http.StatusNotFound, post(`{"context":"missing"}`).StatusCode)
+	assert.Equal(t, http.StatusOK, post(`{"context":"staging"}`).StatusCode)
+
+	onDisk, err := clientcmd.LoadFromFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "staging", onDisk.CurrentContext)
+}
+
+func TestGetContextDetails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	const kubeconfig = `apiVersion: v1
+kind: Config
+current-context: prod
+contexts:
+  - name: prod
+    context: {cluster: prod, user: u}
+clusters:
+  - name: prod
+    cluster:
+      server: https://prod.example.com
+      certificate-authority-data: LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCg==
+users:
+  - name: u
+    user: {token: super-secret-token}
+`
+	require.NoError(t, os.WriteFile(path, []byte(kubeconfig), 0600))
+	k8sClient, err := k8s.NewMultiClusterClient(path)
+	require.NoError(t, err)
+
+	app := fiber.New()
+	h := NewClusterContextHandlers(k8sClient, nil)
+	app.Get("/api/clusters/:cluster/details", h.GetContextDetails)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/clusters/prod/details", nil)
+	resp, err := app.Test(req, 5000)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"caSource":"data"`)
+	assert.NotContains(t, string(body), "super-secret-token")
+	assert.NotContains(t, string(body), "LS0tLS1CRUdJTi")
+
+	req, _ = http.NewRequest(http.MethodGet, "/api/clusters/missing/details", nil)
+	resp, err = app.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDiffClusters_Handler(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewClusterDiffHandlers(env.K8sClient)
+	env.App.Get("/api/clusters/diff", handler.DiffClusters)
+
+	addClusterToRawConfig(env.K8sClient, "prod-cluster")
+	env.K8sClient.InjectClient("prod-cluster", k8sfake.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "only-prod", Namespace: "default"}},
+	))
+
+	k8sClient, err := env.K8sClient.GetClient("test-cluster")
+	require.NoError(t, err)
+	_, err = k8sClient.AppsV1().Deployments("default").Create(context.Background(),
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "only-test", Namespace: "default"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "/api/clusters/diff?a=test-cluster&b=prod-cluster&namespace=default", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Diff struct {
+			Added   []map[string]interface{} `json:"added"`
+			Removed []map[string]interface{} `json:"removed"`
+		} `json:"diff"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	require.Len(t, payload.Diff.Added, 1)
+	assert.Equal(t, "only-prod", payload.Diff.Added[0]["name"])
+	require.Len(t, payload.Diff.Removed, 1)
+	assert.Equal(t, "only-test", payload.Diff.Removed[0]["name"])
+}
+
+func TestDiffClusters_MissingParams(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewClusterDiffHandlers(env.K8sClient)
+	env.App.Get("/api/clusters/diff", handler.DiffClusters)
+
+	req, err := http.NewRequest(http.MethodGet, "/api/clusters/diff?a=test-cluster", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestDiffResource_RejectsSecrets(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewClusterDiffHandlers(env.K8sClient)
+	env.App.Get("/api/clusters/diff/resource", handler.DiffResource)
+
+	req, err := http.NewRequest(http.MethodGet, "/api/clusters/diff/resource?a=test-cluster&b=prod-cluster&namespace=default&name=creds&type=secret", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	req, err = http.NewRequest(http.MethodGet, "/api/clusters/diff/resource?a=test-cluster&b=prod-cluster&type=deployment", nil)
+	require.NoError(t, err)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
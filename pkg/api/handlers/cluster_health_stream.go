@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/kubestellar/console/pkg/api/middleware"
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+const (
+	// defaultHealthStreamInterval is how often the health stream re-evaluates
+	// cluster health when the client does not pass ?interval=.
+	defaultHealthStreamInterval = 15 * time.Second
+	// minHealthStreamInterval / maxHealthStreamInterval clamp ?interval= so a
+	// client cannot turn the stream into a tight probe loop.
+	minHealthStreamInterval = 5 * time.Second
+	maxHealthStreamInterval = 5 * time.Minute
+)
+
+// Health stream SSE event names
+const (
+	// sseEventClusterHealth carries a k8s.ClusterHealth whose status changed
+	// since the previous evaluation (every cluster on the first evaluation).
+	sseEventClusterHealth = "cluster_health"
+	// sseEventClusterRemoved carries {"cluster": "..."} for a cluster that
+	// disappeared from the kubeconfig.
+	sseEventClusterRemoved = "cluster_removed"
+)
+
+// StreamClusterHealth pushes cluster health changes over SSE
+// GET /api/clusters/health/stream[?interval=seconds]
+//
+// Unlike the snapshot endpoints, the stream stays open until the client
+// disconnects (or logs out) and only sends clusters whose health changed.
+func (h *MCPHandlers) StreamClusterHealth(c *fiber.Ctx) error {
+	if isDemoMode(c) {
+		return streamDemoSSE(c, "health", getDemoAllClusterHealth())
+	}
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	interval := defaultHealthStreamInterval
+	if raw := c.Query("interval"); raw != "" {
+		secs, err := strconv.Atoi(raw)
+		if err != nil || secs <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "interval must be a positive number of seconds"})
+		}
+		interval = time.Duration(secs) * time.Second
+		if interval < minHealthStreamInterval {
+			interval = minHealthStreamInterval
+		}
+		if interval > maxHealthStreamInterval {
+			interval = maxHealthStreamInterval
+		}
+	}
+
+	// Snapshot request-scoped values before SetBodyStreamWriter; the
+	// fiber.Ctx may be reused by the time the callback runs (#6029, #6480).
+	userID := middleware.GetUserID(c)
+	requestCtx := c.UserContext()
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		streamCtx, streamCancel := context.WithCancel(requestCtx)
+		defer streamCancel()
+		if userID != uuid.Nil {
+			sessionID := registerSSESession(userID, streamCancel)
+			defer unregisterSSESession(userID, sessionID)
+		}
+		streamHealthChanges(streamCtx, w, interval, client.GetAllClusterHealth)
+	})
+	return nil
+}
+
+// streamHealthChanges evaluates health every interval and writes a
+// cluster_health event for each cluster whose fingerprint changed. Rounds
+// with no changes write an SSE comment so a dead client is noticed on the
+// next flush. Returns when ctx is done or a write fails.
+func streamHealthChanges(ctx context.Context, w *bufio.Writer, interval time.Duration, fetch func(context.Context) ([]k8s.ClusterHealth, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := make(map[string]string)
+	for {
+		health, err := fetch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			slog.Warn("[HealthStream] health evaluation failed", "error", err)
+		}
+
+		changed := 0
+		seen := make(map[string]bool, len(health))
+		for i := range health {
+			ch := health[i]
+			seen[ch.Cluster] = true
+			fp := healthFingerprint(&ch)
+			if last[ch.Cluster] == fp {
+				continue
+			}
+			last[ch.Cluster] = fp
+			if writeSSEEvent(w, sseEventClusterHealth, ch) != nil {
+				return
+			}
+			changed++
+		}
+		// Only prune on a successful evaluation; a failed fetch says nothing
+		// about which clusters still exist.
+		if err == nil {
+			for name := range last {
+				if seen[name] {
+					continue
+				}
+				delete(last, name)
+				if writeSSEEvent(w, sseEventClusterRemoved, fiber.Map{"cluster": name}) != nil {
+					return
+				}
+				changed++
+			}
+		}
+		if changed == 0 {
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// healthFingerprint reduces a ClusterHealth to the fields that represent a
+// status change. Capacity and request totals drift constantly and are
+// deliberately excluded so they do not cause an event every interval.
+func healthFingerprint(h *k8s.ClusterHealth) string {
+	return fmt.Sprintf("%t|%t|%s|%d/%d|%s",
+		h.Healthy, h.Reachable, h.ErrorType, h.ReadyNodes, h.NodeCount, strings.Join(h.Issues, ";"))
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+// syncBuffer lets the test read output while streamHealthChanges writes it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestStreamHealthChanges_EmitsOnTransition(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	fetch := func(context.Context) ([]k8s.ClusterHealth, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		healthy := calls == 1
+		return []k8s.ClusterHealth{
+			{Cluster: "prod", Healthy: healthy, Reachable: true, NodeCount: 3, ReadyNodes: 3},
+			{Cluster: "stable", Healthy: true, Reachable: true, NodeCount: 1, ReadyNodes: 1},
+		}, nil
+	}
+
+	out := &syncBuffer{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		streamHealthChanges(ctx, bufio.NewWriter(out), 10*time.Millisecond, fetch)
+	}()
+
+	deadline := time.After(2 * time.Second)
+	for !strings.Contains(out.String(), `"healthy":false`) {
+		select {
+		case <-deadline:
+			cancel()
+			<-done
+			t.Fatalf("no unhealthy transition event; output:\n%s", out.String())
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+	// Let a few unchanged rounds run to check nothing is re-sent.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+
+	got := out.String()
+	if n := strings.Count(got, "event: "+sseEventClusterHealth); n != 3 {
+		t.Errorf("expected 3 cluster_health events (2 initial + 1 transition), got %d:\n%s", n, got)
+	}
+	if n := strings.Count(got, `"cluster":"stable"`); n != 1 {
+		t.Errorf("unchanged cluster should be sent once, got %d", n)
+	}
+	if !strings.Contains(got, ": keepalive") {
+		t.Error("expected keepalive comment on rounds without changes")
+	}
+}
+
+func TestStreamHealthChanges_EmitsRemoved(t *testing.T) {
+	calls := 0
+	fetch := func(context.Context) ([]k8s.ClusterHealth, error) {
+		calls++
+		if calls == 1 {
+			return []k8s.ClusterHealth{{Cluster: "gone", Healthy: true}}, nil
+		}
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	streamHealthChanges(ctx, bufio.NewWriter(&buf), 10*time.Millisecond, fetch)
+
+	if !strings.Contains(buf.String(), "event: "+sseEventClusterRemoved) {
+		t.Errorf("expected cluster_removed event, got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubestellar/console/pkg/k8s"
+	"github.com/kubestellar/console/pkg/store"
+)
+
+const (
+	// maxClusterDisplayNameLen bounds ClusterMetadata.DisplayName.
+	maxClusterDisplayNameLen = 128
+	// maxClusterTags bounds how many tags one cluster may carry.
+	maxClusterTags = 32
+	// maxClusterTagLen bounds a single tag.
+	maxClusterTagLen = 63
+)
+
+// clusterEnvironments are the accepted ClusterMetadata.Environment values;
+// empty clears it.
+var clusterEnvironments = map[string]bool{
+	"":      true,
+	"dev":   true,
+	"stage": true,
+	"prod":  true,
+}
+
+// ListClusterMetadata returns the saved metadata of every cluster context.
+func (h *MCPHandlers) ListClusterMetadata(c *fiber.Ctx) error {
+	if h.store == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "cluster metadata storage not configured")
+	}
+	metas, err := h.store.ListClusterMetadata(c.UserContext())
+	if err != nil {
+		slog.Error("[ClusterMetadata] failed to list", "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to list cluster metadata")
+	}
+	if metas == nil {
+		metas = []store.ClusterMetadata{}
+	}
+	return c.JSON(fiber.Map{"metadata": metas})
+}
+
+// GetClusterMetadata returns the saved metadata of the context :cluster.
+func (h *MCPHandlers) GetClusterMetadata(c *fiber.Ctx) error {
+	if h.store == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "cluster metadata storage not configured")
+	}
+	cluster := c.Params("cluster")
+	if err := validateClusterMetadataContext(cluster); err != nil {
+		return err
+	}
+	meta, err := h.store.GetClusterMetadata(c.UserContext(), cluster)
+	if err != nil {
+		slog.Error("[ClusterMetadata] failed to get", "cluster", cluster, "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to get cluster metadata")
+	}
+	if meta == nil {
+		return fiber.NewError(fiber.StatusNotFound, "no metadata for cluster "+cluster)
+	}
+	return c.JSON(meta)
+}
+
+// SetClusterMetadata replaces the metadata of the context :cluster with the
+// body {"displayName", "tags", "environment"}.
+func (h *MCPHandlers) SetClusterMetadata(c *fiber.Ctx) error {
+	if h.store == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "cluster metadata storage not configured")
+	}
+	if err := requireEditorOrAdmin(c, h.store); err != nil {
+		return err
+	}
+	cluster := c.Params("cluster")
+	if err := validateClusterMetadataContext(cluster); err != nil {
+		return err
+	}
+	var body struct {
+		DisplayName string   `json:"displayName"`
+		Tags        []string `json:"tags"`
+		Environment string   `json:"environment"`
+	}
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+	meta := &store.ClusterMetadata{
+		Context:     cluster,
+		DisplayName: strings.TrimSpace(body.DisplayName),
+		Tags:        body.Tags,
+		Environment: strings.ToLower(strings.TrimSpace(body.Environment)),
+	}
+	if err := normalizeClusterMetadata(meta); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := h.store.SaveClusterMetadata(c.UserContext(), meta); err != nil {
+		slog.Error("[ClusterMetadata] failed to save", "cluster", cluster, "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to save cluster metadata")
+	}
+	return c.JSON(meta)
+}
+
+// DeleteClusterMetadata removes the metadata of the context :cluster.
+func (h *MCPHandlers) DeleteClusterMetadata(c *fiber.Ctx) error {
+	if h.store == nil {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "cluster metadata storage not configured")
+	}
+	if err := requireEditorOrAdmin(c, h.store); err != nil {
+		return err
+	}
+	cluster := c.Params("cluster")
+	if err := validateClusterMetadataContext(cluster); err != nil {
+		return err
+	}
+	if err := h.store.DeleteClusterMetadata(c.UserContext(), cluster); err != nil {
+		slog.Error("[ClusterMetadata] failed to delete", "cluster", cluster, "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "failed to delete cluster metadata")
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// validateClusterMetadataContext requires a non-empty, valid cluster name.
+func validateClusterMetadataContext(cluster string) error {
+	if cluster == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "cluster is required")
+	}
+	return mcpValidateName("cluster", cluster)
+}
+
+// normalizeClusterMetadata validates meta and drops blank and duplicate tags.
+func normalizeClusterMetadata(meta *store.ClusterMetadata) error {
+	if len(meta.DisplayName) > maxClusterDisplayNameLen {
+		return fmt.Errorf("displayName exceeds %d characters", maxClusterDisplayNameLen)
+	}
+	if !clusterEnvironments[meta.Environment] {
+		return fmt.Errorf("environment must be one of dev, stage or prod")
+	}
+	tags := make([]string, 0, len(meta.Tags))
+	seen := make(map[string]bool, len(meta.Tags))
+	for _, tag := range meta.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len(tag) > maxClusterTagLen {
+			return fmt.Errorf("tag %q exceeds %d characters", tag, maxClusterTagLen)
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxClusterTags {
+		return fmt.Errorf("at most %d tags are allowed", maxClusterTags)
+	}
+	meta.Tags = tags
+	return nil
+}
+
+// applyClusterMetadata fills in the saved display name, tags and environment
+// of each cluster, matched by context. A store failure only loses the
+// metadata, not the listing.
+func (h *MCPHandlers) applyClusterMetadata(ctx context.Context, clusters []k8s.ClusterInfo) {
+	if h.store == nil || len(clusters) == 0 {
+		return
+	}
+	metas, err := h.store.ListClusterMetadata(ctx)
+	if err != nil {
+		slog.Warn("[ClusterMetadata] failed to load for cluster list", "error", err)
+		return
+	}
+	byContext := make(map[string]store.ClusterMetadata, len(metas))
+	for _, meta := range metas {
+		byContext[meta.Context] = meta
+	}
+	for i := range clusters {
+		meta, ok := byContext[clusters[i].Context]
+		if !ok {
+			continue
+		}
+		clusters[i].DisplayName = meta.DisplayName
+		clusters[i].Tags = meta.Tags
+		clusters[i].Environment = meta.Environment
+	}
+}
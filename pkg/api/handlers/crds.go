@@ -25,6 +25,7 @@ var crdGVR = schema.GroupVersionResource{
 // CRDHandlers handles Custom Resource Definition API endpoints
 type CRDHandlers struct {
 	k8sClient *k8s.MultiClusterClient
+	clusterAccess
 }
 
 // NewCRDHandlers creates a new CRD handlers instance
@@ -78,14 +79,18 @@ func (h *CRDHandlers) ListCRDs(c *fiber.Ctx) error {
 			IsDemoData: true,
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), crdListTimeout)
 	defer cancel()
 
-	clusters, err := h.k8sClient.DeduplicatedClusters(ctx)
+	clusters, err := client.DeduplicatedClusters(ctx)
 	if err != nil {
 		var listErr error
-		clusters, listErr = h.k8sClient.ListClusters(ctx)
+		clusters, listErr = client.ListClusters(ctx)
 		if listErr != nil {
 			return c.Status(statusServiceUnavailableCRD).JSON(fiber.Map{"error": "cluster discovery failed", "isDemoData": false})
 		}
@@ -93,12 +98,12 @@ func (h *CRDHandlers) ListCRDs(c *fiber.Ctx) error {
 	allCRDs := make([]CRDSummary, 0)
 
 	for _, cluster := range clusters {
-		client, err := h.k8sClient.GetDynamicClient(cluster.Name)
+		dynClient, err := client.GetDynamicClient(cluster.Name)
 		if err != nil {
 			continue
 		}
 
-		crdList, err := client.Resource(crdGVR).List(ctx, metav1.ListOptions{})
+		crdList, err := dynClient.Resource(crdGVR).List(ctx, metav1.ListOptions{})
 		if err != nil {
 			continue
 		}
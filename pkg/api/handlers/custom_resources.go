@@ -2,14 +2,18 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 
 	"github.com/gofiber/fiber/v2"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubestellar/console/pkg/k8s"
 )
 
 // CustomResourceItem represents a single custom resource instance returned by the API.
@@ -29,6 +33,53 @@ type CustomResourceResponse struct {
 	Items      []CustomResourceItem `json:"items"`
 	Errors     map[string]string    `json:"errors,omitempty"`
 	IsDemoData bool                 `json:"isDemoData"`
+	// Continue is the token of the next page of a paged single-cluster
+	// query, empty after the last page.
+	Continue string `json:"continue,omitempty"`
+}
+
+// crMaxPageLimit caps the ?limit= of a paged query.
+const crMaxPageLimit = 1000
+
+// resolveKind resolves the ?kind= of GetCustomResources on cluster. When
+// it cannot, done is true and err is the error response to return; an
+// ambiguous kind is answered with 400 and the candidate resources.
+func (h *MCPHandlers) resolveKind(c *fiber.Ctx, cluster, kind string) (_ schema.GroupVersionResource, done bool, err error) {
+	if cluster == "" {
+		return schema.GroupVersionResource{}, true, fiber.NewError(fiber.StatusBadRequest, "kind requires cluster")
+	}
+	if !isValidK8sName(strings.ToLower(kind)) {
+		return schema.GroupVersionResource{}, true, fiber.NewError(fiber.StatusBadRequest, "invalid kind parameter")
+	}
+	if h.k8sClient == nil {
+		return schema.GroupVersionResource{}, true, errNoClusterAccess(c)
+	}
+
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return schema.GroupVersionResource{}, true, err
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+	defer cancel()
+	gvr, err := client.ResolveGVR(ctx, cluster, kind)
+	var ambiguous *k8s.AmbiguousKindError
+	switch {
+	case errors.As(err, &ambiguous):
+		candidates := make([]string, 0, len(ambiguous.Candidates))
+		for _, candidate := range ambiguous.Candidates {
+			candidates = append(candidates, candidate.GroupResource().String())
+		}
+		return schema.GroupVersionResource{}, true, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":      "kind is ambiguous; qualify it with its group",
+			"candidates": candidates,
+		})
+	case errors.Is(err, k8s.ErrUnknownKind):
+		return schema.GroupVersionResource{}, true, fiber.NewError(fiber.StatusBadRequest, "unknown kind: "+kind)
+	case err != nil:
+		return schema.GroupVersionResource{}, true, handleK8sError(c, err)
+	}
+	return gvr, false, nil
 }
 
 // GetCustomResources queries custom resource instances across clusters.
@@ -38,11 +89,17 @@ type CustomResourceResponse struct {
 //	group     — API group (e.g. "keda.sh", "kafka.strimzi.io")
 //	version   — API version (e.g. "v1alpha1", "v1beta2")
 //	resource  — plural resource name (e.g. "scaledobjects", "kafkas")
+//	kind      — (optional, with cluster) instead of group/version/resource,
+//	            a kind or resource name such as "ScaledObject" or "so",
+//	            resolved through the cluster's discovery
 //	cluster   — (optional) restrict to a single cluster
 //	namespace — (optional) restrict to a single namespace
+//	limit     — (optional, with cluster) return one page of at most limit items
+//	continue  — (optional, with cluster) the page token from a previous response
 //
 // Kubernetes RBAC controls access — if the user's kubeconfig cannot list the
-// resource, the per-cluster query silently returns zero items.
+// resource, the per-cluster query silently returns zero items. Resources the
+// configured GenericResourcePolicy denies are refused with 403.
 func (h *MCPHandlers) GetCustomResources(c *fiber.Ctx) error {
 	// SECURITY (#7487): custom resource listing can expose sensitive spec/status
 	// data; require a valid console role (viewer or above).
@@ -59,6 +116,22 @@ func (h *MCPHandlers) GetCustomResources(c *fiber.Ctx) error {
 	resource := c.Query("resource")
 	cluster := c.Query("cluster")
 	namespace := c.Query("namespace")
+	limit := c.QueryInt("limit", 0)
+	continueToken := c.Query("continue")
+
+	if kind := c.Query("kind"); kind != "" && resource == "" {
+		gvr, done, err := h.resolveKind(c, cluster, kind)
+		if done {
+			return err
+		}
+		group, version, resource = gvr.Group, gvr.Version, gvr.Resource
+	}
+
+	// Checked before the empty-parameter short-circuit below so core
+	// resources (no group), Secrets in particular, are refused too.
+	if resource != "" && !h.resourcePolicy.Allowed(schema.GroupVersionResource{Group: group, Resource: resource}) {
+		return fiber.NewError(fiber.StatusForbidden, "resource is not available through this endpoint")
+	}
 
 	if group == "" || version == "" || resource == "" {
 		// Return an empty list instead of 400 — callers may query the base URL
@@ -76,16 +149,30 @@ func (h *MCPHandlers) GetCustomResources(c *fiber.Ctx) error {
 	if !isValidK8sName(resource) {
 		return c.Status(400).JSON(fiber.Map{"error": "invalid resource parameter — must match DNS label format"})
 	}
+	if err := mcpValidatePositiveInt("limit", limit, crMaxPageLimit); err != nil {
+		return err
+	}
 
 	if h.k8sClient == nil {
 		return c.Status(503).JSON(CustomResourceResponse{Items: []CustomResourceItem{}, IsDemoData: true})
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	gvr := schema.GroupVersionResource{Group: group, Version: version, Resource: resource}
 
 	// Single-cluster path
 	if cluster != "" {
-		items, err := h.listCR(c.Context(), cluster, namespace, gvr)
+		var items []CustomResourceItem
+		var next string
+		var err error
+		if limit > 0 || continueToken != "" {
+			items, next, err = listCRPage(c.Context(), client, cluster, namespace, gvr, int64(limit), continueToken)
+		} else {
+			items, err = listCR(c.Context(), client, cluster, namespace, gvr)
+		}
 		if err != nil {
 			slog.Warn("custom-resources: cluster error", "cluster", cluster, "error", err)
 			// #7973: distinguish RBAC 403 (caller has no permission) from
@@ -101,11 +188,11 @@ func (h *MCPHandlers) GetCustomResources(c *fiber.Ctx) error {
 			}
 			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to list resources"})
 		}
-		return c.JSON(CustomResourceResponse{Items: items, IsDemoData: false})
+		return c.JSON(CustomResourceResponse{Items: items, IsDemoData: false, Continue: next})
 	}
 
 	// Fan-out across all healthy clusters
-	clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+	clusters, _, err := client.HealthyClusters(c.Context())
 	if err != nil {
 		slog.Warn("custom-resources: HealthyClusters failed", "error", err)
 		return c.Status(500).JSON(fiber.Map{"error": "internal server error"})
@@ -133,7 +220,7 @@ func (h *MCPHandlers) GetCustomResources(c *fiber.Ctx) error {
 			ctx, cancel := context.WithTimeout(clusterCtx, mcpDefaultTimeout)
 			defer cancel()
 
-			items, err := h.listCR(ctx, clusterName, namespace, gvr)
+			items, err := listCR(ctx, client, clusterName, namespace, gvr)
 			if err != nil {
 				slog.Warn("custom-resources: cluster error", "cluster", clusterName, "resource", gvr.Resource, "error", err)
 				// #7973: propagate per-cluster errors instead of silently
@@ -170,52 +257,46 @@ func (h *MCPHandlers) GetCustomResources(c *fiber.Ctx) error {
 	return c.JSON(resp)
 }
 
-// listCR queries a single cluster for custom resource instances using the dynamic client.
-func (h *MCPHandlers) listCR(
+// listCR queries a single cluster for every custom resource instance, a
+// page at a time.
+func listCR(
 	ctx context.Context,
+	client *k8s.MultiClusterClient,
 	clusterName, namespace string,
 	gvr schema.GroupVersionResource,
 ) ([]CustomResourceItem, error) {
-	dynClient, err := h.k8sClient.GetDynamicClient(clusterName)
-	if err != nil {
-		return nil, fmt.Errorf("dynamic client: %w", err)
-	}
-
-	var uList interface{}
-	if namespace != "" {
-		uList, err = dynClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
-	} else {
-		uList, err = dynClient.Resource(gvr).List(ctx, metav1.ListOptions{})
-	}
+	items := make([]CustomResourceItem, 0)
+	err := client.EachCustomResourcePage(ctx, clusterName, namespace, gvr, func(list *unstructured.UnstructuredList) error {
+		for i := range list.Items {
+			items = append(items, parseCRItem(list.Items[i].Object, clusterName))
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("list %s: %w", gvr.Resource, err)
 	}
+	return items, nil
+}
 
-	// Dynamic client returns *unstructured.UnstructuredList
-	type unstructuredList interface {
-		UnstructuredContent() map[string]interface{}
-	}
-	ul, ok := uList.(unstructuredList)
-	if !ok {
-		return nil, fmt.Errorf("unexpected return type %T", uList)
-	}
-
-	content := ul.UnstructuredContent()
-	rawItems, ok := content["items"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("unexpected type for items field in %s response", gvr.Resource)
+// listCRPage queries a single cluster for one page of custom resource
+// instances, returning the continue token of the next page.
+func listCRPage(
+	ctx context.Context,
+	client *k8s.MultiClusterClient,
+	clusterName, namespace string,
+	gvr schema.GroupVersionResource,
+	limit int64, continueToken string,
+) ([]CustomResourceItem, string, error) {
+	list, next, err := client.ListCustomResources(ctx, clusterName, namespace, gvr, limit, continueToken)
+	if err != nil {
+		return nil, "", fmt.Errorf("list %s: %w", gvr.Resource, err)
 	}
-	items := make([]CustomResourceItem, 0, len(rawItems))
 
-	for _, raw := range rawItems {
-		obj, ok := raw.(map[string]interface{})
-		if !ok {
-			continue
-		}
-		items = append(items, parseCRItem(obj, clusterName))
+	items := make([]CustomResourceItem, 0, len(list.Items))
+	for i := range list.Items {
+		items = append(items, parseCRItem(list.Items[i].Object, clusterName))
 	}
-
-	return items, nil
+	return items, next, nil
 }
 
 // parseCRItem extracts the key fields from an unstructured custom resource.
@@ -7,7 +7,13 @@ import (
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/kubestellar/console/pkg/k8s"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
 )
 
 func TestMCPHandlers_GetCustomResources(t *testing.T) {
@@ -52,3 +58,41 @@ func TestMCPHandlers_GetCustomResources(t *testing.T) {
 		assert.Equal(t, 400, resp.StatusCode)
 	})
 }
+
+func TestGetCustomResources_GenericResourcePolicy(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	handler.SetGenericResourcePolicy(k8s.NewGenericResourcePolicy("", "kafkas.kafka.strimzi.io", false))
+	env.App.Get("/api/mcp/custom-resources", handler.GetCustomResources)
+
+	scaledObjectsGVR := schema.GroupVersionResource{Group: "keda.sh", Version: "v1alpha1", Resource: "scaledobjects"}
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("keda.sh/v1alpha1")
+	obj.SetKind("ScaledObject")
+	obj.SetNamespace("apps")
+	obj.SetName("web")
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{scaledObjectsGVR: "ScaledObjectList"}, obj)
+	env.K8sClient.SetDynamicClient("test-cluster", dyn)
+
+	get := func(query string) (int, CustomResourceResponse) {
+		t.Helper()
+		req := httptest.NewRequest("GET", "/api/mcp/custom-resources?cluster=test-cluster&"+query, nil)
+		resp, err := env.App.Test(req, 5000)
+		require.NoError(t, err)
+		var result CustomResourceResponse
+		body, _ := io.ReadAll(resp.Body)
+		_ = json.Unmarshal(body, &result)
+		return resp.StatusCode, result
+	}
+
+	status, result := get("group=keda.sh&version=v1alpha1&resource=scaledobjects")
+	assert.Equal(t, 200, status)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "web", result.Items[0].Name)
+
+	status, _ = get("group=kafka.strimzi.io&version=v1beta2&resource=kafkas")
+	assert.Equal(t, 403, status, "denied by configuration")
+	status, _ = get("version=v1&resource=secrets")
+	assert.Equal(t, 403, status, "secrets are denied unless revealed")
+}
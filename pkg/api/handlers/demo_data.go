@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -66,6 +67,24 @@ func getDemoClusterHealth(cluster string) *k8s.ClusterHealth {
 	return &k8s.ClusterHealth{Cluster: cluster, Healthy: true, Reachable: true, NodeCount: 3, PodCount: 25, CpuCores: 12, MemoryGB: 48}
 }
 
+// Demo cluster version data, platform guessed from the demo cluster name
+func getDemoClusterVersion(cluster string) *k8s.ClusterVersion {
+	v := &k8s.ClusterVersion{Cluster: cluster, GitVersion: "v1.30.2", Major: "1", Minor: "30"}
+	switch {
+	case strings.HasPrefix(cluster, "eks-"):
+		v.GitVersion, v.Platform = "v1.30.2-eks-1552ad0", k8s.PlatformEKS
+	case strings.HasPrefix(cluster, "gke-"):
+		v.GitVersion, v.Platform = "v1.30.2-gke.1587003", k8s.PlatformGKE
+	case strings.HasPrefix(cluster, "aks-"):
+		v.Platform = k8s.PlatformAKS
+	case strings.HasPrefix(cluster, "openshift-"):
+		v.GitVersion, v.Platform = "v1.29.6+aa37e8f", k8s.PlatformOpenShift
+	case strings.HasPrefix(cluster, "k3s-"):
+		v.GitVersion, v.Platform = "v1.30.2+k3s1", k8s.PlatformK3s
+	}
+	return v
+}
+
 // Demo pod data
 func getDemoPods() []k8s.PodInfo {
 	return []k8s.PodInfo{
@@ -82,6 +101,47 @@ func getDemoPods() []k8s.PodInfo {
 	}
 }
 
+// filterDemoScope keeps the demo items in cluster and namespace, so drilling
+// into a demo cluster or namespace shows only its resources. Empty filters
+// match everything.
+func filterDemoScope[T any](items []T, cluster, namespace string, scope func(T) (string, string)) []T {
+	if cluster == "" && namespace == "" {
+		return items
+	}
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		itemCluster, itemNamespace := scope(item)
+		if (cluster == "" || itemCluster == cluster) && (namespace == "" || itemNamespace == namespace) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+func demoPodScope(p k8s.PodInfo) (string, string)           { return p.Cluster, p.Namespace }
+func demoDeploymentScope(d k8s.Deployment) (string, string) { return d.Cluster, d.Namespace }
+
+// getDemoNamespaces returns the namespaces the demo pods and deployments
+// live in, optionally limited to one cluster.
+func getDemoNamespaces(cluster string) []k8s.Namespace {
+	seen := make(map[string]bool)
+	namespaces := make([]k8s.Namespace, 0)
+	add := func(c, ns string) {
+		if (cluster != "" && c != cluster) || seen[c+"/"+ns] {
+			return
+		}
+		seen[c+"/"+ns] = true
+		namespaces = append(namespaces, k8s.Namespace{Name: ns, Cluster: c, Status: "Active", Age: "30d"})
+	}
+	for _, p := range getDemoPods() {
+		add(p.Cluster, p.Namespace)
+	}
+	for _, d := range getDemoDeployments() {
+		add(d.Cluster, d.Namespace)
+	}
+	return namespaces
+}
+
 // Demo pod issues
 func getDemoPodIssues() []k8s.PodIssue {
 	return []k8s.PodIssue{
@@ -92,6 +152,23 @@ func getDemoPodIssues() []k8s.PodIssue {
 	}
 }
 
+// Demo image pull issues
+func getDemoImagePullIssues() []k8s.ImagePullIssue {
+	return []k8s.ImagePullIssue{
+		{Pod: "api-gateway-6c9d8f7b4-q7wz2", Namespace: "production", Cluster: "eks-prod-us-east-1", Container: "gateway", Image: "registry.example.com/platform/gateway:v2.4.1", Reason: "ImagePullBackOff", Message: "Back-off pulling image \"registry.example.com/platform/gateway:v2.4.1\": manifest unknown"},
+		{Pod: "ml-trainer-7f5b9c-lk2pd", Namespace: "ml", Cluster: "gke-staging", Container: "trainer", Image: "private.registry.io/ml/trainer:latest", Reason: "ErrImagePull", Message: "failed to authorize: failed to fetch anonymous token: unauthorized"},
+	}
+}
+
+// Demo OOM kill events
+func getDemoOOMEvents() []k8s.OOMEvent {
+	now := time.Now()
+	return []k8s.OOMEvent{
+		{Pod: "oom-killed-pod-456", Namespace: "production", Cluster: "openshift-prod", Container: "worker", MemoryLimit: "512Mi", MemoryLimitBytes: 512 * 1024 * 1024, RestartCount: 8, TerminatedAt: now.Add(-10 * time.Minute)},
+		{Pod: "cache-warmer-5b7c9-zx4vq", Namespace: "data", Cluster: "eks-prod-us-east-1", Container: "warmer", MemoryLimit: "2Gi", MemoryLimitBytes: 2 * 1024 * 1024 * 1024, RestartCount: 2, TerminatedAt: now.Add(-3 * time.Hour)},
+	}
+}
+
 // Demo events
 func getDemoEvents() []k8s.Event {
 	return []k8s.Event{
@@ -131,6 +208,15 @@ func getDemoNodes() []k8s.NodeInfo {
 	}
 }
 
+// getDemoNodeAlerts returns synthetic node alerts for demo mode.
+func getDemoNodeAlerts() []k8s.NodeAlert {
+	return []k8s.NodeAlert{
+		{Cluster: "alibaba-ack-shanghai", Node: "ack-worker-3", Type: "NotReady", Severity: k8s.NodeAlertCritical, Message: "Node stopped reporting status; the kubelet may be down or unreachable"},
+		{Cluster: "vllm-gpu-cluster", Node: "gpu-node-2", Type: "DiskPressure", Severity: k8s.NodeAlertWarning, Message: "Node is low on disk space; pods may be evicted and images garbage collected"},
+		{Cluster: "eks-prod-us-east-1", Node: "ip-10-0-3-17", Type: "Unschedulable", Severity: k8s.NodeAlertInfo, Message: "Node is cordoned; new pods will not be scheduled on it"},
+	}
+}
+
 // Demo deployments
 func getDemoDeployments() []k8s.Deployment {
 	return []k8s.Deployment{
@@ -172,6 +258,15 @@ func getDemoSecurityIssues() []k8s.SecurityIssue {
 	}
 }
 
+// Demo vulnerability report counts per workload
+func getDemoVulnerabilityReports() []k8s.VulnReport {
+	return []k8s.VulnReport{
+		{Cluster: "eks-prod-us-east-1", Namespace: "production", Kind: "ReplicaSet", Name: "api-server-7d8f9c6b5", Images: []string{"ghcr.io/acme/api-server:2.4.1"}, Critical: 2, High: 7, Medium: 15, Low: 22},
+		{Cluster: "gke-staging", Namespace: "data", Kind: "StatefulSet", Name: "postgres", Images: []string{"docker.io/library/postgres:14.2"}, Critical: 1, High: 4, Medium: 9, Low: 31},
+		{Cluster: "eks-prod-us-east-1", Namespace: "monitoring", Kind: "DaemonSet", Name: "node-exporter", Images: []string{"quay.io/prometheus/node-exporter:v1.7.0"}, Medium: 2, Low: 5},
+	}
+}
+
 // Demo jobs
 func getDemoJobs() []k8s.Job {
 	return []k8s.Job{
@@ -258,9 +353,9 @@ func getDemoLimitRanges() []k8s.LimitRange {
 // Demo ReplicaSets
 func getDemoReplicaSets() []k8s.ReplicaSet {
 	return []k8s.ReplicaSet{
-		{Name: "frontend-7d8f9c6b5", Namespace: "production", Cluster: "eks-prod-us-east-1", Replicas: 3, ReadyReplicas: 3, OwnerName: "frontend", OwnerKind: "Deployment", Age: "2d"},
-		{Name: "api-server-5c4d8e7f2", Namespace: "production", Cluster: "eks-prod-us-east-1", Replicas: 5, ReadyReplicas: 5, OwnerName: "api-server", OwnerKind: "Deployment", Age: "1d"},
-		{Name: "worker-9a8b7c6d5", Namespace: "batch", Cluster: "gke-staging", Replicas: 2, ReadyReplicas: 2, OwnerName: "worker", OwnerKind: "Deployment", Age: "6h"},
+		{Name: "frontend-7d8f9c6b5", Namespace: "production", Cluster: "eks-prod-us-east-1", Replicas: 3, ReadyReplicas: 3, OwnerName: "frontend", OwnerKind: "Deployment", OwnerDeployment: "frontend", Revision: "4", Age: "2d"},
+		{Name: "api-server-5c4d8e7f2", Namespace: "production", Cluster: "eks-prod-us-east-1", Replicas: 5, ReadyReplicas: 5, OwnerName: "api-server", OwnerKind: "Deployment", OwnerDeployment: "api-server", Revision: "7", Age: "1d"},
+		{Name: "worker-9a8b7c6d5", Namespace: "batch", Cluster: "gke-staging", Replicas: 2, ReadyReplicas: 2, OwnerName: "worker", OwnerKind: "Deployment", OwnerDeployment: "worker", Revision: "2", Age: "6h"},
 	}
 }
 
@@ -368,6 +463,46 @@ func getDemoGPUNodes() []k8s.GPUNode {
 	}
 }
 
+// getDemoGPUSummary returns the fleet rollup of getDemoGPUNodes.
+func getDemoGPUSummary() *k8s.GPUSummary {
+	return &k8s.GPUSummary{
+		TotalGPUs:     20,
+		AllocatedGPUs: 12,
+		FreeGPUs:      8,
+		ByType: map[string]k8s.GPUAllocation{
+			"nvidia.com/gpu": {Total: 20, Allocated: 12, Free: 8},
+		},
+		ByManufacturer: map[string]k8s.GPUAllocation{
+			"NVIDIA": {Total: 20, Allocated: 12, Free: 8},
+		},
+		Clusters: []k8s.ClusterGPUSummary{
+			{Cluster: "eks-prod-us-east-1", Nodes: 1, GPUAllocation: k8s.GPUAllocation{Total: 4, Allocated: 2, Free: 2}},
+			{Cluster: "vllm-gpu-cluster", Nodes: 2, GPUAllocation: k8s.GPUAllocation{Total: 16, Allocated: 10, Free: 6}},
+		},
+	}
+}
+
+// getDemoFleetSummary returns a synthetic fleet issue rollup.
+func getDemoFleetSummary() *k8s.FleetSummary {
+	return &k8s.FleetSummary{
+		TotalClusters:       5,
+		UnhealthyClusters:   1,
+		UnreachableClusters: 0,
+		TotalPods:           186,
+		PodIssues:           7,
+		DeploymentIssues:    2,
+		SecurityIssues:      12,
+		SecurityHighIssues:  3,
+		WarningEvents:       23,
+		TopClusters: []k8s.ClusterIssueCounts{
+			{Cluster: "eks-prod-us-east-1", Healthy: true, Reachable: true, PodIssues: 4, DeploymentIssues: 1, SecurityIssues: 6, WarningEvents: 14, TotalIssues: 25},
+			{Cluster: "vllm-gpu-cluster", Healthy: false, Reachable: true, PodIssues: 3, DeploymentIssues: 1, SecurityIssues: 2, WarningEvents: 9, TotalIssues: 15},
+			{Cluster: "gke-staging", Healthy: true, Reachable: true, SecurityIssues: 4, TotalIssues: 4},
+		},
+		GeneratedAt: "2026-02-18T12:00:00Z",
+	}
+}
+
 // getDemoFlatcarNodes returns demo Flatcar Container Linux nodes across multiple clusters.
 func getDemoFlatcarNodes() []k8s.FlatcarNodeInfo {
 	return []k8s.FlatcarNodeInfo{
@@ -474,6 +609,27 @@ func getDemoPodLogs() string {
 2024-01-15T10:34:16Z INFO  Request completed in 45ms`
 }
 
+// Demo job logs, keyed by pod name
+func getDemoJobLogs() map[string]string {
+	return map[string]string{
+		"db-migrate-7x9kq": `2024-01-15T10:30:00Z INFO  Running migrations...
+2024-01-15T10:30:02Z ERROR connection refused: postgres-svc:5432
+2024-01-15T10:30:02Z FATAL migration aborted`,
+		"db-migrate-m2t4p": `2024-01-15T10:31:00Z INFO  Running migrations...
+2024-01-15T10:31:03Z INFO  Applied 12 migrations
+2024-01-15T10:31:03Z INFO  Done`,
+	}
+}
+
+// Demo top pods by CPU usage
+func getDemoTopPods() []k8s.PodMetric {
+	return []k8s.PodMetric{
+		{Name: "ml-training-5f8d9", Namespace: "ml", Cluster: "gke-staging", CPUMillicores: 3800, MemoryBytes: 12 << 30},
+		{Name: "postgres-0", Namespace: "data", Cluster: "eks-prod-us-east-1", CPUMillicores: 950, MemoryBytes: 3 << 30},
+		{Name: "api-server-7d8f9c6b5-x2k4m", Namespace: "production", Cluster: "eks-prod-us-east-1", CPUMillicores: 420, MemoryBytes: 768 << 20},
+	}
+}
+
 // getDemoAllClusterHealth returns health for all demo clusters
 func getDemoAllClusterHealth() []k8s.ClusterHealth {
 	clusters := getDemoClusters()
@@ -157,7 +157,11 @@ func (h *MCPHandlers) proxyDrasiPlatform(c *fiber.Ctx, upstreamPath string, upst
 		return fiber.NewError(fiber.StatusServiceUnavailable, "no kubernetes client available")
 	}
 
-	cfg, err := h.k8sClient.GetRestConfig(cluster)
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+	cfg, err := client.GetRestConfig(cluster)
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("unknown cluster %q: %v", cluster, err))
 	}
@@ -12,10 +12,12 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"text/template"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/kubestellar/console/pkg/models"
 	"github.com/kubestellar/console/pkg/settings"
 	"github.com/kubestellar/console/pkg/store"
 	"golang.org/x/sync/singleflight"
@@ -43,6 +45,15 @@ const backgroundGitHubOpTimeout = 30 * time.Second
 // under concurrent request load (#11827).
 const maxConcurrentGitHubOps = 5
 
+// defaultFeatureRequestsPerHour is the per-user creation limit applied when
+// FEEDBACK_REQUESTS_PER_HOUR is unset. Unlike the in-memory feedbackLimiter
+// on the route, the limit is counted from stored requests so it survives
+// restarts and is shared by every replica on the same database.
+const defaultFeatureRequestsPerHour = 10
+
+// featureRequestRateWindow is the sliding window RequestsPerHour applies to.
+const featureRequestRateWindow = time.Hour
+
 // errGitHubUnauthorized is returned when GitHub rejects the FEEDBACK_GITHUB_TOKEN
 // as invalid or expired (HTTP 401). Callers should branch on this with errors.Is
 // and surface a user-visible "refresh your PAT" message instead of the generic
@@ -125,7 +136,13 @@ func parsePageParams(c *fiber.Ctx) (int, int, error) {
 // api.github.com. Recognize public github.com (with or without scheme, with
 // or without www.) as a special case.
 func resolveGitHubAPIBase() string {
-	raw := strings.TrimSpace(os.Getenv("GITHUB_URL"))
+	return normalizeGitHubAPIBase(os.Getenv("GITHUB_URL"))
+}
+
+// normalizeGitHubAPIBase maps a GitHub or GHE URL to its API base URL using
+// the rules described on resolveGitHubAPIBase. Empty means public GitHub.
+func normalizeGitHubAPIBase(raw string) string {
+	raw = strings.TrimSpace(raw)
 	if raw == "" {
 		return githubAPIBase
 	}
@@ -211,6 +228,7 @@ type FeedbackHandler struct {
 	webhookSecret string
 	repoOwner     string
 	repoName      string
+	githubAPIBase string       // API base URL, e.g. "https://api.github.com" or "https://ghe.example.com/api/v3"
 	httpClient    *http.Client // shared HTTP client for connection reuse
 	// appTokenProvider is the kubestellar-console-bot GitHub App. When
 	// configured, issues are created authenticated as the App so the
@@ -224,6 +242,16 @@ type FeedbackHandler struct {
 	// GitHub stamps `performed_via_github_app.slug`. Falls back to
 	// direct App token or PAT when proxy is unavailable or unconfigured.
 	attributionProxyURL string
+	// requestsPerHour caps feature requests per user per
+	// featureRequestRateWindow; 0 disables the check.
+	requestsPerHour int
+	// deliverers send fix-ready/fix-complete notifications to the webhooks
+	// and email addresses users opted into (see deliverNotification).
+	deliverers []NotificationDeliverer
+	// issueLabels and issueBodyTemplate customize created issues (see
+	// FeedbackConfig); nil means the built-in labels and body.
+	issueLabels       map[models.RequestType][]string
+	issueBodyTemplate *template.Template
 
 	prCacheMu   sync.RWMutex
 	prCache     []GitHubPR
@@ -238,6 +266,24 @@ type FeedbackConfig struct {
 	WebhookSecret string // Secret for validating GitHub webhooks
 	RepoOwner     string // GitHub org/owner (e.g., "kubestellar")
 	RepoName      string // GitHub repo name (e.g., "console")
+	// GitHubBaseURL is the GitHub API base used for issues, comments and PRs.
+	// A GitHub Enterprise Server URL such as https://ghe.example.com gets the
+	// /api/v3 suffix appended. Empty falls back to GITHUB_URL, then to the
+	// public API.
+	GitHubBaseURL string
+	// SMTP enables emailed notifications when its Host is set.
+	SMTP SMTPConfig
+	// RequestsPerHour limits how many feature requests one user can submit
+	// per hour. 0 disables the limit.
+	RequestsPerHour int
+	// IssueLabels overrides the labels of console issues per request type
+	// (FEEDBACK_BUG_LABELS, FEEDBACK_FEATURE_LABELS; comma-separated).
+	// Types without an entry keep the built-in labels.
+	IssueLabels map[models.RequestType][]string
+	// IssueBodyTemplate is a text/template for the issue body, rendered
+	// with issueBodyData (read from FEEDBACK_ISSUE_TEMPLATE_FILE). Empty
+	// uses the built-in body.
+	IssueBodyTemplate string
 }
 
 // NewFeedbackHandler creates a new feedback handler
@@ -247,16 +293,45 @@ func NewFeedbackHandler(s store.Store, cfg FeedbackConfig) *FeedbackHandler {
 			"Add FEEDBACK_GITHUB_TOKEN=<your-pat> to your .env file. " +
 			"Classic PAT: needs 'repo' scope. Fine-grained PAT: needs 'Issues' + 'Contents' read/write permissions.")
 	}
+	apiBase := cfg.apiBase()
 	return &FeedbackHandler{
 		store:               s,
 		githubToken:         cfg.GitHubToken,
 		webhookSecret:       cfg.WebhookSecret,
 		repoOwner:           cfg.RepoOwner,
 		repoName:            cfg.RepoName,
+		githubAPIBase:       apiBase,
 		httpClient:          &http.Client{Timeout: githubAPITimeout},
-		appTokenProvider:    NewGitHubAppTokenProvider(),
+		appTokenProvider:    NewGitHubAppTokenProvider(apiBase),
 		attributionProxyURL: strings.TrimRight(os.Getenv("FEEDBACK_PROXY_URL"), "/"),
+		requestsPerHour:     cfg.RequestsPerHour,
+		deliverers:          newNotificationDeliverers(cfg),
+		issueLabels:         cfg.IssueLabels,
+		issueBodyTemplate:   loadIssueBodyTemplate(cfg.IssueBodyTemplate),
+	}
+}
+
+// loadIssueBodyTemplate parses a configured issue body template. An invalid
+// template is logged and replaced by the built-in one so feedback keeps
+// working.
+func loadIssueBodyTemplate(text string) *template.Template {
+	if text == "" {
+		return defaultIssueBody
+	}
+	tmpl, err := parseIssueBodyTemplate(text)
+	if err != nil {
+		slog.Error("[Feedback] invalid issue body template, using the built-in one", "error", err)
+		return defaultIssueBody
+	}
+	return tmpl
+}
+
+// apiBase returns the normalized API base URL for cfg.
+func (cfg FeedbackConfig) apiBase() string {
+	if strings.TrimSpace(cfg.GitHubBaseURL) != "" {
+		return normalizeGitHubAPIBase(cfg.GitHubBaseURL)
 	}
+	return resolveGitHubAPIBase()
 }
 
 // getEffectiveToken returns the current feedback GitHub token, preferring
@@ -361,11 +436,60 @@ func LoadFeedbackConfig() FeedbackConfig {
 	}
 
 	return FeedbackConfig{
-		GitHubToken:   githubToken,
-		WebhookSecret: os.Getenv("GITHUB_WEBHOOK_SECRET"),
-		RepoOwner:     getEnvOrDefault("FEEDBACK_REPO_OWNER", "kubestellar"),
-		RepoName:      getEnvOrDefault("FEEDBACK_REPO_NAME", "console"),
+		GitHubToken:       githubToken,
+		WebhookSecret:     os.Getenv("GITHUB_WEBHOOK_SECRET"),
+		RepoOwner:         getEnvOrDefault("FEEDBACK_REPO_OWNER", "kubestellar"),
+		RepoName:          getEnvOrDefault("FEEDBACK_REPO_NAME", "console"),
+		GitHubBaseURL:     os.Getenv("FEEDBACK_GITHUB_BASE_URL"),
+		SMTP:              loadSMTPConfig(),
+		RequestsPerHour:   loadRequestsPerHour(),
+		IssueLabels:       loadIssueLabels(),
+		IssueBodyTemplate: loadIssueBodyTemplateFile(),
+	}
+}
+
+// loadIssueLabels reads the per-request-type label overrides.
+func loadIssueLabels() map[models.RequestType][]string {
+	labels := make(map[models.RequestType][]string)
+	for requestType, envVar := range map[models.RequestType]string{
+		models.RequestTypeBug:     "FEEDBACK_BUG_LABELS",
+		models.RequestTypeFeature: "FEEDBACK_FEATURE_LABELS",
+	} {
+		if l := parseLabelList(os.Getenv(envVar)); len(l) > 0 {
+			labels[requestType] = l
+		}
+	}
+	return labels
+}
+
+// loadIssueBodyTemplateFile reads the file named by
+// FEEDBACK_ISSUE_TEMPLATE_FILE. An unreadable file is logged and ignored.
+func loadIssueBodyTemplateFile() string {
+	path := os.Getenv("FEEDBACK_ISSUE_TEMPLATE_FILE")
+	if path == "" {
+		return ""
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		slog.Error("[Feedback] cannot read FEEDBACK_ISSUE_TEMPLATE_FILE, using the built-in issue body", "path", path, "error", err)
+		return ""
+	}
+	return string(data)
+}
+
+// loadRequestsPerHour reads FEEDBACK_REQUESTS_PER_HOUR, falling back to
+// defaultFeatureRequestsPerHour when it is unset or invalid.
+func loadRequestsPerHour() int {
+	raw := os.Getenv("FEEDBACK_REQUESTS_PER_HOUR")
+	if raw == "" {
+		return defaultFeatureRequestsPerHour
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		slog.Warn("[Feedback] invalid FEEDBACK_REQUESTS_PER_HOUR, using default", "value", raw, "default", defaultFeatureRequestsPerHour)
+		return defaultFeatureRequestsPerHour
 	}
+	return n
 }
 
 func getEnvOrDefault(key, defaultVal string) string {
@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFeedbackConfigHelpers(t *testing.T) {
@@ -45,3 +49,38 @@ func TestFeedbackConfigHelpers(t *testing.T) {
 		assert.ElementsMatch(t, []int{789}, issues)
 	})
 }
+
+func TestFeedbackConfig_GitHubBaseURL(t *testing.T) {
+	t.Setenv("GITHUB_URL", "")
+	assert.Equal(t, "https://api.github.com", FeedbackConfig{}.apiBase())
+	assert.Equal(t, "https://ghe.example.com/api/v3", FeedbackConfig{GitHubBaseURL: "https://ghe.example.com/"}.apiBase())
+	assert.Equal(t, "https://ghe.example.com/api/v3", FeedbackConfig{GitHubBaseURL: "https://ghe.example.com/api/v3"}.apiBase())
+
+	t.Setenv("FEEDBACK_GITHUB_BASE_URL", "https://ghe.example.com")
+	assert.Equal(t, "https://ghe.example.com", LoadFeedbackConfig().GitHubBaseURL)
+}
+
+func TestFeedbackHandler_CreatesIssueOnGitHubEnterprise(t *testing.T) {
+	var gotPath, gotAuth string
+	ghes := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"number": 42, "html_url": "https://ghe.example.com/acme/console/issues/42"}`))
+	}))
+	defer ghes.Close()
+
+	handler := NewFeedbackHandler(nil, FeedbackConfig{
+		GitHubToken:   "ghes-token",
+		RepoOwner:     "acme",
+		RepoName:      "console",
+		GitHubBaseURL: ghes.URL,
+	})
+
+	number, url, err := handler.postGitHubIssue(context.Background(), "acme", "console", "title", "body", nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, 42, number)
+	assert.Equal(t, "https://ghe.example.com/acme/console/issues/42", url)
+	assert.Equal(t, "/api/v3/repos/acme/console/issues", gotPath)
+	assert.Equal(t, "Bearer ghes-token", gotAuth)
+}
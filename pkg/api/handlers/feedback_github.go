@@ -267,7 +267,7 @@ func (h *FeedbackHandler) getLatestBotComment(ctx context.Context, issueNumber i
 	}
 
 	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments?per_page=10&sort=created&direction=desc",
-		resolveGitHubAPIBase(), h.repoOwner, repoName, issueNumber)
+		h.githubAPIBase, h.repoOwner, repoName, issueNumber)
 
 	reqCtx, cancel := context.WithTimeout(ctx, githubAPITimeout)
 	defer cancel()
@@ -531,13 +531,8 @@ func (h *FeedbackHandler) createGitHubIssueInRepo(ctx context.Context, request *
 			labels = append(labels, "enhancement")
 		}
 	} else {
-		// Console issues get the AI fix pipeline labels
-		labels = []string{"ai-fix-requested", "needs-triage"}
-		if request.RequestType == models.RequestTypeBug {
-			labels = append(labels, "kind/bug")
-		} else {
-			labels = append(labels, "enhancement")
-		}
+		// Console issues get the AI fix pipeline labels unless configured
+		labels = h.consoleIssueLabels(request.RequestType)
 	}
 
 	repoLabel := "Console Application"
@@ -680,20 +675,21 @@ func (h *FeedbackHandler) createGitHubIssueInRepo(ctx context.Context, request *
 		failedApiBlock = fmt.Sprintf("\n<details>\n<summary>Failed API Calls (%d captured)</summary>\n\n%s\n</details>\n", len(failedApiCalls), apiLines.String())
 	}
 
-	issueBody := fmt.Sprintf(`## User Request
-
-**Type:** %s
-**Target:** %s
-**Submitted by:** @%s
-**Console Request ID:** %s
-
-## Description
-
-%s
-%s%s%s%s
----
-*This issue was automatically created from the KubeStellar Console.*
-`, request.RequestType, repoLabel, user.GitHubLogin, request.ID.String(), request.Description, shaLine, consoleErrorBlock, failedApiBlock, diagnosticsBlock)
+	issueBody, err := h.renderIssueBody(issueBodyData{
+		Type:           request.RequestType,
+		Target:         repoLabel,
+		SubmittedBy:    user.GitHubLogin,
+		RequestID:      request.ID.String(),
+		Title:          request.Title,
+		Description:    request.Description,
+		SHALine:        shaLine,
+		ConsoleErrors:  consoleErrorBlock,
+		FailedAPICalls: failedApiBlock,
+		Diagnostics:    diagnosticsBlock,
+	})
+	if err != nil {
+		return 0, "", nil, ssResult, err
+	}
 
 	// First attempt: create issue with labels
 	number, htmlURL, err := h.postGitHubIssue(ctx, repoOwner, repoName, request.Title, issueBody, labels, clientAuth)
@@ -784,7 +780,7 @@ func (h *FeedbackHandler) postGitHubIssue(ctx context.Context, repoOwner, repoNa
 	if err != nil {
 		return 0, "", fmt.Errorf("failed to marshal issue payload: %w", err)
 	}
-	apiURL := fmt.Sprintf("%s/repos/%s/%s/issues", resolveGitHubAPIBase(), repoOwner, repoName)
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/issues", h.githubAPIBase, repoOwner, repoName)
 
 	// #9901: layer a per-call timeout on top of the request-scoped context.
 	reqCtx, cancel := context.WithTimeout(ctx, githubAPITimeout)
@@ -952,7 +948,7 @@ func (h *FeedbackHandler) uploadScreenshotToGitHub(repoOwner, repoName, requestI
 		return "", fmt.Errorf("failed to marshal upload payload: %w", err)
 	}
 
-	apiURL := fmt.Sprintf("%s/repos/%s/%s/contents/%s", resolveGitHubAPIBase(), repoOwner, repoName, filePath)
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/contents/%s", h.githubAPIBase, repoOwner, repoName, filePath)
 
 	// Use a per-request timeout for screenshot uploads (large base64 payloads)
 	// instead of creating a separate http.Client, to reuse h.httpClient's
@@ -1038,7 +1034,7 @@ func (h *FeedbackHandler) addPRComment(ctx context.Context, request *models.Feat
 	}
 
 	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments",
-		resolveGitHubAPIBase(), h.repoOwner, h.repoName, *request.PRNumber)
+		h.githubAPIBase, h.repoOwner, h.repoName, *request.PRNumber)
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
@@ -1092,6 +1088,12 @@ func (h *FeedbackHandler) createNotification(ctx context.Context, userID uuid.UU
 	}
 	if err := h.store.CreateNotification(ctx, notification); err != nil {
 		slog.Error("[Feedback] failed to create notification", "error", err)
+		return
+	}
+	if deliveredNotificationTypes[notifType] && len(h.deliverers) > 0 {
+		runAsyncGitHubOp("deliverNotification", func(ctx context.Context) {
+			h.deliverNotification(ctx, notification)
+		})
 	}
 }
 
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/kubestellar/console/pkg/models"
+)
+
+// issueBodyData is what the issue body template is rendered with. The
+// SHALine, ConsoleErrors, FailedAPICalls and Diagnostics blocks are
+// pre-rendered markdown and empty when there is nothing to report.
+type issueBodyData struct {
+	Type           models.RequestType
+	Target         string
+	SubmittedBy    string
+	RequestID      string
+	Title          string
+	Description    string
+	SHALine        string
+	ConsoleErrors  string
+	FailedAPICalls string
+	Diagnostics    string
+}
+
+// defaultIssueBodyTemplate is the body of issues created from the console
+// unless FeedbackConfig.IssueBodyTemplate overrides it.
+const defaultIssueBodyTemplate = `## User Request
+
+**Type:** {{.Type}}
+**Target:** {{.Target}}
+**Submitted by:** @{{.SubmittedBy}}
+**Console Request ID:** {{.RequestID}}
+
+## Description
+
+{{.Description}}
+{{.SHALine}}{{.ConsoleErrors}}{{.FailedAPICalls}}{{.Diagnostics}}
+---
+*This issue was automatically created from the KubeStellar Console.*
+`
+
+var defaultIssueBody = template.Must(parseIssueBodyTemplate(defaultIssueBodyTemplate))
+
+// defaultIssueLabels are the labels of console issues per request type
+// unless FeedbackConfig.IssueLabels overrides them. Docs issues always use
+// their own labels because they skip the AI fix pipeline.
+var defaultIssueLabels = map[models.RequestType][]string{
+	models.RequestTypeBug:     {"ai-fix-requested", "needs-triage", "kind/bug"},
+	models.RequestTypeFeature: {"ai-fix-requested", "needs-triage", "enhancement"},
+}
+
+// parseIssueBodyTemplate parses text as an issue body template and renders
+// it once with empty data, so references to fields issueBodyData does not
+// have are reported here rather than when the first issue is filed.
+func parseIssueBodyTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("issue-body").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse issue body template: %w", err)
+	}
+	if err := tmpl.Execute(io.Discard, issueBodyData{}); err != nil {
+		return nil, fmt.Errorf("issue body template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// renderIssueBody renders data with the configured body template.
+func (h *FeedbackHandler) renderIssueBody(data issueBodyData) (string, error) {
+	tmpl := h.issueBodyTemplate
+	if tmpl == nil {
+		tmpl = defaultIssueBody
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("render issue body: %w", err)
+	}
+	return b.String(), nil
+}
+
+// consoleIssueLabels returns the labels for a console issue of requestType.
+func (h *FeedbackHandler) consoleIssueLabels(requestType models.RequestType) []string {
+	labels, ok := h.issueLabels[requestType]
+	if !ok {
+		labels, ok = defaultIssueLabels[requestType]
+	}
+	if !ok {
+		labels = defaultIssueLabels[models.RequestTypeFeature]
+	}
+	return append([]string(nil), labels...)
+}
+
+// parseLabelList splits a comma-separated label list, dropping blanks.
+func parseLabelList(raw string) []string {
+	var labels []string
+	for _, l := range strings.Split(raw, ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			labels = append(labels, l)
+		}
+	}
+	return labels
+}
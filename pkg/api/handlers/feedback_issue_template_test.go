@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubestellar/console/pkg/models"
+)
+
+func TestRenderIssueBody_CustomTemplate(t *testing.T) {
+	h := NewFeedbackHandler(nil, FeedbackConfig{
+		IssueBodyTemplate: "Reported by {{.SubmittedBy}} ({{.Type}}): {{.Title}}\n\n{{.Description}}\n{{.Diagnostics}}",
+		IssueLabels:       map[models.RequestType][]string{models.RequestTypeBug: {"triage/pending", "bug"}},
+	})
+
+	body, err := h.renderIssueBody(issueBodyData{
+		Type:        models.RequestTypeBug,
+		SubmittedBy: "octocat",
+		Title:       "Pods list is empty",
+		Description: "No pods after switching clusters.",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Reported by octocat (bug): Pods list is empty\n\nNo pods after switching clusters.\n", body)
+
+	assert.Equal(t, []string{"triage/pending", "bug"}, h.consoleIssueLabels(models.RequestTypeBug))
+	assert.Equal(t, []string{"ai-fix-requested", "needs-triage", "enhancement"}, h.consoleIssueLabels(models.RequestTypeFeature),
+		"types without an override keep the built-in labels")
+}
+
+func TestParseIssueBodyTemplate_Invalid(t *testing.T) {
+	_, err := parseIssueBodyTemplate("{{.Description")
+	assert.Error(t, err, "syntax errors are rejected")
+	_, err = parseIssueBodyTemplate("{{.Reporter}}")
+	assert.Error(t, err, "unknown fields are rejected")
+
+	h := NewFeedbackHandler(nil, FeedbackConfig{IssueBodyTemplate: "{{.Reporter}}"})
+	body, err := h.renderIssueBody(issueBodyData{Type: models.RequestTypeFeature, SubmittedBy: "octocat"})
+	require.NoError(t, err)
+	assert.Contains(t, body, "**Submitted by:** @octocat", "an invalid template falls back to the built-in body")
+}
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kubestellar/console/pkg/api/middleware"
+	"github.com/kubestellar/console/pkg/models"
+	"github.com/kubestellar/console/pkg/notifications"
+)
+
+// defaultSMTPPort is the submission port used when FEEDBACK_SMTP_PORT is unset.
+const defaultSMTPPort = 587
+
+// notificationWebhookDialTimeout bounds connecting to a user's webhook.
+const notificationWebhookDialTimeout = 10 * time.Second
+
+// publicWebhookTransport is used for user-supplied webhook URLs. Any user can
+// set one, so unlike operator-configured alert webhooks it must never reach
+// private, loopback or link-local addresses; the check runs at dial time so
+// DNS rebinding and redirects are covered too.
+var publicWebhookTransport = &http.Transport{
+	DialContext: dialPublicOnly(notificationWebhookDialTimeout),
+}
+
+// deliveredNotificationTypes are the feature request notifications that are
+// also delivered through the user's NotificationPreferences. The rest stay
+// in-app only.
+var deliveredNotificationTypes = map[models.NotificationType]bool{
+	models.NotificationTypeFixReady:    true,
+	models.NotificationTypeFixComplete: true,
+}
+
+// NotificationDeliverer delivers a feature request notification outside the
+// console. Implementations decide from prefs whether they apply to user and
+// return nil when they do not.
+type NotificationDeliverer interface {
+	Deliver(ctx context.Context, user *models.User, prefs *models.NotificationPreferences, notification *models.Notification) error
+}
+
+// SMTPConfig is the server's outgoing mail configuration for emailed
+// notifications. An empty Host disables email delivery.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// loadSMTPConfig reads the FEEDBACK_SMTP_* environment variables.
+func loadSMTPConfig() SMTPConfig {
+	port := defaultSMTPPort
+	if raw := os.Getenv("FEEDBACK_SMTP_PORT"); raw != "" {
+		if p, err := strconv.Atoi(raw); err == nil && p > 0 {
+			port = p
+		} else {
+			slog.Warn("[Feedback] invalid FEEDBACK_SMTP_PORT, using default", "value", raw, "default", defaultSMTPPort)
+		}
+	}
+	return SMTPConfig{
+		Host:     os.Getenv("FEEDBACK_SMTP_HOST"),
+		Port:     port,
+		Username: os.Getenv("FEEDBACK_SMTP_USERNAME"),
+		Password: os.Getenv("FEEDBACK_SMTP_PASSWORD"),
+		From:     os.Getenv("FEEDBACK_SMTP_FROM"),
+	}
+}
+
+// notificationAlert maps a feature request notification onto the alert shape
+// the notifications package sends.
+func notificationAlert(notification *models.Notification) notifications.Alert {
+	details := map[string]interface{}{"type": string(notification.NotificationType)}
+	if notification.ActionURL != "" {
+		details["actionUrl"] = notification.ActionURL
+	}
+	if notification.FeatureRequestID != nil {
+		details["featureRequestId"] = notification.FeatureRequestID.String()
+	}
+	return notifications.Alert{
+		ID:       notification.ID.String(),
+		RuleName: notification.Title,
+		Severity: notifications.SeverityInfo,
+		Status:   string(notification.NotificationType),
+		Message:  notification.Message,
+		Details:  details,
+		FiredAt:  notification.CreatedAt,
+	}
+}
+
+// webhookDeliverer POSTs notifications to the user's webhook URL through
+// transport.
+type webhookDeliverer struct {
+	transport http.RoundTripper
+}
+
+func (d webhookDeliverer) Deliver(_ context.Context, _ *models.User, prefs *models.NotificationPreferences, notification *models.Notification) error {
+	if prefs.WebhookURL == "" {
+		return nil
+	}
+	// NewWebhookNotifier re-validates the URL so a host removed from
+	// KC_WEBHOOK_ALLOWED_HOSTS after the preference was saved is not called.
+	notifier, err := notifications.NewWebhookNotifier(prefs.WebhookURL)
+	if err != nil {
+		return err
+	}
+	notifier.HTTPClient.Transport = d.transport
+	return notifier.Send(notificationAlert(notification))
+}
+
+// emailDeliverer emails notifications to the user's address.
+type emailDeliverer struct {
+	smtp SMTPConfig
+}
+
+func (d emailDeliverer) Deliver(_ context.Context, user *models.User, prefs *models.NotificationPreferences, notification *models.Notification) error {
+	if !prefs.EmailEnabled || user.Email == "" {
+		return nil
+	}
+	notifier := notifications.NewEmailNotifier(d.smtp.Host, d.smtp.Port, d.smtp.Username, d.smtp.Password, d.smtp.From, []string{user.Email})
+	return notifier.Send(notificationAlert(notification))
+}
+
+// newNotificationDeliverers returns the deliverers available with cfg:
+// webhooks always, email only when SMTP is configured.
+func newNotificationDeliverers(cfg FeedbackConfig) []NotificationDeliverer {
+	deliverers := []NotificationDeliverer{webhookDeliverer{transport: publicWebhookTransport}}
+	if cfg.SMTP.Host != "" {
+		deliverers = append(deliverers, emailDeliverer{smtp: cfg.SMTP})
+	}
+	return deliverers
+}
+
+// deliverNotification hands notification to every deliverer the recipient
+// has opted into. Delivery is best effort: failures are logged and do not
+// affect the in-app notification.
+func (h *FeedbackHandler) deliverNotification(ctx context.Context, notification *models.Notification) {
+	prefs, err := h.store.GetNotificationPreferences(ctx, notification.UserID)
+	if err != nil {
+		slog.Error("[Feedback] failed to load notification preferences", "user", notification.UserID, "error", err)
+		return
+	}
+	if prefs == nil {
+		return
+	}
+	user, err := h.store.GetUser(ctx, notification.UserID)
+	if err != nil || user == nil {
+		slog.Error("[Feedback] failed to load notification recipient", "user", notification.UserID, "error", err)
+		return
+	}
+	for _, d := range h.deliverers {
+		if err := d.Deliver(ctx, user, prefs, notification); err != nil {
+			slog.Warn("[Feedback] notification delivery failed",
+				"deliverer", fmt.Sprintf("%T", d), "type", notification.NotificationType, "error", err)
+		}
+	}
+}
+
+// GetNotificationPreferences returns the current user's notification
+// delivery preferences
+func (h *FeedbackHandler) GetNotificationPreferences(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == uuid.Nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "User authentication required")
+	}
+	prefs, err := h.store.GetNotificationPreferences(c.UserContext(), userID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Failed to get notification preferences")
+	}
+	if prefs == nil {
+		prefs = &models.NotificationPreferences{UserID: userID}
+	}
+	return c.JSON(prefs)
+}
+
+// UpdateNotificationPreferences saves the current user's notification
+// delivery preferences. The webhook URL is validated with the same rules as
+// alert webhooks (KC_WEBHOOK_ALLOWED_HOSTS) and must additionally use https
+// and not name a private, loopback or link-local host.
+func (h *FeedbackHandler) UpdateNotificationPreferences(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == uuid.Nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "User authentication required")
+	}
+	var input models.NotificationPreferences
+	if err := c.BodyParser(&input); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+	if input.WebhookURL != "" {
+		if _, err := notifications.NewWebhookNotifier(input.WebhookURL); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		if err := validatePublicWebhookURL(input.WebhookURL); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	}
+
+	prefs := &models.NotificationPreferences{
+		UserID:       userID,
+		WebhookURL:   input.WebhookURL,
+		EmailEnabled: input.EmailEnabled,
+	}
+	if err := h.store.SaveNotificationPreferences(c.UserContext(), prefs); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Failed to save notification preferences")
+	}
+	return c.JSON(prefs)
+}
+
+// validatePublicWebhookURL rejects user webhook URLs that are plaintext or
+// name a private, loopback or link-local host outright. Hostnames are checked
+// again when publicWebhookTransport dials them.
+func validatePublicWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("webhook URL is not a valid URL")
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("webhook URL must use https")
+	}
+	host := parsed.Hostname()
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return fmt.Errorf("webhook URL must not target a local host")
+	}
+	if ip := net.ParseIP(host); ip != nil && (isBlockedIP(ip) || ip.IsUnspecified()) {
+		return fmt.Errorf("webhook URL must not target a private address")
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubestellar/console/pkg/models"
+	"github.com/kubestellar/console/pkg/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateNotification_DeliversFixReadyToWebhook(t *testing.T) {
+	received := make(chan map[string]any, 2)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err == nil {
+			received <- payload
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	sqlStore, err := store.NewSQLiteStore(filepath.Join(t.TempDir(), "notify-test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlStore.Close() })
+
+	ctx := context.Background()
+	user := &models.User{GitHubID: "gh-notify", GitHubLogin: "notify"}
+	require.NoError(t, sqlStore.CreateUser(ctx, user))
+	require.NoError(t, sqlStore.SaveNotificationPreferences(ctx, &models.NotificationPreferences{
+		UserID:     user.ID,
+		WebhookURL: receiver.URL,
+	}))
+
+	request := &models.FeatureRequest{UserID: user.ID, Title: "Dark mode", RequestType: models.RequestTypeFeature}
+	require.NoError(t, sqlStore.CreateFeatureRequest(ctx, request))
+	requestID := request.ID
+
+	handler := NewFeedbackHandler(sqlStore, FeedbackConfig{})
+	// The receiver listens on loopback, which the production transport refuses.
+	handler.deliverers = []NotificationDeliverer{webhookDeliverer{transport: http.DefaultTransport}}
+
+	// In-app only notification types are not delivered.
+	handler.createNotification(ctx, user.ID, &requestID, models.NotificationTypeTriageAccepted,
+		"Triaged", "Your request was accepted", "")
+	handler.createNotification(ctx, user.ID, &requestID, models.NotificationTypeFixReady,
+		"Fix ready", "A fix is ready for review", "https://github.com/acme/console/pull/7")
+
+	select {
+	case payload := <-received:
+		require.Equal(t, "Fix ready", payload["alert"])
+		require.Equal(t, string(models.NotificationTypeFixReady), payload["status"])
+		require.Equal(t, "A fix is ready for review", payload["message"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not called for the fix-ready notification")
+	}
+	select {
+	case payload := <-received:
+		t.Fatalf("unexpected second delivery: %v", payload)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	notifications, err := sqlStore.GetUserNotifications(ctx, user.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, notifications, 2, "in-app notifications are always created")
+}
+
+func TestWebhookDeliverer_RefusesPrivateTargets(t *testing.T) {
+	called := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	d := webhookDeliverer{transport: publicWebhookTransport}
+	prefs := &models.NotificationPreferences{WebhookURL: receiver.URL}
+	notification := &models.Notification{Title: "Fix ready", NotificationType: models.NotificationTypeFixReady}
+	require.Error(t, d.Deliver(context.Background(), nil, prefs, notification))
+	require.False(t, called, "loopback webhook must not be reached")
+}
+
+func TestValidatePublicWebhookURL(t *testing.T) {
+	cases := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://hooks.example.com/notify", false},
+		{"http://hooks.example.com/notify", true},
+		{"https://localhost/notify", true},
+		{"https://127.0.0.1/notify", true},
+		{"https://10.0.0.5/notify", true},
+		{"https://169.254.169.254/latest/meta-data", true},
+		{"https://[::1]/notify", true},
+		{"https://0.0.0.0/notify", true},
+	}
+	for _, tc := range cases {
+		err := validatePublicWebhookURL(tc.url)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("validatePublicWebhookURL(%q) error = %v, wantErr %v", tc.url, err, tc.wantErr)
+		}
+	}
+}
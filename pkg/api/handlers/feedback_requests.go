@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"math"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -20,6 +21,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kubestellar/console/pkg/api/middleware"
 	"github.com/kubestellar/console/pkg/models"
+	"github.com/kubestellar/console/pkg/store"
 )
 
 func (h *FeedbackHandler) CreateFeatureRequest(c *fiber.Ctx) error {
@@ -79,8 +81,8 @@ func (h *FeedbackHandler) CreateFeatureRequest(c *fiber.Ctx) error {
 		Status:      models.RequestStatusOpen,
 	}
 
-	if err := h.store.CreateFeatureRequest(c.UserContext(), request); err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, "Failed to create feature request")
+	if err := h.insertFeatureRequest(c, request); err != nil {
+		return err
 	}
 
 	// Per-user client credential used by the attribution proxy to
@@ -185,8 +187,64 @@ func (h *FeedbackHandler) CreateFeatureRequest(c *fiber.Ctx) error {
 	})
 }
 
+// insertFeatureRequest stores request. When requestsPerHour is set, the rate
+// check and the insert are a single store call, and a user who already
+// created requestsPerHour feature requests in the last
+// featureRequestRateWindow is rejected with 429 and a Retry-After header.
+func (h *FeedbackHandler) insertFeatureRequest(c *fiber.Ctx, request *models.FeatureRequest) error {
+	ctx := c.UserContext()
+	if h.requestsPerHour <= 0 {
+		if err := h.store.CreateFeatureRequest(ctx, request); err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, "Failed to create feature request")
+		}
+		return nil
+	}
+
+	now := time.Now()
+	since := now.Add(-featureRequestRateWindow)
+	err := h.store.CreateFeatureRequestWithinRate(ctx, request, since, h.requestsPerHour)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, store.ErrFeatureRequestRateLimited) {
+		slog.Error("[Feedback] failed to create feature request", "user", request.UserID, "error", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "Failed to create feature request")
+	}
+
+	// Another request fits once enough of the oldest ones leave the window.
+	// Without the times, fall back to the full window.
+	retryAfter := featureRequestRateWindow
+	times, err := h.store.GetUserFeatureRequestTimesSince(ctx, request.UserID, since)
+	if err != nil {
+		slog.Warn("[Feedback] failed to compute feature request Retry-After", "user", request.UserID, "error", err)
+	} else if len(times) >= h.requestsPerHour {
+		retryAfter = times[len(times)-h.requestsPerHour].Add(featureRequestRateWindow).Sub(now)
+	}
+	retrySeconds := max(int(math.Ceil(retryAfter.Seconds())), 1)
+	c.Set("Retry-After", strconv.Itoa(retrySeconds))
+	return fiber.NewError(fiber.StatusTooManyRequests,
+		fmt.Sprintf("You can submit up to %d requests per hour. Please try again later.", h.requestsPerHour))
+}
+
+// maxFeatureRequestQueryLen bounds the `q` search parameter of
+// ListFeatureRequests.
+const maxFeatureRequestQueryLen = 200
+
+// searchableRequestStatuses are the `status` values ListFeatureRequests
+// accepts. Untriaged statuses are never listed, so they are not searchable.
+var searchableRequestStatuses = map[models.RequestStatus]bool{
+	models.RequestStatusTriageAccepted:   true,
+	models.RequestStatusFeasibilityStudy: true,
+	models.RequestStatusAIStuck:          true,
+	models.RequestStatusFixReady:         true,
+	models.RequestStatusFixComplete:      true,
+	models.RequestStatusUnableToFix:      true,
+	models.RequestStatusClosed:           true,
+}
+
 // ListFeatureRequests returns the user's feature requests
 // Only returns requests that have been triaged (to prevent abuse/profanity in UI)
+// Optional query params: q (title/description substring), status, type.
 func (h *FeedbackHandler) ListFeatureRequests(c *fiber.Ctx) error {
 	userID := middleware.GetUserID(c)
 	if userID == uuid.Nil {
@@ -198,7 +256,33 @@ func (h *FeedbackHandler) ListFeatureRequests(c *fiber.Ctx) error {
 		return err
 	}
 
-	requests, err := h.store.GetUserFeatureRequests(c.UserContext(), userID, limit, offset)
+	q := strings.TrimSpace(c.Query("q"))
+	status := models.RequestStatus(c.Query("status"))
+	requestType := models.RequestType(c.Query("type"))
+	if len(q) > maxFeatureRequestQueryLen {
+		return fiber.NewError(fiber.StatusBadRequest, "q too long")
+	}
+	if status != "" && !searchableRequestStatuses[status] {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid status")
+	}
+	if requestType != "" && requestType != models.RequestTypeBug && requestType != models.RequestTypeFeature {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid type")
+	}
+
+	var requests []models.FeatureRequest
+	if q != "" || status != "" || requestType != "" {
+		requests, err = h.store.SearchFeatureRequests(c.UserContext(), store.FeatureRequestFilter{
+			UserID:          userID,
+			Query:           q,
+			Status:          status,
+			Type:            requestType,
+			ExcludeStatuses: []models.RequestStatus{models.RequestStatusOpen, models.RequestStatusNeedsTriage},
+			Limit:           limit,
+			Offset:          offset,
+		})
+	} else {
+		requests, err = h.store.GetUserFeatureRequests(c.UserContext(), userID, limit, offset)
+	}
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Failed to list feature requests")
 	}
@@ -281,6 +365,8 @@ type QueueItem struct {
 	ClosedByUser      bool   `json:"closed_by_user,omitempty"`
 	CreatedAt         string `json:"created_at"`
 	UpdatedAt         string `json:"updated_at,omitempty"`
+	VoteCount         int    `json:"vote_count"`
+	Voted             bool   `json:"voted"`
 }
 
 // QueueItemCount — minimal shape returned by ListAllFeatureRequests when
@@ -363,31 +449,7 @@ func (h *FeedbackHandler) ListAllFeatureRequests(c *fiber.Ctx) error {
 	queueItemCounts := make([]QueueItemCount, 0, len(taggedIssues))
 	for _, tagged := range taggedIssues {
 		issue := tagged.GitHubIssue
-		// Determine status based on labels
-		status := "needs_triage"
-		requestType := "feature"
-		for _, label := range issue.Labels {
-			switch label.Name {
-			case "triage/accepted":
-				if status == "needs_triage" {
-					status = "triage_accepted"
-				}
-			case "copilot/working", "feasibility-study", "ai-processing", "ai-awaiting-fix":
-				status = "feasibility_study"
-			case "ai-pr-active":
-				status = "fix_in_progress"
-			case "fix-ready", "copilot/fix-ready", "ai-pr-ready", "ai-pr-draft":
-				status = "fix_ready"
-			case "fix-complete", "ai-processing-complete":
-				status = "fix_complete"
-			case "unable-to-fix", "needs-human-review", "ai-needs-human":
-				status = "unable_to_fix"
-			case "bug", "kind/bug":
-				requestType = "bug"
-			case "enhancement", "feature":
-				requestType = "feature"
-			}
-		}
+		status, requestType := queueStatusFromLabels(issue)
 
 		// Check for linked PR - if we have one, at minimum it's fix_ready
 		var prNumber int
@@ -462,9 +524,40 @@ func (h *FeedbackHandler) ListAllFeatureRequests(c *fiber.Ctx) error {
 	if countOnly {
 		return c.JSON(queueItemCounts)
 	}
+	h.attachVoteSummaries(c.UserContext(), userID, queueItems)
 	return c.JSON(queueItems)
 }
 
+// queueStatusFromLabels derives a GitHub issue's queue status and request
+// type from its labels, before linked PRs and the issue state are considered.
+func queueStatusFromLabels(issue GitHubIssue) (status, requestType string) {
+	status = "needs_triage"
+	requestType = "feature"
+	for _, label := range issue.Labels {
+		switch label.Name {
+		case "triage/accepted":
+			if status == "needs_triage" {
+				status = "triage_accepted"
+			}
+		case "copilot/working", "feasibility-study", "ai-processing", "ai-awaiting-fix":
+			status = "feasibility_study"
+		case "ai-pr-active":
+			status = "fix_in_progress"
+		case "fix-ready", "copilot/fix-ready", "ai-pr-ready", "ai-pr-draft":
+			status = "fix_ready"
+		case "fix-complete", "ai-processing-complete":
+			status = "fix_complete"
+		case "unable-to-fix", "needs-human-review", "ai-needs-human":
+			status = "unable_to_fix"
+		case "bug", "kind/bug":
+			requestType = "bug"
+		case "enhancement", "feature":
+			requestType = "feature"
+		}
+	}
+	return status, requestType
+}
+
 // CheckPreviewStatus checks the Netlify deploy preview status for a PR on-demand.
 // Uses GitHub Deployments API to find the actual preview URL — only returns "ready"
 // when the deploy has succeeded. This avoids showing "Preview Available" prematurely.
@@ -485,7 +578,7 @@ func (h *FeedbackHandler) CheckPreviewStatus(c *fiber.Ctx) error {
 	// Query GitHub Deployments API for the Netlify deploy preview environment.
 	// Honor GITHUB_URL for GitHub Enterprise deployments.
 	envName := fmt.Sprintf("deploy-preview-%d", prNumber)
-	apiBase := resolveGitHubAPIBase()
+	apiBase := h.githubAPIBase
 	deploymentsURL := fmt.Sprintf("%s/repos/%s/%s/deployments?environment=%s&per_page=1",
 		apiBase, h.repoOwner, h.repoName, envName)
 
@@ -674,7 +767,7 @@ func (h *FeedbackHandler) getCachedOrFetchPRs(ctx context.Context) []GitHubPR {
 func (h *FeedbackHandler) fetchPRPages(ctx context.Context, state string) []GitHubPR {
 	var allPRs []GitHubPR
 
-	apiBase := resolveGitHubAPIBase()
+	apiBase := h.githubAPIBase
 	for page := 1; page <= maxPRPages; page++ {
 		url := fmt.Sprintf(
 			"%s/repos/%s/%s/pulls?state=%s&per_page=50&sort=updated&direction=desc&page=%d",
@@ -741,7 +834,7 @@ func (h *FeedbackHandler) fetchGitHubIssuesFromRepo(ctx context.Context, githubL
 		client = &http.Client{Timeout: githubAPITimeout}
 	}
 
-	apiBase := resolveGitHubAPIBase()
+	apiBase := h.githubAPIBase
 	allIssues := make([]GitHubIssue, 0)
 
 	for page := 1; page <= maxIssuePages; page++ {
@@ -843,7 +936,9 @@ func (h *FeedbackHandler) listLocalFeatureRequests(c *fiber.Ctx, userID uuid.UUI
 		}
 	}
 
-	return c.JSON(h.featureRequestsToQueueItems(requests))
+	items := h.featureRequestsToQueueItems(requests)
+	h.attachVoteSummaries(c.UserContext(), userID, items)
+	return c.JSON(items)
 }
 
 // featureRequestsToQueueItems converts persisted models.FeatureRequest records
@@ -1100,7 +1195,7 @@ func (h *FeedbackHandler) verifyGitHubIssueOwnership(ctx context.Context, issueN
 	}
 
 	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d",
-		resolveGitHubAPIBase(), h.repoOwner, h.repoName, issueNumber)
+		h.githubAPIBase, h.repoOwner, h.repoName, issueNumber)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -1147,7 +1242,7 @@ func (h *FeedbackHandler) closeGitHubIssue(ctx context.Context, issueNumber int,
 	}
 
 	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d",
-		resolveGitHubAPIBase(), h.repoOwner, repoName, issueNumber)
+		h.githubAPIBase, h.repoOwner, repoName, issueNumber)
 
 	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(jsonData))
 	if err != nil {
@@ -1186,7 +1281,7 @@ func (h *FeedbackHandler) addIssueComment(ctx context.Context, issueNumber int,
 	}
 
 	url := fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments",
-		resolveGitHubAPIBase(), h.repoOwner, repoName, issueNumber)
+		h.githubAPIBase, h.repoOwner, repoName, issueNumber)
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
@@ -1273,6 +1368,164 @@ func (h *FeedbackHandler) SubmitFeedback(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(feedback)
 }
 
+// voteTargetPattern matches the queue IDs of requests listed from GitHub
+// (see ListAllFeatureRequests).
+var voteTargetPattern = regexp.MustCompile(`^gh-(console|docs)-([1-9][0-9]*)$`)
+
+// attachVoteSummaries fills in the vote count and the caller's vote state of
+// each queue item. Votes are an enhancement to the queue, so a store failure
+// is logged and the items are returned without them.
+func (h *FeedbackHandler) attachVoteSummaries(ctx context.Context, userID uuid.UUID, items []QueueItem) {
+	if len(items) == 0 {
+		return
+	}
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	summaries, err := h.store.GetVoteSummaries(ctx, userID, ids)
+	if err != nil {
+		slog.Warn("[Feedback] failed to load feature request votes", "error", err)
+		return
+	}
+	for i := range items {
+		summary := summaries[items[i].ID]
+		items[i].VoteCount = summary.Count
+		items[i].Voted = summary.Voted
+	}
+}
+
+// resolveVoteTarget validates the :id of a vote request and returns it.
+// Local requests must exist, be triaged and belong to someone else. Requests
+// listed from GitHub are identified by their queue ID and checked the same
+// way against the issue on GitHub.
+func (h *FeedbackHandler) resolveVoteTarget(c *fiber.Ctx, userID uuid.UUID) (string, error) {
+	idParam := c.Params("id")
+	if match := voteTargetPattern.FindStringSubmatch(idParam); match != nil {
+		if err := h.verifyGitHubVoteTarget(c, models.TargetRepo(match[1]), match[2]); err != nil {
+			return "", err
+		}
+		return idParam, nil
+	}
+	requestID, err := uuid.Parse(idParam)
+	if err != nil {
+		return "", fiber.NewError(fiber.StatusBadRequest, "Invalid request ID")
+	}
+	request, err := h.store.GetFeatureRequest(c.UserContext(), requestID)
+	if err != nil || request == nil {
+		return "", fiber.NewError(fiber.StatusNotFound, "Feature request not found")
+	}
+	if request.UserID == userID {
+		return "", fiber.NewError(fiber.StatusForbidden, "You cannot vote for your own request")
+	}
+	if request.Status == models.RequestStatusOpen || request.Status == models.RequestStatusNeedsTriage {
+		return "", fiber.NewError(fiber.StatusBadRequest, "Only triaged requests can be voted on")
+	}
+	return requestID.String(), nil
+}
+
+// verifyGitHubVoteTarget fetches issue issueNumber of target's repo and
+// checks it is a triaged issue opened by someone other than the caller.
+func (h *FeedbackHandler) verifyGitHubVoteTarget(c *fiber.Ctx, target models.TargetRepo, issueNumber string) error {
+	if h.getEffectiveToken() == "" || h.repoOwner == "" {
+		return fiber.NewError(fiber.StatusServiceUnavailable, "GitHub not configured")
+	}
+
+	issueURL := fmt.Sprintf("%s/repos/%s/%s/issues/%s",
+		h.githubAPIBase, h.repoOwner, h.resolveRepoName(target), issueNumber)
+	req, err := http.NewRequestWithContext(c.UserContext(), "GET", issueURL, nil)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Failed to create GitHub issue request")
+	}
+	req.Header.Set("Authorization", "Bearer "+h.getEffectiveToken())
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadGateway, "Failed to reach GitHub API")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return fiber.NewError(fiber.StatusNotFound, "Feature request not found")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fiber.NewError(fiber.StatusBadGateway, fmt.Sprintf("GitHub API returned %d", resp.StatusCode))
+	}
+
+	var issue GitHubIssue
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxGitHubResponseBytes)).Decode(&issue); err != nil {
+		return fiber.NewError(fiber.StatusBadGateway, "Failed to parse GitHub issue")
+	}
+	// The issues API also serves pull requests, which are never queue items.
+	if issue.PullRequest != nil {
+		return fiber.NewError(fiber.StatusNotFound, "Feature request not found")
+	}
+	if login := middleware.GetGitHubLogin(c); login != "" && strings.EqualFold(issue.User.Login, login) {
+		return fiber.NewError(fiber.StatusForbidden, "You cannot vote for your own request")
+	}
+	if status, _ := queueStatusFromLabels(issue); status == "needs_triage" {
+		return fiber.NewError(fiber.StatusBadRequest, "Only triaged requests can be voted on")
+	}
+	return nil
+}
+
+// VoteFeatureRequest upvotes a feature request for the current user. Voting
+// again is a no-op.
+func (h *FeedbackHandler) VoteFeatureRequest(c *fiber.Ctx) error {
+	return h.setVote(c, true)
+}
+
+// UnvoteFeatureRequest removes the current user's upvote, if any. Unlike
+// voting, the target is not resolved: a vote must stay removable after its
+// request is deleted or moved back to triage.
+func (h *FeedbackHandler) UnvoteFeatureRequest(c *fiber.Ctx) error {
+	return h.setVote(c, false)
+}
+
+// voteID returns the stored vote key for the :id of a vote request, checking
+// only its format.
+func voteID(idParam string) (string, error) {
+	if voteTargetPattern.MatchString(idParam) {
+		return idParam, nil
+	}
+	requestID, err := uuid.Parse(idParam)
+	if err != nil {
+		return "", fiber.NewError(fiber.StatusBadRequest, "Invalid request ID")
+	}
+	return requestID.String(), nil
+}
+
+func (h *FeedbackHandler) setVote(c *fiber.Ctx, voted bool) error {
+	userID := middleware.GetUserID(c)
+	if userID == uuid.Nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "User authentication required")
+	}
+	var id string
+	var err error
+	if voted {
+		id, err = h.resolveVoteTarget(c, userID)
+	} else {
+		id, err = voteID(c.Params("id"))
+	}
+	if err != nil {
+		return err
+	}
+
+	if voted {
+		err = h.store.CreateVote(c.UserContext(), &models.FeatureVote{FeatureRequestID: id, UserID: userID})
+	} else {
+		err = h.store.DeleteVote(c.UserContext(), id, userID)
+	}
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Failed to update vote")
+	}
+	count, err := h.store.CountVotes(c.UserContext(), id)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Failed to count votes")
+	}
+	return c.JSON(fiber.Map{"id": id, "vote_count": count, "voted": voted})
+}
+
 // GetNotifications returns the user's notifications
 func (h *FeedbackHandler) GetNotifications(c *fiber.Ctx) error {
 	userID := middleware.GetUserID(c)
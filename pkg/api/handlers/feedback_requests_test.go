@@ -1,16 +1,23 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/gofiber/fiber/v2"
 	"github.com/kubestellar/console/pkg/models"
+	"github.com/kubestellar/console/pkg/store"
 	"github.com/kubestellar/console/pkg/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListFeatureRequests(t *testing.T) {
@@ -51,6 +58,51 @@ func TestListFeatureRequests(t *testing.T) {
 	})
 }
 
+func TestListFeatureRequests_Search(t *testing.T) {
+	app := fiber.New()
+	mockStore := new(test.MockStore)
+	handler := NewFeedbackHandler(mockStore, FeedbackConfig{})
+
+	userID := uuid.New()
+	app.Get("/api/feedback/requests", func(c *fiber.Ctx) error {
+		c.Locals("userID", userID)
+		return handler.ListFeatureRequests(c)
+	})
+
+	t.Run("FiltersArePushedToStore", func(t *testing.T) {
+		filter := store.FeatureRequestFilter{
+			UserID:          userID,
+			Query:           "dark mode",
+			Status:          models.RequestStatusFixReady,
+			Type:            models.RequestTypeFeature,
+			ExcludeStatuses: []models.RequestStatus{models.RequestStatusOpen, models.RequestStatusNeedsTriage},
+		}
+		mockStore.On("SearchFeatureRequests", filter).Return([]models.FeatureRequest{
+			{ID: uuid.New(), Title: "Dark mode", Status: models.RequestStatusFixReady},
+		}, nil)
+		mockStore.On("CountUserPendingFeatureRequests", userID).Return(0, nil)
+
+		req := httptest.NewRequest("GET", "/api/feedback/requests?q=dark+mode&status=fix_ready&type=feature", nil)
+		resp, _ := app.Test(req)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var result struct {
+			Items []models.FeatureRequest `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&result)
+		assert.Len(t, result.Items, 1)
+		mockStore.AssertNotCalled(t, "GetUserFeatureRequests", userID, 0, 0)
+	})
+
+	t.Run("RejectsInvalidFilters", func(t *testing.T) {
+		for _, query := range []string{"status=open", "status=bogus", "type=question"} {
+			req := httptest.NewRequest("GET", "/api/feedback/requests?"+query, nil)
+			resp, _ := app.Test(req)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+		}
+	})
+}
+
 func TestCheckPreviewStatus(t *testing.T) {
 	app := fiber.New()
 	mockStore := new(test.MockStore)
@@ -65,3 +117,59 @@ func TestCheckPreviewStatus(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+func TestCreateFeatureRequest_RateLimited(t *testing.T) {
+	issueNumber := 0
+	ghes := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/issues") {
+			issueNumber++
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"number": %d, "html_url": "https://ghe.example.com/acme/console/issues/%d"}`, issueNumber, issueNumber)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ghes.Close()
+
+	sqlStore, err := store.NewSQLiteStore(filepath.Join(t.TempDir(), "rate-limit-test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlStore.Close() })
+	user := &models.User{GitHubID: "gh-rate", GitHubLogin: "rate"}
+	require.NoError(t, sqlStore.CreateUser(context.Background(), user))
+
+	const limit = 2
+	handler := NewFeedbackHandler(sqlStore, FeedbackConfig{
+		GitHubToken:     "ghes-token",
+		RepoOwner:       "acme",
+		RepoName:        "console",
+		GitHubBaseURL:   ghes.URL,
+		RequestsPerHour: limit,
+	})
+	app := fiber.New()
+	app.Post("/api/feedback/requests", func(c *fiber.Ctx) error {
+		c.Locals("userID", user.ID)
+		return handler.CreateFeatureRequest(c)
+	})
+
+	submit := func() *http.Response {
+		body := `{"title": "Add a dark mode toggle", "description": "Please add a dark mode toggle to the settings page", "request_type": "feature"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/feedback/requests", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+		return resp
+	}
+
+	for i := 0; i < limit; i++ {
+		resp := submit()
+		require.Equal(t, http.StatusCreated, resp.StatusCode, "request %d should be accepted", i+1)
+	}
+	resp := submit()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	require.NoError(t, err)
+	assert.Greater(t, retryAfter, 0)
+	assert.LessOrEqual(t, retryAfter, int(featureRequestRateWindow.Seconds()))
+	assert.Equal(t, limit, issueNumber, "the rejected request must not reach GitHub")
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kubestellar/console/pkg/models"
+	"github.com/kubestellar/console/pkg/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureRequestVoting(t *testing.T) {
+	sqlStore, err := store.NewSQLiteStore(filepath.Join(t.TempDir(), "votes-test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlStore.Close() })
+
+	ctx := context.Background()
+	author := &models.User{GitHubID: "gh-author", GitHubLogin: "author"}
+	voter := &models.User{GitHubID: "gh-voter", GitHubLogin: "voter"}
+	require.NoError(t, sqlStore.CreateUser(ctx, author))
+	require.NoError(t, sqlStore.CreateUser(ctx, voter))
+	triaged := &models.FeatureRequest{UserID: author.ID, Title: "Dark mode", RequestType: models.RequestTypeFeature, Status: models.RequestStatusTriageAccepted}
+	untriaged := &models.FeatureRequest{UserID: author.ID, Title: "Pending", RequestType: models.RequestTypeFeature, Status: models.RequestStatusNeedsTriage}
+	require.NoError(t, sqlStore.CreateFeatureRequest(ctx, triaged))
+	require.NoError(t, sqlStore.CreateFeatureRequest(ctx, untriaged))
+
+	// GitHub stand-in: issue 12 is triaged, issue 13 awaits triage, issue
+	// 14 was opened by the voter and everything else does not exist.
+	github := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issues := map[string]string{
+			"/api/v3/repos/kubestellar/console/issues/12": `{"number":12,"state":"open","user":{"login":"author"},"labels":[{"name":"triage/accepted"}]}`,
+			"/api/v3/repos/kubestellar/console/issues/13": `{"number":13,"state":"open","user":{"login":"author"},"labels":[]}`,
+			"/api/v3/repos/kubestellar/console/issues/14": `{"number":14,"state":"open","user":{"login":"voter"},"labels":[{"name":"triage/accepted"}]}`,
+		}
+		body, ok := issues[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(github.Close)
+
+	handler := NewFeedbackHandler(sqlStore, FeedbackConfig{
+		GitHubToken:   "test-token",
+		RepoOwner:     "kubestellar",
+		RepoName:      "console",
+		GitHubBaseURL: github.URL,
+	})
+	app := fiber.New()
+	var caller uuid.UUID
+	var callerLogin string
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("userID", caller)
+		c.Locals("githubLogin", callerLogin)
+		return c.Next()
+	})
+	app.Post("/api/feedback/requests/:id/vote", handler.VoteFeatureRequest)
+	app.Delete("/api/feedback/requests/:id/vote", handler.UnvoteFeatureRequest)
+
+	vote := func(method, id string) (int, models.VoteSummary) {
+		t.Helper()
+		resp, err := app.Test(httptest.NewRequest(method, "/api/feedback/requests/"+id+"/vote", nil))
+		require.NoError(t, err)
+		var summary models.VoteSummary
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&summary))
+		}
+		return resp.StatusCode, summary
+	}
+
+	caller, callerLogin = voter.ID, voter.GitHubLogin
+	status, summary := vote(http.MethodPost, triaged.ID.String())
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, models.VoteSummary{Count: 1, Voted: true}, summary)
+
+	// Voting twice is idempotent.
+	status, summary = vote(http.MethodPost, triaged.ID.String())
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, models.VoteSummary{Count: 1, Voted: true}, summary)
+
+	items := handler.featureRequestsToQueueItems([]models.FeatureRequest{*triaged})
+	handler.attachVoteSummaries(ctx, voter.ID, items)
+	require.Equal(t, 1, items[0].VoteCount)
+	require.True(t, items[0].Voted)
+
+	status, summary = vote(http.MethodDelete, triaged.ID.String())
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, models.VoteSummary{Count: 0, Voted: false}, summary)
+
+	status, summary = vote(http.MethodPost, "gh-console-12")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, 1, summary.Count)
+	status, _ = vote(http.MethodPost, "gh-console-13")
+	require.Equal(t, http.StatusBadRequest, status)
+	status, _ = vote(http.MethodPost, "gh-console-14")
+	require.Equal(t, http.StatusForbidden, status)
+	status, _ = vote(http.MethodPost, "gh-console-99")
+	require.Equal(t, http.StatusNotFound, status)
+
+	status, _ = vote(http.MethodPost, untriaged.ID.String())
+	require.Equal(t, http.StatusBadRequest, status)
+	status, _ = vote(http.MethodPost, "gh-other-12")
+	require.Equal(t, http.StatusBadRequest, status)
+	status, _ = vote(http.MethodPost, uuid.NewString())
+	require.Equal(t, http.StatusNotFound, status)
+
+	// A vote stays removable after its request goes back to triage or its
+	// issue disappears from GitHub.
+	status, _ = vote(http.MethodPost, triaged.ID.String())
+	require.Equal(t, http.StatusOK, status)
+	triaged.Status = models.RequestStatusNeedsTriage
+	require.NoError(t, sqlStore.UpdateFeatureRequest(ctx, triaged))
+	status, summary = vote(http.MethodDelete, triaged.ID.String())
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, models.VoteSummary{Count: 0, Voted: false}, summary)
+	status, _ = vote(http.MethodDelete, "gh-console-99")
+	require.Equal(t, http.StatusOK, status)
+	status, _ = vote(http.MethodDelete, "not-an-id")
+	require.Equal(t, http.StatusBadRequest, status)
+
+	caller, callerLogin = author.ID, author.GitHubLogin
+	status, _ = vote(http.MethodPost, triaged.ID.String())
+	require.Equal(t, http.StatusForbidden, status)
+}
@@ -16,6 +16,7 @@ const gatewayDefaultTimeout = 15 * time.Second
 type GatewayHandlers struct {
 	k8sClient *k8s.MultiClusterClient
 	hub       *Hub
+	clusterAccess
 }
 
 // NewGatewayHandlers creates a new Gateway handlers instance
@@ -32,6 +33,10 @@ func (h *GatewayHandlers) ListGateways(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	// Optional filters
 	cluster := c.Query("cluster")
@@ -42,7 +47,7 @@ func (h *GatewayHandlers) ListGateways(c *fiber.Ctx) error {
 
 	if cluster != "" {
 		// Get gateways for specific cluster
-		gateways, err := h.k8sClient.ListGatewaysForCluster(ctx, cluster, namespace)
+		gateways, err := client.ListGatewaysForCluster(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -54,7 +59,7 @@ func (h *GatewayHandlers) ListGateways(c *fiber.Ctx) error {
 	}
 
 	// Get gateways across all clusters
-	list, err := h.k8sClient.ListGateways(ctx)
+	list, err := client.ListGateways(ctx)
 	if err != nil {
 		// If we got partial results alongside errors, log and return what we have
 		if list != nil && len(list.Items) > 0 {
@@ -73,6 +78,10 @@ func (h *GatewayHandlers) ListHTTPRoutes(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	// Optional filters
 	cluster := c.Query("cluster")
@@ -83,7 +92,7 @@ func (h *GatewayHandlers) ListHTTPRoutes(c *fiber.Ctx) error {
 
 	if cluster != "" {
 		// Get routes for specific cluster
-		routes, err := h.k8sClient.ListHTTPRoutesForCluster(ctx, cluster, namespace)
+		routes, err := client.ListHTTPRoutesForCluster(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -95,7 +104,7 @@ func (h *GatewayHandlers) ListHTTPRoutes(c *fiber.Ctx) error {
 	}
 
 	// Get routes across all clusters
-	list, err := h.k8sClient.ListHTTPRoutes(ctx)
+	list, err := client.ListHTTPRoutes(ctx)
 	if err != nil {
 		// If we got partial results alongside errors, log and return what we have
 		if list != nil && len(list.Items) > 0 {
@@ -114,11 +123,15 @@ func (h *GatewayHandlers) GetGatewayAPIStatus(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), gatewayDefaultTimeout)
 	defer cancel()
 
-	clusters, _, err := h.k8sClient.HealthyClusters(ctx)
+	clusters, _, err := client.HealthyClusters(ctx)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -130,7 +143,7 @@ func (h *GatewayHandlers) GetGatewayAPIStatus(c *fiber.Ctx) error {
 
 	status := make([]clusterGatewayStatus, 0, len(clusters))
 	for _, cluster := range clusters {
-		available := h.k8sClient.IsGatewayAPIAvailable(ctx, cluster.Name)
+		available := client.IsGatewayAPIAvailable(ctx, cluster.Name)
 		status = append(status, clusterGatewayStatus{
 			Cluster:             cluster.Name,
 			GatewayAPIAvailable: available,
@@ -148,6 +161,10 @@ func (h *GatewayHandlers) GetGateway(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -156,7 +173,7 @@ func (h *GatewayHandlers) GetGateway(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), gatewayDefaultTimeout)
 	defer cancel()
 
-	gateways, err := h.k8sClient.ListGatewaysForCluster(ctx, cluster, namespace)
+	gateways, err := client.ListGatewaysForCluster(ctx, cluster, namespace)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -176,6 +193,10 @@ func (h *GatewayHandlers) GetHTTPRoute(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -184,7 +205,7 @@ func (h *GatewayHandlers) GetHTTPRoute(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), gatewayDefaultTimeout)
 	defer cancel()
 
-	routes, err := h.k8sClient.ListHTTPRoutesForCluster(ctx, cluster, namespace)
+	routes, err := client.ListHTTPRoutesForCluster(ctx, cluster, namespace)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -93,6 +93,7 @@ type GitHubAppTokenProvider struct {
 	appID          string
 	installationID string
 	privateKeyPEM  []byte
+	apiBase        string
 	httpClient     *http.Client
 
 	mu          sync.Mutex
@@ -104,8 +105,9 @@ type GitHubAppTokenProvider struct {
 // and returns a provider. Returns nil if any required var is missing —
 // caller should treat nil as "App auth disabled" and fall back to the
 // legacy PAT-based flow. Nil provider + feature flag off is the safe
-// default state during rollout.
-func NewGitHubAppTokenProvider() *GitHubAppTokenProvider {
+// default state during rollout. apiBase is the GitHub API base URL the
+// installation token is minted against, normally FeedbackConfig.apiBase().
+func NewGitHubAppTokenProvider(apiBase string) *GitHubAppTokenProvider {
 	appID := os.Getenv(appIDEnv)
 	installationID := os.Getenv(appInstallationIDEnv)
 	privateKey := os.Getenv(appPrivateKeyEnv)
@@ -123,6 +125,7 @@ func NewGitHubAppTokenProvider() *GitHubAppTokenProvider {
 		appID:          appID,
 		installationID: installationID,
 		privateKeyPEM:  []byte(privateKey),
+		apiBase:        apiBase,
 		httpClient:     &http.Client{Timeout: tokenMintTimeout},
 	}
 }
@@ -165,7 +168,7 @@ func (p *GitHubAppTokenProvider) mintInstallationToken(ctx context.Context) (str
 		return "", time.Time{}, fmt.Errorf("sign app JWT: %w", err)
 	}
 
-	url := fmt.Sprintf("%s/app/installations/%s/access_tokens", resolveGitHubAPIBase(), p.installationID)
+	url := fmt.Sprintf("%s/app/installations/%s/access_tokens", p.apiBase, p.installationID)
 	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 	if err != nil {
 		return "", time.Time{}, err
@@ -13,7 +13,7 @@ func TestNewGitHubAppTokenProvider_MissingEnv(t *testing.T) {
 	t.Setenv(appInstallationIDEnv, "")
 	t.Setenv(appPrivateKeyEnv, "")
 
-	p := NewGitHubAppTokenProvider()
+	p := NewGitHubAppTokenProvider(githubAPIBase)
 	if p != nil {
 		t.Errorf("expected nil provider when env vars missing, got %v", p)
 	}
@@ -36,13 +36,28 @@ func TestNewGitHubAppTokenProvider_PartialEnv(t *testing.T) {
 			t.Setenv(appIDEnv, c.appID)
 			t.Setenv(appInstallationIDEnv, c.installationID)
 			t.Setenv(appPrivateKeyEnv, c.privateKey)
-			if p := NewGitHubAppTokenProvider(); p != nil {
+			if p := NewGitHubAppTokenProvider(githubAPIBase); p != nil {
 				t.Errorf("expected nil provider for %s, got %v", c.name, p)
 			}
 		})
 	}
 }
 
+func TestNewFeedbackHandler_AppProviderUsesFeedbackBase(t *testing.T) {
+	t.Setenv(appIDEnv, "123")
+	t.Setenv(appInstallationIDEnv, "456")
+	t.Setenv(appPrivateKeyEnv, "key")
+	t.Setenv("GITHUB_URL", "")
+
+	h := NewFeedbackHandler(nil, FeedbackConfig{GitHubBaseURL: "https://github.example.com"})
+	if h.appTokenProvider == nil {
+		t.Fatal("expected App token provider to be configured")
+	}
+	if got, want := h.appTokenProvider.apiBase, "https://github.example.com/api/v3"; got != want {
+		t.Errorf("provider apiBase = %q, want %q", got, want)
+	}
+}
+
 func TestExpectedAppSlug_Default(t *testing.T) {
 	os.Unsetenv(appSlugEnv)
 	if got := ExpectedAppSlug(); got != DefaultConsoleAppSlug {
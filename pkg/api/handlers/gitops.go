@@ -85,6 +85,8 @@ type GitOpsHandlers struct {
 	// something meaningful (#5950). Populated by DetectDrift.
 	driftCacheMu sync.RWMutex
 	driftCache   map[string]driftCacheEntry
+
+	clusterAccess
 }
 
 // NewGitOpsHandlers creates a new GitOps handlers instance.
@@ -288,10 +290,14 @@ func (h *GitOpsHandlers) ListHelmReleases(c *fiber.Ctx) error {
 
 	// Query all clusters in parallel with timeout
 	if h.k8sClient != nil {
+		client, err := h.clusterClientFor(c, h.k8sClient)
+		if err != nil {
+			return err
+		}
 		hcCtx, hcCancel := context.WithTimeout(c.Context(), gitopsLookupTimeout)
 		defer hcCancel()
 
-		clusters, _, err := h.k8sClient.HealthyClusters(hcCtx)
+		clusters, _, err := client.HealthyClusters(hcCtx)
 		if err != nil {
 			slog.Warn("[GitOps] error listing healthy clusters for releases", "error", err)
 			return c.Status(500).JSON(fiber.Map{"error": "internal server error", "releases": []HelmRelease{}})
@@ -310,7 +316,7 @@ func (h *GitOpsHandlers) ListHelmReleases(c *fiber.Ctx) error {
 				ctx, cancel := context.WithTimeout(c.Context(), helmStreamPerClusterTimeout)
 				defer cancel()
 
-				releases := h.getHelmReleasesForCluster(ctx, clusterName)
+				releases := h.getHelmReleasesForCluster(ctx, client, clusterName)
 				if len(releases) > 0 {
 					mu.Lock()
 					allReleases = append(allReleases, releases...)
@@ -329,10 +335,14 @@ func (h *GitOpsHandlers) ListHelmReleases(c *fiber.Ctx) error {
 
 // listHelmReleasesForCluster lists helm releases for a specific cluster
 func (h *GitOpsHandlers) listHelmReleasesForCluster(c *fiber.Ctx, cluster string) error {
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 	ctx, cancel := context.WithTimeout(c.Context(), helmStreamPerClusterTimeout)
 	defer cancel()
 
-	releases := h.getHelmReleasesForCluster(ctx, cluster)
+	releases := h.getHelmReleasesForCluster(ctx, client, cluster)
 	return c.JSON(fiber.Map{"releases": releases})
 }
 
@@ -367,7 +377,7 @@ type helmReleaseBody struct {
 // secrets with label owner=helm (the storage backend Helm uses by default).
 // If the k8s client is unavailable for the requested cluster, it falls back
 // to shelling out to the helm binary.
-func (h *GitOpsHandlers) getHelmReleasesForCluster(ctx context.Context, cluster string) []HelmRelease {
+func (h *GitOpsHandlers) getHelmReleasesForCluster(ctx context.Context, client *k8s.MultiClusterClient, cluster string) []HelmRelease {
 	// Check cooldown — if this cluster recently failed with RBAC error, skip
 	helmFailureMu.RLock()
 	if cooldownUntil, ok := helmFailureCooldown[cluster]; ok && time.Now().Before(cooldownUntil) {
@@ -377,8 +387,8 @@ func (h *GitOpsHandlers) getHelmReleasesForCluster(ctx context.Context, cluster
 	helmFailureMu.RUnlock()
 
 	// Try K8s API approach first when client is available.
-	if h.k8sClient != nil {
-		releases, err := h.getHelmReleasesViaK8sAPI(ctx, cluster)
+	if client != nil {
+		releases, err := h.getHelmReleasesViaK8sAPI(ctx, client, cluster)
 		if err == nil {
 			return releases
 		}
@@ -409,13 +419,13 @@ func isRBACError(err error) bool {
 
 // getHelmReleasesViaK8sAPI lists Helm releases by querying Kubernetes secrets
 // with label owner=helm across all namespaces.
-func (h *GitOpsHandlers) getHelmReleasesViaK8sAPI(ctx context.Context, cluster string) ([]HelmRelease, error) {
+func (h *GitOpsHandlers) getHelmReleasesViaK8sAPI(ctx context.Context, client *k8s.MultiClusterClient, cluster string) ([]HelmRelease, error) {
 	clusterCtx := cluster
 	if clusterCtx == "" {
 		clusterCtx = "in-cluster"
 	}
 
-	clientset, err := h.k8sClient.GetClient(clusterCtx)
+	clientset, err := client.GetClient(clusterCtx)
 	if err != nil {
 		return nil, fmt.Errorf("get client for %s: %w", clusterCtx, err)
 	}
@@ -568,7 +578,11 @@ func (h *GitOpsHandlers) ListKustomizations(c *fiber.Ctx) error {
 
 	// Query all clusters in parallel with timeout
 	if h.k8sClient != nil {
-		clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+		client, err := h.clusterClientFor(c, h.k8sClient)
+		if err != nil {
+			return err
+		}
+		clusters, _, err := client.HealthyClusters(c.Context())
 		if err != nil {
 			slog.Warn("[GitOps] error listing healthy clusters for kustomizations", "error", err)
 			return c.Status(500).JSON(fiber.Map{"error": "internal server error", "kustomizations": []Kustomization{}})
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/kubestellar/console/pkg/api/v1alpha1"
+	"github.com/kubestellar/console/pkg/k8s"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -84,9 +85,13 @@ func (h *GitOpsHandlers) GetHelmValues(c *fiber.Ctx) error {
 
 	// If namespace not provided, look it up from helm list (with timeout)
 	if namespace == "" {
+		client, err := h.clusterClientFor(c, h.k8sClient)
+		if err != nil {
+			return err
+		}
 		lookupCtx, lookupCancel := context.WithTimeout(c.Context(), gitopsLookupTimeout)
 		defer lookupCancel()
-		namespace = h.findReleaseNamespace(lookupCtx, cluster, release)
+		namespace = h.findReleaseNamespace(lookupCtx, client, cluster, release)
 	}
 
 	args := []string{"get", "values", release, "--output", "json"}
@@ -125,8 +130,8 @@ func (h *GitOpsHandlers) GetHelmValues(c *fiber.Ctx) error {
 }
 
 // findReleaseNamespace finds the namespace for a release by listing all releases
-func (h *GitOpsHandlers) findReleaseNamespace(ctx context.Context, cluster, releaseName string) string {
-	releases := h.getHelmReleasesForCluster(ctx, cluster)
+func (h *GitOpsHandlers) findReleaseNamespace(ctx context.Context, client *k8s.MultiClusterClient, cluster, releaseName string) string {
+	releases := h.getHelmReleasesForCluster(ctx, client, cluster)
 	for _, r := range releases {
 		if r.Name == releaseName {
 			return r.Namespace
@@ -205,11 +210,15 @@ func (h *GitOpsHandlers) ListArgoApplications(c *fiber.Ctx) error {
 			"isDemoData": true,
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), argocdQueryTimeout)
 	defer cancel()
 
-	appList, err := h.k8sClient.ListArgoApplications(ctx)
+	appList, err := client.ListArgoApplications(ctx)
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{
 			"error":      fmt.Sprintf("Failed to list ArgoCD applications: %v", err),
@@ -249,11 +258,15 @@ func (h *GitOpsHandlers) GetArgoHealthSummary(c *fiber.Ctx) error {
 			"isDemoData": true,
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), argocdQueryTimeout)
 	defer cancel()
 
-	appList, err := h.k8sClient.ListArgoApplications(ctx)
+	appList, err := client.ListArgoApplications(ctx)
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{
 			"error":      fmt.Sprintf("Failed to list ArgoCD applications: %v", err),
@@ -300,11 +313,15 @@ func (h *GitOpsHandlers) GetArgoSyncSummary(c *fiber.Ctx) error {
 			"isDemoData": true,
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), argocdQueryTimeout)
 	defer cancel()
 
-	appList, err := h.k8sClient.ListArgoApplications(ctx)
+	appList, err := client.ListArgoApplications(ctx)
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{
 			"error":      fmt.Sprintf("Failed to list ArgoCD applications: %v", err),
@@ -375,11 +392,15 @@ func (h *GitOpsHandlers) ListArgoApplicationSets(c *fiber.Ctx) error {
 			"isDemoData": true,
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), argocdQueryTimeout)
 	defer cancel()
 
-	appSetList, err := h.k8sClient.ListArgoApplicationSets(ctx)
+	appSetList, err := client.ListArgoApplicationSets(ctx)
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{
 			"error":      fmt.Sprintf("Failed to list ArgoCD ApplicationSets: %v", err),
@@ -419,13 +440,17 @@ func (h *GitOpsHandlers) GetArgoStatus(c *fiber.Ctx) error {
 			"clusters": []interface{}{},
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), argocdQueryTimeout)
 	defer cancel()
 
 	// Check for Application CRDs
-	appList, _ := h.k8sClient.ListArgoApplications(ctx)
-	appSetList, _ := h.k8sClient.ListArgoApplicationSets(ctx)
+	appList, _ := client.ListArgoApplications(ctx)
+	appSetList, _ := client.ListArgoApplicationSets(ctx)
 
 	// Build per-cluster detection
 	clusterMap := make(map[string]*v1alpha1.ArgoClusterStatus)
@@ -40,7 +40,11 @@ func (h *GitOpsHandlers) ListOperators(c *fiber.Ctx) error {
 	// Query all clusters in parallel — operators are slow, so we wait for all
 	// (no maxResponseDeadline; SSE streaming is preferred for UI)
 	if h.k8sClient != nil {
-		clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+		client, err := h.clusterClientFor(c, h.k8sClient)
+		if err != nil {
+			return err
+		}
+		clusters, _, err := client.HealthyClusters(c.Context())
 		if err != nil {
 			slog.Warn("[GitOps] error listing healthy clusters for operators", "error", err)
 			return c.Status(500).JSON(fiber.Map{"error": "internal server error", "operators": []Operator{}})
@@ -103,6 +107,10 @@ func (h *GitOpsHandlers) StreamOperators(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	// Capture request context before entering the stream writer so client
 	// disconnect propagates to per-cluster goroutines (#6480).
@@ -129,7 +137,7 @@ func (h *GitOpsHandlers) StreamOperators(c *fiber.Ctx) error {
 		return nil
 	}
 
-	clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+	clusters, _, err := client.HealthyClusters(c.Context())
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -466,7 +474,11 @@ func (h *GitOpsHandlers) ListOperatorSubscriptions(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
-		clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+		client, err := h.clusterClientFor(c, h.k8sClient)
+		if err != nil {
+			return err
+		}
+		clusters, _, err := client.HealthyClusters(c.Context())
 		if err != nil {
 			slog.Warn("[GitOps] error listing healthy clusters for subscriptions", "error", err)
 			return c.Status(500).JSON(fiber.Map{"error": "internal server error", "subscriptions": []OperatorSubscription{}})
@@ -525,6 +537,10 @@ func (h *GitOpsHandlers) StreamOperatorSubscriptions(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	requestCtx := c.UserContext()
 
@@ -548,7 +564,7 @@ func (h *GitOpsHandlers) StreamOperatorSubscriptions(c *fiber.Ctx) error {
 		return nil
 	}
 
-	clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+	clusters, _, err := client.HealthyClusters(c.Context())
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -698,6 +714,10 @@ func (h *GitOpsHandlers) StreamHelmReleases(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	requestCtx := c.UserContext()
 
@@ -710,7 +730,7 @@ func (h *GitOpsHandlers) StreamHelmReleases(c *fiber.Ctx) error {
 			writeSSEEvent(w, "connected", fiber.Map{"status": "streaming"})
 			ctx, cancel := context.WithTimeout(requestCtx, helmStreamPerClusterTimeout)
 			defer cancel()
-			releases := h.getHelmReleasesForCluster(ctx, cluster)
+			releases := h.getHelmReleasesForCluster(ctx, client, cluster)
 			writeSSEEvent(w, "cluster_data", fiber.Map{
 				"cluster":  cluster,
 				"releases": releases,
@@ -721,7 +741,7 @@ func (h *GitOpsHandlers) StreamHelmReleases(c *fiber.Ctx) error {
 		return nil
 	}
 
-	clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+	clusters, _, err := client.HealthyClusters(c.Context())
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -748,7 +768,7 @@ func (h *GitOpsHandlers) StreamHelmReleases(c *fiber.Ctx) error {
 				ctx, cancel := context.WithTimeout(requestCtx, helmStreamPerClusterTimeout)
 				defer cancel()
 
-				releases := h.getHelmReleasesForCluster(ctx, clusterName)
+				releases := h.getHelmReleasesForCluster(ctx, client, clusterName)
 				mu.Lock()
 				completedClusters++
 				writeSSEEvent(w, "cluster_data", fiber.Map{
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMCPGetJobLogs_DemoModeReturnsPerPodLogs asserts that demo mode returns
+// logs keyed by pod name, matching the shape of the live response.
+func TestMCPGetJobLogs_DemoModeReturnsPerPodLogs(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/mcp/jobs/logs", handler.GetJobLogs)
+
+	req, err := http.NewRequest("GET", "/api/mcp/jobs/logs", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Demo-Mode", "true")
+
+	resp, err := env.App.Test(req, podLogsTestTimeoutMS)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Logs   map[string]string `json:"logs"`
+		Source string            `json:"source"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "demo", payload.Source)
+	assert.NotEmpty(t, payload.Logs)
+}
+
+// TestMCPGetJobLogs_MissingParamsReturns400 asserts that the handler
+// refuses requests missing any of cluster/namespace/job.
+func TestMCPGetJobLogs_MissingParamsReturns400(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/mcp/jobs/logs", handler.GetJobLogs)
+
+	req, err := http.NewRequest("GET", "/api/mcp/jobs/logs?cluster=test-cluster&namespace=default", nil)
+	require.NoError(t, err)
+
+	resp, err := env.App.Test(req, podLogsTestTimeoutMS)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
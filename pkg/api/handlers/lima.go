@@ -18,6 +18,7 @@ const limaListTimeout = 30 * time.Second
 // LimaHandlers handles Lima VM status API endpoints.
 type LimaHandlers struct {
 	k8sClient *k8s.MultiClusterClient
+	clusterAccess
 }
 
 // NewLimaHandlers creates a new Lima handlers instance.
@@ -67,6 +68,10 @@ func (h *LimaHandlers) ListLima(c *fiber.Ctx) error {
 			IsDemoData:    true,
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Query("cluster")
 	if cluster != "" {
@@ -82,7 +87,7 @@ func (h *LimaHandlers) ListLima(c *fiber.Ctx) error {
 	if cluster != "" {
 		clusters = append(clusters, k8s.ClusterInfo{Name: cluster, Context: cluster})
 	} else {
-		deduplicated, err := h.k8sClient.DeduplicatedClusters(ctx)
+		deduplicated, err := client.DeduplicatedClusters(ctx)
 		if err != nil {
 			return c.Status(fiber.StatusServiceUnavailable).JSON(LimaListResponse{
 				LimaInstances: []LimaInstanceSummary{},
@@ -103,7 +108,7 @@ func (h *LimaHandlers) ListLima(c *fiber.Ctx) error {
 	successfulClusterQueries := 0
 
 	for _, cl := range clusters {
-		nodes, err := h.k8sClient.GetNodes(ctx, cl.Name)
+		nodes, err := client.GetNodes(ctx, cl.Name)
 		if err != nil {
 			continue
 		}
@@ -72,7 +72,7 @@ func handleK8sError(c *fiber.Ctx, err error) error {
 	switch errType {
 	case "not_found":
 		// Invalid or non-existent cluster — return 404 with consistent error format (#4907, #4908)
-		slog.Info("[MCP] cluster not found", "errorType", errType, "error", err)
+		slog.InfoContext(c.Context(), "[MCP] cluster not found", "errorType", errType, "error", err)
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"clusterStatus": "not_found",
 			"errorType":     errType,
@@ -80,14 +80,14 @@ func handleK8sError(c *fiber.Ctx, err error) error {
 		})
 	case "network", "auth", "timeout", "certificate":
 		// Cluster exists but is unreachable — return 503 with consistent error format (#4908)
-		slog.Info("[MCP] cluster unavailable", "errorType", errType, "error", err)
+		slog.InfoContext(c.Context(), "[MCP] cluster unavailable", "errorType", errType, "error", err)
 		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
 			"clusterStatus": "unavailable",
 			"errorType":     errType,
 			"errorMessage":  sanitizedErrorMessages[errType],
 		})
 	default:
-		slog.Error("[MCP] internal error", "error", err)
+		slog.ErrorContext(c.Context(), "[MCP] internal error", "error", err)
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"clusterStatus": "error",
 			"errorType":     "internal",
@@ -112,13 +112,15 @@ type clusterErrorTracker struct {
 	errors []ClusterError
 }
 
-func (t *clusterErrorTracker) add(cluster string, err error) {
+// add records a failure for cluster. ctx is the request (or derived) context
+// so the log line carries the request ID.
+func (t *clusterErrorTracker) add(ctx context.Context, cluster string, err error) {
 	errType := k8s.ClassifyError(err.Error())
 	msg, ok := sanitizedErrorMessages[errType]
 	if !ok {
 		msg = "An internal error occurred"
 	}
-	slog.Info("[MCP] per-cluster error", "cluster", cluster, "errorType", errType, "error", err)
+	slog.InfoContext(ctx, "[MCP] per-cluster error", "cluster", cluster, "errorType", errType, "error", err)
 	t.mu.Lock()
 	t.errors = append(t.errors, ClusterError{
 		Cluster:   cluster,
@@ -146,6 +148,21 @@ type MCPHandlers struct {
 	bridge    *mcp.Bridge
 	k8sClient *k8s.MultiClusterClient
 	store     store.Store
+	// defaultNamespace replaces an empty ?namespace= on pod and service
+	// lists. Callers opt back into every namespace with ?allNamespaces=true.
+	defaultNamespace string
+	// maxAllNamespacePods rejects all-namespace lists on clusters with more
+	// pods than this. Zero disables the guardrail.
+	maxAllNamespacePods int
+	// clusterTimeout is the configured per-cluster deadline of fan-outs to
+	// every cluster. Zero uses mcpDefaultTimeout.
+	clusterTimeout time.Duration
+	clusterAccess
+	// resourcePolicy limits the resources GetCustomResources and
+	// GetResourceYAML serve.
+	resourcePolicy k8s.GenericResourcePolicy
+	// alertRules are the rules EvaluateAlertRules runs.
+	alertRules []k8s.AlertRule
 }
 
 // NewMCPHandlers creates a new MCP handlers instance
@@ -157,6 +174,48 @@ func NewMCPHandlers(bridge *mcp.Bridge, k8sClient *k8s.MultiClusterClient, s sto
 	}
 }
 
+// SetNamespaceGuardrail configures the default namespace applied by GetPods
+// and GetServices and the all-namespaces pod limit applied by every
+// namespaced list handler.
+func (h *MCPHandlers) SetNamespaceGuardrail(defaultNamespace string, maxAllNamespacePods int) {
+	h.defaultNamespace = defaultNamespace
+	h.maxAllNamespacePods = maxAllNamespacePods
+}
+
+// SetClusterTimeout sets the per-cluster deadline used when a request fans
+// out to every cluster. Zero keeps mcpDefaultTimeout.
+func (h *MCPHandlers) SetClusterTimeout(timeout time.Duration) {
+	h.clusterTimeout = timeout
+}
+
+// SetGenericResourcePolicy sets the resources the generic resource
+// endpoints may serve.
+func (h *MCPHandlers) SetGenericResourcePolicy(policy k8s.GenericResourcePolicy) {
+	h.resourcePolicy = policy
+}
+
+// SetAlertRules sets the rules EvaluateAlertRules runs.
+func (h *MCPHandlers) SetAlertRules(rules []k8s.AlertRule) {
+	h.alertRules = rules
+}
+
+// clusterClient returns the cluster client for the request, see
+// clusterClientFor.
+func (h *MCPHandlers) clusterClient(c *fiber.Ctx) (*k8s.MultiClusterClient, error) {
+	return h.clusterClientFor(c, h.k8sClient)
+}
+
+// fanOutTimeout is the per-cluster deadline of standard fan-outs.
+func (h *MCPHandlers) fanOutTimeout() time.Duration {
+	return resolveClusterTimeout(h.clusterTimeout, mcpDefaultTimeout)
+}
+
+// extendedFanOutTimeout is the per-cluster deadline of heavier fan-outs
+// (pods, events, GPU nodes); it is never shorter than fanOutTimeout.
+func (h *MCPHandlers) extendedFanOutTimeout() time.Duration {
+	return max(mcpExtendedTimeout, h.fanOutTimeout())
+}
+
 // GetStatus returns the MCP bridge status
 func (h *MCPHandlers) GetStatus(c *fiber.Ctx) error {
 	status := fiber.Map{
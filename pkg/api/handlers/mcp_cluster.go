@@ -8,6 +8,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/kubestellar/console/pkg/k8s"
+	"github.com/kubestellar/console/pkg/mcp"
 )
 
 // listClustersHealthWarmup coordinates background health-refresh goroutines
@@ -50,13 +51,15 @@ func (h *MCPHandlers) ListClusters(c *fiber.Ctx) error {
 		return demoResponse(c, "clusters", getDemoClusters())
 	}
 
-	ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 	defer cancel()
 
 	// Try MCP bridge first if available
 	if h.bridge != nil {
-		clusters, err := h.bridge.ListClusters(ctx)
-		if err == nil && len(clusters) > 0 {
+		bridged, err := h.bridge.ListClusters(ctx)
+		if err == nil && len(bridged) > 0 {
+			clusters := clustersFromBridge(bridged)
+			h.applyClusterMetadata(ctx, clusters)
 			return c.JSON(fiber.Map{"clusters": clusters, "source": "mcp"})
 		}
 		slog.Error("[MCP] bridge ListClusters failed, falling back to k8s client", "error", err)
@@ -64,7 +67,12 @@ func (h *MCPHandlers) ListClusters(c *fiber.Ctx) error {
 
 	// Fall back to direct k8s client
 	if h.k8sClient != nil {
-		clusters, err := h.k8sClient.ListClusters(ctx)
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		clusters, err := client.ListClusters(ctx)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -72,8 +80,12 @@ func (h *MCPHandlers) ListClusters(c *fiber.Ctx) error {
 		// Enrich with cached health data only — never block on live health
 		// checks here. The background health refresh (or explicit
 		// /api/mcp/health/all calls) populates the cache asynchronously.
-		healthMap := h.k8sClient.GetCachedHealth()
+		healthMap := client.GetCachedHealth()
 		for i := range clusters {
+			if clusters[i].InventoryOnly {
+				// Never probed; keep the health the inventory hub reports.
+				continue
+			}
 			if health, ok := healthMap[clusters[i].Name]; ok {
 				clusters[i].Healthy = health.Healthy
 				clusters[i].NodeCount = health.NodeCount
@@ -91,6 +103,7 @@ func (h *MCPHandlers) ListClusters(c *fiber.Ctx) error {
 				clusters[i].HealthUnknown = true
 			}
 		}
+		h.applyClusterMetadata(ctx, clusters)
 
 		// Kick off a background health refresh so subsequent calls get fresh
 		// data — but only if another refresh is not already in flight. Under
@@ -102,7 +115,7 @@ func (h *MCPHandlers) ListClusters(c *fiber.Ctx) error {
 				defer finishClusterHealthWarmup()
 				ctx, cancel := context.WithTimeout(context.Background(), mcpHealthTimeout)
 				defer cancel()
-				h.k8sClient.GetAllClusterHealth(ctx)
+				client.GetAllClusterHealth(ctx)
 			}()
 		}
 
@@ -115,6 +128,25 @@ func (h *MCPHandlers) ListClusters(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// clustersFromBridge converts the MCP bridge's cluster list to the
+// ClusterInfo the k8s path returns, so both carry the saved metadata.
+func clustersFromBridge(bridged []mcp.ClusterInfo) []k8s.ClusterInfo {
+	clusters := make([]k8s.ClusterInfo, 0, len(bridged))
+	for _, cl := range bridged {
+		clusters = append(clusters, k8s.ClusterInfo{
+			Name:      cl.Name,
+			Context:   cl.Context,
+			Server:    cl.Server,
+			User:      cl.User,
+			Healthy:   cl.Healthy,
+			Source:    cl.Source,
+			NodeCount: cl.NodeCount,
+			PodCount:  cl.PodCount,
+		})
+	}
+	return clusters
+}
+
 // GetClusterHealth returns health for a specific cluster
 func (h *MCPHandlers) GetClusterHealth(c *fiber.Ctx) error {
 	cluster := c.Params("cluster")
@@ -127,7 +159,7 @@ func (h *MCPHandlers) GetClusterHealth(c *fiber.Ctx) error {
 		return c.JSON(getDemoClusterHealth(cluster))
 	}
 
-	ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 	defer cancel()
 
 	// Try MCP bridge first if available
@@ -141,7 +173,12 @@ func (h *MCPHandlers) GetClusterHealth(c *fiber.Ctx) error {
 
 	// Fall back to direct k8s client
 	if h.k8sClient != nil {
-		health, err := h.k8sClient.GetClusterHealth(ctx, cluster)
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		health, err := client.GetClusterHealth(ctx, cluster)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -151,6 +188,37 @@ func (h *MCPHandlers) GetClusterHealth(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// GetClusterVersion returns the Kubernetes server version and detected
+// platform (OpenShift, EKS, GKE, ...) of a single cluster.
+func (h *MCPHandlers) GetClusterVersion(c *fiber.Ctx) error {
+	cluster := c.Params("cluster")
+	if err := mcpValidateName("cluster", cluster); err != nil {
+		return err
+	}
+
+	if isDemoMode(c) {
+		return demoResponse(c, "version", getDemoClusterVersion(cluster))
+	}
+
+	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+		defer cancel()
+
+		version, err := client.GetClusterVersion(ctx, cluster)
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		return c.JSON(fiber.Map{"version": version, "source": "k8s"})
+	}
+
+	return errNoClusterAccess(c)
+}
+
 // GetAllClusterHealth returns health for all clusters
 func (h *MCPHandlers) GetAllClusterHealth(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
@@ -160,10 +228,15 @@ func (h *MCPHandlers) GetAllClusterHealth(c *fiber.Ctx) error {
 
 	// Use direct k8s client for this as it's more efficient
 	if h.k8sClient != nil {
-		ctx, cancel := context.WithTimeout(c.Context(), mcpHealthTimeout)
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpHealthTimeout)
 		defer cancel()
 
-		health, err := h.k8sClient.GetAllClusterHealth(ctx)
+		health, err := client.GetAllClusterHealth(ctx)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -173,6 +246,54 @@ func (h *MCPHandlers) GetAllClusterHealth(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// GetFleetSummary returns fleet-wide issue counts and the most affected clusters
+func (h *MCPHandlers) GetFleetSummary(c *fiber.Ctx) error {
+	if isDemoMode(c) {
+		return demoResponse(c, "summary", getDemoFleetSummary())
+	}
+
+	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		summary, err := client.GetFleetSummary(c.UserContext())
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		return c.JSON(fiber.Map{"summary": summary, "source": "k8s"})
+	}
+
+	return errNoClusterAccess(c)
+}
+
+// EvaluateAlertRules runs the configured alert rules against the fleet
+// summary and returns each rule's value and whether it fires.
+func (h *MCPHandlers) EvaluateAlertRules(c *fiber.Ctx) error {
+	rules := h.alertRules
+	if rules == nil {
+		rules = []k8s.AlertRule{}
+	}
+	if isDemoMode(c) {
+		return demoResponse(c, "results", k8s.EvaluateAlertRulesOn(getDemoFleetSummary(), rules))
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	results, err := client.EvaluateAlertRules(c.UserContext(), rules)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"results": results, "source": "k8s"})
+}
+
 // GetNodes returns detailed node information
 func (h *MCPHandlers) GetNodes(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
@@ -186,9 +307,14 @@ func (h *MCPHandlers) GetNodes(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query all clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
@@ -196,10 +322,10 @@ func (h *MCPHandlers) GetNodes(c *fiber.Ctx) error {
 			var wg sync.WaitGroup
 			var mu sync.Mutex
 			allNodes := make([]k8s.NodeInfo, 0)
-			clusterTimeout := mcpDefaultTimeout
+			clusterTimeout := h.fanOutTimeout()
 			var errTracker clusterErrorTracker
 
-			clusterCtx, clusterCancel := context.WithCancel(c.Context())
+			clusterCtx, clusterCancel := context.WithCancel(c.UserContext())
 			defer clusterCancel()
 
 			for _, cl := range clusters {
@@ -209,9 +335,9 @@ func (h *MCPHandlers) GetNodes(c *fiber.Ctx) error {
 					ctx, cancel := context.WithTimeout(clusterCtx, clusterTimeout)
 					defer cancel()
 
-					nodes, err := h.k8sClient.GetNodes(ctx, clusterName)
+					nodes, err := client.GetNodes(ctx, clusterName)
 					if err != nil {
-						errTracker.add(clusterName, err)
+						errTracker.add(ctx, clusterName, err)
 					} else if len(nodes) > 0 {
 						mu.Lock()
 						allNodes = append(allNodes, nodes...)
@@ -224,10 +350,10 @@ func (h *MCPHandlers) GetNodes(c *fiber.Ctx) error {
 			return c.JSON(errTracker.annotate(fiber.Map{"nodes": allNodes, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		nodes, err := h.k8sClient.GetNodes(ctx, cluster)
+		nodes, err := client.GetNodes(ctx, cluster)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -240,6 +366,86 @@ func (h *MCPHandlers) GetNodes(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// GetNodeAlerts returns alerts for nodes that are not ready, under memory,
+// disk or PID pressure, or cordoned, for one cluster or every healthy one.
+func (h *MCPHandlers) GetNodeAlerts(c *fiber.Ctx) error {
+	if isDemoMode(c) {
+		return demoResponse(c, "alerts", getDemoNodeAlerts())
+	}
+
+	cluster := c.Query("cluster")
+	if err := mcpValidateName("cluster", cluster); err != nil {
+		return err
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	if cluster == "" {
+		clusters, _, err := client.HealthyClusters(c.UserContext())
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		alerts, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.NodeAlert, error) {
+			return client.GetNodeAlerts(ctx, clusterName)
+		})
+		return c.JSON(errTracker.annotate(fiber.Map{"alerts": alerts, "source": "k8s"}))
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+	defer cancel()
+
+	alerts, err := client.GetNodeAlerts(ctx, cluster)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"alerts": alerts, "source": "k8s"})
+}
+
+// GetPodsOnNode returns every pod scheduled on :node in :cluster, across all
+// namespaces.
+func (h *MCPHandlers) GetPodsOnNode(c *fiber.Ctx) error {
+	cluster := c.Params("cluster")
+	node := c.Params("node")
+
+	if isDemoMode(c) {
+		// Demo pods carry no node, so show the demo cluster's pods.
+		return demoResponse(c, "pods", filterDemoScope(getDemoPods(), cluster, "", demoPodScope))
+	}
+
+	if err := mcpValidateName("cluster", cluster); err != nil {
+		return err
+	}
+	if err := mcpValidateName("node", node); err != nil {
+		return err
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+	defer cancel()
+
+	pods, err := client.GetPodsOnNode(ctx, cluster, node)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	if pods == nil {
+		pods = make([]k8s.PodInfo, 0)
+	}
+	return c.JSON(fiber.Map{"pods": pods, "cluster": cluster, "node": node, "source": "k8s"})
+}
+
 // GetEvents returns events from clusters
 func (h *MCPHandlers) GetEvents(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
@@ -254,13 +460,16 @@ func (h *MCPHandlers) GetEvents(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 	if err := mcpValidatePositiveInt("limit", limit, mcpMaxEventLimit); err != nil {
 		return err
 	}
 
 	// Try MCP bridge first
 	if h.bridge != nil {
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
 		events, err := h.bridge.GetEvents(ctx, cluster, namespace, limit)
@@ -272,11 +481,16 @@ func (h *MCPHandlers) GetEvents(c *fiber.Ctx) error {
 
 	// Fall back to direct k8s client
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query deduplicated clusters in parallel with timeout
 		if cluster == "" {
 			// Use deduplicated clusters to avoid querying the same physical cluster
 			// via multiple kubeconfig contexts (e.g. "vllm-d" and its long OpenShift name)
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
@@ -294,10 +508,10 @@ func (h *MCPHandlers) GetEvents(c *fiber.Ctx) error {
 			var wg sync.WaitGroup
 			var mu sync.Mutex
 			allEvents := make([]k8s.Event, 0)
-			clusterTimeout := mcpDefaultTimeout
+			clusterTimeout := h.fanOutTimeout()
 			var errTracker clusterErrorTracker
 
-			clusterCtx, clusterCancel := context.WithCancel(c.Context())
+			clusterCtx, clusterCancel := context.WithCancel(c.UserContext())
 			defer clusterCancel()
 
 			for _, cl := range clusters {
@@ -307,9 +521,9 @@ func (h *MCPHandlers) GetEvents(c *fiber.Ctx) error {
 					ctx, cancel := context.WithTimeout(clusterCtx, clusterTimeout)
 					defer cancel()
 
-					events, err := h.k8sClient.GetEvents(ctx, clusterName, namespace, perClusterLimit)
+					events, err := client.GetEvents(ctx, clusterName, namespace, perClusterLimit)
 					if err != nil {
-						errTracker.add(clusterName, err)
+						errTracker.add(ctx, clusterName, err)
 					} else if len(events) > 0 {
 						mu.Lock()
 						allEvents = append(allEvents, events...)
@@ -330,10 +544,10 @@ func (h *MCPHandlers) GetEvents(c *fiber.Ctx) error {
 			return c.JSON(errTracker.annotate(fiber.Map{"events": allEvents, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		events, err := h.k8sClient.GetEvents(ctx, cluster, namespace, limit)
+		events, err := client.GetEvents(ctx, cluster, namespace, limit)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -366,7 +580,7 @@ func (h *MCPHandlers) GetWarningEvents(c *fiber.Ctx) error {
 
 	// Try MCP bridge first
 	if h.bridge != nil {
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
 		events, err := h.bridge.GetWarningEvents(ctx, cluster, namespace, limit)
@@ -378,9 +592,14 @@ func (h *MCPHandlers) GetWarningEvents(c *fiber.Ctx) error {
 
 	// Fall back to direct k8s client
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query deduplicated clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
@@ -397,10 +616,10 @@ func (h *MCPHandlers) GetWarningEvents(c *fiber.Ctx) error {
 			var wg sync.WaitGroup
 			var mu sync.Mutex
 			allEvents := make([]k8s.Event, 0)
-			clusterTimeout := mcpDefaultTimeout
+			clusterTimeout := h.fanOutTimeout()
 			var errTracker clusterErrorTracker
 
-			clusterCtx, clusterCancel := context.WithCancel(c.Context())
+			clusterCtx, clusterCancel := context.WithCancel(c.UserContext())
 			defer clusterCancel()
 
 			for _, cl := range clusters {
@@ -410,9 +629,9 @@ func (h *MCPHandlers) GetWarningEvents(c *fiber.Ctx) error {
 					ctx, cancel := context.WithTimeout(clusterCtx, clusterTimeout)
 					defer cancel()
 
-					events, err := h.k8sClient.GetWarningEvents(ctx, clusterName, namespace, perClusterLimit)
+					events, err := client.GetWarningEvents(ctx, clusterName, namespace, perClusterLimit)
 					if err != nil {
-						errTracker.add(clusterName, err)
+						errTracker.add(ctx, clusterName, err)
 					} else if len(events) > 0 {
 						mu.Lock()
 						allEvents = append(allEvents, events...)
@@ -433,10 +652,10 @@ func (h *MCPHandlers) GetWarningEvents(c *fiber.Ctx) error {
 			return c.JSON(errTracker.annotate(fiber.Map{"events": allEvents, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		events, err := h.k8sClient.GetWarningEvents(ctx, cluster, namespace, limit)
+		events, err := client.GetWarningEvents(ctx, cluster, namespace, limit)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -449,6 +668,54 @@ func (h *MCPHandlers) GetWarningEvents(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// GetVulnerabilityReports returns per-workload image vulnerability counts
+// from the Trivy operator. Clusters without Trivy return no reports.
+func (h *MCPHandlers) GetVulnerabilityReports(c *fiber.Ctx) error {
+	// Demo mode: return demo data immediately
+	if isDemoMode(c) {
+		return demoResponse(c, "reports", getDemoVulnerabilityReports())
+	}
+
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+
+	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		// If no cluster specified, query all clusters in parallel
+		if cluster == "" {
+			clusters, _, err := client.HealthyClusters(c.UserContext())
+			if err != nil {
+				return handleK8sError(c, err)
+			}
+
+			allReports, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
+				func(ctx context.Context, clusterName string) ([]k8s.VulnReport, error) {
+					return client.GetVulnerabilityReports(ctx, clusterName, namespace)
+				})
+			return c.JSON(errTracker.annotate(fiber.Map{"reports": allReports, "source": "k8s"}))
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+		defer cancel()
+
+		reports, err := client.GetVulnerabilityReports(ctx, cluster, namespace)
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		return c.JSON(fiber.Map{"reports": reports, "source": "k8s"})
+	}
+
+	return errNoClusterAccess(c)
+}
+
 // CheckSecurityIssues returns security misconfigurations
 func (h *MCPHandlers) CheckSecurityIssues(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
@@ -465,9 +732,14 @@ func (h *MCPHandlers) CheckSecurityIssues(c *fiber.Ctx) error {
 
 	// Fall back to direct k8s client
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query all clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
@@ -475,10 +747,10 @@ func (h *MCPHandlers) CheckSecurityIssues(c *fiber.Ctx) error {
 			var wg sync.WaitGroup
 			var mu sync.Mutex
 			allIssues := make([]k8s.SecurityIssue, 0)
-			clusterTimeout := mcpDefaultTimeout
+			clusterTimeout := h.fanOutTimeout()
 			var errTracker clusterErrorTracker
 
-			clusterCtx, clusterCancel := context.WithCancel(c.Context())
+			clusterCtx, clusterCancel := context.WithCancel(c.UserContext())
 			defer clusterCancel()
 
 			for _, cl := range clusters {
@@ -488,9 +760,9 @@ func (h *MCPHandlers) CheckSecurityIssues(c *fiber.Ctx) error {
 					ctx, cancel := context.WithTimeout(clusterCtx, clusterTimeout)
 					defer cancel()
 
-					issues, err := h.k8sClient.CheckSecurityIssues(ctx, clusterName, namespace)
+					issues, err := client.CheckSecurityIssues(ctx, clusterName, namespace)
 					if err != nil {
-						errTracker.add(clusterName, err)
+						errTracker.add(ctx, clusterName, err)
 					} else if len(issues) > 0 {
 						mu.Lock()
 						allIssues = append(allIssues, issues...)
@@ -503,10 +775,10 @@ func (h *MCPHandlers) CheckSecurityIssues(c *fiber.Ctx) error {
 			return c.JSON(errTracker.annotate(fiber.Map{"issues": allIssues, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		issues, err := h.k8sClient.CheckSecurityIssues(ctx, cluster, namespace)
+		issues, err := client.CheckSecurityIssues(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -1,9 +1,21 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
 	"github.com/kubestellar/console/pkg/k8s"
+	"github.com/kubestellar/console/pkg/mcp"
+	"github.com/kubestellar/console/pkg/store"
+	"github.com/kubestellar/console/pkg/test"
 )
 
 // TestMultiClusterEventSortOrder verifies that the helper used by
@@ -61,3 +73,115 @@ func clusterNames(events []k8s.Event) []string {
 	}
 	return names
 }
+
+func TestMCPGetFleetSummary(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/fleet/summary", handler.GetFleetSummary)
+
+	req, err := http.NewRequest("GET", "/api/fleet/summary", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 10000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Summary k8s.FleetSummary `json:"summary"`
+		Source  string           `json:"source"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "k8s", payload.Source)
+	assert.Equal(t, 1, payload.Summary.TotalClusters)
+	assert.NotEmpty(t, payload.Summary.GeneratedAt)
+}
+
+func TestMCPGetClusterVersion(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/clusters/:cluster/version", handler.GetClusterVersion)
+
+	req, err := http.NewRequest("GET", "/api/clusters/test-cluster/version", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 10000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Version k8s.ClusterVersion `json:"version"`
+		Source  string             `json:"source"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "k8s", payload.Source)
+	assert.Equal(t, "test-cluster", payload.Version.Cluster)
+	assert.NotEmpty(t, payload.Version.GitVersion)
+}
+
+func TestMCPListClusters_AppliesMetadata(t *testing.T) {
+	env := setupTestEnv(t)
+	mockStore := new(test.MockStore)
+	mockStore.On("ListClusterMetadata").Return([]store.ClusterMetadata{
+		{Context: "test-cluster", DisplayName: "Test Cluster", Tags: []string{"team-a"}, Environment: "dev"},
+	}, nil)
+	handler := NewMCPHandlers(nil, env.K8sClient, mockStore)
+	env.App.Get("/api/mcp/clusters", handler.ListClusters)
+
+	req, err := http.NewRequest("GET", "/api/mcp/clusters", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 10000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Clusters []k8s.ClusterInfo `json:"clusters"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	require.Len(t, payload.Clusters, 1)
+	assert.Equal(t, "Test Cluster", payload.Clusters[0].DisplayName)
+	assert.Equal(t, []string{"team-a"}, payload.Clusters[0].Tags)
+	assert.Equal(t, "dev", payload.Clusters[0].Environment)
+}
+
+func TestClustersFromBridge_AppliesMetadata(t *testing.T) {
+	mockStore := new(test.MockStore)
+	mockStore.On("ListClusterMetadata").Return([]store.ClusterMetadata{
+		{Context: "prod", DisplayName: "Production", Tags: []string{"team-a"}, Environment: "prod"},
+	}, nil)
+	handler := NewMCPHandlers(nil, nil, mockStore)
+
+	clusters := clustersFromBridge([]mcp.ClusterInfo{
+		{Name: "prod", Context: "prod", Healthy: true, NodeCount: 3},
+		{Name: "dev", Context: "dev"},
+	})
+	handler.applyClusterMetadata(context.Background(), clusters)
+
+	require.Len(t, clusters, 2)
+	assert.Equal(t, "Production", clusters[0].DisplayName)
+	assert.Equal(t, []string{"team-a"}, clusters[0].Tags)
+	assert.Equal(t, "prod", clusters[0].Environment)
+	assert.True(t, clusters[0].Healthy)
+	assert.Equal(t, 3, clusters[0].NodeCount)
+	assert.Empty(t, clusters[1].DisplayName)
+}
+
+func TestMCPSetClusterMetadata(t *testing.T) {
+	env := setupTestEnv(t)
+	mockStore := env.Store.(*test.MockStore)
+	mockStore.On("SaveClusterMetadata", mock.MatchedBy(func(meta *store.ClusterMetadata) bool {
+		return meta.Context == "test-cluster" && meta.DisplayName == "Test" &&
+			len(meta.Tags) == 1 && meta.Tags[0] == "gpu" && meta.Environment == "prod"
+	})).Return(nil).Once()
+	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
+	env.App.Put("/api/clusters/:cluster/metadata", handler.SetClusterMetadata)
+
+	put := func(body string) int {
+		req := httptest.NewRequest(http.MethodPut, "/api/clusters/test-cluster/metadata", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := env.App.Test(req, 10000)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+	// Blank and duplicate tags are dropped; the environment is lower-cased.
+	assert.Equal(t, http.StatusOK, put(`{"displayName":" Test ","tags":["gpu"," ","gpu"],"environment":"Prod"}`))
+	assert.Equal(t, http.StatusBadRequest, put(`{"environment":"qa"}`))
+	mockStore.AssertExpectations(t)
+}
@@ -21,24 +21,29 @@ func (h *MCPHandlers) GetGPUNodes(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query all clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allNodes, errTracker := queryAllClustersWithTimeout(c.Context(), clusters, mcpExtendedTimeout,
+			allNodes, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.extendedFanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.GPUNode, error) {
-					return h.k8sClient.GetGPUNodes(ctx, clusterName)
+					return client.GetGPUNodes(ctx, clusterName)
 				})
 			return c.JSON(errTracker.annotate(fiber.Map{"nodes": allNodes, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpExtendedTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpExtendedTimeout)
 		defer cancel()
 
-		nodes, err := h.k8sClient.GetGPUNodes(ctx, cluster)
+		nodes, err := client.GetGPUNodes(ctx, cluster)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -51,6 +56,29 @@ func (h *MCPHandlers) GetGPUNodes(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// GetGPUSummary returns fleet-wide GPU totals with per-type, per-manufacturer
+// and per-cluster breakdowns.
+func (h *MCPHandlers) GetGPUSummary(c *fiber.Ctx) error {
+	if isDemoMode(c) {
+		return demoResponse(c, "summary", getDemoGPUSummary())
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	summary, err := client.GetGPUSummary(c.UserContext())
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"summary": summary, "source": "k8s"})
+}
+
 // GetGPUNodeHealth returns proactive health check results for GPU nodes
 func (h *MCPHandlers) GetGPUNodeHealth(c *fiber.Ctx) error {
 	if isDemoMode(c) {
@@ -63,23 +91,28 @@ func (h *MCPHandlers) GetGPUNodeHealth(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allNodes, errTracker := queryAllClustersWithTimeout(c.Context(), clusters, mcpExtendedTimeout,
+			allNodes, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.extendedFanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.GPUNodeHealthStatus, error) {
-					return h.k8sClient.GetGPUNodeHealth(ctx, clusterName)
+					return client.GetGPUNodeHealth(ctx, clusterName)
 				})
 			return c.JSON(errTracker.annotate(fiber.Map{"nodes": allNodes, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpExtendedTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpExtendedTimeout)
 		defer cancel()
 
-		nodes, err := h.k8sClient.GetGPUNodeHealth(ctx, cluster)
+		nodes, err := client.GetGPUNodeHealth(ctx, cluster)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -110,10 +143,15 @@ func (h *MCPHandlers) GetGPUHealthCronJobStatus(c *fiber.Ctx) error {
 		return errNoClusterAccess(c)
 	}
 
-	ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 	defer cancel()
 
-	status, err := h.k8sClient.GetGPUHealthCronJobStatus(ctx, cluster)
+	status, err := client.GetGPUHealthCronJobStatus(ctx, cluster)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -147,10 +185,15 @@ func (h *MCPHandlers) GetGPUHealthCronJobResults(c *fiber.Ctx) error {
 		return errNoClusterAccess(c)
 	}
 
-	ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 	defer cancel()
 
-	status, err := h.k8sClient.GetGPUHealthCronJobStatus(ctx, cluster)
+	status, err := client.GetGPUHealthCronJobStatus(ctx, cluster)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -170,16 +213,21 @@ func (h *MCPHandlers) GetNVIDIAOperatorStatus(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query all clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allStatus, errTracker := queryAllClusters(c.Context(), clusters,
+			allStatus, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]*k8s.NVIDIAOperatorStatus, error) {
-					status, err := h.k8sClient.GetNVIDIAOperatorStatus(ctx, clusterName)
+					status, err := client.GetNVIDIAOperatorStatus(ctx, clusterName)
 					if err != nil {
 						return nil, err
 					}
@@ -191,10 +239,10 @@ func (h *MCPHandlers) GetNVIDIAOperatorStatus(c *fiber.Ctx) error {
 			return c.JSON(errTracker.annotate(fiber.Map{"operators": allStatus, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		status, err := h.k8sClient.GetNVIDIAOperatorStatus(ctx, cluster)
+		status, err := client.GetNVIDIAOperatorStatus(ctx, cluster)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -203,3 +203,36 @@ func TestMCPGetGPUNodes_AllClusters(t *testing.T) {
 	assert.Equal(t, "k8s", payload["source"])
 	assert.NotNil(t, payload["nodes"])
 }
+
+func TestMCPGetGPUSummary(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/gpu/summary", handler.GetGPUSummary)
+
+	k8sClient, err := env.K8sClient.GetClient("test-cluster")
+	require.NoError(t, err)
+	fakeClient := k8sClient.(*k8sfake.Clientset)
+	_, err = fakeClient.CoreV1().Nodes().Create(context.Background(), &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-node-1", Labels: map[string]string{"nvidia.com/gpu.product": "Tesla T4"}},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", "/api/gpu/summary", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 10000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Summary k8s.GPUSummary `json:"summary"`
+		Source  string         `json:"source"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "k8s", payload.Source)
+	assert.Equal(t, 2, payload.Summary.TotalGPUs)
+	assert.Equal(t, 2, payload.Summary.FreeGPUs)
+	assert.Equal(t, k8s.GPUAllocation{Total: 2, Free: 2}, payload.Summary.ByType["Tesla T4"])
+}
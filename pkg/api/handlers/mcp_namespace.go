@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// resolveListNamespace substitutes the configured default namespace for an
+// empty ?namespace=. ?allNamespaces=true keeps the empty value so callers can
+// still ask for every namespace (subject to checkAllNamespacePodLimit).
+func (h *MCPHandlers) resolveListNamespace(c *fiber.Ctx, namespace string) string {
+	if namespace != "" || h.defaultNamespace == "" || c.QueryBool("allNamespaces") {
+		return namespace
+	}
+	return h.defaultNamespace
+}
+
+// maxConcurrentPodCountChecks caps the clusters checkAllNamespacePodLimit
+// counts pods on at once, so a large fleet does not open one API request
+// per cluster simultaneously.
+const maxConcurrentPodCountChecks = 10
+
+// checkAllNamespacePodLimit returns a 400 error when an all-namespaces list
+// would touch a cluster holding more than maxAllNamespacePods pods. The pod
+// count stands in for cluster size, so every namespaced list handler calls
+// this, not only pod lists. An empty cluster means every healthy cluster.
+// Clusters whose pod count cannot be checked are let through; the list call
+// will surface the real error.
+func (h *MCPHandlers) checkAllNamespacePodLimit(c *fiber.Ctx, cluster, namespace string) error {
+	if namespace != "" || h.maxAllNamespacePods <= 0 || h.k8sClient == nil {
+		return nil
+	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	clusterNames := []string{cluster}
+	if cluster == "" {
+		clusters, _, err := client.HealthyClusters(c.UserContext())
+		if err != nil {
+			return nil
+		}
+		clusterNames = clusterNames[:0]
+		for _, cl := range clusters {
+			clusterNames = append(clusterNames, cl.Name)
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var oversized string
+	sem := make(chan struct{}, maxConcurrentPodCountChecks)
+	for _, name := range clusterNames {
+		wg.Add(1)
+		go func(clusterName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+			defer cancel()
+
+			exceeds, err := client.PodCountExceeds(ctx, clusterName, h.maxAllNamespacePods)
+			if err != nil {
+				slog.WarnContext(ctx, "[MCP] all-namespace pod count check failed", "cluster", clusterName, "error", err)
+				return
+			}
+			if exceeds {
+				mu.Lock()
+				if oversized == "" || clusterName < oversized {
+					oversized = clusterName
+				}
+				mu.Unlock()
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	if oversized != "" {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf(
+			"cluster %s has more than %d pods; specify a namespace or paginate instead of listing all namespaces",
+			oversized, h.maxAllNamespacePods))
+	}
+	return nil
+}
@@ -34,6 +34,15 @@ func queryAllClusters[T any](
 	return queryAllClustersWithTimeout(ctx, clusters, mcpDefaultTimeout, queryFn)
 }
 
+// resolveClusterTimeout returns the configured per-cluster timeout, or def
+// when none is configured.
+func resolveClusterTimeout(configured, def time.Duration) time.Duration {
+	if configured > 0 {
+		return configured
+	}
+	return def
+}
+
 // queryAllClustersWithTimeout is like queryAllClusters but accepts a custom
 // per-cluster timeout. Use this when the default timeout is insufficient
 // (e.g., GPU node queries, pod listings on large clusters).
@@ -59,7 +68,7 @@ func queryAllClustersWithTimeout[T any](
 			defer cancel()
 			items, err := queryFn(itemCtx, clusterName)
 			if err != nil {
-				errTracker.add(clusterName, err)
+				errTracker.add(ctx, clusterName, err)
 			} else if len(items) > 0 {
 				mu.Lock()
 				results = append(results, items...)
@@ -2,12 +2,19 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/kubestellar/console/pkg/k8s"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 func TestQueryAllClusters_Success(t *testing.T) {
@@ -131,3 +138,77 @@ func TestWaitWithDeadline_Integration(t *testing.T) {
 	// But it should finish around 1s + overhead.
 	assert.Less(t, duration, 2*time.Second)
 }
+
+func TestMCPHandlers_ClusterTimeoutIsConfigurable(t *testing.T) {
+	const clusterDelay = 2 * time.Second
+	// The fake clientset ignores contexts, so serve the slow cluster from a
+	// real apiserver stand-in that client-go can abandon at the deadline.
+	apiserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(clusterDelay):
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"ConfigMapList","apiVersion":"v1","metadata":{},"items":[{"metadata":{"name":"slow-cm","namespace":"default"}}]}`))
+	}))
+	defer apiserver.Close()
+
+	env := setupTestEnv(t)
+	slowClient, err := kubernetes.NewForConfig(&rest.Config{Host: apiserver.URL})
+	require.NoError(t, err)
+	addClusterToRawConfig(env.K8sClient, "slow-cluster")
+	env.K8sClient.InjectClient("slow-cluster", slowClient)
+
+	tests := []struct {
+		name     string
+		timeout  time.Duration
+		wantData bool
+	}{
+		{name: "timeout longer than cluster delay", timeout: 5 * time.Second, wantData: true},
+		{name: "timeout shorter than cluster delay", timeout: 500 * time.Millisecond, wantData: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewMCPHandlers(nil, env.K8sClient, nil)
+			h.SetClusterTimeout(tt.timeout)
+			app := fiber.New()
+			app.Get("/api/mcp/configmaps", h.GetConfigMaps)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/mcp/configmaps?namespace=default", nil)
+			resp, err := app.Test(req, -1)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var payload struct {
+				ConfigMaps    []k8s.ConfigMap `json:"configmaps"`
+				Partial       bool            `json:"partial"`
+				ClusterErrors []ClusterError  `json:"clusterErrors"`
+			}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+			if tt.wantData {
+				require.Len(t, payload.ConfigMaps, 1)
+				assert.Equal(t, "slow-cm", payload.ConfigMaps[0].Name)
+				assert.False(t, payload.Partial)
+				assert.Empty(t, payload.ClusterErrors)
+			} else {
+				assert.Empty(t, payload.ConfigMaps)
+				assert.True(t, payload.Partial)
+				require.Len(t, payload.ClusterErrors, 1)
+				assert.Equal(t, "slow-cluster", payload.ClusterErrors[0].Cluster)
+				assert.Equal(t, "timeout", payload.ClusterErrors[0].ErrorType)
+			}
+		})
+	}
+}
+
+func TestMCPHandlers_FanOutTimeoutDefaults(t *testing.T) {
+	h := NewMCPHandlers(nil, nil, nil)
+	assert.Equal(t, mcpDefaultTimeout, h.fanOutTimeout())
+	assert.Equal(t, mcpExtendedTimeout, h.extendedFanOutTimeout())
+
+	// A configured timeout longer than the extended one raises both.
+	h.SetClusterTimeout(time.Minute)
+	assert.Equal(t, time.Minute, h.fanOutTimeout())
+	assert.Equal(t, time.Minute, h.extendedFanOutTimeout())
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +16,8 @@ import (
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kubestellar/console/pkg/k8s"
@@ -30,24 +34,32 @@ func (h *MCPHandlers) GetConfigMaps(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allConfigMaps, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.ConfigMap, error) {
-				return h.k8sClient.GetConfigMaps(ctx, clusterName, namespace)
+			allConfigMaps, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.ConfigMap, error) {
+				return client.GetConfigMaps(ctx, clusterName, namespace)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"configmaps": allConfigMaps, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		configmaps, err := h.k8sClient.GetConfigMaps(ctx, cluster, namespace)
+		configmaps, err := client.GetConfigMaps(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -73,24 +85,32 @@ func (h *MCPHandlers) GetSecrets(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allSecrets, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.Secret, error) {
-				return h.k8sClient.GetSecrets(ctx, clusterName, namespace)
+			allSecrets, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.Secret, error) {
+				return client.GetSecrets(ctx, clusterName, namespace)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"secrets": allSecrets, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		secrets, err := h.k8sClient.GetSecrets(ctx, cluster, namespace)
+		secrets, err := client.GetSecrets(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -103,6 +123,65 @@ func (h *MCPHandlers) GetSecrets(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// GetSecretValue reveals the decoded value of a single Secret key
+// GET /api/mcp/secrets/value?cluster=&namespace=&name=&key=&reveal=true
+//
+// The caller must pass reveal=true to confirm intent. The route is wrapped by
+// audit.Wrap, so every reveal attempt is audited with its outcome.
+func (h *MCPHandlers) GetSecretValue(c *fiber.Ctx) error {
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+	name := c.Query("name")
+	key := c.Query("key")
+
+	if !c.QueryBool("reveal") {
+		return fiber.NewError(fiber.StatusBadRequest, "reveal=true is required to view a secret value")
+	}
+	if cluster == "" || namespace == "" || name == "" || key == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "cluster, namespace, name and key are required")
+	}
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+	if err := mcpValidateName("name", name); err != nil {
+		return err
+	}
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid key: "+strings.Join(errs, "; "))
+	}
+	if err := requireEditorOrAdmin(c, h.store); err != nil {
+		return err
+	}
+
+	if isDemoMode(c) {
+		return c.JSON(fiber.Map{"cluster": cluster, "namespace": namespace, "name": name, "key": key, "value": "demo-secret-value", "source": "demo"})
+	}
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+	defer cancel()
+
+	value, err := client.GetSecretValue(ctx, cluster, namespace, name, key)
+	audit.Log(c, audit.ActionRevealSecret, "secret", cluster+"/"+namespace+"/"+name,
+		"cluster="+cluster, "namespace="+namespace, "key="+key)
+	if err != nil {
+		if errors.Is(err, k8s.ErrSecretKeyNotFound) || k8sErrors.IsNotFound(err) {
+			return fiber.NewError(fiber.StatusNotFound, "secret or key not found")
+		}
+		return handleK8sError(c, err)
+	}
+
+	c.Set("Cache-Control", "no-store")
+	return c.JSON(fiber.Map{"cluster": cluster, "namespace": namespace, "name": name, "key": key, "value": value, "source": "k8s"})
+}
+
 // GetServiceAccounts returns ServiceAccounts from clusters
 func (h *MCPHandlers) GetServiceAccounts(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
@@ -116,24 +195,32 @@ func (h *MCPHandlers) GetServiceAccounts(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allServiceAccounts, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.ServiceAccount, error) {
-				return h.k8sClient.GetServiceAccounts(ctx, clusterName, namespace)
+			allServiceAccounts, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.ServiceAccount, error) {
+				return client.GetServiceAccounts(ctx, clusterName, namespace)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"serviceAccounts": allServiceAccounts, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		serviceAccounts, err := h.k8sClient.GetServiceAccounts(ctx, cluster, namespace)
+		serviceAccounts, err := client.GetServiceAccounts(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -159,24 +246,32 @@ func (h *MCPHandlers) GetPVCs(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allPVCs, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.PVC, error) {
-				return h.k8sClient.GetPVCs(ctx, clusterName, namespace)
+			allPVCs, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.PVC, error) {
+				return client.GetPVCs(ctx, clusterName, namespace)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"pvcs": allPVCs, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		pvcs, err := h.k8sClient.GetPVCs(ctx, cluster, namespace)
+		pvcs, err := client.GetPVCs(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -202,22 +297,27 @@ func (h *MCPHandlers) GetPVs(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allPVs, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.PV, error) {
-				return h.k8sClient.GetPVs(ctx, clusterName)
+			allPVs, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.PV, error) {
+				return client.GetPVs(ctx, clusterName)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"pvs": allPVs, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		pvs, err := h.k8sClient.GetPVs(ctx, cluster)
+		pvs, err := client.GetPVs(ctx, cluster)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -245,22 +345,27 @@ func (h *MCPHandlers) GetResourceQuotas(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allQuotas, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.ResourceQuota, error) {
-				return h.k8sClient.GetResourceQuotas(ctx, clusterName, namespace)
+			allQuotas, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.ResourceQuota, error) {
+				return client.GetResourceQuotas(ctx, clusterName, namespace)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"resourceQuotas": allQuotas, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		quotas, err := h.k8sClient.GetResourceQuotas(ctx, cluster, namespace)
+		quotas, err := client.GetResourceQuotas(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -288,22 +393,27 @@ func (h *MCPHandlers) GetLimitRanges(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allRanges, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.LimitRange, error) {
-				return h.k8sClient.GetLimitRanges(ctx, clusterName, namespace)
+			allRanges, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.LimitRange, error) {
+				return client.GetLimitRanges(ctx, clusterName, namespace)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"limitRanges": allRanges, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		ranges, err := h.k8sClient.GetLimitRanges(ctx, cluster, namespace)
+		ranges, err := client.GetLimitRanges(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -354,12 +464,17 @@ func (h *MCPHandlers) CreateOrUpdateResourceQuota(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
 		// Auto-create namespace if requested (used by GPU reservation flow)
 		if req.EnsureNamespace {
-			if err := h.k8sClient.EnsureNamespaceExists(ctx, req.Cluster, req.Namespace); err != nil {
+			if err := client.EnsureNamespaceExists(ctx, req.Cluster, req.Namespace); err != nil {
 				slog.Error("[MCP] failed to create namespace", "error", err)
 				return c.Status(500).JSON(fiber.Map{"error": "internal server error"})
 			}
@@ -373,7 +488,7 @@ func (h *MCPHandlers) CreateOrUpdateResourceQuota(c *fiber.Ctx) error {
 			Annotations: req.Annotations,
 		}
 
-		quota, err := h.k8sClient.CreateOrUpdateResourceQuota(ctx, req.Cluster, spec)
+		quota, err := client.CreateOrUpdateResourceQuota(ctx, req.Cluster, spec)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -409,10 +524,15 @@ func (h *MCPHandlers) DeleteResourceQuota(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		err := h.k8sClient.DeleteResourceQuota(ctx, cluster, namespace, name)
+		err = client.DeleteResourceQuota(ctx, cluster, namespace, name)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -426,7 +546,12 @@ func (h *MCPHandlers) DeleteResourceQuota(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
-// GetPodLogs returns logs from a pod
+// GetPodLogs returns logs from a pod, at most ?maxBytes= bytes of them
+// (mcpDefaultLogMaxBytes by default). "truncated" is true when the log was cut.
+// With ?allContainers=true and no ?container=, every container's logs are
+// merged with "[container]" prefixes, tail and maxBytes applying to each
+// container; ?timestamps=true timestamps the lines so they interleave in
+// time order.
 func (h *MCPHandlers) GetPodLogs(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
 	if isDemoMode(c) {
@@ -438,6 +563,9 @@ func (h *MCPHandlers) GetPodLogs(c *fiber.Ctx) error {
 	pod := c.Query("pod")
 	container := c.Query("container")
 	tailLines := c.QueryInt("tail", 100)
+	maxBytes := c.QueryInt("maxBytes", mcpDefaultLogMaxBytes)
+	allContainers := c.QueryBool("allContainers")
+	timestamps := c.QueryBool("timestamps")
 
 	if cluster == "" || namespace == "" || pod == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cluster, namespace, and pod are required"})
@@ -454,12 +582,76 @@ func (h *MCPHandlers) GetPodLogs(c *fiber.Ctx) error {
 	if err := mcpValidatePositiveInt("tail", tailLines, mcpMaxTailLines); err != nil {
 		return err
 	}
+	if err := mcpValidatePositiveInt("maxBytes", maxBytes, mcpMaxLogMaxBytes); err != nil {
+		return err
+	}
+	if maxBytes == 0 {
+		maxBytes = mcpDefaultLogMaxBytes
+	}
+	if allContainers && container != "" {
+		return fiber.NewError(fiber.StatusBadRequest, "container and allContainers are mutually exclusive")
+	}
 
 	if h.k8sClient != nil {
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		logs, err := h.k8sClient.GetPodLogs(ctx, cluster, namespace, pod, container, int64(tailLines))
+		var logs string
+		var truncated bool
+		if allContainers {
+			logs, truncated, err = client.GetPodLogsAllContainers(ctx, cluster, namespace, pod, int64(tailLines), int64(maxBytes), timestamps)
+		} else {
+			logs, truncated, err = client.GetPodLogs(ctx, cluster, namespace, pod, container, int64(tailLines), int64(maxBytes))
+		}
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		return c.JSON(fiber.Map{"logs": logs, "truncated": truncated, "source": "k8s"})
+	}
+
+	return errNoClusterAccess(c)
+}
+
+// GetJobLogs returns logs from every pod of a Job, keyed by pod name
+func (h *MCPHandlers) GetJobLogs(c *fiber.Ctx) error {
+	// Demo mode: return demo data immediately
+	if isDemoMode(c) {
+		return demoResponse(c, "logs", getDemoJobLogs())
+	}
+
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+	job := c.Query("job")
+	tailLines := c.QueryInt("tail", 100)
+
+	if cluster == "" || namespace == "" || job == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cluster, namespace, and job are required"})
+	}
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+	if err := mcpValidateName("job", job); err != nil {
+		return err
+	}
+	if err := mcpValidatePositiveInt("tail", tailLines, mcpMaxTailLines); err != nil {
+		return err
+	}
+
+	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+		defer cancel()
+
+		logs, err := client.GetJobLogs(ctx, cluster, namespace, job, int64(tailLines))
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -606,7 +798,7 @@ func (h *MCPHandlers) CallOpsTool(c *fiber.Ctx) error {
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 	defer cancel()
 
 	result, err := h.bridge.CallOpsTool(ctx, req.Name, req.Arguments)
@@ -639,7 +831,7 @@ func (h *MCPHandlers) CallDeployTool(c *fiber.Ctx) error {
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 	defer cancel()
 
 	result, err := h.bridge.CallDeployTool(ctx, req.Name, req.Arguments)
@@ -665,24 +857,29 @@ func (h *MCPHandlers) GetFlatcarNodes(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// No cluster specified → query all healthy clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allNodes, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.FlatcarNodeInfo, error) {
-				return h.k8sClient.GetFlatcarNodes(ctx, clusterName)
+			allNodes, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.FlatcarNodeInfo, error) {
+				return client.GetFlatcarNodes(ctx, clusterName)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"nodes": allNodes, "source": "k8s"}))
 		}
 
 		// Single cluster query
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		nodes, err := h.k8sClient.GetFlatcarNodes(ctx, cluster)
+		nodes, err := client.GetFlatcarNodes(ctx, cluster)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -707,22 +904,27 @@ func (h *MCPHandlers) GetIngresses(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allItems, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.Ingress, error) {
-				return h.k8sClient.GetIngresses(ctx, clusterName, namespace)
+			allItems, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.Ingress, error) {
+				return client.GetIngresses(ctx, clusterName, namespace)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"ingresses": allItems, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		items, err := h.k8sClient.GetIngresses(ctx, cluster, namespace)
+		items, err := client.GetIngresses(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -750,22 +952,27 @@ func (h *MCPHandlers) GetNetworkPolicies(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allItems, errTracker := queryAllClusters(c.Context(), clusters, func(ctx context.Context, clusterName string) ([]k8s.NetworkPolicy, error) {
-				return h.k8sClient.GetNetworkPolicies(ctx, clusterName, namespace)
+			allItems, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(), func(ctx context.Context, clusterName string) ([]k8s.NetworkPolicy, error) {
+				return client.GetNetworkPolicies(ctx, clusterName, namespace)
 			})
 			return c.JSON(errTracker.annotate(fiber.Map{"networkpolicies": allItems, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		items, err := h.k8sClient.GetNetworkPolicies(ctx, cluster, namespace)
+		items, err := client.GetNetworkPolicies(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -840,7 +1047,12 @@ func (h *MCPHandlers) GetPodNetworkStats(c *fiber.Ctx) error {
 		return errNoClusterAccess(c)
 	}
 
-	clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	clusters, _, err := client.HealthyClusters(c.UserContext())
 	if err != nil {
 		slog.Error("[MCP] internal error listing healthy clusters for network stats", "error", err)
 		return c.Status(500).JSON(fiber.Map{"error": "internal server error"})
@@ -851,7 +1063,7 @@ func (h *MCPHandlers) GetPodNetworkStats(c *fiber.Ctx) error {
 	allStats := make([]PodNetworkStats, 0)
 	var errTracker clusterErrorTracker
 
-	clusterCtx, clusterCancel := context.WithCancel(c.Context())
+	clusterCtx, clusterCancel := context.WithCancel(c.UserContext())
 	defer clusterCancel()
 
 	for _, cl := range clusters {
@@ -862,15 +1074,15 @@ func (h *MCPHandlers) GetPodNetworkStats(c *fiber.Ctx) error {
 			ctx, cancel := context.WithTimeout(clusterCtx, podNetworkStatsTimeout)
 			defer cancel()
 
-			client, clientErr := h.k8sClient.GetClient(clusterName)
+			clientset, clientErr := client.GetClient(clusterName)
 			if clientErr != nil {
-				errTracker.add(clusterName, clientErr)
+				errTracker.add(ctx, clusterName, clientErr)
 				return
 			}
 
 			// Query pods matching each multi-tenancy label in all namespaces
 			for _, label := range multiTenancyLabels {
-				pods, listErr := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+				pods, listErr := clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
 					LabelSelector: fmt.Sprintf("app=%s", label),
 				})
 				if listErr != nil {
@@ -897,7 +1109,7 @@ func (h *MCPHandlers) GetPodNetworkStats(c *fiber.Ctx) error {
 						continue
 					}
 
-					ifaceStats := fetchPodInterfaceStats(ctx, client, nodeName, pod.Namespace, pod.Name)
+					ifaceStats := fetchPodInterfaceStats(ctx, clientset, nodeName, pod.Namespace, pod.Name)
 					if len(ifaceStats) == 0 {
 						continue
 					}
@@ -999,14 +1211,68 @@ func fetchPodInterfaceStats(
 	return nil
 }
 
-// GetResourceYAML returns the YAML representation of a Kubernetes resource.
-// This is a stub handler — full resource YAML retrieval requires dynamic client
-// support which will be added in a future iteration. For now, it returns an
-// empty yaml field so the frontend can gracefully fall back to demo YAML.
+// GetResourceYAML returns the YAML representation of a Kubernetes resource
+// with server-populated fields (managedFields, resourceVersion, status, ...)
+// stripped so it can be edited and re-applied through kc-agent.
+// GET /api/mcp/resource-yaml?cluster=&namespace=&name=&type=deployment
+// or &gvr=apps/v1/deployments for resources outside the built-in set.
+// In demo mode an empty yaml field lets the frontend fall back to demo YAML.
 func (h *MCPHandlers) GetResourceYAML(c *fiber.Ctx) error {
 	if isDemoMode(c) {
 		return c.JSON(fiber.Map{"yaml": "", "source": "demo"})
 	}
 
-	return c.JSON(fiber.Map{"yaml": "", "source": "stub"})
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+	name := c.Query("name")
+	if cluster == "" || name == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cluster and name are required"})
+	}
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+	if err := mcpValidateName("name", name); err != nil {
+		return err
+	}
+
+	var gvr schema.GroupVersionResource
+	if raw := c.Query("gvr"); raw != "" {
+		parsed, err := k8s.ParseGVR(raw)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		gvr = parsed
+	} else {
+		resolved, ok := k8s.LookupResourceType(c.Query("type"))
+		if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "unknown resource type — pass gvr=group/version/resource"})
+		}
+		gvr = resolved
+	}
+	// Secret payloads must not leak through a generic read endpoint, and the
+	// configured policy applies here as it does to GetCustomResources.
+	if !h.resourcePolicy.Allowed(gvr) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "resource is not available through this endpoint"})
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+	defer cancel()
+
+	out, err := client.GetResourceYAML(ctx, cluster, namespace, gvr, name)
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "resource not found"})
+		}
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"yaml": string(out), "source": "k8s"})
 }
@@ -2,14 +2,18 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/kubestellar/console/pkg/api/audit"
 	"github.com/kubestellar/console/pkg/models"
+	"github.com/kubestellar/console/pkg/store"
 	"github.com/kubestellar/console/pkg/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -99,6 +103,109 @@ func TestGetSecrets(t *testing.T) {
 	assert.Equal(t, "test-secret", secrets[0].(map[string]interface{})["name"])
 }
 
+// auditRecordingStore captures audit entries written via audit.Log.
+type auditRecordingStore struct {
+	*test.MockStore
+	mu      sync.Mutex
+	entries []store.AuditEntry
+}
+
+func (s *auditRecordingStore) InsertAuditEntry(_ context.Context, entry store.AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *auditRecordingStore) byAction(action string) []store.AuditEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []store.AuditEntry
+	for _, e := range s.entries {
+		if e.Action == action {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func TestGetSecretValue(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
+	env.App.Get("/api/mcp/secrets/value", audit.Wrap(audit.ActionRevealSecret, "secret", handler.GetSecretValue))
+
+	rec := &auditRecordingStore{MockStore: env.Store.(*test.MockStore)}
+	audit.SetStore(rec)
+	t.Cleanup(func() { audit.SetStore(nil) })
+
+	scheme := newK8sScheme()
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("s3cr3t"), "user": []byte("admin")},
+	}
+	injectDynamicClusterWithObjects(env, "test-cluster", scheme, []runtime.Object{secret}, secret)
+
+	t.Run("reveals one key and audits", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/mcp/secrets/value?cluster=test-cluster&namespace=default&name=db&key=password&reveal=true", nil)
+		require.NoError(t, err)
+		resp, err := env.App.Test(req, 5000)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, "no-store", resp.Header.Get("Cache-Control"))
+
+		var response map[string]interface{}
+		body, _ := io.ReadAll(resp.Body)
+		require.NoError(t, json.Unmarshal(body, &response))
+		assert.Equal(t, "s3cr3t", response["value"])
+		assert.NotContains(t, string(body), "admin", "only the requested key may be returned")
+
+		entries := rec.byAction(audit.ActionRevealSecret)
+		require.Len(t, entries, 1)
+		assert.Equal(t, testAdminUserID.String(), entries[0].UserID)
+		assert.Contains(t, entries[0].Detail, "test-cluster/default/db")
+		assert.Contains(t, entries[0].Detail, "key=password")
+		assert.Equal(t, "test-cluster", entries[0].Cluster)
+		assert.Equal(t, audit.OutcomeSuccess, entries[0].Outcome)
+	})
+
+	t.Run("requires reveal flag", func(t *testing.T) {
+		before := len(rec.byAction(audit.ActionRevealSecret))
+		req, err := http.NewRequest("GET", "/api/mcp/secrets/value?cluster=test-cluster&namespace=default&name=db&key=password", nil)
+		require.NoError(t, err)
+		resp, err := env.App.Test(req, 5000)
+		require.NoError(t, err)
+		assert.Equal(t, 400, resp.StatusCode)
+		assert.NotContains(t, readBody(t, resp), "s3cr3t", "rejected requests must not reveal anything")
+
+		entries := rec.byAction(audit.ActionRevealSecret)
+		require.Len(t, entries, before+1)
+		assert.Equal(t, audit.OutcomeFailure, entries[before].Outcome)
+	})
+
+	t.Run("missing key is 404 and audited as a failure", func(t *testing.T) {
+		before := len(rec.byAction(audit.ActionRevealSecret))
+		req, err := http.NewRequest("GET", "/api/mcp/secrets/value?cluster=test-cluster&namespace=default&name=db&key=token&reveal=true", nil)
+		require.NoError(t, err)
+		resp, err := env.App.Test(req, 5000)
+		require.NoError(t, err)
+		assert.Equal(t, 404, resp.StatusCode)
+
+		entries := rec.byAction(audit.ActionRevealSecret)
+		require.Len(t, entries, before+1)
+		assert.Equal(t, audit.OutcomeFailure, entries[before].Outcome)
+		assert.Contains(t, entries[before].Detail, "key=token")
+	})
+
+	t.Run("invalid key is 400", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/mcp/secrets/value?cluster=test-cluster&namespace=default&name=db&key=a%2Fb&reveal=true", nil)
+		require.NoError(t, err)
+		resp, err := env.App.Test(req, 5000)
+		require.NoError(t, err)
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+}
+
 func TestCreateOrUpdateResourceQuota(t *testing.T) {
 	env := setupTestEnv(t)
 	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
@@ -187,3 +294,54 @@ func TestCallDeployTool_RBAC(t *testing.T) {
 		assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
 	})
 }
+
+func TestGetResourceYAML(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
+	env.App.Get("/api/mcp/resource-yaml", handler.GetResourceYAML)
+
+	scheme := newK8sScheme()
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "app-config",
+			Namespace:       "default",
+			ResourceVersion: "7",
+		},
+		Data: map[string]string{"mode": "prod"},
+	}
+	injectDynamicClusterWithObjects(env, "test-cluster", scheme, []runtime.Object{cm})
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{"friendly type", "cluster=test-cluster&namespace=default&type=configmap&name=app-config", 200},
+		{"explicit gvr", "cluster=test-cluster&namespace=default&gvr=v1/configmaps&name=app-config", 200},
+		{"not found", "cluster=test-cluster&namespace=default&type=configmap&name=missing", 404},
+		{"unknown type", "cluster=test-cluster&namespace=default&type=widget&name=app-config", 400},
+		{"secrets refused", "cluster=test-cluster&namespace=default&type=secret&name=app-config", 403},
+		{"missing name", "cluster=test-cluster&type=configmap", 400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("GET", "/api/mcp/resource-yaml?"+tt.query, nil)
+			require.NoError(t, err)
+			resp, err := env.App.Test(req, 5000)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantStatus, resp.StatusCode)
+			if tt.wantStatus != 200 {
+				return
+			}
+
+			var response map[string]interface{}
+			body, _ := io.ReadAll(resp.Body)
+			require.NoError(t, json.Unmarshal(body, &response))
+			yamlText := response["yaml"].(string)
+			assert.Contains(t, yamlText, "mode: prod")
+			assert.NotContains(t, yamlText, "resourceVersion")
+			assert.Equal(t, "k8s", response["source"])
+		})
+	}
+}
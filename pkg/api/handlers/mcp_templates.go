@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+// ListResourceTemplates returns the built-in resource templates and their
+// parameters. Creating a resource from one is a user-initiated mutation, so
+// it runs through kc-agent at POST /templates/{name}/create under the user's
+// kubeconfig (#7993).
+func (h *MCPHandlers) ListResourceTemplates(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"templates": k8s.ListResourceTemplates()})
+}
@@ -10,11 +10,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubestellar/console/pkg/api/middleware"
+	"github.com/kubestellar/console/pkg/k8s"
 )
 
 func TestWaitWithDeadline_CompletesBeforeDeadline(t *testing.T) {
@@ -97,6 +106,32 @@ func TestMCPGetPods_DemoModeReturnsDemoData(t *testing.T) {
 	assert.NotEmpty(t, pods)
 }
 
+func TestMCPGetPods_DemoModeFiltersByScope(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, nil, nil)
+	env.App.Get("/api/mcp/pods", handler.GetPods)
+
+	req, err := http.NewRequest("GET", "/api/mcp/pods?cluster=rancher-mgmt&namespace=monitoring", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Demo-Mode", "true")
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "demo mode must not need cluster access")
+
+	var payload struct {
+		Pods   []k8s.PodInfo `json:"pods"`
+		Source string        `json:"source"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "demo", payload.Source)
+	require.NotEmpty(t, payload.Pods)
+	for _, p := range payload.Pods {
+		assert.Equal(t, "rancher-mgmt", p.Cluster)
+		assert.Equal(t, "monitoring", p.Namespace)
+	}
+}
+
 func TestMCPGetPods_NoClusterAccessReturns503(t *testing.T) {
 	env := setupTestEnv(t)
 	handler := NewMCPHandlers(nil, nil, nil)
@@ -135,6 +170,101 @@ func TestMCPGetPods_SingleClusterEmptyIsArray(t *testing.T) {
 	assert.Len(t, pods, 0)
 }
 
+func TestMCPGetPods_NamespaceScopedUser(t *testing.T) {
+	env := setupTestEnv(t)
+	pod := func(namespace, name string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+	env.K8sClient.InjectClient("test-cluster", k8sfake.NewSimpleClientset(
+		pod("team-a", "api"), pod("team-a", "worker"), pod("team-b", "billing"),
+	))
+	allowlist, err := middleware.ParseNamespaceAllowlist("viewer=team-a")
+	require.NoError(t, err)
+	env.App.Use("/api/mcp", middleware.NamespaceScope(allowlist, func(*fiber.Ctx) (string, error) {
+		return "viewer", nil
+	}))
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/mcp/pods", handler.GetPods)
+
+	req, err := http.NewRequest("GET", "/api/mcp/pods?cluster=test-cluster&allNamespaces=true", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var payload struct {
+		Pods   []k8s.PodInfo `json:"pods"`
+		Source string        `json:"source"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "k8s", payload.Source)
+	require.Len(t, payload.Pods, 2)
+	for _, p := range payload.Pods {
+		assert.Equal(t, "team-a", p.Namespace)
+	}
+
+	req, err = http.NewRequest("GET", "/api/mcp/pods?cluster=test-cluster&namespace=team-b", nil)
+	require.NoError(t, err)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	req, err = http.NewRequest("GET", "/api/mcp/pods?cluster=test-cluster&namespace=team-a", nil)
+	require.NoError(t, err)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestMCPGetPods_InvalidPhaseReturns400(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/mcp/pods", handler.GetPods)
+
+	req, err := http.NewRequest("GET", "/api/mcp/pods?cluster=test-cluster&phase=Crashing", nil)
+	require.NoError(t, err)
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestMCPGetTopPods_MetricsServerMissingReturns503(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/pods/top", handler.GetTopPods)
+
+	podMetricsGVR := schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{podMetricsGVR: "PodMetricsList"})
+	dyn.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Group: "metrics.k8s.io", Resource: "pods"}, "")
+	})
+	env.K8sClient.SetDynamicClient("test-cluster", dyn)
+
+	req, err := http.NewRequest("GET", "/api/pods/top?cluster=test-cluster&sortBy=memory&limit=10", nil)
+	require.NoError(t, err)
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "metrics_unavailable", payload["errorType"])
+}
+
+func TestMCPGetTopPods_InvalidSortByReturns400(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/pods/top", handler.GetTopPods)
+
+	req, err := http.NewRequest("GET", "/api/pods/top?cluster=test-cluster&sortBy=disk", nil)
+	require.NoError(t, err)
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
 func TestMCPGetPods_InternalErrorIsSanitized(t *testing.T) {
 	env := setupTestEnv(t)
 	handler := NewMCPHandlers(nil, env.K8sClient, nil)
@@ -323,3 +453,17 @@ func TestMCPGetPods_AuthErrorReturnsUnavailable(t *testing.T) {
 	assert.Equal(t, "unavailable", payload["clusterStatus"])
 	assert.Equal(t, "auth", payload["errorType"])
 }
+
+func TestMCPGetPods_ImpersonationWithoutLoginReturns401(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	handler.SetImpersonation(true)
+	env.App.Get("/api/mcp/pods", handler.GetPods)
+
+	req, err := http.NewRequest("GET", "/api/mcp/pods?cluster=test-cluster", nil)
+	require.NoError(t, err)
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
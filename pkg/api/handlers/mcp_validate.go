@@ -25,6 +25,13 @@ const mcpMaxEventLimit = 1000
 // mcpMaxTailLines is the maximum number of log tail lines a client may request.
 const mcpMaxTailLines = 10000
 
+// mcpDefaultLogMaxBytes is the pod log size returned when the client does not
+// pass maxBytes.
+const mcpDefaultLogMaxBytes = 1 << 20 // 1 MiB
+
+// mcpMaxLogMaxBytes is the maximum pod log size a client may request.
+const mcpMaxLogMaxBytes = 10 << 20 // 10 MiB
+
 // mcpAllowedWorkloadTypes enumerates the valid values for the "type" query parameter
 // on the /api/mcp/workloads endpoint.
 var mcpAllowedWorkloadTypes = map[string]bool{
@@ -32,6 +39,8 @@ var mcpAllowedWorkloadTypes = map[string]bool{
 	"Deployment":  true,
 	"StatefulSet": true,
 	"DaemonSet":   true,
+	"ReplicaSet":  true,
+	"Pod":         true,
 }
 
 // mcpValidateName checks that a non-empty string is a valid Kubernetes name.
@@ -85,11 +94,12 @@ func mcpValidatePositiveInt(param string, value, max int) error {
 }
 
 // mcpValidateWorkloadType checks that a workload type filter is one of the
-// recognised values ("Deployment", "StatefulSet", "DaemonSet", or empty).
+// recognised values ("Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Pod",
+// or empty).
 func mcpValidateWorkloadType(value string) error {
 	if !mcpAllowedWorkloadTypes[value] {
 		return fiber.NewError(fiber.StatusBadRequest,
-			"invalid type: must be one of Deployment, StatefulSet, DaemonSet")
+			"invalid type: must be one of Deployment, StatefulSet, DaemonSet, ReplicaSet, Pod")
 	}
 	return nil
 }
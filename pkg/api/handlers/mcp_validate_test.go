@@ -96,7 +96,7 @@ func TestMCPValidation_ValidWorkloadTypeAccepted(t *testing.T) {
 	handler := NewMCPHandlers(nil, env.K8sClient, nil)
 	env.App.Get("/api/mcp/workloads", handler.GetWorkloads)
 
-	for _, wt := range []string{"", "Deployment", "StatefulSet", "DaemonSet"} {
+	for _, wt := range []string{"", "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Pod"} {
 		url := "/api/mcp/workloads"
 		if wt != "" {
 			url += "?type=" + wt
@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"sync"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 
@@ -11,11 +13,75 @@ import (
 	"github.com/kubestellar/console/pkg/k8s"
 )
 
+// mcpDefaultTopPodsLimit and mcpMaxTopPodsLimit bound the limit query
+// parameter of GetTopPods.
+const (
+	mcpDefaultTopPodsLimit = 10
+	mcpMaxTopPodsLimit     = 500
+)
+
+// mcpDefaultRecentChangesWindow and mcpMaxRecentChangesWindow bound the since
+// query parameter of GetRecentChanges.
+const (
+	mcpDefaultRecentChangesWindow = time.Hour
+	mcpMaxRecentChangesWindow     = 7 * 24 * time.Hour
+)
+
+// GetTopPods returns the pods using the most CPU or memory on a cluster
+func (h *MCPHandlers) GetTopPods(c *fiber.Ctx) error {
+	// Demo mode: return demo data immediately
+	if isDemoMode(c) {
+		return demoResponse(c, "pods", getDemoTopPods())
+	}
+
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+	sortBy := c.Query("sortBy", k8s.TopPodsSortByCPU)
+	limit := c.QueryInt("limit", mcpDefaultTopPodsLimit)
+
+	if cluster == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cluster is required"})
+	}
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+	if sortBy != k8s.TopPodsSortByCPU && sortBy != k8s.TopPodsSortByMemory {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid sortBy: must be cpu or memory")
+	}
+	if err := mcpValidatePositiveInt("limit", limit, mcpMaxTopPodsLimit); err != nil {
+		return err
+	}
+
+	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+		defer cancel()
+
+		pods, err := client.GetTopPods(ctx, cluster, namespace, sortBy, limit)
+		if errors.Is(err, k8s.ErrMetricsUnavailable) {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"error":     "metrics-server is not available on this cluster",
+				"errorType": "metrics_unavailable",
+			})
+		}
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		return c.JSON(fiber.Map{"pods": pods, "sortBy": sortBy, "source": "k8s"})
+	}
+
+	return errNoClusterAccess(c)
+}
+
 // GetPods returns pods for a namespace/cluster
 func (h *MCPHandlers) GetPods(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
 	if isDemoMode(c) {
-		return demoResponse(c, "pods", getDemoPods())
+		return demoResponse(c, "pods", filterDemoScope(getDemoPods(), c.Query("cluster"), c.Query("namespace"), demoPodScope))
 	}
 
 	cluster := c.Query("cluster")
@@ -28,10 +94,19 @@ func (h *MCPHandlers) GetPods(c *fiber.Ctx) error {
 	if err := mcpValidateLabelSelector(labelSelector); err != nil {
 		return err
 	}
+	phase, err := k8s.NormalizePodPhaseFilter(c.Query("phase"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	namespace = h.resolveListNamespace(c, namespace)
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
-	// Try MCP bridge first for its richer functionality
-	if h.bridge != nil {
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+	// Try MCP bridge first for its richer functionality. The bridge has no
+	// phase filter, so filtered requests go straight to the k8s client.
+	if h.bridge != nil && phase == "" {
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
 		pods, err := h.bridge.GetPods(ctx, cluster, namespace, labelSelector)
@@ -43,24 +118,29 @@ func (h *MCPHandlers) GetPods(c *fiber.Ctx) error {
 
 	// Fall back to direct k8s client
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query all clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allPods, errTracker := queryAllClustersWithTimeout(c.Context(), clusters, mcpExtendedTimeout,
+			allPods, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.extendedFanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.PodInfo, error) {
-					return h.k8sClient.GetPods(ctx, clusterName, namespace)
+					return client.GetPodsByPhase(ctx, clusterName, namespace, phase)
 				})
 			return c.JSON(errTracker.annotate(fiber.Map{"pods": allPods, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		pods, err := h.k8sClient.GetPods(ctx, cluster, namespace)
+		pods, err := client.GetPodsByPhase(ctx, cluster, namespace, phase)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -89,7 +169,7 @@ func (h *MCPHandlers) FindPodIssues(c *fiber.Ctx) error {
 
 	// Try MCP bridge first
 	if h.bridge != nil {
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
 		issues, err := h.bridge.FindPodIssues(ctx, cluster, namespace)
@@ -101,24 +181,29 @@ func (h *MCPHandlers) FindPodIssues(c *fiber.Ctx) error {
 
 	// Fall back to direct k8s client
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query all clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allIssues, errTracker := queryAllClustersWithTimeout(c.Context(), clusters, mcpExtendedTimeout,
+			allIssues, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.extendedFanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.PodIssue, error) {
-					return h.k8sClient.FindPodIssues(ctx, clusterName, namespace)
+					return client.FindPodIssues(ctx, clusterName, namespace)
 				})
 			return c.JSON(errTracker.annotate(fiber.Map{"issues": allIssues, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		issues, err := h.k8sClient.FindPodIssues(ctx, cluster, namespace)
+		issues, err := client.FindPodIssues(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -131,6 +216,107 @@ func (h *MCPHandlers) FindPodIssues(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// GetImagePullIssues returns containers stuck on image pull failures with the
+// image and registry error message
+func (h *MCPHandlers) GetImagePullIssues(c *fiber.Ctx) error {
+	// Demo mode: return demo data immediately
+	if isDemoMode(c) {
+		return demoResponse(c, "issues", getDemoImagePullIssues())
+	}
+
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+
+	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		// If no cluster specified, query all clusters in parallel
+		if cluster == "" {
+			clusters, _, err := client.HealthyClusters(c.UserContext())
+			if err != nil {
+				return handleK8sError(c, err)
+			}
+
+			allIssues, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.extendedFanOutTimeout(),
+				func(ctx context.Context, clusterName string) ([]k8s.ImagePullIssue, error) {
+					return client.GetImagePullIssues(ctx, clusterName, namespace)
+				})
+			return c.JSON(errTracker.annotate(fiber.Map{"issues": allIssues, "source": "k8s"}))
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+		defer cancel()
+
+		issues, err := client.GetImagePullIssues(ctx, cluster, namespace)
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		if issues == nil {
+			issues = make([]k8s.ImagePullIssue, 0)
+		}
+		return c.JSON(fiber.Map{"issues": issues, "source": "k8s"})
+	}
+
+	return errNoClusterAccess(c)
+}
+
+// GetOOMKilledPods returns recent OOM kills with the container memory limit
+func (h *MCPHandlers) GetOOMKilledPods(c *fiber.Ctx) error {
+	// Demo mode: return demo data immediately
+	if isDemoMode(c) {
+		return demoResponse(c, "events", getDemoOOMEvents())
+	}
+
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+
+	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
+		// If no cluster specified, query all clusters in parallel
+		if cluster == "" {
+			clusters, _, err := client.HealthyClusters(c.UserContext())
+			if err != nil {
+				return handleK8sError(c, err)
+			}
+
+			allEvents, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.extendedFanOutTimeout(),
+				func(ctx context.Context, clusterName string) ([]k8s.OOMEvent, error) {
+					return client.GetOOMKilledPods(ctx, clusterName, namespace)
+				})
+			return c.JSON(errTracker.annotate(fiber.Map{"events": allEvents, "source": "k8s"}))
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+		defer cancel()
+
+		events, err := client.GetOOMKilledPods(ctx, cluster, namespace)
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		if events == nil {
+			events = make([]k8s.OOMEvent, 0)
+		}
+		return c.JSON(fiber.Map{"events": events, "source": "k8s"})
+	}
+
+	return errNoClusterAccess(c)
+}
+
 // FindDeploymentIssues returns deployments with issues
 func (h *MCPHandlers) FindDeploymentIssues(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
@@ -147,23 +333,28 @@ func (h *MCPHandlers) FindDeploymentIssues(c *fiber.Ctx) error {
 
 	// Fall back to direct k8s client
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query all clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allIssues, errTracker := queryAllClusters(c.Context(), clusters,
+			allIssues, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.DeploymentIssue, error) {
-					return h.k8sClient.FindDeploymentIssues(ctx, clusterName, namespace)
+					return client.FindDeploymentIssues(ctx, clusterName, namespace)
 				})
 			return c.JSON(errTracker.annotate(fiber.Map{"issues": allIssues, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
-		issues, err := h.k8sClient.FindDeploymentIssues(ctx, cluster, namespace)
+		issues, err := client.FindDeploymentIssues(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -180,7 +371,7 @@ func (h *MCPHandlers) FindDeploymentIssues(c *fiber.Ctx) error {
 func (h *MCPHandlers) GetDeployments(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
 	if isDemoMode(c) {
-		return demoResponse(c, "deployments", getDemoDeployments())
+		return demoResponse(c, "deployments", filterDemoScope(getDemoDeployments(), c.Query("cluster"), c.Query("namespace"), demoDeploymentScope))
 	}
 
 	cluster := c.Query("cluster")
@@ -189,25 +380,33 @@ func (h *MCPHandlers) GetDeployments(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		// If no cluster specified, query all clusters in parallel
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allDeployments, _ := queryAllClusters(c.Context(), clusters,
+			allDeployments, _ := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.Deployment, error) {
-					return h.k8sClient.GetDeployments(ctx, clusterName, namespace)
+					return client.GetDeployments(ctx, clusterName, namespace)
 				})
 			return c.JSON(fiber.Map{"deployments": allDeployments, "source": "k8s"})
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
-		deployments, err := h.k8sClient.GetDeployments(ctx, cluster, namespace)
+		deployments, err := client.GetDeployments(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -233,10 +432,19 @@ func (h *MCPHandlers) GetServices(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	namespace = h.resolveListNamespace(c, namespace)
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
@@ -255,9 +463,9 @@ func (h *MCPHandlers) GetServices(c *fiber.Ctx) error {
 			for _, cl := range clusters {
 				clusterCounts[cl.Name] = 0
 			}
-			clusterTimeout := mcpDefaultTimeout
+			clusterTimeout := h.fanOutTimeout()
 
-			clusterCtx, clusterCancel := context.WithCancel(c.Context())
+			clusterCtx, clusterCancel := context.WithCancel(c.UserContext())
 			defer clusterCancel()
 
 			for _, cl := range clusters {
@@ -267,7 +475,7 @@ func (h *MCPHandlers) GetServices(c *fiber.Ctx) error {
 					ctx, cancel := context.WithTimeout(clusterCtx, clusterTimeout)
 					defer cancel()
 
-					services, err := h.k8sClient.GetServices(ctx, clusterName, namespace)
+					services, err := client.GetServices(ctx, clusterName, namespace)
 					if err != nil {
 						slog.Warn("[GetServices] failed to fetch services for cluster", "cluster", clusterName, "error", err)
 						return
@@ -306,10 +514,10 @@ func (h *MCPHandlers) GetServices(c *fiber.Ctx) error {
 			})
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		services, err := h.k8sClient.GetServices(ctx, cluster, namespace)
+		services, err := client.GetServices(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -335,25 +543,33 @@ func (h *MCPHandlers) GetJobs(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allJobs, _ := queryAllClusters(c.Context(), clusters,
+			allJobs, _ := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.Job, error) {
-					return h.k8sClient.GetJobs(ctx, clusterName, namespace)
+					return client.GetJobs(ctx, clusterName, namespace)
 				})
 			return c.JSON(fiber.Map{"jobs": allJobs, "source": "k8s"})
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		jobs, err := h.k8sClient.GetJobs(ctx, cluster, namespace)
+		jobs, err := client.GetJobs(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -381,23 +597,28 @@ func (h *MCPHandlers) GetHPAs(c *fiber.Ctx) error {
 	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allHPAs, _ := queryAllClusters(c.Context(), clusters,
+			allHPAs, _ := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.HPA, error) {
-					return h.k8sClient.GetHPAs(ctx, clusterName, namespace)
+					return client.GetHPAs(ctx, clusterName, namespace)
 				})
 			return c.JSON(fiber.Map{"hpas": allHPAs, "source": "k8s"})
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		hpas, err := h.k8sClient.GetHPAs(ctx, cluster, namespace)
+		hpas, err := client.GetHPAs(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -410,6 +631,108 @@ func (h *MCPHandlers) GetHPAs(c *fiber.Ctx) error {
 	return errNoClusterAccess(c)
 }
 
+// GetHPAEvents returns the scaling history of one HPA: its events, newest
+// first, with the replica change of each rescale.
+// GET /api/mcp/hpas/events?cluster=&namespace=&name=
+func (h *MCPHandlers) GetHPAEvents(c *fiber.Ctx) error {
+	if isDemoMode(c) {
+		return demoResponse(c, "events", []k8s.HPAEvent{})
+	}
+
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+	name := c.Query("name")
+	if cluster == "" || namespace == "" || name == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "cluster, namespace and name are required")
+	}
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+	if err := mcpValidateName("name", name); err != nil {
+		return err
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+	defer cancel()
+
+	events, err := client.GetHPAEvents(ctx, cluster, namespace, name)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"events": events, "source": "k8s"})
+}
+
+// GetRecentChanges returns the core resources created or modified within
+// ?since= (a duration, default 1h), on ?cluster= or on every healthy cluster.
+// Kinds a cluster forbids listing are reported in skippedKinds.
+func (h *MCPHandlers) GetRecentChanges(c *fiber.Ctx) error {
+	if isDemoMode(c) {
+		return demoResponse(c, "changes", []k8s.ChangeEntry{})
+	}
+
+	cluster := c.Query("cluster")
+	namespace := c.Query("namespace")
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+	since := mcpDefaultRecentChangesWindow
+	if raw := c.Query("since"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 || d > mcpMaxRecentChangesWindow {
+			return fiber.NewError(fiber.StatusBadRequest, "since must be a positive duration of at most 168h")
+		}
+		since = d
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
+	if cluster == "" {
+		clusters, _, err := client.HealthyClusters(c.UserContext())
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		var mu sync.Mutex
+		skipped := make([]k8s.SkippedKind, 0)
+		changes, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.extendedFanOutTimeout(),
+			func(ctx context.Context, clusterName string) ([]k8s.ChangeEntry, error) {
+				changes, clusterSkipped, err := client.GetRecentChanges(ctx, clusterName, namespace, since)
+				mu.Lock()
+				skipped = append(skipped, clusterSkipped...)
+				mu.Unlock()
+				return changes, err
+			})
+		mu.Lock()
+		defer mu.Unlock()
+		return c.JSON(errTracker.annotate(fiber.Map{"changes": changes, "skippedKinds": skipped, "source": "k8s"}))
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
+	defer cancel()
+
+	changes, skipped, err := client.GetRecentChanges(ctx, cluster, namespace, since)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	if skipped == nil {
+		skipped = make([]k8s.SkippedKind, 0)
+	}
+	return c.JSON(fiber.Map{"changes": changes, "skippedKinds": skipped, "source": "k8s"})
+}
+
 // GetReplicaSets returns ReplicaSets from clusters
 func (h *MCPHandlers) GetReplicaSets(c *fiber.Ctx) error {
 	// Demo mode: return demo data immediately
@@ -423,25 +746,33 @@ func (h *MCPHandlers) GetReplicaSets(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allItems, _ := queryAllClusters(c.Context(), clusters,
+			allItems, errTracker := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.ReplicaSet, error) {
-					return h.k8sClient.GetReplicaSets(ctx, clusterName, namespace)
+					return client.GetReplicaSets(ctx, clusterName, namespace)
 				})
-			return c.JSON(fiber.Map{"replicasets": allItems, "source": "k8s"})
+			return c.JSON(errTracker.annotate(fiber.Map{"replicasets": allItems, "source": "k8s"}))
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		items, err := h.k8sClient.GetReplicaSets(ctx, cluster, namespace)
+		items, err := client.GetReplicaSets(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -467,25 +798,33 @@ func (h *MCPHandlers) GetStatefulSets(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allItems, _ := queryAllClusters(c.Context(), clusters,
+			allItems, _ := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.StatefulSet, error) {
-					return h.k8sClient.GetStatefulSets(ctx, clusterName, namespace)
+					return client.GetStatefulSets(ctx, clusterName, namespace)
 				})
 			return c.JSON(fiber.Map{"statefulsets": allItems, "source": "k8s"})
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		items, err := h.k8sClient.GetStatefulSets(ctx, cluster, namespace)
+		items, err := client.GetStatefulSets(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -511,25 +850,33 @@ func (h *MCPHandlers) GetDaemonSets(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allItems, _ := queryAllClusters(c.Context(), clusters,
+			allItems, _ := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.DaemonSet, error) {
-					return h.k8sClient.GetDaemonSets(ctx, clusterName, namespace)
+					return client.GetDaemonSets(ctx, clusterName, namespace)
 				})
 			return c.JSON(fiber.Map{"daemonsets": allItems, "source": "k8s"})
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		items, err := h.k8sClient.GetDaemonSets(ctx, cluster, namespace)
+		items, err := client.GetDaemonSets(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -555,25 +902,33 @@ func (h *MCPHandlers) GetCronJobs(c *fiber.Ctx) error {
 	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
 		return err
 	}
+	if err := h.checkAllNamespacePodLimit(c, cluster, namespace); err != nil {
+		return err
+	}
 
 	if h.k8sClient != nil {
+		client, err := h.clusterClient(c)
+		if err != nil {
+			return err
+		}
+
 		if cluster == "" {
-			clusters, _, err := h.k8sClient.HealthyClusters(c.Context())
+			clusters, _, err := client.HealthyClusters(c.UserContext())
 			if err != nil {
 				return handleK8sError(c, err)
 			}
 
-			allItems, _ := queryAllClusters(c.Context(), clusters,
+			allItems, _ := queryAllClustersWithTimeout(c.UserContext(), clusters, h.fanOutTimeout(),
 				func(ctx context.Context, clusterName string) ([]k8s.CronJob, error) {
-					return h.k8sClient.GetCronJobs(ctx, clusterName, namespace)
+					return client.GetCronJobs(ctx, clusterName, namespace)
 				})
 			return c.JSON(fiber.Map{"cronjobs": allItems, "source": "k8s"})
 		}
 
-		ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+		ctx, cancel := context.WithTimeout(c.UserContext(), mcpDefaultTimeout)
 		defer cancel()
 
-		items, err := h.k8sClient.GetCronJobs(ctx, cluster, namespace)
+		items, err := client.GetCronJobs(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -598,6 +953,11 @@ func (h *MCPHandlers) GetWorkloads(c *fiber.Ctx) error {
 		return errNoClusterAccess(c)
 	}
 
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
+
 	cluster := c.Query("cluster")
 	namespace := c.Query("namespace")
 	workloadType := c.Query("type")
@@ -609,10 +969,10 @@ func (h *MCPHandlers) GetWorkloads(c *fiber.Ctx) error {
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(c.Context(), maxResponseDeadline)
+	ctx, cancel := context.WithTimeout(c.UserContext(), maxResponseDeadline)
 	defer cancel()
 
-	list, err := h.k8sClient.ListWorkloads(ctx, cluster, namespace, workloadType)
+	list, err := client.ListWorkloads(ctx, cluster, namespace, workloadType)
 	if err != nil {
 		slog.Error("[MCP] internal error listing workloads", "error", err)
 		return c.Status(500).JSON(fiber.Map{"error": "internal server error"})
@@ -8,7 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -48,6 +50,79 @@ func TestGetPods(t *testing.T) {
 	assert.Equal(t, "test-pod", pods[0].(map[string]interface{})["name"])
 }
 
+func TestGetPods_AllNamespaceGuardrail(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
+	handler.SetNamespaceGuardrail("", 2)
+	env.App.Get("/api/mcp/workloads/pods", handler.GetPods)
+
+	var pods []runtime.Object
+	for _, p := range []struct{ name, ns string }{{"a", "default"}, {"b", "default"}, {"c", "kube-system"}} {
+		pods = append(pods, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: p.name, Namespace: p.ns}})
+	}
+	injectDynamicClusterWithObjects(env, "test-cluster", newK8sScheme(), nil, pods...)
+
+	// 3 pods > limit of 2: the all-namespaces list is rejected.
+	req, err := http.NewRequest("GET", "/api/mcp/workloads/pods?cluster=test-cluster", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "specify a namespace")
+
+	// Naming a namespace bypasses the guardrail.
+	req, err = http.NewRequest("GET", "/api/mcp/workloads/pods?cluster=test-cluster&namespace=default", nil)
+	require.NoError(t, err)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// A default namespace also bypasses it unless allNamespaces is requested.
+	handler.SetNamespaceGuardrail("kube-system", 2)
+	req, err = http.NewRequest("GET", "/api/mcp/workloads/pods?cluster=test-cluster", nil)
+	require.NoError(t, err)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var response map[string]interface{}
+	body, _ = io.ReadAll(resp.Body)
+	require.NoError(t, json.Unmarshal(body, &response))
+	assert.Len(t, response["pods"], 1)
+
+	req, err = http.NewRequest("GET", "/api/mcp/workloads/pods?cluster=test-cluster&allNamespaces=true", nil)
+	require.NoError(t, err)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestGetDeployments_AllNamespaceGuardrail(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
+	handler.SetNamespaceGuardrail("", 2)
+	env.App.Get("/api/mcp/deployments", handler.GetDeployments)
+
+	var pods []runtime.Object
+	for _, name := range []string{"a", "b", "c"} {
+		pods = append(pods, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}})
+	}
+	injectDynamicClusterWithObjects(env, "test-cluster", newK8sScheme(), nil, pods...)
+
+	// The pod count gates every all-namespaces list, not only pod lists.
+	req, err := http.NewRequest("GET", "/api/mcp/deployments?cluster=test-cluster", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	req, err = http.NewRequest("GET", "/api/mcp/deployments?cluster=test-cluster&namespace=default", nil)
+	require.NoError(t, err)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestFindPodIssues(t *testing.T) {
 	env := setupTestEnv(t)
 	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
@@ -94,3 +169,148 @@ func TestFindPodIssues(t *testing.T) {
 	assert.NotEmpty(t, issues)
 	assert.Equal(t, "failing-pod", issues[0].(map[string]interface{})["name"])
 }
+
+func TestGetImagePullIssues(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
+	env.App.Get("/api/mcp/image-pull-issues", handler.GetImagePullIssues)
+
+	scheme := newK8sScheme()
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pull-fail",
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "c1", Image: "private.example.com/app:v1"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "c1",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason:  "ErrImagePull",
+							Message: "unauthorized: authentication required",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	injectDynamicClusterWithObjects(env, "test-cluster", scheme, []runtime.Object{pod}, pod)
+
+	req, err := http.NewRequest("GET", "/api/mcp/image-pull-issues?cluster=test-cluster&namespace=default", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var response map[string]interface{}
+	body, _ := io.ReadAll(resp.Body)
+	err = json.Unmarshal(body, &response)
+	require.NoError(t, err)
+
+	issues := response["issues"].([]interface{})
+	require.Len(t, issues, 1)
+	issue := issues[0].(map[string]interface{})
+	assert.Equal(t, "private.example.com/app:v1", issue["image"])
+	assert.Equal(t, "unauthorized: authentication required", issue["message"])
+}
+
+func TestGetOOMKilledPods(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
+	env.App.Get("/api/mcp/oom-events", handler.GetOOMKilledPods)
+
+	scheme := newK8sScheme()
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "oom-pod",
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "c1",
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "c1",
+				RestartCount: 3,
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+				},
+			}},
+		},
+	}
+
+	injectDynamicClusterWithObjects(env, "test-cluster", scheme, []runtime.Object{pod}, pod)
+
+	req, err := http.NewRequest("GET", "/api/mcp/oom-events?cluster=test-cluster&namespace=default", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var response map[string]interface{}
+	body, _ := io.ReadAll(resp.Body)
+	err = json.Unmarshal(body, &response)
+	require.NoError(t, err)
+
+	events := response["events"].([]interface{})
+	require.Len(t, events, 1)
+	event := events[0].(map[string]interface{})
+	assert.Equal(t, "oom-pod", event["pod"])
+	assert.Equal(t, "128Mi", event["memoryLimit"])
+}
+
+func TestGetReplicaSets_FanOutOwnerDeployment(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, env.Store)
+	env.App.Get("/api/mcp/replicasets", handler.GetReplicaSets)
+
+	scheme := newK8sScheme()
+	isController := true
+	rs := &appsv1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{Kind: "ReplicaSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-5d8f7c9b6",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "web",
+				Controller: &isController,
+			}},
+		},
+	}
+
+	injectDynamicClusterWithObjects(env, "test-cluster", scheme, []runtime.Object{rs}, rs)
+
+	// No cluster parameter: fans out across all healthy clusters.
+	req, err := http.NewRequest("GET", "/api/mcp/replicasets?namespace=default", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var response map[string]interface{}
+	body, _ := io.ReadAll(resp.Body)
+	err = json.Unmarshal(body, &response)
+	require.NoError(t, err)
+
+	items := response["replicasets"].([]interface{})
+	require.Len(t, items, 1)
+	item := items[0].(map[string]interface{})
+	assert.Equal(t, "web", item["ownerDeployment"])
+	assert.Equal(t, "test-cluster", item["cluster"])
+}
@@ -15,6 +15,7 @@ const mcsDefaultTimeout = 15 * time.Second
 type MCSHandlers struct {
 	k8sClient *k8s.MultiClusterClient
 	hub       *Hub
+	clusterAccess
 }
 
 // NewMCSHandlers creates a new MCS handlers instance
@@ -31,6 +32,10 @@ func (h *MCSHandlers) ListServiceExports(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	// Optional filters
 	cluster := c.Query("cluster")
@@ -41,7 +46,7 @@ func (h *MCSHandlers) ListServiceExports(c *fiber.Ctx) error {
 
 	if cluster != "" {
 		// Get exports for specific cluster
-		exports, err := h.k8sClient.ListServiceExportsForCluster(ctx, cluster, namespace)
+		exports, err := client.ListServiceExportsForCluster(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -53,7 +58,7 @@ func (h *MCSHandlers) ListServiceExports(c *fiber.Ctx) error {
 	}
 
 	// Get exports across all clusters
-	list, err := h.k8sClient.ListServiceExports(ctx)
+	list, err := client.ListServiceExports(ctx)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -67,6 +72,10 @@ func (h *MCSHandlers) ListServiceImports(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	// Optional filters
 	cluster := c.Query("cluster")
@@ -77,7 +86,7 @@ func (h *MCSHandlers) ListServiceImports(c *fiber.Ctx) error {
 
 	if cluster != "" {
 		// Get imports for specific cluster
-		imports, err := h.k8sClient.ListServiceImportsForCluster(ctx, cluster, namespace)
+		imports, err := client.ListServiceImportsForCluster(ctx, cluster, namespace)
 		if err != nil {
 			return handleK8sError(c, err)
 		}
@@ -89,7 +98,7 @@ func (h *MCSHandlers) ListServiceImports(c *fiber.Ctx) error {
 	}
 
 	// Get imports across all clusters
-	list, err := h.k8sClient.ListServiceImports(ctx)
+	list, err := client.ListServiceImports(ctx)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -97,17 +106,42 @@ func (h *MCSHandlers) ListServiceImports(c *fiber.Ctx) error {
 	return c.JSON(list)
 }
 
+// GetMCSTopology returns the clusterset-wide export/import graph
+// GET /api/mcs/topology
+func (h *MCSHandlers) GetMCSTopology(c *fiber.Ctx) error {
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), mcsDefaultTimeout)
+	defer cancel()
+
+	topology, err := client.GetMCSTopology(ctx)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	return c.JSON(topology)
+}
+
 // GetMCSStatus returns the MCS availability status for all clusters
 // GET /api/mcs/status
 func (h *MCSHandlers) GetMCSStatus(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), mcsDefaultTimeout)
 	defer cancel()
 
-	clusters, _, err := h.k8sClient.HealthyClusters(ctx)
+	clusters, _, err := client.HealthyClusters(ctx)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -119,7 +153,7 @@ func (h *MCSHandlers) GetMCSStatus(c *fiber.Ctx) error {
 
 	status := make([]clusterMCSStatus, 0, len(clusters))
 	for _, cluster := range clusters {
-		available := h.k8sClient.IsMCSAvailable(ctx, cluster.Name)
+		available := client.IsMCSAvailable(ctx, cluster.Name)
 		status = append(status, clusterMCSStatus{
 			Cluster:      cluster.Name,
 			MCSAvailable: available,
@@ -137,6 +171,10 @@ func (h *MCSHandlers) GetServiceExport(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -145,7 +183,7 @@ func (h *MCSHandlers) GetServiceExport(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), mcsDefaultTimeout)
 	defer cancel()
 
-	exports, err := h.k8sClient.ListServiceExportsForCluster(ctx, cluster, namespace)
+	exports, err := client.ListServiceExportsForCluster(ctx, cluster, namespace)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -165,6 +203,10 @@ func (h *MCSHandlers) GetServiceImport(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -173,7 +215,7 @@ func (h *MCSHandlers) GetServiceImport(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), mcsDefaultTimeout)
 	defer cancel()
 
-	imports, err := h.k8sClient.ListServiceImportsForCluster(ctx, cluster, namespace)
+	imports, err := client.ListServiceImportsForCluster(ctx, cluster, namespace)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -187,6 +229,42 @@ func (h *MCSHandlers) GetServiceImport(c *fiber.Ctx) error {
 	return c.Status(404).JSON(fiber.Map{"error": "ServiceImport not found"})
 }
 
+// GetServiceImportEndpoints returns mirrored endpoint readiness for a ServiceImport
+// GET /api/mcs/imports/:cluster/:namespace/:name/endpoints
+func (h *MCSHandlers) GetServiceImportEndpoints(c *fiber.Ctx) error {
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
+
+	cluster := c.Params("cluster")
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+	// The name becomes a label selector value; reject anything that is not
+	// a Service name before it gets there.
+	if err := validateDNSLabel("namespace", namespace); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err := validateDNSLabel("name", name); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), mcsDefaultTimeout)
+	defer cancel()
+
+	endpoints, err := client.GetServiceImportEndpoints(ctx, cluster, namespace, name)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	if endpoints.MCSAvailable && !endpoints.ImportFound {
+		return c.Status(404).JSON(fiber.Map{"error": "ServiceImport not found"})
+	}
+	return c.JSON(endpoints)
+}
+
 // CreateServiceExport and DeleteServiceExport were removed in #7993 Phase 1.5
 // PR B. These handlers ran via the backend pod ServiceAccount, violating the
 // architectural rule that user-initiated k8s mutations must run under the
@@ -172,6 +172,95 @@ func TestListServiceImports(t *testing.T) {
 	assert.NotEmpty(t, list.Items)
 }
 
+func TestGetServiceImportEndpoints(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCSHandlers(env.K8sClient, env.Hub)
+	env.App.Get("/api/mcs/imports/:cluster/:namespace/:name/endpoints", handler.GetServiceImportEndpoints)
+
+	imp := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "multicluster.x-k8s.io/v1alpha1",
+			"kind":       "ServiceImport",
+			"metadata": map[string]interface{}{
+				"name":      "remote-svc",
+				"namespace": "default",
+			},
+			"status": map[string]interface{}{
+				"clusters": []interface{}{map[string]interface{}{"cluster": "east"}},
+			},
+		},
+	}
+	dynClient := injectDynamicCluster(env, "test-cluster", serviceImportGVRs())
+	dynClient.PrependReactor("get", "serviceimports", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.GetAction).GetName() != "remote-svc" {
+			return false, nil, nil
+		}
+		return true, imp, nil
+	})
+
+	req, _ := http.NewRequest("GET", "/api/mcs/imports/test-cluster/default/remote-svc/endpoints", nil)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var got v1alpha1.ServiceImportEndpoints
+	body, _ := io.ReadAll(resp.Body)
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.True(t, got.ImportFound)
+	assert.False(t, got.Reachable, "no EndpointSlices have been mirrored")
+	require.Len(t, got.Clusters, 1)
+	assert.Equal(t, "east", got.Clusters[0].SourceCluster)
+
+	// Unknown import -> 404
+	req, _ = http.NewRequest("GET", "/api/mcs/imports/test-cluster/default/missing/endpoints", nil)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 404, resp.StatusCode)
+
+	// A name that would widen the EndpointSlice selector -> 400
+	req, _ = http.NewRequest("GET", "/api/mcs/imports/test-cluster/default/remote-svc,app%3Dweb/endpoints", nil)
+	resp, err = env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 400, resp.StatusCode)
+}
+
+func TestGetMCSTopology(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCSHandlers(env.K8sClient, env.Hub)
+	env.App.Get("/api/mcs/topology", handler.GetMCSTopology)
+
+	export := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "multicluster.x-k8s.io/v1alpha1",
+			"kind":       "ServiceExport",
+			"metadata":   map[string]interface{}{"name": "cart", "namespace": "shop"},
+		},
+	}
+	gvrs := serviceExportGVRs()
+	for gvr, kind := range serviceImportGVRs() {
+		gvrs[gvr] = kind
+	}
+	dynClient := injectDynamicCluster(env, "test-cluster", gvrs)
+	dynClient.PrependReactor("list", "serviceexports", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &unstructured.UnstructuredList{
+			Object: map[string]interface{}{"kind": "ServiceExportList", "apiVersion": "multicluster.x-k8s.io/v1alpha1"},
+			Items:  []unstructured.Unstructured{*export},
+		}, nil
+	})
+
+	req, _ := http.NewRequest("GET", "/api/mcs/topology", nil)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var topo v1alpha1.MCSTopology
+	body, _ := io.ReadAll(resp.Body)
+	require.NoError(t, json.Unmarshal(body, &topo))
+	require.Len(t, topo.Services, 1)
+	assert.Equal(t, []string{"test-cluster"}, topo.Services[0].ExportedFrom)
+	assert.Empty(t, topo.Services[0].ImportedInto)
+}
+
 // TestCreateServiceExport and TestDeleteServiceExport were removed in #7993
 // Phase 1.5 PR B. Those backend handlers were deleted (no frontend consumer)
 // and the user-initiated mutations now run via kc-agent /serviceexports. The
@@ -25,6 +25,10 @@ const nsDefaultTimeout = 15 * time.Second
 type NamespaceHandler struct {
 	store     store.Store
 	k8sClient *k8s.MultiClusterClient
+	// clusterTimeout is the configured per-cluster deadline of the
+	// all-clusters listing. Zero uses nsDefaultTimeout.
+	clusterTimeout time.Duration
+	clusterAccess
 }
 
 // NewNamespaceHandler creates a new namespace handler
@@ -32,6 +36,12 @@ func NewNamespaceHandler(s store.Store, k8sClient *k8s.MultiClusterClient) *Name
 	return &NamespaceHandler{store: s, k8sClient: k8sClient}
 }
 
+// SetClusterTimeout sets the per-cluster deadline used when listing
+// namespaces across every cluster. Zero keeps nsDefaultTimeout.
+func (h *NamespaceHandler) SetClusterTimeout(timeout time.Duration) {
+	h.clusterTimeout = timeout
+}
+
 // ListNamespaces returns namespaces for a cluster
 func (h *NamespaceHandler) ListNamespaces(c *fiber.Ctx) error {
 	// SECURITY (#7485): namespace listing exposes cluster structure; require a
@@ -43,6 +53,10 @@ func (h *NamespaceHandler) ListNamespaces(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Query("cluster")
 	if cluster == "" {
@@ -52,7 +66,7 @@ func (h *NamespaceHandler) ListNamespaces(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), nsDefaultTimeout)
 	defer cancel()
 
-	namespaces, err := h.k8sClient.ListNamespacesWithDetails(ctx, cluster)
+	namespaces, err := client.ListNamespacesWithDetails(ctx, cluster)
 	if err != nil {
 		slog.Error("[Namespaces] failed to list namespaces", "error", err)
 		return fiber.NewError(fiber.StatusInternalServerError, "internal server error")
@@ -61,6 +75,56 @@ func (h *NamespaceHandler) ListNamespaces(c *fiber.Ctx) error {
 	return c.JSON(namespaces)
 }
 
+// GetNamespaces returns namespaces with status phase and age for one cluster,
+// or for every healthy cluster when no cluster is given. Pass withCounts=true
+// to also populate per-namespace pod counts (one extra list call per
+// namespace, so the UI only asks for it on detail views).
+func (h *NamespaceHandler) GetNamespaces(c *fiber.Ctx) error {
+	if err := requireViewerOrAbove(c, h.store); err != nil {
+		return err
+	}
+
+	if isDemoMode(c) {
+		return demoResponse(c, "namespaces", getDemoNamespaces(c.Query("cluster")))
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
+
+	cluster := c.Query("cluster")
+	if err := mcpValidateName("cluster", cluster); err != nil {
+		return err
+	}
+	withCounts := c.Query("withCounts") == "true"
+
+	if cluster == "" {
+		clusters, _, err := client.HealthyClusters(c.Context())
+		if err != nil {
+			return handleK8sError(c, err)
+		}
+		namespaces, errTracker := queryAllClustersWithTimeout(c.Context(), clusters,
+			resolveClusterTimeout(h.clusterTimeout, nsDefaultTimeout),
+			func(ctx context.Context, clusterName string) ([]k8s.Namespace, error) {
+				return client.GetNamespaces(ctx, clusterName, withCounts)
+			})
+		return c.JSON(errTracker.annotate(fiber.Map{"namespaces": namespaces, "source": "k8s"}))
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), nsDefaultTimeout)
+	defer cancel()
+
+	namespaces, err := client.GetNamespaces(ctx, cluster, withCounts)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"namespaces": namespaces, "source": "k8s"})
+}
+
 // GetNamespaceAccess returns role bindings for a namespace.
 // SECURITY: Restricted to admin users to prevent non-admin users from
 // enumerating namespace access and binding subjects (#5466).
@@ -81,6 +145,10 @@ func (h *NamespaceHandler) GetNamespaceAccess(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Query("cluster")
 	name := c.Params("name")
@@ -91,7 +159,7 @@ func (h *NamespaceHandler) GetNamespaceAccess(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), nsDefaultTimeout)
 	defer cancel()
 
-	bindings, err := h.k8sClient.ListRoleBindings(ctx, cluster, name)
+	bindings, err := client.ListRoleBindings(ctx, cluster, name)
 	if err != nil {
 		slog.Error("[Namespaces] failed to list role bindings", "error", err)
 		return fiber.NewError(fiber.StatusInternalServerError, "internal server error")
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"github.com/kubestellar/console/pkg/k8s"
 	"github.com/kubestellar/console/pkg/models"
 	"github.com/kubestellar/console/pkg/test"
 	"github.com/stretchr/testify/assert"
@@ -125,3 +126,39 @@ func TestNamespaceHandlers(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+func TestNamespaceHandlers_GetNamespaces(t *testing.T) {
+	env := setupTestEnv(t)
+	h := NewNamespaceHandler(env.Store, env.K8sClient)
+	env.App.Get("/api/namespaces/summary", h.GetNamespaces)
+
+	fakeClient, err := env.K8sClient.GetClient("test-cluster")
+	require.NoError(t, err)
+	_, _ = fakeClient.CoreV1().Namespaces().Create(t.Context(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "active-ns"},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+	}, metav1.CreateOptions{})
+	_, _ = fakeClient.CoreV1().Namespaces().Create(t.Context(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "dying-ns"},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+	}, metav1.CreateOptions{})
+	_, _ = fakeClient.CoreV1().Pods("active-ns").Create(t.Context(), &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "active-ns"},
+	}, metav1.CreateOptions{})
+
+	req := httptest.NewRequest("GET", "/api/namespaces/summary?cluster=test-cluster&withCounts=true", nil)
+	resp, err := env.App.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result struct {
+		Namespaces []k8s.Namespace `json:"namespaces"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	require.Len(t, result.Namespaces, 2)
+	assert.Equal(t, "active-ns", result.Namespaces[0].Name)
+	assert.Equal(t, "Active", result.Namespaces[0].Status)
+	require.NotNil(t, result.Namespaces[0].PodCount)
+	assert.Equal(t, 1, *result.Namespaces[0].PodCount)
+	assert.Equal(t, "Terminating", result.Namespaces[1].Status)
+}
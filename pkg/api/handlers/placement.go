@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+// placementTimeout bounds the fleet-wide scan behind a placement
+// recommendation. Offline clusters are skipped via HealthyClusters, so this
+// only has to cover slow-but-reachable clusters.
+const placementTimeout = 30 * time.Second
+
+// maxPlacementGPUCount rejects nonsensical GPU requests before the fleet is
+// scanned. No single node in practice exposes more than this many devices.
+const maxPlacementGPUCount = 64
+
+// PlacementHandlers serves GPU workload placement recommendations.
+type PlacementHandlers struct {
+	k8sClient *k8s.MultiClusterClient
+	clusterAccess
+}
+
+// NewPlacementHandlers creates a new placement handlers instance
+func NewPlacementHandlers(k8sClient *k8s.MultiClusterClient) *PlacementHandlers {
+	return &PlacementHandlers{k8sClient: k8sClient}
+}
+
+// RecommendPlacement ranks clusters/nodes that can fit a GPU job.
+// POST /api/placement/recommend
+func (h *PlacementHandlers) RecommendPlacement(c *fiber.Ctx) error {
+	var req k8s.ResourceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.GPUCount <= 0 || req.GPUCount > maxPlacementGPUCount {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "gpuCount must be between 1 and 64"})
+	}
+	if req.CPUMillicores < 0 || req.MemoryBytes < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cpuMillicores and memoryBytes must not be negative"})
+	}
+	for _, cluster := range req.Clusters {
+		if err := mcpValidateName("cluster", cluster); err != nil {
+			return err
+		}
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), placementTimeout)
+	defer cancel()
+
+	rec, err := client.RecommendPlacement(ctx, req)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"recommendation": rec, "source": "k8s"})
+}
+
+// maxProjectFitReplicas rejects replica counts no real workload uses before
+// the cluster is scanned.
+const maxProjectFitReplicas = 10000
+
+// projectFitRequest is the body of POST /api/clusters/:cluster/project-fit.
+type projectFitRequest struct {
+	PodRequests corev1.ResourceList `json:"podRequests"`
+	Replicas    int                 `json:"replicas"`
+	k8s.FitConstraints
+}
+
+// ProjectFit projects how many replicas of a pod fit on one cluster.
+// POST /api/clusters/:cluster/project-fit
+func (h *PlacementHandlers) ProjectFit(c *fiber.Ctx) error {
+	cluster := c.Params("cluster")
+	if err := mcpValidateName("cluster", cluster); err != nil {
+		return err
+	}
+	var req projectFitRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid request body"})
+	}
+	if req.Replicas <= 0 || req.Replicas > maxProjectFitReplicas {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "replicas must be between 1 and 10000"})
+	}
+	if len(req.PodRequests) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "podRequests must not be empty"})
+	}
+	for name, qty := range req.PodRequests {
+		if qty.Sign() < 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "request for " + string(name) + " must not be negative"})
+		}
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), mcpDefaultTimeout)
+	defer cancel()
+
+	projection, err := client.ProjectFit(ctx, cluster, req.PodRequests, req.Replicas, req.FitConstraints)
+	if err != nil {
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"projection": projection, "source": "k8s"})
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+func TestRecommendPlacement_Success(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewPlacementHandlers(env.K8sClient)
+	env.App.Post("/api/placement/recommend", handler.RecommendPlacement)
+
+	k8sClient, err := env.K8sClient.GetClient("test-cluster")
+	require.NoError(t, err)
+	fakeClient := k8sClient.(*k8sfake.Clientset)
+	_, err = fakeClient.CoreV1().Nodes().Create(context.Background(), &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-node-1"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				"nvidia.com/gpu":      resource.MustParse("4"),
+				corev1.ResourceCPU:    resource.MustParse("16"),
+				corev1.ResourceMemory: resource.MustParse("64Gi"),
+			},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, "/api/placement/recommend", strings.NewReader(`{"gpuCount":2}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Recommendation struct {
+			Candidates []map[string]interface{} `json:"candidates"`
+		} `json:"recommendation"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	require.Len(t, payload.Recommendation.Candidates, 1)
+	assert.Equal(t, "gpu-node-1", payload.Recommendation.Candidates[0]["node"])
+	assert.Equal(t, float64(4), payload.Recommendation.Candidates[0]["freeGPUs"])
+}
+
+func TestRecommendPlacement_InvalidGPUCount(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewPlacementHandlers(env.K8sClient)
+	env.App.Post("/api/placement/recommend", handler.RecommendPlacement)
+
+	req, err := http.NewRequest(http.MethodPost, "/api/placement/recommend", strings.NewReader(`{"gpuCount":0}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestProjectFit_Success(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewPlacementHandlers(env.K8sClient)
+	env.App.Post("/api/clusters/:cluster/project-fit", handler.ProjectFit)
+
+	k8sClient, err := env.K8sClient.GetClient("test-cluster")
+	require.NoError(t, err)
+	fakeClient := k8sClient.(*k8sfake.Clientset)
+	_, err = fakeClient.CoreV1().Nodes().Create(context.Background(), &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	body := `{"podRequests":{"cpu":"1","memory":"1Gi"},"replicas":6}`
+	req, err := http.NewRequest(http.MethodPost, "/api/clusters/test-cluster/project-fit", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Projection k8s.FitProjection `json:"projection"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, 4, payload.Projection.Fits)
+	assert.False(t, payload.Projection.FitsAll)
+	require.Len(t, payload.Projection.Nodes, 1)
+	assert.Equal(t, "node-1", payload.Projection.Nodes[0].Node)
+}
+
+func TestProjectFit_InvalidReplicas(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewPlacementHandlers(env.K8sClient)
+	env.App.Post("/api/clusters/:cluster/project-fit", handler.ProjectFit)
+
+	body := `{"podRequests":{"cpu":"1"},"replicas":0}`
+	req, err := http.NewRequest(http.MethodPost, "/api/clusters/test-cluster/project-fit", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
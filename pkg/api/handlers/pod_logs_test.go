@@ -8,6 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 )
 
 // Fiber test timeout (ms). Pod log calls should complete essentially instantly
@@ -117,6 +120,105 @@ func TestMCPGetPodLogs_FakeClientReturnsLogs(t *testing.T) {
 	assert.True(t, ok, "k8s response should include logs field")
 }
 
+// TestMCPGetPodLogs_MaxBytesTruncates asserts that ?maxBytes= caps the
+// returned log and sets the truncated flag. The fake clientset ignores
+// PodLogOptions.LimitBytes and always returns "fake logs" (9 bytes), so this
+// also covers the client-side cap.
+func TestMCPGetPodLogs_MaxBytesTruncates(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/mcp/pods/logs", handler.GetPodLogs)
+
+	tests := []struct {
+		maxBytes      int
+		wantLogs      string
+		wantTruncated bool
+	}{
+		{4, "fake", true},
+		{9, "fake logs", false},
+		{100, "fake logs", false},
+	}
+	for _, tt := range tests {
+		req, err := http.NewRequest(
+			"GET",
+			"/api/mcp/pods/logs?cluster=test-cluster&namespace=default&pod=nginx&maxBytes="+itoa(tt.maxBytes),
+			nil,
+		)
+		require.NoError(t, err)
+
+		resp, err := env.App.Test(req, podLogsTestTimeoutMS)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var payload map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+		assert.Equal(t, tt.wantLogs, payload["logs"], "maxBytes=%d", tt.maxBytes)
+		assert.Equal(t, tt.wantTruncated, payload["truncated"], "maxBytes=%d", tt.maxBytes)
+	}
+}
+
+// TestMCPGetPodLogs_AllContainersMergesLogs asserts that ?allContainers=true
+// returns every container's logs with container prefixes, and that it can't
+// be combined with ?container=.
+func TestMCPGetPodLogs_AllContainersMergesLogs(t *testing.T) {
+	env := setupTestEnv(t)
+	env.K8sClient.InjectClient("test-cluster", k8sfake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "app"},
+			{Name: "istio-proxy"},
+		}},
+	}))
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/mcp/pods/logs", handler.GetPodLogs)
+
+	req, err := http.NewRequest(
+		"GET",
+		"/api/mcp/pods/logs?cluster=test-cluster&namespace=default&pod=web&allContainers=true&timestamps=true",
+		nil,
+	)
+	require.NoError(t, err)
+
+	resp, err := env.App.Test(req, podLogsTestTimeoutMS)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "[app] fake logs\n[istio-proxy] fake logs\n", payload["logs"])
+	assert.Equal(t, false, payload["truncated"])
+
+	req, err = http.NewRequest(
+		"GET",
+		"/api/mcp/pods/logs?cluster=test-cluster&namespace=default&pod=web&allContainers=true&container=app",
+		nil,
+	)
+	require.NoError(t, err)
+
+	resp, err = env.App.Test(req, podLogsTestTimeoutMS)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+// TestMCPGetPodLogs_MaxBytesExceedsMaxReturns400 asserts that maxBytes above
+// mcpMaxLogMaxBytes is rejected.
+func TestMCPGetPodLogs_MaxBytesExceedsMaxReturns400(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewMCPHandlers(nil, env.K8sClient, nil)
+	env.App.Get("/api/mcp/pods/logs", handler.GetPodLogs)
+
+	req, err := http.NewRequest(
+		"GET",
+		"/api/mcp/pods/logs?cluster=test-cluster&namespace=default&pod=nginx&maxBytes="+itoa(mcpMaxLogMaxBytes+1),
+		nil,
+	)
+	require.NoError(t, err)
+
+	resp, err := env.App.Test(req, podLogsTestTimeoutMS)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
 // TestMCPGetPodLogs_TailExceedsMaxReturns400 asserts that values above the
 // server-side `mcpMaxTailLines` cap are rejected before the k8s client is
 // ever called.
@@ -30,6 +30,7 @@ func parseUUID(s string) (uuid.UUID, error) {
 type RBACHandler struct {
 	store     store.Store
 	k8sClient *k8s.MultiClusterClient
+	clusterAccess
 }
 
 // NewRBACHandler creates a new RBAC handler
@@ -182,17 +183,21 @@ func (h *RBACHandler) GetUserManagementSummary(c *fiber.Ctx) error {
 
 	// Count K8s service accounts (if k8s client is available)
 	if h.k8sClient != nil {
+		client, err := h.clusterClientFor(c, h.k8sClient)
+		if err != nil {
+			return err
+		}
 		ctx, cancel := context.WithTimeout(c.Context(), k8s.RBACDefaultTimeout)
 		defer cancel()
 
-		total, clusters, err := h.k8sClient.CountServiceAccountsAllClusters(ctx)
+		total, clusters, err := client.CountServiceAccountsAllClusters(ctx)
 		if err == nil {
 			summary.K8sServiceAccounts.Total = total
 			summary.K8sServiceAccounts.Clusters = clusters
 		}
 
 		// Get current user permissions
-		perms, err := h.k8sClient.GetAllClusterPermissions(ctx)
+		perms, err := client.GetAllClusterPermissions(ctx)
 		if err == nil {
 			summary.CurrentUserPermissions = perms
 		}
@@ -215,6 +220,10 @@ func (h *RBACHandler) ListK8sServiceAccounts(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Query("cluster")
 	namespace := c.Query("namespace")
@@ -224,7 +233,7 @@ func (h *RBACHandler) ListK8sServiceAccounts(c *fiber.Ctx) error {
 
 	if cluster != "" {
 		// Get SAs from specific cluster
-		sas, err := h.k8sClient.ListServiceAccounts(ctx, cluster, namespace)
+		sas, err := client.ListServiceAccounts(ctx, cluster, namespace)
 		if err != nil {
 			slog.Warn("[RBAC] failed to list service accounts", "error", err)
 			return fiber.NewError(fiber.StatusInternalServerError, "internal server error")
@@ -233,7 +242,7 @@ func (h *RBACHandler) ListK8sServiceAccounts(c *fiber.Ctx) error {
 	}
 
 	// Get SAs from all clusters
-	clusters, _, err := h.k8sClient.HealthyClusters(ctx)
+	clusters, _, err := client.HealthyClusters(ctx)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Failed to list clusters")
 	}
@@ -251,7 +260,7 @@ func (h *RBACHandler) ListK8sServiceAccounts(c *fiber.Ctx) error {
 	for _, cl := range clusters {
 		clusterName := cl.Name
 		g.Go(func() error {
-			sas, err := h.k8sClient.ListServiceAccounts(gctx, clusterName, namespace)
+			sas, err := client.ListServiceAccounts(gctx, clusterName, namespace)
 			if err != nil {
 				mu.Lock()
 				clusterErrors[clusterName] = err.Error()
@@ -303,6 +312,10 @@ func (h *RBACHandler) ListK8sRoles(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Query("cluster")
 	namespace := c.Query("namespace")
@@ -315,13 +328,13 @@ func (h *RBACHandler) ListK8sRoles(c *fiber.Ctx) error {
 		// Get roles from specific cluster
 		roles := make([]models.K8sRole, 0)
 		if namespace != "" {
-			nsRoles, err := h.k8sClient.ListRoles(ctx, cluster, namespace)
+			nsRoles, err := client.ListRoles(ctx, cluster, namespace)
 			if err != nil {
 				return fiber.NewError(fiber.StatusInternalServerError, "Failed to list roles")
 			}
 			roles = append(roles, nsRoles...)
 		}
-		clusterRoles, err := h.k8sClient.ListClusterRoles(ctx, cluster, includeSystem)
+		clusterRoles, err := client.ListClusterRoles(ctx, cluster, includeSystem)
 		if err != nil {
 			return fiber.NewError(fiber.StatusInternalServerError, "Failed to list cluster roles")
 		}
@@ -351,6 +364,10 @@ func (h *RBACHandler) ListK8sRoleBindings(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Query("cluster")
 	namespace := c.Query("namespace")
@@ -366,14 +383,14 @@ func (h *RBACHandler) ListK8sRoleBindings(c *fiber.Ctx) error {
 	bindings := make([]models.K8sRoleBinding, 0)
 
 	if namespace != "" {
-		nsBindings, err := h.k8sClient.ListRoleBindings(ctx, cluster, namespace)
+		nsBindings, err := client.ListRoleBindings(ctx, cluster, namespace)
 		if err != nil {
 			return fiber.NewError(fiber.StatusInternalServerError, "Failed to list role bindings")
 		}
 		bindings = append(bindings, nsBindings...)
 	}
 
-	clusterBindings, err := h.k8sClient.ListClusterRoleBindings(ctx, cluster, includeSystem)
+	clusterBindings, err := client.ListClusterRoleBindings(ctx, cluster, includeSystem)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Failed to list cluster role bindings")
 	}
@@ -414,6 +431,10 @@ func (h *RBACHandler) ListK8sUsers(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Query("cluster")
 	if cluster == "" {
@@ -423,7 +444,7 @@ func (h *RBACHandler) ListK8sUsers(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), k8s.RBACDefaultTimeout)
 	defer cancel()
 
-	users, err := h.k8sClient.GetAllK8sUsers(ctx, cluster)
+	users, err := client.GetAllK8sUsers(ctx, cluster)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Failed to list K8s users")
 	}
@@ -449,6 +470,10 @@ func (h *RBACHandler) ListOpenShiftUsers(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Query("cluster")
 	if cluster == "" {
@@ -458,7 +483,7 @@ func (h *RBACHandler) ListOpenShiftUsers(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), rbacAnalysisTimeout)
 	defer cancel()
 
-	users, err := h.k8sClient.ListOpenShiftUsers(ctx, cluster)
+	users, err := client.ListOpenShiftUsers(ctx, cluster)
 	if err != nil {
 		slog.Warn("[RBAC] failed to list openshift users", "error", err)
 		return fiber.NewError(fiber.StatusInternalServerError, "internal server error")
@@ -24,6 +24,7 @@ var serviceExportGVR = schema.GroupVersionResource{
 // ServiceExportHandlers handles MCS ServiceExport API endpoints
 type ServiceExportHandlers struct {
 	k8sClient *k8s.MultiClusterClient
+	clusterAccess
 }
 
 // NewServiceExportHandlers creates a new ServiceExport handlers instance
@@ -69,14 +70,18 @@ func (h *ServiceExportHandlers) ListServiceExports(c *fiber.Ctx) error {
 			IsDemoData: true,
 		})
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), svcExportListTimeout)
 	defer cancel()
 
-	clusters, err := h.k8sClient.DeduplicatedClusters(ctx)
+	clusters, err := client.DeduplicatedClusters(ctx)
 	if err != nil {
 		var listErr error
-		clusters, listErr = h.k8sClient.ListClusters(ctx)
+		clusters, listErr = client.ListClusters(ctx)
 		if listErr != nil {
 			return c.Status(500).JSON(fiber.Map{"error": "cluster discovery failed", "isDemoData": false})
 		}
@@ -91,7 +96,7 @@ func (h *ServiceExportHandlers) ListServiceExports(c *fiber.Ctx) error {
 	successCount := 0
 
 	for _, cluster := range clusters {
-		client, err := h.k8sClient.GetDynamicClient(cluster.Name)
+		dynClient, err := client.GetDynamicClient(cluster.Name)
 		if err != nil {
 			clusterErrors = append(clusterErrors, ClusterError{
 				Cluster:   cluster.Name,
@@ -101,7 +106,7 @@ func (h *ServiceExportHandlers) ListServiceExports(c *fiber.Ctx) error {
 			continue
 		}
 
-		exportList, err := client.Resource(serviceExportGVR).Namespace("").List(ctx, metav1.ListOptions{})
+		exportList, err := dynClient.Resource(serviceExportGVR).Namespace("").List(ctx, metav1.ListOptions{})
 		if err != nil {
 			// Previously this was skipped silently on the assumption that the
 			// MCS CRDs may not be installed. That assumption masked real
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kubestellar/console/pkg/k8s"
+)
+
+// serviceEndpointsTimeout bounds the Service + EndpointSlice lookup for a
+// single service on a single cluster.
+const serviceEndpointsTimeout = 15 * time.Second
+
+// ServiceHandlers serves per-Service detail endpoints.
+type ServiceHandlers struct {
+	k8sClient *k8s.MultiClusterClient
+	clusterAccess
+}
+
+// NewServiceHandlers creates a new service handlers instance
+func NewServiceHandlers(k8sClient *k8s.MultiClusterClient) *ServiceHandlers {
+	return &ServiceHandlers{k8sClient: k8sClient}
+}
+
+// GetServiceEndpoints returns the ready and not-ready addresses backing a Service
+// GET /api/services/:cluster/:namespace/:name/endpoints
+func (h *ServiceHandlers) GetServiceEndpoints(c *fiber.Ctx) error {
+	cluster := c.Params("cluster")
+	namespace := c.Params("namespace")
+	name := c.Params("name")
+	if cluster == "" || namespace == "" || name == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "cluster, namespace and name are required"})
+	}
+	if err := mcpValidateClusterAndNamespace(cluster, namespace); err != nil {
+		return err
+	}
+	if err := mcpValidateName("name", name); err != nil {
+		return err
+	}
+
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), serviceEndpointsTimeout)
+	defer cancel()
+
+	endpoints, err := client.GetServiceEndpoints(ctx, cluster, namespace, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Service not found"})
+		}
+		return handleK8sError(c, err)
+	}
+	return c.JSON(fiber.Map{"endpoints": endpoints, "source": "k8s"})
+}
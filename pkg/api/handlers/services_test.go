@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetServiceEndpoints_Success(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewServiceHandlers(env.K8sClient)
+	env.App.Get("/api/services/:cluster/:namespace/:name/endpoints", handler.GetServiceEndpoints)
+
+	k8sClient, err := env.K8sClient.GetClient("test-cluster")
+	require.NoError(t, err)
+	fakeClient := k8sClient.(*k8sfake.Clientset)
+	_, err = fakeClient.CoreV1().Services("default").Create(context.Background(), &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = fakeClient.DiscoveryV1().EndpointSlices("default").Create(context.Background(), &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-abc12",
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "web"},
+		},
+		Endpoints: []discoveryv1.Endpoint{{
+			Addresses: []string{"10.0.0.1"},
+			TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-1"},
+		}},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "/api/services/test-cluster/default/web/endpoints", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var payload struct {
+		Endpoints []map[string]interface{} `json:"endpoints"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&payload))
+	require.Len(t, payload.Endpoints, 1)
+	assert.Equal(t, "web-1", payload.Endpoints[0]["podName"])
+	assert.Equal(t, true, payload.Endpoints[0]["ready"])
+}
+
+func TestGetServiceEndpoints_NotFound(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewServiceHandlers(env.K8sClient)
+	env.App.Get("/api/services/:cluster/:namespace/:name/endpoints", handler.GetServiceEndpoints)
+
+	req, err := http.NewRequest(http.MethodGet, "/api/services/test-cluster/default/missing/endpoints", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -98,6 +98,8 @@ func setupTestEnv(t *testing.T) *testEnv {
 	mockStore.On("SaveClusterGroup", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockStore.On("DeleteClusterGroup", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockStore.On("ListClusterGroups", mock.Anything).Return(map[string][]byte{}, nil).Maybe()
+	// ListClusters enriches its result with saved cluster metadata.
+	mockStore.On("ListClusterMetadata").Return([]store.ClusterMetadata{}, nil).Maybe()
 
 	app := fiber.New()
 
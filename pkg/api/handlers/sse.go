@@ -315,10 +315,11 @@ func sseCacheSet(key string, data interface{}) {
 func streamClusters(
 	c *fiber.Ctx,
 	h *MCPHandlers,
+	client *k8s.MultiClusterClient,
 	cfg sseClusterStreamConfig,
 	fetchFn func(ctx context.Context, clusterName string) (interface{}, error),
 ) error {
-	healthy, offline, err := h.k8sClient.HealthyClusters(c.Context())
+	healthy, offline, err := client.HealthyClusters(c.Context())
 	if err != nil {
 		slog.Error("[SSE] internal error", "error", err)
 		return c.Status(500).JSON(fiber.Map{"error": "internal server error"})
@@ -363,6 +364,7 @@ func streamClusters(
 	// SetBodyStreamWriter callback. The fiber.Ctx may be reused by the time
 	// the callback runs, so c.Locals is not safe to read inside it (#6029).
 	userID := middleware.GetUserID(c)
+	cacheIdentity := h.clusterCacheIdentity(c)
 
 	// Capture a standalone parent context derived from the request context so
 	// the SetBodyStreamWriter callback does not touch fiber.Ctx after it may
@@ -438,10 +440,11 @@ func streamClusters(
 		// Spawn goroutines only for healthy/unknown clusters
 		var wg sync.WaitGroup
 		for _, cl := range healthy {
-			// #7044 — Include user ID in cache key to prevent cross-user data
-			// leakage between different roles (e.g. admin vs viewer).
-			// Also includes namespace to prevent cross-namespace data leakage (#4151).
-			cacheKey := userID.String() + ":" + cfg.demoKey + ":" + cl.Name + ":" + cfg.namespace
+			// #7044 — Include the caller's identity in the cache key to prevent
+			// cross-user data leakage between different roles (e.g. admin vs
+			// viewer) or impersonated users. Also includes namespace to
+			// prevent cross-namespace data leakage (#4151).
+			cacheKey := cacheIdentity + ":" + cfg.demoKey + ":" + cl.Name + ":" + cfg.namespace
 
 			// Check response cache — serve instantly if fresh
 			if cached := sseCacheGet(cacheKey); cached != nil {
@@ -465,7 +468,7 @@ func streamClusters(
 
 				// Use shorter timeout for clusters that recently timed out
 				timeout := cfg.clusterTimeout
-				if h.k8sClient.IsSlow(clusterName) {
+				if client.IsSlow(clusterName) {
 					timeout = sseSlowClusterTimeout
 				}
 
@@ -489,7 +492,7 @@ func streamClusters(
 				if fetchErr != nil {
 					slog.Error("[SSE] cluster fetch failed", "cluster", clusterName, "elapsed", elapsed, "error", fetchErr)
 					if elapsed > 5*time.Second {
-						h.k8sClient.MarkSlow(clusterName)
+						client.MarkSlow(clusterName)
 					}
 					// Surface the per-cluster failure to the client as an SSE
 					// event so the UI can mark the cluster as errored instead
@@ -514,7 +517,7 @@ func streamClusters(
 				sseCacheSet(cKey, data)
 
 				if elapsed > 5*time.Second {
-					h.k8sClient.MarkSlow(clusterName)
+					client.MarkSlow(clusterName)
 				}
 
 				mu.Lock()
@@ -602,16 +605,20 @@ func (h *MCPHandlers) GetPodsStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "pods",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		pods, err := h.k8sClient.GetPods(ctx, cluster, namespace)
+		pods, err := client.GetPods(ctx, cluster, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -627,16 +634,20 @@ func (h *MCPHandlers) FindPodIssuesStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "issues",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		issues, err := h.k8sClient.FindPodIssues(ctx, cluster, namespace)
+		issues, err := client.FindPodIssues(ctx, cluster, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -652,16 +663,20 @@ func (h *MCPHandlers) GetDeploymentsStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "deployments",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		deps, err := h.k8sClient.GetDeployments(ctx, cluster, namespace)
+		deps, err := client.GetDeployments(ctx, cluster, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -677,6 +692,10 @@ func (h *MCPHandlers) GetEventsStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	limit := c.QueryInt("limit", defaultWarningEventsLimit)
@@ -688,12 +707,12 @@ func (h *MCPHandlers) GetEventsStream(c *fiber.Ctx) error {
 		limit = maxWarningEventsLimit
 	}
 
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "events",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		events, err := h.k8sClient.GetEvents(ctx, cluster, namespace, limit)
+		events, err := client.GetEvents(ctx, cluster, namespace, limit)
 		if err != nil {
 			return nil, err
 		}
@@ -709,14 +728,18 @@ func (h *MCPHandlers) GetServicesStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "services",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		svcs, err := h.k8sClient.GetServices(ctx, cluster, namespace)
+		svcs, err := client.GetServices(ctx, cluster, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -732,16 +755,20 @@ func (h *MCPHandlers) CheckSecurityIssuesStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "issues",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		issues, err := h.k8sClient.CheckSecurityIssues(ctx, cluster, namespace)
+		issues, err := client.CheckSecurityIssues(ctx, cluster, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -757,16 +784,20 @@ func (h *MCPHandlers) FindDeploymentIssuesStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "issues",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		issues, err := h.k8sClient.FindDeploymentIssues(ctx, cluster, namespace)
+		issues, err := client.FindDeploymentIssues(ctx, cluster, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -782,14 +813,18 @@ func (h *MCPHandlers) GetNodesStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "nodes",
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		return h.k8sClient.GetNodes(ctx, cluster)
+		return client.GetNodes(ctx, cluster)
 	})
 }
 
@@ -801,14 +836,18 @@ func (h *MCPHandlers) GetGPUNodesStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "nodes",
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		return h.k8sClient.GetGPUNodes(ctx, cluster)
+		return client.GetGPUNodes(ctx, cluster)
 	})
 }
 
@@ -820,14 +859,18 @@ func (h *MCPHandlers) GetGPUNodeHealthStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "nodes",
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		return h.k8sClient.GetGPUNodeHealth(ctx, cluster)
+		return client.GetGPUNodeHealth(ctx, cluster)
 	})
 }
 
@@ -847,18 +890,22 @@ func (h *MCPHandlers) GetWarningEventsStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
 	limit := parseWarningEventsLimit(c.Query("limit"))
 
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "events",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		return h.k8sClient.GetWarningEvents(ctx, cluster, namespace, limit)
+		return client.GetWarningEvents(ctx, cluster, namespace, limit)
 	})
 }
 
@@ -887,16 +934,20 @@ func (h *MCPHandlers) GetJobsStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "jobs",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		return h.k8sClient.GetJobs(ctx, cluster, namespace)
+		return client.GetJobs(ctx, cluster, namespace)
 	})
 }
 
@@ -908,16 +959,20 @@ func (h *MCPHandlers) GetConfigMapsStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "configmaps",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		return h.k8sClient.GetConfigMaps(ctx, cluster, namespace)
+		return client.GetConfigMaps(ctx, cluster, namespace)
 	})
 }
 
@@ -935,16 +990,20 @@ func (h *MCPHandlers) GetSecretsStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "secrets",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		return h.k8sClient.GetSecrets(ctx, cluster, namespace)
+		return client.GetSecrets(ctx, cluster, namespace)
 	})
 }
 
@@ -956,17 +1015,21 @@ func (h *MCPHandlers) GetWorkloadsStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	namespace := c.Query("namespace")
 	workloadType := c.Query("type")
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "workloads",
 		namespace:      namespace,
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		workloads, err := h.k8sClient.ListWorkloadsForCluster(ctx, cluster, namespace, workloadType)
+		workloads, err := client.ListWorkloadsForCluster(ctx, cluster, namespace, workloadType)
 		if err != nil {
 			return nil, err
 		}
@@ -982,14 +1045,18 @@ func (h *MCPHandlers) GetNVIDIAOperatorStatusStream(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClient(c)
+	if err != nil {
+		return err
+	}
 
 	clusterFilter := c.Query("cluster")
-	return streamClusters(c, h, sseClusterStreamConfig{
+	return streamClusters(c, h, client, sseClusterStreamConfig{
 		demoKey:        "operators",
 		clusterTimeout: ssePerClusterTimeout,
 		clusterFilter:  clusterFilter,
 	}, func(ctx context.Context, cluster string) (interface{}, error) {
-		status, err := h.k8sClient.GetNVIDIAOperatorStatus(ctx, cluster)
+		status, err := client.GetNVIDIAOperatorStatus(ctx, cluster)
 		if err != nil {
 			return nil, err
 		}
@@ -17,6 +17,7 @@ const topologyTimeout = 30 * time.Second
 type TopologyHandlers struct {
 	k8sClient *k8s.MultiClusterClient
 	hub       *Hub
+	clusterAccess
 }
 
 // NewTopologyHandlers creates a new topology handlers instance
@@ -75,6 +76,10 @@ func (h *TopologyHandlers) GetTopology(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), topologyTimeout)
 	defer cancel()
@@ -82,19 +87,19 @@ func (h *TopologyHandlers) GetTopology(c *fiber.Ctx) error {
 	// Collect data from all sources, tracking partial failures (#4774)
 	var partialErrors []string
 
-	exports, err := h.k8sClient.ListServiceExports(ctx)
+	exports, err := client.ListServiceExports(ctx)
 	if err != nil {
 		partialErrors = append(partialErrors, fmt.Sprintf("service_exports: %v", err))
 	}
-	imports, err := h.k8sClient.ListServiceImports(ctx)
+	imports, err := client.ListServiceImports(ctx)
 	if err != nil {
 		partialErrors = append(partialErrors, fmt.Sprintf("service_imports: %v", err))
 	}
-	gateways, err := h.k8sClient.ListGateways(ctx)
+	gateways, err := client.ListGateways(ctx)
 	if err != nil {
 		partialErrors = append(partialErrors, fmt.Sprintf("gateways: %v", err))
 	}
-	httpRoutes, err := h.k8sClient.ListHTTPRoutes(ctx)
+	httpRoutes, err := client.ListHTTPRoutes(ctx)
 	if err != nil {
 		partialErrors = append(partialErrors, fmt.Sprintf("http_routes: %v", err))
 	}
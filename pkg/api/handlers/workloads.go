@@ -54,6 +54,7 @@ type WorkloadHandlers struct {
 	store     store.Store
 	stopOnce  sync.Once
 	stopCh    chan struct{}
+	clusterAccess
 }
 
 // NewWorkloadHandlers creates a new workload handlers instance
@@ -92,6 +93,10 @@ func (h *WorkloadHandlers) ListWorkloads(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	// Optional filters
 	cluster := c.Query("cluster")
@@ -101,7 +106,7 @@ func (h *WorkloadHandlers) ListWorkloads(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), workloadListTimeout)
 	defer cancel()
 
-	workloads, err := h.k8sClient.ListWorkloads(ctx, cluster, namespace, workloadType)
+	workloads, err := client.ListWorkloads(ctx, cluster, namespace, workloadType)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -122,6 +127,10 @@ func (h *WorkloadHandlers) GetWorkload(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -130,7 +139,7 @@ func (h *WorkloadHandlers) GetWorkload(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), workloadDefaultTimeout)
 	defer cancel()
 
-	workload, err := h.k8sClient.GetWorkload(ctx, cluster, namespace, name)
+	workload, err := client.GetWorkload(ctx, cluster, namespace, name)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -163,6 +172,10 @@ func (h *WorkloadHandlers) ResolveDependencies(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -171,7 +184,7 @@ func (h *WorkloadHandlers) ResolveDependencies(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), workloadDefaultTimeout)
 	defer cancel()
 
-	workloadKind, bundle, err := h.k8sClient.ResolveWorkloadDependencies(ctx, cluster, namespace, name)
+	workloadKind, bundle, err := client.ResolveWorkloadDependencies(ctx, cluster, namespace, name)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			slog.Info("[Workloads] not found", "error", err)
@@ -225,11 +238,16 @@ func (h *WorkloadHandlers) MonitorWorkload(c *fiber.Ctx) error {
 			"status":       "Healthy",
 			"dependencies": make([]fiber.Map, 0),
 			"issues":       make([]fiber.Map, 0),
+			"probes":       make([]fiber.Map, 0),
 		})
 	}
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -238,7 +256,7 @@ func (h *WorkloadHandlers) MonitorWorkload(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), workloadDefaultTimeout)
 	defer cancel()
 
-	result, err := h.k8sClient.MonitorWorkload(ctx, cluster, namespace, name)
+	result, err := client.MonitorWorkload(ctx, cluster, namespace, name)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			slog.Info("[Workloads] not found", "error", err)
@@ -266,6 +284,10 @@ func (h *WorkloadHandlers) GetDeployStatus(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -274,7 +296,7 @@ func (h *WorkloadHandlers) GetDeployStatus(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.Context(), workloadDefaultTimeout)
 	defer cancel()
 
-	workload, err := h.k8sClient.GetWorkload(ctx, cluster, namespace, name)
+	workload, err := client.GetWorkload(ctx, cluster, namespace, name)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -451,9 +473,13 @@ func (h *WorkloadHandlers) ListClusterGroups(c *fiber.Ctx) error {
 		},
 	}
 	if h.k8sClient != nil {
+		client, err := h.clusterClientFor(c, h.k8sClient)
+		if err != nil {
+			return err
+		}
 		ctx, cancel := context.WithTimeout(c.Context(), workloadListTimeout)
 		defer cancel()
-		if healthyClusters, _, err := h.k8sClient.HealthyClusters(ctx); err == nil {
+		if healthyClusters, _, err := client.HealthyClusters(ctx); err == nil {
 			names := make([]string, 0, len(healthyClusters))
 			for _, cl := range healthyClusters {
 				names = append(names, cl.Name)
@@ -477,6 +503,10 @@ func (h *WorkloadHandlers) CreateClusterGroup(c *fiber.Ctx) error {
 	if err := h.requireAdmin(c); err != nil {
 		return err
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	var group ClusterGroup
 	if err := c.BodyParser(&group); err != nil {
@@ -508,7 +538,7 @@ func (h *WorkloadHandlers) CreateClusterGroup(c *fiber.Ctx) error {
 
 		var labelErrors []string
 		for _, cluster := range group.Clusters {
-			if err := h.k8sClient.LabelClusterNodes(ctx, cluster, map[string]string{
+			if err := client.LabelClusterNodes(ctx, cluster, map[string]string{
 				"kubestellar.io/group": group.Name,
 			}); err != nil {
 				slog.Error("[Workloads] failed to label cluster", "cluster", cluster, "error", err)
@@ -535,6 +565,10 @@ func (h *WorkloadHandlers) UpdateClusterGroup(c *fiber.Ctx) error {
 	if err := h.requireAdmin(c); err != nil {
 		return err
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	name := c.Params("name")
 	if name == allHealthyClustersGroupName {
@@ -572,7 +606,7 @@ func (h *WorkloadHandlers) UpdateClusterGroup(c *fiber.Ctx) error {
 		var labelErrors []string
 		for _, cluster := range oldGroup.Clusters {
 			if !newSet[cluster] {
-				if err := h.k8sClient.RemoveClusterNodeLabels(ctx, cluster, []string{"kubestellar.io/group"}); err != nil {
+				if err := client.RemoveClusterNodeLabels(ctx, cluster, []string{"kubestellar.io/group"}); err != nil {
 					slog.Error("[Workloads] failed to remove label from cluster", "cluster", cluster, "error", err)
 					labelErrors = append(labelErrors, fmt.Sprintf("cluster %s: %v", cluster, err))
 				}
@@ -580,7 +614,7 @@ func (h *WorkloadHandlers) UpdateClusterGroup(c *fiber.Ctx) error {
 		}
 		for _, cluster := range group.Clusters {
 			if !oldSet[cluster] {
-				if err := h.k8sClient.LabelClusterNodes(ctx, cluster, map[string]string{
+				if err := client.LabelClusterNodes(ctx, cluster, map[string]string{
 					"kubestellar.io/group": group.Name,
 				}); err != nil {
 					slog.Error("[Workloads] failed to label cluster", "cluster", cluster, "error", err)
@@ -610,6 +644,10 @@ func (h *WorkloadHandlers) DeleteClusterGroup(c *fiber.Ctx) error {
 	if err := h.requireAdmin(c); err != nil {
 		return err
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	name := c.Params("name")
 	if name == allHealthyClustersGroupName {
@@ -631,7 +669,7 @@ func (h *WorkloadHandlers) DeleteClusterGroup(c *fiber.Ctx) error {
 
 		var labelErrors []string
 		for _, cluster := range group.Clusters {
-			if err := h.k8sClient.RemoveClusterNodeLabels(ctx, cluster, []string{"kubestellar.io/group"}); err != nil {
+			if err := client.RemoveClusterNodeLabels(ctx, cluster, []string{"kubestellar.io/group"}); err != nil {
 				slog.Error("[Workloads] failed to remove label from cluster", "cluster", cluster, "error", err)
 				labelErrors = append(labelErrors, fmt.Sprintf("cluster %s: %v", cluster, err))
 			}
@@ -711,6 +749,10 @@ func (h *WorkloadHandlers) EvaluateClusterQuery(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	var query ClusterGroupQuery
 	if err := c.BodyParser(&query); err != nil {
@@ -741,7 +783,7 @@ func (h *WorkloadHandlers) EvaluateClusterQuery(c *fiber.Ctx) error {
 	// Deduplicate clusters — multiple kubeconfig contexts can point to the
 	// same physical cluster (e.g. "vllm-d" and "default/api-fmaas-vllm-d-…").
 	// We only want one result per unique server URL.
-	dedupClusters, _, err := h.k8sClient.HealthyClusters(ctx)
+	dedupClusters, _, err := client.HealthyClusters(ctx)
 	if err != nil {
 		slog.Error("[Workloads] failed to list clusters", "error", err)
 		return c.Status(500).JSON(fiber.Map{"error": "internal server error"})
@@ -752,7 +794,7 @@ func (h *WorkloadHandlers) EvaluateClusterQuery(c *fiber.Ctx) error {
 	}
 
 	// Get all cluster health data and keep only deduplicated entries
-	allHealth, err := h.k8sClient.GetAllClusterHealth(ctx)
+	allHealth, err := client.GetAllClusterHealth(ctx)
 	if err != nil {
 		slog.Error("[Workloads] failed to get cluster health", "error", err)
 		return c.Status(500).JSON(fiber.Map{"error": "internal server error"})
@@ -773,7 +815,7 @@ func (h *WorkloadHandlers) EvaluateClusterQuery(c *fiber.Ctx) error {
 		for _, cl := range dedupClusters {
 			clName := cl.Name
 			g.Go(func() error {
-				nodes, err := h.k8sClient.GetNodes(gctx, clName)
+				nodes, err := client.GetNodes(gctx, clName)
 				if err != nil {
 					// Non-fatal: skip clusters that fail, matching original behavior.
 					slog.Warn("[Workloads] failed to get nodes for cluster", "cluster", clName, "error", err)
@@ -802,6 +844,29 @@ func (h *WorkloadHandlers) EvaluateClusterQuery(c *fiber.Ctx) error {
 	})
 }
 
+// GetClusterLabelTaxonomy returns the node label keys and values found
+// across clusters, for autocomplete in the group query builder
+// GET /api/cluster-groups/labels
+func (h *WorkloadHandlers) GetClusterLabelTaxonomy(c *fiber.Ctx) error {
+	if h.k8sClient == nil {
+		return errNoClusterAccess(c)
+	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), workloadListTimeout)
+	defer cancel()
+
+	taxonomy, err := client.GetClusterLabelTaxonomy(ctx)
+	if err != nil {
+		slog.Error("[Workloads] failed to build cluster label taxonomy", "error", err)
+		return c.Status(500).JSON(fiber.Map{"error": "internal server error"})
+	}
+	return c.JSON(fiber.Map{"labels": taxonomy})
+}
+
 // clusterMatchesQuery checks if a cluster matches all query conditions
 func clusterMatchesQuery(health k8s.ClusterHealth, nodes []k8s.NodeInfo, query *ClusterGroupQuery) bool {
 	// Check label selector against node labels
@@ -1002,9 +1067,13 @@ func (h *WorkloadHandlers) GenerateClusterQuery(c *fiber.Ctx) error {
 	// Build cluster context for the AI
 	var clusterContext string
 	if h.k8sClient != nil {
+		client, err := h.clusterClientFor(c, h.k8sClient)
+		if err != nil {
+			return err
+		}
 		ctx, cancel := context.WithTimeout(c.Context(), workloadPodsTimeout)
 		defer cancel()
-		healthData, _ := h.k8sClient.GetAllClusterHealth(ctx)
+		healthData, _ := client.GetAllClusterHealth(ctx)
 		clusterContext = buildClusterContextForAI(healthData)
 	}
 
@@ -1117,11 +1186,15 @@ func (h *WorkloadHandlers) GetClusterCapabilities(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), workloadListTimeout)
 	defer cancel()
 
-	capabilities, err := h.k8sClient.GetClusterCapabilities(ctx)
+	capabilities, err := client.GetClusterCapabilities(ctx)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -1135,11 +1208,15 @@ func (h *WorkloadHandlers) ListBindingPolicies(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Context(), workloadDefaultTimeout)
 	defer cancel()
 
-	policies, err := h.k8sClient.ListBindingPolicies(ctx)
+	policies, err := client.ListBindingPolicies(ctx)
 	if err != nil {
 		return handleK8sError(c, err)
 	}
@@ -1154,6 +1231,10 @@ func (h *WorkloadHandlers) GetDeployLogs(c *fiber.Ctx) error {
 	if h.k8sClient == nil {
 		return errNoClusterAccess(c)
 	}
+	client, err := h.clusterClientFor(c, h.k8sClient)
+	if err != nil {
+		return err
+	}
 
 	cluster := c.Params("cluster")
 	namespace := c.Params("namespace")
@@ -1165,7 +1246,7 @@ func (h *WorkloadHandlers) GetDeployLogs(c *fiber.Ctx) error {
 		tailLines = defaultTailLines
 	}
 
-	client, err := h.k8sClient.GetClient(cluster)
+	clientset, err := client.GetClient(cluster)
 	if err != nil {
 		return c.Status(500).JSON(fiber.Map{"error": fmt.Sprintf("cluster %s: %v", cluster, err)})
 	}
@@ -1181,12 +1262,12 @@ func (h *WorkloadHandlers) GetDeployLogs(c *fiber.Ctx) error {
 	}
 
 	// Try label selector first: app=<name>
-	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: fmt.Sprintf("app=%s", name),
 	})
 	if err != nil || len(pods.Items) == 0 {
 		// Fallback: list all pods and filter by name prefix
-		allPods, listErr := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		allPods, listErr := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
 		if listErr != nil {
 			return c.Status(500).JSON(fiber.Map{"error": listErr.Error()})
 		}
@@ -1206,7 +1287,7 @@ func (h *WorkloadHandlers) GetDeployLogs(c *fiber.Ctx) error {
 	var allEvents []corev1.Event
 
 	// Events for the deployment itself
-	deployEvents, _ := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+	deployEvents, _ := clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("involvedObject.name=%s", name),
 		Limit:         maxEventsPerQuery,
 	})
@@ -1216,7 +1297,7 @@ func (h *WorkloadHandlers) GetDeployLogs(c *fiber.Ctx) error {
 
 	// Events for each pod
 	for _, pod := range pods.Items {
-		podEvents, _ := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		podEvents, _ := clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
 			FieldSelector: fmt.Sprintf("involvedObject.name=%s", pod.Name),
 			Limit:         maxEventsPerQuery,
 		})
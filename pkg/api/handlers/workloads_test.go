@@ -329,6 +329,32 @@ func TestEvaluateClusterQuery(t *testing.T) {
 	assert.Equal(t, "c1-ctx", clusters[0])
 }
 
+func TestGetClusterLabelTaxonomy(t *testing.T) {
+	env := setupTestEnv(t)
+	handler := NewWorkloadHandlers(env.K8sClient, env.Hub, env.Store)
+	env.App.Get("/api/cluster-groups/labels", handler.GetClusterLabelTaxonomy)
+
+	env.K8sClient.SetRawConfig(&api.Config{
+		Contexts: map[string]*api.Context{"c1-ctx": {Cluster: "cluster1"}},
+		Clusters: map[string]*api.Cluster{"cluster1": {Server: "https://c1.com"}},
+	})
+	env.K8sClient.InjectClient("c1-ctx", k8sfake.NewSimpleClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"region": "us-east"}},
+	}))
+
+	req, err := http.NewRequest("GET", "/api/cluster-groups/labels", nil)
+	require.NoError(t, err)
+	resp, err := env.App.Test(req, 5000)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var result struct {
+		Labels map[string][]string `json:"labels"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, []string{"us-east"}, result.Labels["region"])
+}
+
 // MockAIProvider implements agent.AIProvider for testing.
 type MockAIProvider struct {
 	Response string
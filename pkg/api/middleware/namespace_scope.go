@@ -0,0 +1,234 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// namespaceAllowlistUserPrefix marks a NamespaceAllowlist entry that applies
+// to one GitHub login rather than to a role.
+const namespaceAllowlistUserPrefix = "user:"
+
+// unrestrictedRole is never namespace-scoped, whatever the allowlist says.
+const unrestrictedRole = "admin"
+
+// NamespaceAllowlist maps roles and users to the namespaces they may see.
+// Roles and users without an entry are unrestricted.
+type NamespaceAllowlist struct {
+	roles map[string][]string
+	users map[string][]string
+}
+
+// ParseNamespaceAllowlist parses entries separated by ";" of the form
+// "<subject>=<namespace>,<namespace>", where subject is a role name
+// ("viewer", "editor") or "user:<github-login>", e.g.
+// "viewer=team-a;user:alice=team-a,team-b". A user entry takes precedence
+// over the entry of the user's role. An empty spec returns nil.
+func ParseNamespaceAllowlist(spec string) (*NamespaceAllowlist, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+	a := &NamespaceAllowlist{roles: map[string][]string{}, users: map[string][]string{}}
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		subject, list, ok := strings.Cut(entry, "=")
+		subject = strings.TrimSpace(subject)
+		if !ok || subject == "" {
+			return nil, fmt.Errorf("invalid namespace allowlist entry %q: want <subject>=<namespaces>", entry)
+		}
+		var namespaces []string
+		for _, ns := range strings.Split(list, ",") {
+			if ns = strings.TrimSpace(ns); ns != "" {
+				namespaces = append(namespaces, ns)
+			}
+		}
+		if len(namespaces) == 0 {
+			return nil, fmt.Errorf("invalid namespace allowlist entry %q: no namespaces", entry)
+		}
+		if login, isUser := strings.CutPrefix(subject, namespaceAllowlistUserPrefix); isUser {
+			a.users[login] = namespaces
+		} else {
+			a.roles[subject] = namespaces
+		}
+	}
+	return a, nil
+}
+
+// Namespaces returns the namespaces login, holding role, may see, and
+// whether the caller is restricted at all.
+func (a *NamespaceAllowlist) Namespaces(login, role string) ([]string, bool) {
+	if a == nil || role == unrestrictedRole {
+		return nil, false
+	}
+	if ns, ok := a.users[login]; ok {
+		return ns, true
+	}
+	ns, ok := a.roles[role]
+	return ns, ok
+}
+
+// NamespaceScope returns a middleware that confines restricted callers to
+// the namespaces allowlist grants them. roleOf returns the caller's role.
+// Every namespace the request names — ?namespace=, a :namespace route
+// parameter, or a "namespace"/"namespaces" field anywhere in a JSON body —
+// must be allowed, or the request is rejected with 403 before the handler
+// runs. Without ?namespace=, the JSON response is filtered: array items at
+// any depth whose "namespace" is outside the set are dropped, and on a
+// namespace listing the namespaces themselves are matched by "name".
+// Streaming and tool-call responses cannot be filtered, so restricted callers
+// must name a namespace on paths ending in /stream or /call. A nil allowlist
+// returns a pass-through, so the middleware can be mounted per route.
+func NamespaceScope(allowlist *NamespaceAllowlist, roleOf func(c *fiber.Ctx) (string, error)) fiber.Handler {
+	if allowlist == nil {
+		return func(c *fiber.Ctx) error { return c.Next() }
+	}
+	return func(c *fiber.Ctx) error {
+		role, err := roleOf(c)
+		if err != nil {
+			return err
+		}
+		allowed, restricted := allowlist.Namespaces(GetGitHubLogin(c), role)
+		if !restricted {
+			return c.Next()
+		}
+
+		named := requestNamespaces(c)
+		for _, ns := range named {
+			if !slices.Contains(allowed, ns) {
+				return fiber.NewError(fiber.StatusForbidden, "access to namespace "+ns+" is not allowed")
+			}
+		}
+		if c.Query("namespace") != "" {
+			return c.Next()
+		}
+		if path := c.Path(); strings.HasSuffix(path, "/stream") || strings.HasSuffix(path, "/call") {
+			if len(named) == 0 {
+				return fiber.NewError(fiber.StatusForbidden, "namespace is required")
+			}
+			return c.Next()
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+		if c.Response().StatusCode() != fiber.StatusOK ||
+			!strings.HasPrefix(string(c.Response().Header.ContentType()), fiber.MIMEApplicationJSON) {
+			return nil
+		}
+		filtered, err := filterNamespacedJSON(c.Response().Body(), allowed, isNamespaceListing(c.Path()))
+		if err != nil {
+			slog.Warn("[NamespaceScope] response not filtered", "path", c.Path(), "error", err)
+			return fiber.NewError(fiber.StatusInternalServerError, "failed to filter response")
+		}
+		c.Response().SetBodyRaw(filtered)
+		return nil
+	}
+}
+
+// requestNamespaces returns the namespaces named by the request's query,
+// route parameters and JSON body. Route parameters are only known when the
+// middleware is mounted on the route itself rather than with Use.
+func requestNamespaces(c *fiber.Ctx) []string {
+	var named []string
+	for _, ns := range []string{c.Query("namespace"), c.Params("namespace")} {
+		if ns != "" {
+			named = append(named, ns)
+		}
+	}
+	if len(c.Body()) == 0 ||
+		!strings.HasPrefix(string(c.Request().Header.ContentType()), fiber.MIMEApplicationJSON) {
+		return named
+	}
+	var body any
+	if json.Unmarshal(c.Body(), &body) != nil {
+		return named // malformed bodies are rejected by the handler
+	}
+	return appendBodyNamespaces(named, body)
+}
+
+// appendBodyNamespaces appends the "namespace" strings and "namespaces"
+// string lists found at any depth of v.
+func appendBodyNamespaces(named []string, v any) []string {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, child := range v {
+			switch key {
+			case "namespace":
+				if ns, _ := child.(string); ns != "" {
+					named = append(named, ns)
+				}
+			case "namespaces":
+				if list, ok := child.([]any); ok {
+					for _, item := range list {
+						if ns, _ := item.(string); ns != "" {
+							named = append(named, ns)
+						}
+					}
+				}
+			}
+			named = appendBodyNamespaces(named, child)
+		}
+	case []any:
+		for _, item := range v {
+			named = appendBodyNamespaces(named, item)
+		}
+	}
+	return named
+}
+
+// isNamespaceListing reports whether path lists namespaces, whose items
+// carry the namespace in "name" rather than "namespace".
+func isNamespaceListing(path string) bool {
+	return strings.HasSuffix(path, "/namespaces") || strings.HasSuffix(path, "/namespaces/summary")
+}
+
+// filterNamespacedJSON drops the array items, at any depth of a JSON body,
+// whose "namespace" is set and not in allowed. Items without a namespace
+// (cluster-scoped objects) are kept. When listing is set, the outermost
+// arrays hold namespaces and are matched by "name" instead. Bodies that are
+// not JSON are returned unchanged.
+func filterNamespacedJSON(body []byte, allowed []string, listing bool) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber() // keep large integers exact
+	var v any
+	if dec.Decode(&v) != nil {
+		return body, nil
+	}
+	key := "namespace"
+	if listing {
+		key = "name"
+	}
+	return json.Marshal(filterNamespacedValue(v, allowed, key))
+}
+
+// filterNamespacedValue filters the arrays in v, matching the items of the
+// outermost arrays by key and those of nested arrays by "namespace".
+func filterNamespacedValue(v any, allowed []string, key string) any {
+	switch v := v.(type) {
+	case []any:
+		kept := make([]any, 0, len(v))
+		for _, item := range v {
+			if obj, ok := item.(map[string]any); ok {
+				if ns, _ := obj[key].(string); ns != "" && !slices.Contains(allowed, ns) {
+					continue
+				}
+			}
+			kept = append(kept, filterNamespacedValue(item, allowed, "namespace"))
+		}
+		return kept
+	case map[string]any:
+		for k, child := range v {
+			v[k] = filterNamespacedValue(child, allowed, key)
+		}
+	}
+	return v
+}
@@ -0,0 +1,134 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubestellar/console/pkg/api/middleware"
+)
+
+func TestParseNamespaceAllowlist(t *testing.T) {
+	t.Parallel()
+	a, err := middleware.ParseNamespaceAllowlist(" viewer = team-a ; user:alice=team-a, team-b ;")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		login, role    string
+		wantNamespaces []string
+		wantRestricted bool
+	}{
+		{"bob", "viewer", []string{"team-a"}, true},
+		{"alice", "viewer", []string{"team-a", "team-b"}, true}, // user entry wins
+		{"alice", "admin", nil, false},                          // admins are never scoped
+		{"carol", "editor", nil, false},                         // no entry for the role
+	}
+	for _, tt := range tests {
+		ns, restricted := a.Namespaces(tt.login, tt.role)
+		if restricted != tt.wantRestricted || !slices.Equal(ns, tt.wantNamespaces) {
+			t.Errorf("Namespaces(%q, %q) = %v, %v; want %v, %v",
+				tt.login, tt.role, ns, restricted, tt.wantNamespaces, tt.wantRestricted)
+		}
+	}
+
+	for _, bad := range []string{"viewer", "=team-a", "viewer=, ,"} {
+		if _, err := middleware.ParseNamespaceAllowlist(bad); err == nil {
+			t.Errorf("ParseNamespaceAllowlist(%q): expected an error", bad)
+		}
+	}
+	if a, err := middleware.ParseNamespaceAllowlist(""); a != nil || err != nil {
+		t.Errorf("ParseNamespaceAllowlist(\"\") = %v, %v; want nil, nil", a, err)
+	}
+}
+
+func TestNamespaceScope(t *testing.T) {
+	t.Parallel()
+	allowlist, err := middleware.ParseNamespaceAllowlist("viewer=team-a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scope := middleware.NamespaceScope(allowlist, func(*fiber.Ctx) (string, error) {
+		return "viewer", nil
+	})
+
+	app := fiber.New()
+	ok := func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) }
+	app.Post("/api/mcp/resourcequotas", scope, ok)
+	app.Post("/api/mcp/tools/ops/call", scope, ok)
+	app.Get("/api/workloads/:cluster/:namespace/:name", scope, ok)
+	app.Get("/api/namespaces", scope, func(c *fiber.Ctx) error {
+		return c.JSON([]fiber.Map{{"name": "team-a"}, {"name": "team-b"}})
+	})
+	app.Get("/api/topology", scope, func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"graph": fiber.Map{"nodes": []fiber.Map{
+			{"id": "a", "namespace": "team-a"},
+			{"id": "b", "namespace": "team-b"},
+			{"id": "node-1"},
+		}}})
+	})
+
+	tests := []struct {
+		method, path, body string
+		want               int
+	}{
+		{http.MethodPost, "/api/mcp/resourcequotas", `{"cluster":"c1","namespace":"team-b","ensure_namespace":true}`, http.StatusForbidden},
+		{http.MethodPost, "/api/mcp/resourcequotas", `{"cluster":"c1","namespace":"team-a"}`, http.StatusOK},
+		{http.MethodPost, "/api/mcp/tools/ops/call", `{"name":"get_pods","arguments":{"namespace":"kube-system"}}`, http.StatusForbidden},
+		{http.MethodPost, "/api/mcp/tools/ops/call", `{"name":"get_pods","arguments":{}}`, http.StatusForbidden},
+		{http.MethodPost, "/api/mcp/tools/ops/call", `{"name":"get_pods","arguments":{"namespace":"team-a"}}`, http.StatusOK},
+		{http.MethodGet, "/api/workloads/c1/team-b/web", "", http.StatusForbidden},
+		{http.MethodGet, "/api/workloads/c1/team-a/web", "", http.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		if tt.body != "" {
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", tt.method, tt.path, err)
+		}
+		if resp.StatusCode != tt.want {
+			t.Errorf("%s %s %s: expected %d, got %d", tt.method, tt.path, tt.body, tt.want, resp.StatusCode)
+		}
+	}
+
+	get := func(path string) string {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+	var namespaces []map[string]string
+	if err := json.Unmarshal([]byte(get("/api/namespaces")), &namespaces); err != nil {
+		t.Fatalf("decode namespaces: %v", err)
+	}
+	if len(namespaces) != 1 || namespaces[0]["name"] != "team-a" {
+		t.Errorf("expected only team-a to be listed, got %v", namespaces)
+	}
+	var topology struct {
+		Graph struct {
+			Nodes []map[string]string `json:"nodes"`
+		} `json:"graph"`
+	}
+	if err := json.Unmarshal([]byte(get("/api/topology")), &topology); err != nil {
+		t.Fatalf("decode topology: %v", err)
+	}
+	var ids []string
+	for _, n := range topology.Graph.Nodes {
+		ids = append(ids, n["id"])
+	}
+	if !slices.Equal(ids, []string{"a", "node-1"}) {
+		t.Errorf("expected nested team-b items to be dropped, got %v", ids)
+	}
+}
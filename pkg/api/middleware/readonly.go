@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// readOnlyForbiddenMsg is returned for mutations rejected in read-only mode.
+const readOnlyForbiddenMsg = "The console is in read-only mode"
+
+// ReadOnlyProtectedRoutes are the cluster-affecting route patterns rejected
+// for mutating methods when READ_ONLY=true. A pattern matches a path that
+// starts with the same segments; "*" matches any single segment. Console
+// state such as feedback, settings and dashboards is deliberately absent so
+// it keeps working on a read-only deployment.
+var ReadOnlyProtectedRoutes = []string{
+	"/api/workloads",                   // workload deploy / scale / delete
+	"/api/mcp",                         // resource quotas and MCP tool calls
+	"/api/clusters/current",            // switches the kubeconfig context
+	"/api/cluster-groups",              // group create / update / delete label ManagedClusters
+	"/api/drasi/proxy",                 // forwards writes to Drasi sources and queries
+	"/api/gadget/trace",                // runs Inspektor Gadget traces on nodes
+	"/api/kagent/tools/call",           // agent tools may change clusters
+	"/api/kagenti-provider/tools/call", // agent tools may change clusters
+	"/api/self-upgrade/trigger",        // rolls the console's own deployment
+}
+
+// ReadOnlyExemptRoutes are patterns under ReadOnlyProtectedRoutes that stay
+// open in read-only mode: queries that only use POST to carry a body, and
+// console state stored alongside cluster-affecting routes.
+var ReadOnlyExemptRoutes = []string{
+	"/api/cluster-groups/evaluate", // evaluates a label query
+	"/api/cluster-groups/ai-query", // turns a prompt into a label query
+	"/api/cluster-groups/sync",     // saves group definitions in the console store
+}
+
+// ReadOnly returns a middleware that rejects POST, PUT, PATCH and DELETE
+// requests to paths matching any of protected, and none of exempt, with 403.
+// Other methods and unprotected paths pass through.
+func ReadOnly(protected, exempt []string) fiber.Handler {
+	patterns := splitPaths(protected)
+	exemptions := splitPaths(exempt)
+	return func(c *fiber.Ctx) error {
+		if safeHTTPMethods[c.Method()] {
+			return c.Next()
+		}
+		path := splitPath(c.Path())
+		for _, pattern := range exemptions {
+			if matchRoutePattern(pattern, path) {
+				return c.Next()
+			}
+		}
+		for _, pattern := range patterns {
+			if matchRoutePattern(pattern, path) {
+				slog.Warn("[ReadOnly] mutation rejected",
+					"ip", c.IP(), "method", c.Method(), "path", c.Path())
+				return fiber.NewError(fiber.StatusForbidden, readOnlyForbiddenMsg)
+			}
+		}
+		return c.Next()
+	}
+}
+
+// splitPaths splits each of patterns with splitPath.
+func splitPaths(patterns []string) [][]string {
+	split := make([][]string, 0, len(patterns))
+	for _, p := range patterns {
+		split = append(split, splitPath(p))
+	}
+	return split
+}
+
+// splitPath lowercases p, since Fiber routes case-insensitively, and splits
+// it into segments.
+func splitPath(p string) []string {
+	return strings.Split(strings.Trim(strings.ToLower(p), "/"), "/")
+}
+
+// matchRoutePattern reports whether path starts with pattern's segments.
+func matchRoutePattern(pattern, path []string) bool {
+	if len(path) < len(pattern) {
+		return false
+	}
+	for i, seg := range pattern {
+		if seg != "*" && seg != path[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubestellar/console/pkg/api/middleware"
+)
+
+func TestReadOnly(t *testing.T) {
+	t.Parallel()
+	app := fiber.New()
+	app.Use(middleware.ReadOnly(middleware.ReadOnlyProtectedRoutes, middleware.ReadOnlyExemptRoutes))
+	app.Use(func(c *fiber.Ctx) error {
+		return c.SendString(testOKBody)
+	})
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{http.MethodPost, "/api/workloads/deploy", http.StatusForbidden},
+		{http.MethodGet, "/api/workloads", http.StatusOK},
+		{http.MethodPost, "/API/Workloads/deploy", http.StatusForbidden},
+		{http.MethodDelete, "/api/mcp/resourcequotas", http.StatusForbidden},
+		{http.MethodPost, "/api/cluster-groups", http.StatusForbidden},
+		{http.MethodPut, "/api/cluster-groups/prod", http.StatusForbidden},
+		{http.MethodDelete, "/api/cluster-groups/prod", http.StatusForbidden},
+		{http.MethodGet, "/api/cluster-groups", http.StatusOK},
+		{http.MethodPost, "/api/cluster-groups/evaluate", http.StatusOK},
+		{http.MethodPost, "/api/drasi/proxy/api/v1/sources", http.StatusForbidden},
+		{http.MethodPost, "/api/feedback/requests", http.StatusOK},
+		{http.MethodPut, "/api/settings", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.StatusCode != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, resp.StatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader carries the correlation ID in both directions. An
+	// incoming value is honored so a caller can stitch its own logs to ours.
+	RequestIDHeader = "X-Request-ID"
+
+	// requestIDLocalsKey is the fiber Locals key for the request ID. Locals
+	// are fasthttp user values, so c.Context().Value(requestIDLocalsKey) —
+	// and every context derived from it — also resolves the ID.
+	requestIDLocalsKey = "requestID"
+
+	// maxRequestIDLen bounds an incoming X-Request-ID so a client cannot
+	// bloat every log line for the request.
+	maxRequestIDLen = 128
+
+	// requestIDLogKey is the attribute name added to request-scoped logs.
+	requestIDLogKey = "request_id"
+)
+
+// requestIDCtxKey stores the request ID on the fiber UserContext.
+type requestIDCtxKey struct{}
+
+// RequestID assigns each request a correlation ID, reusing a well-formed
+// incoming X-Request-ID. The ID is stored in Locals and on the UserContext,
+// and echoed in the response header.
+func RequestID() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id := c.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.New().String()
+		}
+		c.Locals(requestIDLocalsKey, id)
+		c.SetUserContext(context.WithValue(c.UserContext(), requestIDCtxKey{}, id))
+		c.Set(RequestIDHeader, id)
+		return c.Next()
+	}
+}
+
+// GetRequestID returns the request ID assigned by RequestID, or "" if the
+// middleware did not run.
+func GetRequestID(c *fiber.Ctx) string {
+	id, _ := c.Locals(requestIDLocalsKey).(string)
+	return id
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, which may be
+// c.Context(), c.UserContext(), or any context derived from either.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if id, ok := ctx.Value(requestIDCtxKey{}).(string); ok {
+		return id
+	}
+	id, _ := ctx.Value(requestIDLocalsKey).(string)
+	return id
+}
+
+// validRequestID accepts IDs made of letters, digits and "-_.:" only, so a
+// client-supplied value cannot inject anything into log output.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// requestIDLogHandler adds the request ID from the record's context to every
+// log line emitted with one of the slog *Context functions.
+type requestIDLogHandler struct {
+	slog.Handler
+}
+
+// NewRequestIDLogHandler wraps h so records logged with a request context
+// (slog.InfoContext(c.Context(), ...), including from fan-out goroutines
+// using a derived context) carry a request_id attribute.
+func NewRequestIDLogHandler(h slog.Handler) slog.Handler {
+	return &requestIDLogHandler{Handler: h}
+}
+
+func (h *requestIDLogHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestIDFromContext(ctx); id != "" {
+		r.AddAttrs(slog.String(requestIDLogKey, id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *requestIDLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &requestIDLogHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *requestIDLogHandler) WithGroup(name string) slog.Handler {
+	return &requestIDLogHandler{Handler: h.Handler.WithGroup(name)}
+}
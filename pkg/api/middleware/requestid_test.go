@@ -0,0 +1,138 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubestellar/console/pkg/api/middleware"
+)
+
+func TestRequestID_GeneratesHeader(t *testing.T) {
+	t.Parallel()
+	app := fiber.New()
+	app.Use(middleware.RequestID())
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString(middleware.GetRequestID(c))
+	})
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	id := resp.Header.Get(middleware.RequestIDHeader)
+	if id == "" {
+		t.Fatal("expected X-Request-ID response header")
+	}
+	var body bytes.Buffer
+	_, _ = body.ReadFrom(resp.Body)
+	if body.String() != id {
+		t.Errorf("GetRequestID = %q, header = %q", body.String(), id)
+	}
+}
+
+func TestRequestID_HonorsIncomingHeader(t *testing.T) {
+	t.Parallel()
+	app := fiber.New()
+	app.Use(middleware.RequestID())
+	app.Get("/", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	tests := []struct {
+		name     string
+		incoming string
+		wantSame bool
+	}{
+		{"well formed", "req-1234_abc.def:9", true},
+		{"log injection", "abc\" injected=\"1", false},
+		{"too long", strings.Repeat("a", 129), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(middleware.RequestIDHeader, tt.incoming)
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			got := resp.Header.Get(middleware.RequestIDHeader)
+			if got == "" {
+				t.Fatal("expected X-Request-ID response header")
+			}
+			if (got == tt.incoming) != tt.wantSame {
+				t.Errorf("incoming %q -> %q, wantSame=%v", tt.incoming, got, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestRequestID_PropagatesToHandlerLogs(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	var mu sync.Mutex
+	logger := slog.New(middleware.NewRequestIDLogHandler(slog.NewJSONHandler(&lockedWriter{w: &buf, mu: &mu}, nil)))
+
+	app := fiber.New()
+	app.Use(middleware.RequestID())
+	app.Get("/", func(c *fiber.Ctx) error {
+		logger.InfoContext(c.Context(), "from handler")
+		// Fan-out goroutines log with a context derived from the request.
+		ctx, cancel := context.WithCancel(c.Context())
+		defer cancel()
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger.InfoContext(ctx, "from goroutine")
+		}()
+		wg.Wait()
+		logger.InfoContext(c.UserContext(), "from user context")
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(middleware.RequestIDHeader, "trace-42")
+	if _, err := app.Test(req); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 log lines, got %d: %s", len(lines), buf.String())
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, `"request_id":"trace-42"`) {
+			t.Errorf("log line missing request_id: %s", line)
+		}
+	}
+}
+
+func TestNewRequestIDLogHandler_NoIDInContext(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	logger := slog.New(middleware.NewRequestIDLogHandler(slog.NewJSONHandler(&buf, nil)))
+	logger.InfoContext(context.Background(), "background")
+	if strings.Contains(buf.String(), "request_id") {
+		t.Errorf("unexpected request_id in %s", buf.String())
+	}
+}
+
+// lockedWriter serializes writes from concurrent log calls.
+type lockedWriter struct {
+	w  *bytes.Buffer
+	mu *sync.Mutex
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
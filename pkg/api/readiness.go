@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Readiness check states reported by /readyz.
+const (
+	readinessOK            = "ok"
+	readinessDown          = "down"
+	readinessDegraded      = "degraded"
+	readinessUnknown       = "unknown"
+	readinessNotConfigured = "not_configured"
+)
+
+// readinessCheck is the state of one dependency in the /readyz response.
+// Only a critical check that is down makes the server not ready.
+type readinessCheck struct {
+	Status   string `json:"status"`
+	Critical bool   `json:"critical"`
+	Message  string `json:"message,omitempty"`
+}
+
+// handleReadyz reports whether the server can serve traffic, with the state
+// of each dependency. It returns 503 while shutting down or when a critical
+// dependency is down. Only the database is critical: unreachable clusters,
+// missing GitHub configuration or a stopped MCP bridge leave the console
+// usable (it is how users find out their clusters are down), so taking the
+// pod out of rotation for them would only hide that information.
+func (s *Server) handleReadyz(c *fiber.Ctx) error {
+	if atomic.LoadInt32(&s.shuttingDown) == 1 {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"status": "shutting_down"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), serverHealthTimeout)
+	defer cancel()
+	checks := map[string]readinessCheck{
+		"database": s.checkDatabase(ctx),
+		"clusters": s.checkClusters(),
+		"github":   s.checkGitHub(),
+		"mcp":      s.checkMCPBridge(),
+	}
+
+	status, code := "ready", fiber.StatusOK
+	for _, check := range checks {
+		if check.Critical && check.Status == readinessDown {
+			status, code = "not_ready", fiber.StatusServiceUnavailable
+			break
+		}
+	}
+	return c.Status(code).JSON(fiber.Map{"status": status, "checks": checks})
+}
+
+// checkDatabase pings the store. /readyz is unauthenticated, so the ping
+// error is logged rather than returned.
+func (s *Server) checkDatabase(ctx context.Context) readinessCheck {
+	check := readinessCheck{Status: readinessOK, Critical: true}
+	if s.store == nil {
+		check.Status, check.Message = readinessDown, "store not initialized"
+	} else if err := s.store.Ping(ctx); err != nil {
+		slog.Error("[Readyz] database ping failed", "error", err)
+		check.Status, check.Message = readinessDown, "database unreachable"
+	}
+	return check
+}
+
+// checkClusters reads the health cache populated by the health poller, so
+// it never waits on a slow cluster.
+func (s *Server) checkClusters() readinessCheck {
+	if s.k8sClient == nil {
+		return readinessCheck{Status: readinessNotConfigured}
+	}
+	cached := s.k8sClient.GetCachedHealth()
+	if len(cached) == 0 {
+		return readinessCheck{Status: readinessUnknown, Message: "no cluster health checked yet"}
+	}
+	for _, h := range cached {
+		if h != nil && h.Reachable {
+			return readinessCheck{Status: readinessOK}
+		}
+	}
+	return readinessCheck{Status: readinessDegraded, Message: "no cluster is reachable"}
+}
+
+func (s *Server) checkGitHub() readinessCheck {
+	switch {
+	case s.oauthConfigured() && s.config.GitHubToken != "":
+		return readinessCheck{Status: readinessOK}
+	case s.oauthConfigured():
+		return readinessCheck{Status: readinessDegraded, Message: "GITHUB_TOKEN not set: feedback and GitHub features are disabled"}
+	case s.config.GitHubToken != "":
+		return readinessCheck{Status: readinessDegraded, Message: "GitHub OAuth not configured"}
+	default:
+		return readinessCheck{Status: readinessNotConfigured}
+	}
+}
+
+func (s *Server) checkMCPBridge() readinessCheck {
+	if s.bridge == nil {
+		return readinessCheck{Status: readinessNotConfigured}
+	}
+	status := s.bridge.Status()
+	available := func(client string) bool {
+		clientStatus, _ := status[client].(map[string]interface{})
+		ok, _ := clientStatus["available"].(bool)
+		return ok
+	}
+	ops, deploy, gadget := available("opsClient"), available("deployClient"), available("gadgetClient")
+	switch {
+	case !ops && !deploy && !gadget:
+		return readinessCheck{Status: readinessDown, Message: "no MCP plugin is running"}
+	case !ops || !deploy:
+		return readinessCheck{Status: readinessDegraded, Message: "some MCP plugins are not running"}
+	default:
+		return readinessCheck{Status: readinessOK}
+	}
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubestellar/console/pkg/store"
+)
+
+type readyzResponse struct {
+	Status string                    `json:"status"`
+	Checks map[string]readinessCheck `json:"checks"`
+}
+
+func getReadyz(t *testing.T, s *Server) (int, readyzResponse) {
+	t.Helper()
+	app := fiber.New()
+	app.Get("/readyz", s.handleReadyz)
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var body readyzResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return resp.StatusCode, body
+}
+
+func TestReadyz_ReflectsStore(t *testing.T) {
+	sqliteStore, err := store.NewSQLiteStore(filepath.Join(t.TempDir(), "readyz.db"))
+	require.NoError(t, err)
+	s := &Server{store: sqliteStore}
+
+	code, body := getReadyz(t, s)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ready", body.Status)
+	assert.Equal(t, readinessOK, body.Checks["database"].Status)
+	assert.Equal(t, readinessNotConfigured, body.Checks["clusters"].Status)
+	assert.Equal(t, readinessNotConfigured, body.Checks["mcp"].Status)
+
+	require.NoError(t, sqliteStore.Close())
+	code, body = getReadyz(t, s)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "not_ready", body.Status)
+	assert.Equal(t, readinessDown, body.Checks["database"].Status)
+	assert.Equal(t, "database unreachable", body.Checks["database"].Message, "the raw error must not leak")
+}
+
+func TestReadyz_ShuttingDown(t *testing.T) {
+	s := &Server{shuttingDown: 1}
+	app := fiber.New()
+	app.Get("/readyz", s.handleReadyz)
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// shutdownDrainTimeout bounds how long Shutdown waits for in-flight requests
+// after cancelling their contexts.
+const shutdownDrainTimeout = 10 * time.Second
+
+// shutdownContextMiddleware roots each request's UserContext at baseCtx, so
+// work a handler fans out from c.UserContext() stops as soon as Shutdown
+// starts instead of running on after the store and clients are closed. The
+// MCP handlers derive their per-cluster deadlines from c.UserContext() for
+// this reason; c.Context() is not cancelled by Shutdown.
+func (s *Server) shutdownContextMiddleware(c *fiber.Ctx) error {
+	if s.baseCtx != nil {
+		c.SetUserContext(s.baseCtx)
+	}
+	return c.Next()
+}
@@ -12,6 +12,7 @@ func (s *Server) setupGitOpsRoutes(api fiber.Router) {
 // GitOps routes (drift detection and sync)
 // SECURITY: All GitOps routes require authentication in both dev and production modes
 gitopsHandlers := handlers.NewGitOpsHandlers(s.bridge, s.k8sClient, s.store)
+gitopsHandlers.SetImpersonation(s.config.ImpersonateUsers)
 api.Get("/gitops/drifts", gitopsHandlers.ListDrifts)
 api.Get("/gitops/helm-releases", gitopsHandlers.ListHelmReleases)
 api.Get("/gitops/helm-history", gitopsHandlers.ListHelmHistory)
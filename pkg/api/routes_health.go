@@ -1,15 +1,19 @@
 package api
 
 import (
+"crypto/subtle"
 "strings"
 "sync/atomic"
 
 "github.com/gofiber/fiber/v2"
+"github.com/gofiber/fiber/v2/middleware/adaptor"
+
+"github.com/kubestellar/console/pkg/k8s"
 )
 
-// setupHealthRoutes registers the /healthz, /health, and /api/version
-// endpoints. These are unauthenticated and used by load balancers,
-// liveness probes, and the frontend boot sequence.
+// setupHealthRoutes registers the /healthz, /readyz, /health, /metrics and
+// /api/version endpoints. Apart from /metrics these are unauthenticated and
+// used by load balancers, liveness probes, and the frontend boot sequence.
 func (s *Server) setupHealthRoutes() {
 // Minimal probe endpoint for load balancers and k8s liveness checks.
 // Returns only status — no configuration metadata.
@@ -20,6 +24,18 @@ return c.JSON(fiber.Map{"status": "shutting_down"})
 return c.JSON(fiber.Map{"status": "ok"})
 })
 
+// Readiness probe — reports database, cluster, GitHub and MCP bridge state
+// and returns 503 when a critical dependency is down. See handleReadyz.
+s.app.Get("/readyz", s.handleReadyz)
+
+// Prometheus metrics — per-cluster request counts, error types and
+// latencies recorded by the k8s client. The labels name kubeconfig
+// contexts, so scrapers authenticate with METRICS_TOKEN instead of a
+// console session, and the endpoint is off while no token is configured.
+if s.config.MetricsToken != "" {
+s.app.Get("/metrics", requireBearerToken(s.config.MetricsToken), adaptor.HTTPHandler(k8s.MetricsHandler()))
+}
+
 // Health check — returns version and UI configuration for the frontend.
 // Build metadata (go_version, git_commit, etc.) lives in /api/version.
 s.app.Get("/health", func(c *fiber.Ctx) error {
@@ -59,6 +75,7 @@ resp := fiber.Map{
 "oauth_configured": s.oauthConfigured(),
 "in_cluster":       inCluster,
 "no_local_agent":   noLocalAgent,
+"read_only":        s.config.ReadOnly,
 "install_method":   detectInstallMethod(inCluster),
 "project":          s.config.ConsoleProject,
 "branding": fiber.Map{
@@ -126,3 +143,15 @@ return c.JSON(fiber.Map{
 })
 })
 }
+
+// requireBearerToken rejects requests whose Authorization header does not
+// carry token as a bearer token.
+func requireBearerToken(token string) fiber.Handler {
+return func(c *fiber.Ctx) error {
+got, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+}
+return c.Next()
+}
+}
@@ -3,6 +3,7 @@ package api
 import (
 "github.com/gofiber/fiber/v2"
 
+"github.com/kubestellar/console/pkg/api/audit"
 "github.com/kubestellar/console/pkg/api/handlers"
 )
 
@@ -11,64 +12,103 @@ import (
 // The workloadHandlers are created here and stored on s.workloadHandlers
 // because they have startup side effects (cache refresh, persisted groups).
 func (s *Server) setupK8sResourceRoutes(api fiber.Router) {
+// Namespace-scoped users are confined on every route below that reads
+// namespaced objects (see middleware.NamespaceScope).
+scope := s.namespaceScope()
+
 // MCS (Multi-Cluster Service) routes
 mcsHandlers := handlers.NewMCSHandlers(s.k8sClient, s.hub)
+mcsHandlers.SetImpersonation(s.config.ImpersonateUsers)
 api.Get("/mcs/status", mcsHandlers.GetMCSStatus)
+api.Get("/mcs/topology", mcsHandlers.GetMCSTopology)
 api.Get("/mcs/exports", mcsHandlers.ListServiceExports)
-api.Get("/mcs/exports/:cluster/:namespace/:name", mcsHandlers.GetServiceExport)
+api.Get("/mcs/exports/:cluster/:namespace/:name", scope, mcsHandlers.GetServiceExport)
 // Create/Delete ServiceExport routes removed in #7993 Phase 1.5 PR B.
 // User-initiated mutations now run via kc-agent /serviceexports under
 // the user's kubeconfig. The backend handlers had no frontend consumer.
 api.Get("/mcs/imports", mcsHandlers.ListServiceImports)
-api.Get("/mcs/imports/:cluster/:namespace/:name", mcsHandlers.GetServiceImport)
+api.Get("/mcs/imports/:cluster/:namespace/:name", scope, mcsHandlers.GetServiceImport)
+api.Get("/mcs/imports/:cluster/:namespace/:name/endpoints", scope, mcsHandlers.GetServiceImportEndpoints)
 
 // Gateway API routes
 gatewayHandlers := handlers.NewGatewayHandlers(s.k8sClient, s.hub)
+gatewayHandlers.SetImpersonation(s.config.ImpersonateUsers)
 api.Get("/gateway/status", gatewayHandlers.GetGatewayAPIStatus)
 api.Get("/gateway/gateways", gatewayHandlers.ListGateways)
-api.Get("/gateway/gateways/:cluster/:namespace/:name", gatewayHandlers.GetGateway)
+api.Get("/gateway/gateways/:cluster/:namespace/:name", scope, gatewayHandlers.GetGateway)
 api.Get("/gateway/httproutes", gatewayHandlers.ListHTTPRoutes)
-api.Get("/gateway/httproutes/:cluster/:namespace/:name", gatewayHandlers.GetHTTPRoute)
+api.Get("/gateway/httproutes/:cluster/:namespace/:name", scope, gatewayHandlers.GetHTTPRoute)
 
 // CRD routes (Custom Resource Definition browser)
 crdHandlers := handlers.NewCRDHandlers(s.k8sClient)
+crdHandlers.SetImpersonation(s.config.ImpersonateUsers)
 api.Get("/crds", crdHandlers.ListCRDs)
 
 // Lima routes (Lima VM status)
 limaHandlers := handlers.NewLimaHandlers(s.k8sClient)
+limaHandlers.SetImpersonation(s.config.ImpersonateUsers)
 api.Get("/lima", limaHandlers.ListLima)
 
 // MCS ServiceExport routes
 svcExportHandlers := handlers.NewServiceExportHandlers(s.k8sClient)
+svcExportHandlers.SetImpersonation(s.config.ImpersonateUsers)
 api.Get("/service-exports", svcExportHandlers.ListServiceExports)
 
 // Admission webhook routes
 webhookHandlers := handlers.NewWebhookHandlers(s.k8sClient)
+webhookHandlers.SetImpersonation(s.config.ImpersonateUsers)
 api.Get("/admission-webhooks", webhookHandlers.ListWebhooks)
 
+// GPU placement recommendation routes
+placementHandlers := handlers.NewPlacementHandlers(s.k8sClient)
+placementHandlers.SetImpersonation(s.config.ImpersonateUsers)
+api.Post("/placement/recommend", placementHandlers.RecommendPlacement)
+api.Post("/clusters/:cluster/project-fit", placementHandlers.ProjectFit)
+
+// Cluster comparison routes
+clusterDiffHandlers := handlers.NewClusterDiffHandlers(s.k8sClient)
+clusterDiffHandlers.SetImpersonation(s.config.ImpersonateUsers)
+clusterDiffHandlers.SetGenericResourcePolicy(s.genericResourcePolicy())
+api.Get("/clusters/diff", scope, clusterDiffHandlers.DiffClusters)
+api.Get("/clusters/diff/resource", scope, clusterDiffHandlers.DiffResource)
+
+// Kubeconfig current-context switch (local file edit, not a cluster mutation)
+clusterContextHandlers := handlers.NewClusterContextHandlers(s.k8sClient, s.store)
+api.Post("/clusters/current", audit.Wrap(audit.ActionSetCurrentContext, "kubeconfig_context", clusterContextHandlers.SetCurrentContext))
+api.Get("/clusters/:cluster/details", clusterContextHandlers.GetContextDetails)
+
+// Service detail routes
+serviceHandlers := handlers.NewServiceHandlers(s.k8sClient)
+serviceHandlers.SetImpersonation(s.config.ImpersonateUsers)
+api.Get("/services/:cluster/:namespace/:name/endpoints", scope, serviceHandlers.GetServiceEndpoints)
+
 // Service Topology routes
 topologyHandlers := handlers.NewTopologyHandlers(s.k8sClient, s.hub)
-api.Get("/topology", topologyHandlers.GetTopology)
+topologyHandlers.SetImpersonation(s.config.ImpersonateUsers)
+api.Get("/topology", scope, topologyHandlers.GetTopology)
 
 // Workload routes
 workloadHandlers := handlers.NewWorkloadHandlers(s.k8sClient, s.hub, s.store)
+workloadHandlers.SetImpersonation(s.config.ImpersonateUsers)
 // Reload persisted cluster groups on startup (#7013) and start periodic
 // refresh so multi-instance deployments converge on DB state (#10007).
 workloadHandlers.LoadPersistedClusterGroups()
 workloadHandlers.StartCacheRefresh()
 s.workloadHandlers = workloadHandlers
-api.Get("/workloads", workloadHandlers.ListWorkloads)
+api.Get("/workloads", scope, workloadHandlers.ListWorkloads)
 api.Get("/workloads/capabilities", workloadHandlers.GetClusterCapabilities)
 api.Get("/workloads/policies", workloadHandlers.ListBindingPolicies)
-api.Get("/workloads/deploy-status/:cluster/:namespace/:name", workloadHandlers.GetDeployStatus)
-api.Get("/workloads/deploy-logs/:cluster/:namespace/:name", workloadHandlers.GetDeployLogs)
-api.Get("/workloads/resolve-deps/:cluster/:namespace/:name", workloadHandlers.ResolveDependencies)
-api.Get("/workloads/monitor/:cluster/:namespace/:name", workloadHandlers.MonitorWorkload)
-api.Get("/workloads/:cluster/:namespace/:name", workloadHandlers.GetWorkload)
+api.Get("/workloads/deploy-status/:cluster/:namespace/:name", scope, workloadHandlers.GetDeployStatus)
+api.Get("/workloads/deploy-logs/:cluster/:namespace/:name", scope, workloadHandlers.GetDeployLogs)
+api.Get("/workloads/resolve-deps/:cluster/:namespace/:name", scope, workloadHandlers.ResolveDependencies)
+api.Get("/workloads/monitor/:cluster/:namespace/:name", scope, workloadHandlers.MonitorWorkload)
+api.Get("/workloads/:cluster/:namespace/:name", scope, workloadHandlers.GetWorkload)
 // NOTE: /workloads/deploy, /workloads/scale, and the DELETE
 // /workloads/:cluster/:namespace/:name route all moved to kc-agent
 // (#7993 Phase 1 PRs A and B). The agent uses the user's kubeconfig
 // instead of the backend pod SA for those mutating operations.
+// Multi-workload scale/restart/delete is kc-agent's POST /workloads/batch
+// for the same reason; there is deliberately no POST /api/workloads/batch.
 
 // Cluster Group routes
 api.Get("/cluster-groups", workloadHandlers.ListClusterGroups)
@@ -76,6 +116,9 @@ api.Post("/cluster-groups", workloadHandlers.CreateClusterGroup)
 api.Post("/cluster-groups/sync", workloadHandlers.SyncClusterGroups)
 api.Post("/cluster-groups/evaluate", workloadHandlers.EvaluateClusterQuery)
 api.Post("/cluster-groups/ai-query", workloadHandlers.GenerateClusterQuery)
+api.Get("/cluster-groups/labels", workloadHandlers.GetClusterLabelTaxonomy)
 api.Put("/cluster-groups/:name", workloadHandlers.UpdateClusterGroup)
 api.Delete("/cluster-groups/:name", workloadHandlers.DeleteClusterGroup)
+// Draining a group's nodes is kc-agent's POST /cluster-groups/drain (#7993):
+// it evicts pods, so it runs under the user's kubeconfig, not the pod SA.
 }
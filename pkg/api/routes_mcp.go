@@ -3,6 +3,7 @@ package api
 import (
 "github.com/gofiber/fiber/v2"
 
+"github.com/kubestellar/console/pkg/api/audit"
 "github.com/kubestellar/console/pkg/api/handlers"
 )
 
@@ -10,8 +11,18 @@ import (
 // variants and the /drasi/proxy/* reverse proxy. The namespaces handler
 // is shared with setupRoutes for the /api/namespaces endpoint.
 func (s *Server) setupMCPRoutes(api fiber.Router, namespaces *handlers.NamespaceHandler) {
+// Confine namespace-scoped users before any /mcp route runs.
+if s.namespaceAllowlist != nil {
+api.Use("/mcp", s.namespaceScope())
+}
+
 // MCP handlers (cluster operations via kubestellar tools and direct k8s)
 mcpHandlers := handlers.NewMCPHandlers(s.bridge, s.k8sClient, s.store)
+mcpHandlers.SetNamespaceGuardrail(s.config.DefaultNamespace, s.config.MaxAllNamespacePods)
+mcpHandlers.SetClusterTimeout(s.config.ClusterFanOutTimeout)
+mcpHandlers.SetImpersonation(s.config.ImpersonateUsers)
+mcpHandlers.SetGenericResourcePolicy(s.genericResourcePolicy())
+mcpHandlers.SetAlertRules(s.alertRules)
 
 // MCP routes — SECURITY: All MCP routes require authentication.
 // NOTE: /mcp/clusters and /mcp/clusters/health are registered as
@@ -21,8 +32,16 @@ api.Get("/mcp/status", mcpHandlers.GetStatus)
 api.Get("/mcp/tools/ops", mcpHandlers.GetOpsTools)
 api.Get("/mcp/tools/deploy", mcpHandlers.GetDeployTools)
 api.Get("/mcp/clusters/:cluster/health", mcpHandlers.GetClusterHealth)
+api.Get("/clusters/:cluster/version", mcpHandlers.GetClusterVersion)
+api.Get("/clusters/metadata", mcpHandlers.ListClusterMetadata)
+api.Get("/clusters/:cluster/metadata", mcpHandlers.GetClusterMetadata)
+api.Put("/clusters/:cluster/metadata", mcpHandlers.SetClusterMetadata)
+api.Delete("/clusters/:cluster/metadata", mcpHandlers.DeleteClusterMetadata)
 api.Get("/mcp/pods", mcpHandlers.GetPods)
+api.Get("/pods/top", mcpHandlers.GetTopPods)
 api.Get("/mcp/pod-issues", mcpHandlers.FindPodIssues)
+api.Get("/mcp/image-pull-issues", mcpHandlers.GetImagePullIssues)
+api.Get("/mcp/oom-events", mcpHandlers.GetOOMKilledPods)
 api.Get("/mcp/deployment-issues", mcpHandlers.FindDeploymentIssues)
 api.Get("/mcp/deployments", mcpHandlers.GetDeployments)
 api.Get("/mcp/gpu-nodes", mcpHandlers.GetGPUNodes)
@@ -33,24 +52,38 @@ api.Get("/mcp/gpu-nodes/health/cronjob", mcpHandlers.GetGPUHealthCronJobStatus)
 // body shape, running under the user's kubeconfig.
 api.Get("/mcp/gpu-nodes/health/cronjob/results", mcpHandlers.GetGPUHealthCronJobResults)
 api.Get("/mcp/nvidia-operators", mcpHandlers.GetNVIDIAOperatorStatus)
+api.Get("/gpu/summary", mcpHandlers.GetGPUSummary)
+api.Get("/fleet/summary", mcpHandlers.GetFleetSummary)
+api.Get("/fleet/alert-rules/evaluate", mcpHandlers.EvaluateAlertRules)
+api.Get("/clusters/health/stream", mcpHandlers.StreamClusterHealth)
 api.Get("/mcp/nodes", mcpHandlers.GetNodes)
+api.Get("/mcp/node-alerts", mcpHandlers.GetNodeAlerts)
+api.Get("/nodes/:cluster/:node/pods", s.namespaceScope(), mcpHandlers.GetPodsOnNode)
 api.Get("/mcp/flatcar/nodes", mcpHandlers.GetFlatcarNodes)
 api.Get("/mcp/events", mcpHandlers.GetEvents)
 api.Get("/mcp/events/warnings", mcpHandlers.GetWarningEvents)
 api.Get("/mcp/security-issues", mcpHandlers.CheckSecurityIssues)
+api.Get("/mcp/vulnerability-reports", mcpHandlers.GetVulnerabilityReports)
 api.Get("/mcp/services", mcpHandlers.GetServices)
 api.Get("/mcp/jobs", mcpHandlers.GetJobs)
 api.Get("/mcp/hpas", mcpHandlers.GetHPAs)
+api.Get("/mcp/hpas/events", mcpHandlers.GetHPAEvents)
+api.Get("/mcp/recent-changes", mcpHandlers.GetRecentChanges)
 api.Get("/mcp/configmaps", mcpHandlers.GetConfigMaps)
 api.Get("/mcp/secrets", mcpHandlers.GetSecrets)
+api.Get("/mcp/secrets/value", audit.Wrap(audit.ActionRevealSecret, "secret", mcpHandlers.GetSecretValue))
 api.Get("/mcp/serviceaccounts", mcpHandlers.GetServiceAccounts)
 api.Get("/mcp/pvcs", mcpHandlers.GetPVCs)
 api.Get("/mcp/pvs", mcpHandlers.GetPVs)
 api.Get("/mcp/resourcequotas", mcpHandlers.GetResourceQuotas)
-api.Post("/mcp/resourcequotas", mcpHandlers.CreateOrUpdateResourceQuota)
-api.Delete("/mcp/resourcequotas", mcpHandlers.DeleteResourceQuota)
+api.Post("/mcp/resourcequotas", audit.Wrap(audit.ActionCreateResourceQuota, "resource_quota", mcpHandlers.CreateOrUpdateResourceQuota))
+api.Delete("/mcp/resourcequotas", audit.Wrap(audit.ActionDeleteResourceQuota, "resource_quota", mcpHandlers.DeleteResourceQuota))
 api.Get("/mcp/limitranges", mcpHandlers.GetLimitRanges)
+api.Get("/templates", mcpHandlers.ListResourceTemplates)
+// Creating from a template runs via kc-agent /templates/{name}/create
+// under the user's kubeconfig (#7993).
 api.Get("/mcp/pods/logs", mcpHandlers.GetPodLogs)
+api.Get("/mcp/jobs/logs", mcpHandlers.GetJobLogs)
 api.Post("/mcp/tools/ops/call", mcpHandlers.CallOpsTool)
 api.Post("/mcp/tools/deploy/call", mcpHandlers.CallDeployTool)
 api.Get("/mcp/wasmcloud/hosts", mcpHandlers.GetWasmCloudHosts)
@@ -68,6 +101,9 @@ api.Get("/mcp/ingresses", mcpHandlers.GetIngresses)
 api.Get("/mcp/networkpolicies", mcpHandlers.GetNetworkPolicies)
 api.Get("/mcp/pod-network-stats", mcpHandlers.GetPodNetworkStats)
 api.Get("/mcp/resource-yaml", mcpHandlers.GetResourceYAML)
+// NOTE: the generic DELETE /resources/:cluster/:namespace/:gvr/:name
+// (with ?cascade=foreground|background|orphan) is served by kc-agent so the
+// delete runs under the user's kubeconfig (#7993).
 
 // Widget-friendly aliases — the widget registry references these shorter
 // paths.  Without explicit routes they fall through to the SPA catch-all
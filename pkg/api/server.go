@@ -187,6 +187,62 @@ type Config struct {
 	NoLocalAgent bool
 	// Watchdog support: when set, the backend listens on this port instead of Port
 	BackendPort int
+	// DefaultNamespace is used by pod and service lists when the request
+	// omits ?namespace= (DEFAULT_NAMESPACE). Empty keeps "all namespaces".
+	DefaultNamespace string
+	// MaxAllNamespacePods rejects all-namespace pod lists on clusters with
+	// more pods than this (MAX_ALL_NAMESPACE_PODS). Zero disables the check.
+	MaxAllNamespacePods int
+	// ClusterFanOutTimeout is the per-cluster deadline of requests that fan
+	// out to every cluster (CLUSTER_FANOUT_TIMEOUT, e.g. "30s"). Zero keeps
+	// the handlers' built-in defaults.
+	ClusterFanOutTimeout time.Duration
+	// ImpersonateUsers makes MCP cluster queries act as the signed-in user
+	// (Impersonate-User: their GitHub login) so cluster RBAC applies to them
+	// (K8S_IMPERSONATE_USERS). The console's credentials need the
+	// "impersonate" verb on users.
+	ImpersonateUsers bool
+	// ReadOnly rejects mutating requests to the cluster-affecting routes in
+	// middleware.ReadOnlyProtectedRoutes with 403 (READ_ONLY). Console state
+	// such as feedback and settings stays writable.
+	ReadOnly bool
+	// GenericResourceAllow and GenericResourceDeny are comma-separated
+	// "resource.group" lists limiting what the generic custom resource
+	// endpoint serves (GENERIC_RESOURCE_ALLOW, GENERIC_RESOURCE_DENY). An
+	// empty allow list allows everything not denied.
+	GenericResourceAllow string
+	GenericResourceDeny  string
+	// GenericResourceRevealSecrets lets the generic endpoint serve Secrets,
+	// which it otherwise refuses (GENERIC_RESOURCE_REVEAL_SECRETS).
+	GenericResourceRevealSecrets bool
+	// AlertRulesFile is a JSON array of k8s.AlertRule evaluated against the
+	// fleet summary by /api/fleet/alert-rules/evaluate (ALERT_RULES_FILE).
+	AlertRulesFile string
+	// FleetAlertWebhookURL receives a notification when an alert rule starts
+	// firing and when it resolves (FLEET_ALERT_WEBHOOK_URL). Empty disables
+	// delivery.
+	FleetAlertWebhookURL string
+	// FleetAlertWebhookFormat is the payload shape, "generic" JSON or
+	// "slack" (FLEET_ALERT_WEBHOOK_FORMAT).
+	FleetAlertWebhookFormat string
+	// FleetAlertWebhookTemplateFile is a text/template over
+	// notifications.Alert used as the payload instead
+	// (FLEET_ALERT_WEBHOOK_TEMPLATE_FILE).
+	FleetAlertWebhookTemplateFile string
+	// FleetAlertInterval is how often the rules are evaluated for delivery
+	// (FLEET_ALERT_INTERVAL, e.g. "1m").
+	FleetAlertInterval time.Duration
+	// FleetAlertDebounce is the minimum time between two firing
+	// notifications of one rule (FLEET_ALERT_DEBOUNCE, e.g. "15m").
+	FleetAlertDebounce time.Duration
+	// NamespaceAllowlist confines roles or users to namespaces on the /mcp
+	// routes (NAMESPACE_ALLOWLIST), e.g. "viewer=team-a;user:alice=team-b".
+	// See middleware.ParseNamespaceAllowlist. Admins are never restricted.
+	NamespaceAllowlist string
+	// MetricsToken is the bearer token Prometheus must send to scrape
+	// /metrics (METRICS_TOKEN). The metric labels carry kubeconfig context
+	// names, so /metrics is not served at all while this is empty.
+	MetricsToken string
 }
 
 // Server represents the API server
@@ -204,11 +260,17 @@ type Server struct {
 	oauthMu             sync.RWMutex          // protects authHandler during manifest flow hot-reload
 	shuttingDown        int32                 // atomic flag: 1 during graceful shutdown
 	gpuUtilWorker       *GPUUtilizationWorker
+	alertRules          []k8s.AlertRule            // from Config.AlertRulesFile
+	fleetAlertWorker    *FleetAlertWorker          // nil unless a fleet alert webhook is configured
 	workloadHandlers    *handlers.WorkloadHandlers // for cache refresh shutdown (#10007)
 	rewardsHandler      *handlers.RewardsHandler   // for eviction goroutine shutdown
 	failureTracker      *middleware.FailureTracker  // tracks auth failure counts for rate limiting
 	done                chan struct{}              // closed on Shutdown to stop background goroutines
+	baseCtx             context.Context            // root of every request's UserContext; cancelled on Shutdown
+	cancelBase          context.CancelFunc
 	shutdownOnce        sync.Once                  // ensures Shutdown is idempotent (#6478)
+	// namespaceAllowlist is parsed from Config.NamespaceAllowlist; nil when unset.
+	namespaceAllowlist *middleware.NamespaceAllowlist
 }
 
 // NewServer creates a new API server. It starts a temporary loading page
@@ -379,6 +441,14 @@ func NewServer(cfg Config) (*Server, error) {
 	}
 	slog.Info("[Server] settings manager initialized", "path", settingsManager.GetSettingsPath())
 
+	// An invalid allowlist fails startup rather than leaving scoped users
+	// unrestricted.
+	namespaceAllowlist, err := middleware.ParseNamespaceAllowlist(cfg.NamespaceAllowlist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid NAMESPACE_ALLOWLIST: %w", err)
+	}
+
+	baseCtx, cancelBase := context.WithCancel(context.Background())
 	server := &Server{
 		app:                 app,
 		store:               db,
@@ -389,12 +459,16 @@ func NewServer(cfg Config) (*Server, error) {
 		notificationService: notificationService,
 		persistenceStore:    persistenceStore,
 		loadingSrv:          loadingSrv,
+		namespaceAllowlist:  namespaceAllowlist,
 		done:                make(chan struct{}),
+		baseCtx:             baseCtx,
+		cancelBase:          cancelBase,
 	}
 
 	// Enable SQLite persistence for audit entries (#8670 Phase 3).
 	audit.SetStore(db)
 
+	server.alertRules = server.loadAlertRules()
 	server.setupMiddleware()
 	server.setupRoutes()
 
@@ -406,6 +480,17 @@ func NewServer(cfg Config) (*Server, error) {
 		slog.Info("[Server] GPU utilization worker skipped — no Kubernetes client available")
 	}
 
+	// Deliver fleet alert rule transitions to the configured webhook
+	if k8sClient != nil && cfg.FleetAlertWebhookURL != "" && len(server.alertRules) > 0 {
+		if notifier, err := newFleetAlertNotifier(cfg); err != nil {
+			slog.Error("[Server] fleet alert webhook disabled", "error", err)
+		} else {
+			server.fleetAlertWorker = NewFleetAlertWorker(k8sClient, server.alertRules, notifier,
+				cfg.FleetAlertInterval, cfg.FleetAlertDebounce)
+			server.fleetAlertWorker.Start()
+		}
+	}
+
 	slog.Info("Server initialization complete")
 
 	return server, nil
@@ -454,6 +539,13 @@ func (s *Server) setupMiddleware() {
 	// Recovery middleware
 	s.app.Use(recover.New())
 
+	// Root request contexts at baseCtx so Shutdown can cancel in-flight
+	// fan-outs. Must run before RequestID, which layers onto UserContext.
+	s.app.Use(s.shutdownContextMiddleware)
+
+	// Request ID — first so every later middleware and handler log can use it
+	s.app.Use(middleware.RequestID())
+
 	// Gzip/Brotli compression for API responses only — static assets are pre-compressed at build time.
 	// The handler is created once and reused across requests (#7575).
 	compressHandler := compress.New(compress.Config{
@@ -469,7 +561,7 @@ func (s *Server) setupMiddleware() {
 
 	// Logger
 	s.app.Use(logger.New(logger.Config{
-		Format:     "${time} | ${status} | ${latency} | ${method} ${path}\n",
+		Format:     "${time} | ${status} | ${latency} | ${method} ${path} | ${respHeader:X-Request-ID}\n",
 		TimeFormat: "15:04:05",
 	}))
 
@@ -477,8 +569,8 @@ func (s *Server) setupMiddleware() {
 	s.app.Use(cors.New(cors.Config{
 		AllowOrigins:     s.config.FrontendURL,
 		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders:     "Origin,Content-Type,Accept,Authorization,X-Requested-With,X-KC-Client-Auth",
-		ExposeHeaders:    "X-Token-Refresh",
+		AllowHeaders:     "Origin,Content-Type,Accept,Authorization,X-Requested-With,X-KC-Client-Auth,X-Request-ID",
+		ExposeHeaders:    "X-Token-Refresh,X-Request-ID",
 		AllowCredentials: true,
 	}))
 
@@ -548,6 +640,13 @@ func (s *Server) setupMiddleware() {
 
 		return c.Next()
 	})
+
+	// Read-only mode rejects cluster-affecting mutations before any route
+	// runs (READ_ONLY=true).
+	if s.config.ReadOnly {
+		slog.Info("[Server] read-only mode: cluster mutations are disabled")
+		s.app.Use(middleware.ReadOnly(middleware.ReadOnlyProtectedRoutes, middleware.ReadOnlyExemptRoutes))
+	}
 }
 
 // startupLoadingHTML is a self-contained loading page served while the server initializes.
@@ -601,6 +700,47 @@ func (s *Server) oauthConfigured() bool {
 	return s.config.GitHubClientID != "" && s.config.GitHubSecret != ""
 }
 
+// genericResourcePolicy returns the configured policy of the generic
+// resource endpoints.
+func (s *Server) genericResourcePolicy() k8s.GenericResourcePolicy {
+	return k8s.NewGenericResourcePolicy(s.config.GenericResourceAllow,
+		s.config.GenericResourceDeny, s.config.GenericResourceRevealSecrets)
+}
+
+// loadAlertRules loads the configured alert rules. An unreadable or invalid
+// file is logged and no rules are run.
+func (s *Server) loadAlertRules() []k8s.AlertRule {
+	if s.config.AlertRulesFile == "" {
+		return nil
+	}
+	rules, err := k8s.LoadAlertRules(s.config.AlertRulesFile)
+	if err != nil {
+		slog.Error("[Server] ignoring alert rules", "path", s.config.AlertRulesFile, "error", err)
+		return nil
+	}
+	return rules
+}
+
+// namespaceScope returns the NamespaceScope middleware for the configured
+// namespace allowlist, a pass-through when none is set. Routes outside /mcp
+// that read or list namespaced objects mount it individually.
+func (s *Server) namespaceScope() fiber.Handler {
+	return middleware.NamespaceScope(s.namespaceAllowlist, s.userRole)
+}
+
+// userRole returns the signed-in user's role for the namespace allowlist,
+// empty when the user is unknown.
+func (s *Server) userRole(c *fiber.Ctx) (string, error) {
+	user, err := s.store.GetUser(c.UserContext(), middleware.GetUserID(c))
+	if err != nil {
+		return "", fiber.NewError(fiber.StatusInternalServerError, "Failed to verify user role")
+	}
+	if user == nil {
+		return "", nil
+	}
+	return user.Role, nil
+}
+
 // resolveOAuthCredentials checks the SQLite store for persisted OAuth
 // credentials (from the GitHub App Manifest flow) when env vars are empty.
 func (s *Server) resolveOAuthCredentials() {
@@ -856,6 +996,8 @@ s.failureTracker = failureTracker
 	}
 
 	// Feedback POST route: uses its own feedbackLimiter (10 req/hr per user).
+	// CreateFeatureRequest additionally enforces FEEDBACK_REQUESTS_PER_HOUR
+	// from stored request timestamps, which survives restarts.
 	// The apiLimiterWithSkip wrapper exempts this path so dashboard polling
 	// cannot block user feedback submission (#9969).
 	feedbackCfg := handlers.LoadFeedbackConfig()
@@ -1038,6 +1180,7 @@ s.failureTracker = failureTracker
 
 	// RBAC and User Management routes
 	rbac := handlers.NewRBACHandler(s.store, s.k8sClient)
+	rbac.SetImpersonation(s.config.ImpersonateUsers)
 	api.Get("/users", rbac.ListConsoleUsers)
 	api.Put("/users/:id/role", rbac.UpdateUserRole)
 	api.Delete("/users/:id", rbac.DeleteConsoleUser)
@@ -1059,7 +1202,8 @@ s.failureTracker = failureTracker
 	// ${LOCAL_AGENT_HTTP_URL}/permissions/summary, and
 	// ${LOCAL_AGENT_HTTP_URL}/rbac/can-i so SelfSubjectAccessReviews run
 	// under the user's kubeconfig instead of the backend pod ServiceAccount
-	// when console is deployed in-cluster.
+	// when console is deployed in-cluster. The namespace-scoped
+	// POST /clusters/:cluster/can-i (deploy preflight) lives there too.
 
 	// Admin audit-log endpoint (#8670 Phase 3) — returns recent audit entries.
 	auditHandler := handlers.NewAuditHandler(s.store)
@@ -1085,7 +1229,10 @@ s.failureTracker = failureTracker
 	// migrated to kc-agent in #7993 Phases 1.5 and 2 — they now run under the
 	// user's kubeconfig instead of the backend pod ServiceAccount.
 	namespaces := handlers.NewNamespaceHandler(s.store, s.k8sClient)
-	api.Get("/namespaces", namespaces.ListNamespaces)
+	namespaces.SetClusterTimeout(s.config.ClusterFanOutTimeout)
+	namespaces.SetImpersonation(s.config.ImpersonateUsers)
+	api.Get("/namespaces", s.namespaceScope(), namespaces.ListNamespaces)
+	api.Get("/namespaces/summary", s.namespaceScope(), namespaces.GetNamespaces)
 	api.Get("/namespaces/:name/access", namespaces.GetNamespaceAccess)
 
 	// Admin visibility routes — rate-limit metrics (#8676 Phase 3).
@@ -1116,6 +1263,9 @@ s.failureTracker = failureTracker
 	// hits 401, retries cascade, and eventually trigger 429 rate-limits
 	// (#10925). In production (OAuth configured) full JWTAuth is applied.
 	mcpHandlers := handlers.NewMCPHandlers(s.bridge, s.k8sClient, s.store)
+	mcpHandlers.SetClusterTimeout(s.config.ClusterFanOutTimeout)
+	mcpHandlers.SetImpersonation(s.config.ImpersonateUsers)
+	mcpHandlers.SetGenericResourcePolicy(s.genericResourcePolicy())
 	clusterDiscoveryAuth := middleware.JWTAuth(s.config.JWTSecret)
 	if s.config.DevMode {
 		// In dev mode, allow unauthenticated cluster discovery so the
@@ -1140,16 +1290,25 @@ s.failureTracker = failureTracker
 	api.Post("/feedback/requests/:id/feedback", feedback.SubmitFeedback)
 	api.Post("/feedback/requests/:id/close", feedback.CloseRequest)
 	api.Post("/feedback/requests/:id/request-update", feedback.RequestUpdate)
+	api.Post("/feedback/requests/:id/vote", feedback.VoteFeatureRequest)
+	api.Delete("/feedback/requests/:id/vote", feedback.UnvoteFeatureRequest)
 	api.Get("/feedback/preview/:pr_number", feedback.CheckPreviewStatus)
 	api.Get("/notifications", feedback.GetNotifications)
 	api.Get("/notifications/unread-count", feedback.GetUnreadCount)
 	api.Post("/notifications/:id/read", feedback.MarkNotificationRead)
 	api.Post("/notifications/read-all", feedback.MarkAllNotificationsRead)
+	api.Get("/notifications/preferences", feedback.GetNotificationPreferences)
+	api.Put("/notifications/preferences", feedback.UpdateNotificationPreferences)
 
 	// Benchmark data routes (llm-d benchmark results from Google Drive)
-	benchmarkHandlers := handlers.NewBenchmarkHandlers(s.config.BenchmarkGoogleDriveAPIKey, s.config.BenchmarkFolderID)
+	benchmarkHandlers := handlers.NewBenchmarkHandlers(s.config.BenchmarkGoogleDriveAPIKey, s.config.BenchmarkFolderID, s.store)
 	api.Get("/benchmarks/reports", benchmarkHandlers.GetReports)
 	api.Get("/benchmarks/reports/stream", benchmarkHandlers.StreamReports)
+	api.Get("/benchmarks/baselines", benchmarkHandlers.ListBaselines)
+	api.Post("/benchmarks/baselines", benchmarkHandlers.MarkBaseline)
+	api.Delete("/benchmarks/baselines/:uid", benchmarkHandlers.UnmarkBaseline)
+	api.Get("/benchmarks/compare", benchmarkHandlers.CompareReports)
+	api.Get("/benchmarks/export", benchmarkHandlers.ExportReports)
 
 	// GitHub activity rewards (points for issues/PRs across configured orgs)
 	s.rewardsHandler = handlers.NewRewardsHandler(handlers.RewardsConfig{
@@ -1503,6 +1662,11 @@ func (s *Server) Shutdown() error {
 
 		// Signal background goroutines (orbit scheduler, etc.) to stop.
 		close(s.done)
+		// Cancel every in-flight request's context so multi-cluster fan-outs
+		// return promptly instead of racing the teardown below.
+		if s.cancelBase != nil {
+			s.cancelBase()
+		}
 
 		// If Shutdown is called before Start, the temporary loading server
 		// is still running and holding the port. Shut it down first.
@@ -1516,6 +1680,9 @@ func (s *Server) Shutdown() error {
 		if s.gpuUtilWorker != nil {
 			s.gpuUtilWorker.Stop()
 		}
+		if s.fleetAlertWorker != nil {
+			s.fleetAlertWorker.Stop()
+		}
 		s.hub.Close()
 		// #10007 — stop the periodic cluster group cache refresh goroutine.
 		if s.workloadHandlers != nil {
@@ -1543,11 +1710,14 @@ func (s *Server) Shutdown() error {
 				slog.Error("[Server] MCP bridge shutdown error", "error", err)
 			}
 		}
-		if err := s.store.Close(); err != nil {
+		// Wait for in-flight requests to finish before closing the store
+		// they may still be writing to. Their contexts are already
+		// cancelled, so this is normally quick; the timeout bounds handlers
+		// that ignore cancellation.
+		shutdownErr = s.app.ShutdownWithTimeout(shutdownDrainTimeout)
+		if err := s.store.Close(); err != nil && shutdownErr == nil {
 			shutdownErr = err
-			return
 		}
-		shutdownErr = s.app.Shutdown()
 	})
 	return shutdownErr
 }
@@ -1586,6 +1756,24 @@ func LoadConfigFromEnv() Config {
 		}
 	}
 
+	var maxAllNamespacePods int
+	if p := os.Getenv("MAX_ALL_NAMESPACE_PODS"); p != "" {
+		if v, err := strconv.Atoi(p); err != nil || v < 0 {
+			slog.Warn("[Server] invalid MAX_ALL_NAMESPACE_PODS, ignoring", "value", p, "error", err)
+		} else {
+			maxAllNamespacePods = v
+		}
+	}
+
+	var clusterFanOutTimeout time.Duration
+	if p := os.Getenv("CLUSTER_FANOUT_TIMEOUT"); p != "" {
+		if v, err := time.ParseDuration(p); err != nil || v <= 0 {
+			slog.Warn("[Server] invalid CLUSTER_FANOUT_TIMEOUT, using default", "value", p, "error", err)
+		} else {
+			clusterFanOutTimeout = v
+		}
+	}
+
 	dbPath := "./data/console.db"
 	if p := os.Getenv("DATABASE_PATH"); p != "" {
 		dbPath = p
@@ -1679,7 +1867,46 @@ func LoadConfigFromEnv() Config {
 		NoLocalAgent: os.Getenv("NO_LOCAL_AGENT") == "true",
 		// Watchdog backend port override
 		BackendPort: backendPort,
+		// All-namespaces list guardrail
+		DefaultNamespace:    os.Getenv("DEFAULT_NAMESPACE"),
+		MaxAllNamespacePods: maxAllNamespacePods,
+		// Per-cluster deadline for multi-cluster fan-outs
+		ClusterFanOutTimeout: clusterFanOutTimeout,
+		// Act as the signed-in user against clusters
+		ImpersonateUsers: os.Getenv("K8S_IMPERSONATE_USERS") == "true",
+		// Reject cluster mutations
+		ReadOnly: os.Getenv("READ_ONLY") == "true",
+		// Resources served by the generic resource endpoint
+		GenericResourceAllow:         os.Getenv("GENERIC_RESOURCE_ALLOW"),
+		GenericResourceDeny:          os.Getenv("GENERIC_RESOURCE_DENY"),
+		GenericResourceRevealSecrets: os.Getenv("GENERIC_RESOURCE_REVEAL_SECRETS") == "true",
+		// Fleet alert rules
+		AlertRulesFile:                os.Getenv("ALERT_RULES_FILE"),
+		FleetAlertWebhookURL:          os.Getenv("FLEET_ALERT_WEBHOOK_URL"),
+		FleetAlertWebhookFormat:       os.Getenv("FLEET_ALERT_WEBHOOK_FORMAT"),
+		FleetAlertWebhookTemplateFile: os.Getenv("FLEET_ALERT_WEBHOOK_TEMPLATE_FILE"),
+		FleetAlertInterval:            positiveDurationEnv("FLEET_ALERT_INTERVAL"),
+		FleetAlertDebounce:            positiveDurationEnv("FLEET_ALERT_DEBOUNCE"),
+		// Namespaces each role or user may see
+		NamespaceAllowlist: os.Getenv("NAMESPACE_ALLOWLIST"),
+		// Bearer token for scraping /metrics
+		MetricsToken: os.Getenv("METRICS_TOKEN"),
+	}
+}
+
+// positiveDurationEnv parses key as a positive duration, returning zero
+// (the caller's default) when it is unset or invalid.
+func positiveDurationEnv(key string) time.Duration {
+	p := os.Getenv(key)
+	if p == "" {
+		return 0
+	}
+	v, err := time.ParseDuration(p)
+	if err != nil || v <= 0 {
+		slog.Warn("[Server] invalid duration, using default", "env", key, "value", p, "error", err)
+		return 0
 	}
+	return v
 }
 
 func getEnvOrDefault(key, defaultVal string) string {
@@ -7,9 +7,11 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -83,6 +85,35 @@ func TestLoadConfigFromEnv_KCAgentToken(t *testing.T) {
 		"KC_AGENT_TOKEN must be read from env so backend can expose it to the frontend")
 }
 
+func TestRequireBearerToken(t *testing.T) {
+	app := fiber.New()
+	app.Get("/metrics", requireBearerToken("scrape-secret"), func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	cases := []struct {
+		name   string
+		header string
+		want   int
+	}{
+		{"no header", "", http.StatusUnauthorized},
+		{"wrong token", "Bearer nope", http.StatusUnauthorized},
+		{"not bearer", "Basic scrape-secret", http.StatusUnauthorized},
+		{"valid token", "Bearer scrape-secret", http.StatusOK},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, resp.StatusCode)
+		})
+	}
+}
+
 func TestHealth_OAuthConfiguredRequiresBothIdAndSecret(t *testing.T) {
 	cases := []struct {
 		name     string
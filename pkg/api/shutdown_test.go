@@ -1,12 +1,23 @@
 package api
 
 import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/kubestellar/console/pkg/api/handlers"
+	"github.com/kubestellar/console/pkg/k8s"
 	"github.com/kubestellar/console/pkg/store"
 )
 
@@ -57,3 +68,108 @@ func TestShutdown_Idempotent(t *testing.T) {
 		t.Fatalf("second Shutdown returned error: %v", err)
 	}
 }
+
+// TestShutdown_CancelsInFlightFanOut sends GetPods across every cluster
+// against an apiserver that never answers pod lists, then shuts the server
+// down and checks the handler's per-cluster calls are cancelled and drained
+// well before the slow work would have finished on its own.
+func TestShutdown_CancelsInFlightFanOut(t *testing.T) {
+	const (
+		fanOut       = 3
+		slowWork     = 30 * time.Second
+		promptCancel = 5 * time.Second
+	)
+
+	started := make(chan struct{}, fanOut)
+	var cancelled atomic.Int32
+	apiserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/pods") {
+			http.NotFound(w, r)
+			return
+		}
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		select {
+		case <-r.Context().Done():
+			cancelled.Add(1)
+		case <-time.After(slowWork):
+		}
+	}))
+	defer apiserver.Close()
+
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	cfg := clientcmdapi.NewConfig()
+	for i := 0; i < fanOut; i++ {
+		// A path prefix per cluster keeps DeduplicatedClusters, which groups
+		// by server URL, from folding the contexts into one.
+		name := fmt.Sprintf("cluster-%d", i)
+		cfg.Clusters[name] = &clientcmdapi.Cluster{Server: apiserver.URL + "/" + name}
+		cfg.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: name}
+		cfg.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	}
+	cfg.CurrentContext = "cluster-0"
+	if err := clientcmd.WriteToFile(*cfg, kubeconfig); err != nil {
+		t.Fatalf("WriteToFile: %v", err)
+	}
+	k8sClient, err := k8s.NewMultiClusterClient(kubeconfig)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+	if err := k8sClient.LoadConfig(); err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	sqliteStore, err := store.NewSQLiteStore(filepath.Join(t.TempDir(), "shutdown-fanout.db"))
+	if err != nil {
+		t.Fatalf("failed to open sqlite store: %v", err)
+	}
+	baseCtx, cancelBase := context.WithCancel(context.Background())
+	s := &Server{
+		app:        fiber.New(),
+		store:      sqliteStore,
+		hub:        handlers.NewHub(),
+		done:       make(chan struct{}),
+		baseCtx:    baseCtx,
+		cancelBase: cancelBase,
+	}
+	s.app.Use(s.shutdownContextMiddleware)
+	mcpHandlers := handlers.NewMCPHandlers(nil, k8sClient, nil)
+	s.app.Get("/api/mcp/pods", mcpHandlers.GetPods)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go func() { _ = s.app.Listener(ln) }()
+	go func() {
+		client := &http.Client{Timeout: slowWork}
+		if resp, err := client.Get("http://" + ln.Addr().String() + "/api/mcp/pods?namespace=default"); err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	for i := 0; i < fanOut; i++ {
+		select {
+		case <-started:
+		case <-time.After(promptCancel):
+			t.Fatal("fan-out pod lists did not reach the apiserver")
+		}
+	}
+
+	start := time.Now()
+	if err := s.Shutdown(); err != nil {
+		t.Fatalf("Shutdown returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > promptCancel {
+		t.Errorf("Shutdown took %v, want in-flight work cancelled promptly", elapsed)
+	}
+	deadline := time.Now().Add(promptCancel)
+	for cancelled.Load() != fanOut && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := cancelled.Load(); got != fanOut {
+		t.Errorf("%d of %d fan-out pod lists observed cancellation", got, fanOut)
+	}
+}
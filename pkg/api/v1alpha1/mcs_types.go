@@ -57,17 +57,49 @@ const (
 
 // ServiceImport represents an imported service from another cluster
 type ServiceImport struct {
-	Name          string            `json:"name"`
-	Namespace     string            `json:"namespace"`
-	Cluster       string            `json:"cluster"`
-	SourceCluster string            `json:"sourceCluster,omitempty"`
-	Type          ServiceImportType `json:"type"`
-	DNSName       string            `json:"dnsName,omitempty"`
-	ClusterSetIPs []string          `json:"clusterSetIPs,omitempty"`
-	Ports         []ServicePort     `json:"ports,omitempty"`
-	Endpoints     int               `json:"endpoints"`
-	CreatedAt     time.Time         `json:"createdAt"`
-	Conditions    []Condition       `json:"conditions,omitempty"`
+	Name           string            `json:"name"`
+	Namespace      string            `json:"namespace"`
+	Cluster        string            `json:"cluster"`
+	SourceCluster  string            `json:"sourceCluster,omitempty"`
+	SourceClusters []string          `json:"sourceClusters,omitempty"` // every exporting cluster, sorted
+	Type           ServiceImportType `json:"type"`
+	DNSName        string            `json:"dnsName,omitempty"`
+	ClusterSetIPs  []string          `json:"clusterSetIPs,omitempty"`
+	Ports          []ServicePort     `json:"ports,omitempty"`
+	Endpoints      int               `json:"endpoints"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	Conditions     []Condition       `json:"conditions,omitempty"`
+	// Conflicts describes port definitions its source clusters disagree
+	// on, and any Conflict condition set by the MCS controller.
+	Conflicts []string `json:"conflicts,omitempty"`
+}
+
+// ServiceImportClusterEndpoints is the mirrored endpoint readiness for one
+// source cluster of a ServiceImport
+type ServiceImportClusterEndpoints struct {
+	SourceCluster string `json:"sourceCluster"`
+	Slices        int    `json:"slices"`
+	Ready         int    `json:"ready"`
+	NotReady      int    `json:"notReady"`
+}
+
+// ServiceImportEndpoints reports whether a ServiceImport's endpoints have
+// been mirrored into the importing cluster
+type ServiceImportEndpoints struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster"`
+	// MCSAvailable is false when the MCS CRDs are not installed; the
+	// remaining fields are then empty.
+	MCSAvailable bool `json:"mcsAvailable"`
+	ImportFound  bool `json:"importFound"`
+	// Clusters lists every source cluster named in the import status or on
+	// a mirrored EndpointSlice, sorted by name. A source cluster with zero
+	// slices has not been mirrored yet.
+	Clusters   []ServiceImportClusterEndpoints `json:"clusters"`
+	TotalReady int                             `json:"totalReady"`
+	// Reachable is true when at least one mirrored endpoint is ready.
+	Reachable bool `json:"reachable"`
 }
 
 // ServicePort represents a port exposed by a service
@@ -112,6 +144,49 @@ type ServiceImportList struct {
 	ClusterErrors []MCSClusterError `json:"clusterErrors,omitempty"`
 }
 
+// MCS topology node and edge types
+const (
+	MCSTopologyNodeCluster = "cluster"
+	MCSTopologyNodeService = "service"
+	MCSTopologyEdgeExport  = "exports"
+	MCSTopologyEdgeImport  = "imports"
+)
+
+// MCSTopologyService is one multi-cluster service, keyed by namespace/name,
+// with the clusters exporting and importing it
+type MCSTopologyService struct {
+	Name         string   `json:"name"`
+	Namespace    string   `json:"namespace"`
+	DNSName      string   `json:"dnsName"`
+	ExportedFrom []string `json:"exportedFrom"`
+	ImportedInto []string `json:"importedInto"`
+}
+
+// MCSTopologyNode is a cluster or service node in the topology graph
+type MCSTopologyNode struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Label string `json:"label"`
+}
+
+// MCSTopologyEdge links an exporting cluster to a service ("exports") or a
+// service to an importing cluster ("imports")
+type MCSTopologyEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Type   string `json:"type"`
+}
+
+// MCSTopology is the clusterset-wide export/import graph
+type MCSTopology struct {
+	Services []MCSTopologyService `json:"services"`
+	Nodes    []MCSTopologyNode    `json:"nodes"`
+	Edges    []MCSTopologyEdge    `json:"edges"`
+	// SkippedClusters do not have the MCS CRDs installed.
+	SkippedClusters []string          `json:"skippedClusters,omitempty"`
+	ClusterErrors   []MCSClusterError `json:"clusterErrors,omitempty"`
+}
+
 // ClusterServiceSummary provides a per-cluster summary of MCS resources
 type ClusterServiceSummary struct {
 	Cluster      string `json:"cluster"`
@@ -43,6 +43,8 @@ const (
 	WorkloadTypeDeployment  WorkloadType = "Deployment"
 	WorkloadTypeStatefulSet WorkloadType = "StatefulSet"
 	WorkloadTypeDaemonSet   WorkloadType = "DaemonSet"
+	WorkloadTypeReplicaSet  WorkloadType = "ReplicaSet"
+	WorkloadTypePod         WorkloadType = "Pod"
 	WorkloadTypeJob         WorkloadType = "Job"
 	WorkloadTypeCronJob     WorkloadType = "CronJob"
 	WorkloadTypeCustom      WorkloadType = "Custom"
@@ -68,6 +70,16 @@ type Workload struct {
 	Message   string    `json:"message,omitempty"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	// RootOwner is the top-most controller the workload rolls up to through
+	// its ownerReferences; the workload itself when it has no controller.
+	RootOwner *WorkloadOwner `json:"rootOwner,omitempty"`
+}
+
+// WorkloadOwner identifies a controller object by kind and name, within the
+// namespace of the object it owns.
+type WorkloadOwner struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
 }
 
 // ClusterDeployment represents the deployment status of a workload in a specific cluster
@@ -121,6 +133,47 @@ type DeployResponse struct {
 	FailedClusters []string      `json:"failedClusters,omitempty"`
 	Dependencies   []DeployedDep `json:"dependencies,omitempty"`
 	Warnings       []string      `json:"warnings,omitempty"`
+	// Rollback is set when an atomic deploy failed and the resources it had
+	// created were removed again.
+	Rollback *DeployRollback `json:"rollback,omitempty"`
+}
+
+// DeployRollback reports the cleanup of a failed atomic deploy. RolledBack
+// lists resources created by the deploy and deleted again; LeftInPlace
+// lists resources the deploy touched but did not remove: pre-existing ones
+// it updated or skipped, and created ones whose delete failed (with Error).
+type DeployRollback struct {
+	RolledBack  []DeployedResource `json:"rolledBack"`
+	LeftInPlace []DeployedResource `json:"leftInPlace"`
+}
+
+// DeployedResource identifies one resource on one target cluster.
+type DeployedResource struct {
+	Cluster   string `json:"cluster"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Action    string `json:"action,omitempty"` // what the deploy did: "created", "updated", "skipped"
+	Error     string `json:"error,omitempty"`
+}
+
+// Deploy progress statuses reported through DeployProgress.Status
+const (
+	DeployProgressApplying = "applying"
+	DeployProgressApplied  = "applied"
+	DeployProgressFailed   = "failed"
+)
+
+// DeployProgress is one step of a streamed deploy: a single dependency, or
+// the workload itself, on one target cluster. Action carries the
+// DeployedDep action ("created", "updated", "skipped") once applied.
+type DeployProgress struct {
+	Cluster string `json:"cluster"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Action  string `json:"action,omitempty"`
+	Error   string `json:"error,omitempty"`
 }
 
 // BindingPolicy represents a KubeStellar BindingPolicy for workload placement
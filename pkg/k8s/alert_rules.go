@@ -0,0 +1,127 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Alert rule metrics, computed from the fleet summary detectors.
+const (
+	// AlertMetricPodIssueRatio is the fraction of the fleet's pods with an
+	// issue, 0 to 1: a threshold of 0.05 is "5% of pods".
+	AlertMetricPodIssueRatio = "pod-issue-ratio"
+	// AlertMetricUnhealthyClusterCount is the number of unhealthy clusters.
+	AlertMetricUnhealthyClusterCount = "unhealthy-cluster-count"
+	// AlertMetricSecurityHighCount is the number of high-severity security
+	// issues fleet-wide.
+	AlertMetricSecurityHighCount = "security-high-count"
+)
+
+// alertComparators are the comparators an AlertRule may use.
+var alertComparators = map[string]func(value, threshold float64) bool{
+	">":  func(v, t float64) bool { return v > t },
+	">=": func(v, t float64) bool { return v >= t },
+	"<":  func(v, t float64) bool { return v < t },
+	"<=": func(v, t float64) bool { return v <= t },
+	"==": func(v, t float64) bool { return v == t },
+}
+
+// AlertRule fires when Metric compared with Threshold holds, e.g.
+// {"metric": "pod-issue-ratio", "comparator": ">", "threshold": 0.05}.
+type AlertRule struct {
+	Name       string  `json:"name"`
+	Metric     string  `json:"metric"`
+	Comparator string  `json:"comparator"`
+	Threshold  float64 `json:"threshold"`
+	// Severity is passed through to the result for display.
+	Severity string `json:"severity,omitempty"`
+}
+
+// Validate reports whether r names a known metric and comparator.
+func (r AlertRule) Validate() error {
+	switch r.Metric {
+	case AlertMetricPodIssueRatio, AlertMetricUnhealthyClusterCount, AlertMetricSecurityHighCount:
+	default:
+		return fmt.Errorf("alert rule %q: unknown metric %q", r.Name, r.Metric)
+	}
+	if _, ok := alertComparators[r.Comparator]; !ok {
+		return fmt.Errorf("alert rule %q: unknown comparator %q", r.Name, r.Comparator)
+	}
+	return nil
+}
+
+// AlertResult is one rule's evaluation.
+type AlertResult struct {
+	Rule   AlertRule `json:"rule"`
+	Value  float64   `json:"value"`
+	Firing bool      `json:"firing"`
+	// Error is set instead of Value for rules that cannot be evaluated.
+	Error       string `json:"error,omitempty"`
+	EvaluatedAt string `json:"evaluatedAt"`
+}
+
+// EvaluateAlertRules evaluates rules against the fleet summary. Invalid
+// rules are reported in their result rather than failing the call.
+func (m *MultiClusterClient) EvaluateAlertRules(ctx context.Context, rules []AlertRule) ([]AlertResult, error) {
+	summary, err := m.GetFleetSummary(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return EvaluateAlertRulesOn(summary, rules), nil
+}
+
+// EvaluateAlertRulesOn evaluates rules against summary.
+func EvaluateAlertRulesOn(summary *FleetSummary, rules []AlertRule) []AlertResult {
+	now := time.Now().UTC().Format(time.RFC3339)
+	results := make([]AlertResult, 0, len(rules))
+	for _, rule := range rules {
+		result := AlertResult{Rule: rule, EvaluatedAt: now}
+		if err := rule.Validate(); err != nil {
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+		result.Value = alertMetricValue(summary, rule.Metric)
+		result.Firing = alertComparators[rule.Comparator](result.Value, rule.Threshold)
+		results = append(results, result)
+	}
+	return results
+}
+
+// alertMetricValue computes metric from summary.
+func alertMetricValue(summary *FleetSummary, metric string) float64 {
+	switch metric {
+	case AlertMetricPodIssueRatio:
+		if summary.TotalPods == 0 {
+			return 0
+		}
+		return float64(summary.PodIssues) / float64(summary.TotalPods)
+	case AlertMetricUnhealthyClusterCount:
+		return float64(summary.UnhealthyClusters)
+	case AlertMetricSecurityHighCount:
+		return float64(summary.SecurityHighIssues)
+	}
+	return 0
+}
+
+// LoadAlertRules reads a JSON array of alert rules from path, rejecting the
+// file if any rule is invalid.
+func LoadAlertRules(path string) ([]AlertRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read alert rules: %w", err)
+	}
+	var rules []AlertRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("parse alert rules %s: %w", path, err)
+	}
+	for _, r := range rules {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
@@ -0,0 +1,58 @@
+package k8s
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEvaluateAlertRules_PodIssueRatioFires(t *testing.T) {
+	m, err := NewMultiClusterClient("")
+	require.NoError(t, err)
+	injectTestClusters(m, "east")
+
+	crashing := fleetCleanPod("crashing")
+	crashing.Status.ContainerStatuses[0].Ready = false
+	crashing.Status.ContainerStatuses[0].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+	}
+	m.InjectClient("east", k8sfake.NewSimpleClientset(fleetReadyNode("n1"),
+		crashing, fleetCleanPod("web-1"), fleetCleanPod("web-2"), fleetCleanPod("web-3")))
+
+	results, err := m.EvaluateAlertRules(context.Background(), []AlertRule{
+		{Name: "pods failing", Metric: AlertMetricPodIssueRatio, Comparator: ">", Threshold: 0.05},
+		{Name: "clusters down", Metric: AlertMetricUnhealthyClusterCount, Comparator: ">=", Threshold: 1},
+		{Name: "typo", Metric: "pod-issue-ration", Comparator: ">", Threshold: 0},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.True(t, results[0].Firing, "1 of 4 pods failing is above 5%")
+	assert.InDelta(t, 0.25, results[0].Value, 0.001)
+	assert.False(t, results[1].Firing)
+	assert.False(t, results[2].Firing)
+	assert.NotEmpty(t, results[2].Error, "unknown metrics are reported, not evaluated")
+}
+
+func TestLoadAlertRules(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "rules.json")
+	require.NoError(t, os.WriteFile(valid,
+		[]byte(`[{"name":"high","metric":"security-high-count","comparator":">","threshold":0}]`), 0o600))
+	rules, err := LoadAlertRules(valid)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, AlertMetricSecurityHighCount, rules[0].Metric)
+
+	invalid := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(invalid,
+		[]byte(`[{"name":"bad","metric":"security-high-count","comparator":"!="}]`), 0o600))
+	_, err = LoadAlertRules(invalid)
+	assert.Error(t, err)
+}
@@ -0,0 +1,154 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Actions accepted in BatchOp.Action.
+const (
+	BatchActionScale   = "scale"
+	BatchActionRestart = "restart"
+	BatchActionDelete  = "delete"
+)
+
+const (
+	// MaxBatchOps caps how many operations one BatchOperate call accepts.
+	MaxBatchOps = 100
+	// maxConcurrentBatchOps bounds how many operations run at once so a
+	// large selection does not flood the apiservers.
+	maxConcurrentBatchOps = 5
+	// restartedAtAnnotation is the pod template annotation `kubectl rollout
+	// restart` sets to trigger a new rollout.
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+// ErrInvalidBatchOp is returned by BatchOperate when the batch is empty, too
+// large, or contains an operation that can never succeed (unknown action or
+// kind, scale without replicas). Nothing is executed in that case.
+var ErrInvalidBatchOp = errors.New("invalid batch operation")
+
+// BatchOp is one action on one workload. Kind is Deployment, StatefulSet or
+// DaemonSet; Replicas is required for BatchActionScale and ignored otherwise.
+type BatchOp struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Action    string `json:"action"`
+	Replicas  *int32 `json:"replicas,omitempty"`
+}
+
+// BatchResult is the outcome of the BatchOp at the same index.
+type BatchResult struct {
+	Op      BatchOp `json:"op"`
+	Success bool    `json:"success"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// BatchOperate runs ops with bounded concurrency and returns one result per
+// op, in the same order. A failing op does not stop the others; the error
+// return is reserved for batches rejected up front with ErrInvalidBatchOp.
+// Deletes use foreground propagation, like DeleteWorkload, and restarts
+// bump the pod template's restartedAt annotation like `kubectl rollout
+// restart`.
+func (m *MultiClusterClient) BatchOperate(ctx context.Context, ops []BatchOp) ([]BatchResult, error) {
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("%w: no operations", ErrInvalidBatchOp)
+	}
+	if len(ops) > MaxBatchOps {
+		return nil, fmt.Errorf("%w: %d operations exceeds the limit of %d", ErrInvalidBatchOp, len(ops), MaxBatchOps)
+	}
+	for i, op := range ops {
+		if err := validateBatchOp(op); err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %v", ErrInvalidBatchOp, i, err)
+		}
+	}
+
+	results := make([]BatchResult, len(ops))
+	g := new(errgroup.Group)
+	g.SetLimit(maxConcurrentBatchOps)
+	for i, op := range ops {
+		g.Go(func() error {
+			results[i] = BatchResult{Op: op, Success: true}
+			if err := m.runBatchOp(ctx, op); err != nil {
+				results[i].Success = false
+				results[i].Error = err.Error()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return results, nil
+}
+
+func validateBatchOp(op BatchOp) error {
+	if op.Cluster == "" || op.Namespace == "" || op.Name == "" {
+		return errors.New("cluster, namespace and name are required")
+	}
+	if _, ok := workloadGVRByKind[op.Kind]; !ok {
+		return fmt.Errorf("unsupported kind %q", op.Kind)
+	}
+	switch op.Action {
+	case BatchActionScale:
+		if op.Kind == "DaemonSet" {
+			return errors.New("DaemonSets cannot be scaled")
+		}
+		if op.Replicas == nil || *op.Replicas < 0 {
+			return errors.New("scale requires a non-negative replicas")
+		}
+	case BatchActionRestart, BatchActionDelete:
+	default:
+		return fmt.Errorf("unknown action %q", op.Action)
+	}
+	return nil
+}
+
+func (m *MultiClusterClient) runBatchOp(ctx context.Context, op BatchOp) (err error) {
+	defer observeClusterRequest(op.Cluster, "BatchOperate", time.Now(), &err)
+	gvr := workloadGVRByKind[op.Kind]
+	if op.Action == BatchActionDelete {
+		return m.DeleteResource(ctx, op.Cluster, op.Namespace, gvr, op.Name, metav1.DeletePropagationForeground)
+	}
+
+	dynamicClient, err := m.GetDynamicClient(op.Cluster)
+	if err != nil {
+		return err
+	}
+	resource := dynamicClient.Resource(gvr).Namespace(op.Namespace)
+
+	if op.Action == BatchActionScale {
+		obj, err := resource.Get(ctx, op.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(obj.Object, int64(*op.Replicas), "spec", "replicas"); err != nil {
+			return err
+		}
+		_, err = resource.Update(ctx, obj, metav1.UpdateOptions{})
+		return err
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{restartedAtAnnotation: time.Now().Format(time.RFC3339)},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = resource.Patch(ctx, op.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
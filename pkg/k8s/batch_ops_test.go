@@ -0,0 +1,108 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newBatchTestClient(t *testing.T) *MultiClusterClient {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add apps scheme: %v", err)
+	}
+	deployment := func(name string) *appsv1.Deployment {
+		replicas := int32(1)
+		return &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		}
+	}
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["east"] = dynfake.NewSimpleDynamicClient(scheme, deployment("api"))
+	m.dynamicClients["west"] = dynfake.NewSimpleDynamicClient(scheme, deployment("worker"))
+	return m
+}
+
+func TestBatchOperate_ScaleAndDeleteAcrossClusters(t *testing.T) {
+	m := newBatchTestClient(t)
+	ctx := context.Background()
+	replicas := int32(4)
+
+	results, err := m.BatchOperate(ctx, []BatchOp{
+		{Cluster: "east", Namespace: "default", Kind: "Deployment", Name: "api", Action: BatchActionScale, Replicas: &replicas},
+		{Cluster: "west", Namespace: "default", Kind: "Deployment", Name: "worker", Action: BatchActionDelete},
+		{Cluster: "west", Namespace: "default", Kind: "Deployment", Name: "missing", Action: BatchActionRestart},
+	})
+	if err != nil {
+		t.Fatalf("BatchOperate: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	if !results[0].Success || results[0].Op.Name != "api" {
+		t.Errorf("scale result = %+v, want success for api", results[0])
+	}
+	if !results[1].Success || results[1].Op.Name != "worker" {
+		t.Errorf("delete result = %+v, want success for worker", results[1])
+	}
+	if results[2].Success || results[2].Error == "" {
+		t.Errorf("restart of missing deployment = %+v, want a per-op error", results[2])
+	}
+
+	east, _ := m.GetDynamicClient("east")
+	obj, err := east.Resource(gvrDeployments).Namespace("default").Get(ctx, "api", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get api: %v", err)
+	}
+	if got, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); got != 4 {
+		t.Errorf("api replicas = %d, want 4", got)
+	}
+	west, _ := m.GetDynamicClient("west")
+	if _, err := west.Resource(gvrDeployments).Namespace("default").Get(ctx, "worker", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("worker should be deleted, get err = %v", err)
+	}
+}
+
+func TestBatchOperate_Restart(t *testing.T) {
+	m := newBatchTestClient(t)
+	ctx := context.Background()
+
+	results, err := m.BatchOperate(ctx, []BatchOp{
+		{Cluster: "east", Namespace: "default", Kind: "Deployment", Name: "api", Action: BatchActionRestart},
+	})
+	if err != nil || !results[0].Success {
+		t.Fatalf("restart: err=%v results=%+v", err, results)
+	}
+	east, _ := m.GetDynamicClient("east")
+	obj, _ := east.Resource(gvrDeployments).Namespace("default").Get(ctx, "api", metav1.GetOptions{})
+	annotations, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "annotations")
+	if annotations[restartedAtAnnotation] == "" {
+		t.Errorf("restartedAt annotation not set: %v", annotations)
+	}
+}
+
+func TestBatchOperate_RejectsInvalidBatch(t *testing.T) {
+	m := newBatchTestClient(t)
+	cases := map[string][]BatchOp{
+		"empty":           nil,
+		"unknown action":  {{Cluster: "east", Namespace: "default", Kind: "Deployment", Name: "api", Action: "pause"}},
+		"scale no count":  {{Cluster: "east", Namespace: "default", Kind: "Deployment", Name: "api", Action: BatchActionScale}},
+		"scale daemonset": {{Cluster: "east", Namespace: "default", Kind: "DaemonSet", Name: "agent", Action: BatchActionScale, Replicas: new(int32)}},
+		"unsupported":     {{Cluster: "east", Namespace: "default", Kind: "CronJob", Name: "nightly", Action: BatchActionDelete}},
+	}
+	for name, ops := range cases {
+		if _, err := m.BatchOperate(context.Background(), ops); !errors.Is(err, ErrInvalidBatchOp) {
+			t.Errorf("%s: err = %v, want ErrInvalidBatchOp", name, err)
+		}
+	}
+}
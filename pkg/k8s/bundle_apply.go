@@ -0,0 +1,166 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	// MaxBundleDocuments caps how many resources one ApplyBundle call accepts.
+	MaxBundleDocuments = 500
+	// maxConcurrentBundleTargets bounds how many target clusters a bundle is
+	// applied to at once. Documents within a cluster are applied in order.
+	maxConcurrentBundleTargets = 5
+)
+
+// ApplyOptions controls how ApplyBundle applies a bundle.
+type ApplyOptions struct {
+	// Namespace is used for namespaced documents that do not set
+	// metadata.namespace. Empty leaves them as invalid.
+	Namespace string
+	// DryRun validates every document against the target apiservers
+	// without persisting anything.
+	DryRun bool
+}
+
+// BundleResourceResult is the outcome of applying one bundle document to
+// one target cluster.
+type BundleResourceResult struct {
+	Cluster   string `json:"cluster"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BundleResult collects the per-resource results of ApplyBundle, grouped by
+// target cluster in the order given and by apply order within a cluster.
+type BundleResult struct {
+	Resources []BundleResourceResult `json:"resources"`
+	Applied   int                    `json:"applied"`
+	Failed    int                    `json:"failed"`
+}
+
+// ApplyBundle splits a multi-document YAML bundle, such as one produced by
+// ExportNamespace, orders the documents with the same apply order
+// DeployWorkload uses for dependencies (namespaces first, unknown kinds such
+// as workloads last) and server-side applies them to every target cluster.
+// A document failing on one cluster does not stop the rest; the error
+// return is reserved for bundles rejected up front with ErrInvalidManifest.
+func (m *MultiClusterClient) ApplyBundle(ctx context.Context, targetClusters []string, yamlBundle []byte, opts ApplyOptions) (*BundleResult, error) {
+	if len(targetClusters) == 0 {
+		return nil, errors.New("at least one target cluster is required")
+	}
+	docs, err := decodeManifests(yamlBundle)
+	if err != nil {
+		return nil, err
+	}
+	for i, doc := range docs {
+		if err := validateManifestIdentity(doc); err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+		if doc.GetNamespace() == "" && opts.Namespace != "" {
+			doc.SetNamespace(opts.Namespace)
+		}
+	}
+	sort.SliceStable(docs, func(i, j int) bool {
+		return bundleApplyOrder(docs[i].GetKind()) < bundleApplyOrder(docs[j].GetKind())
+	})
+
+	perCluster := make([][]BundleResourceResult, len(targetClusters))
+	g := new(errgroup.Group)
+	g.SetLimit(maxConcurrentBundleTargets)
+	for i, cluster := range targetClusters {
+		g.Go(func() error {
+			perCluster[i] = m.applyBundleToCluster(ctx, cluster, docs, opts.DryRun)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	result := &BundleResult{Resources: make([]BundleResourceResult, 0, len(docs)*len(targetClusters))}
+	for _, results := range perCluster {
+		for _, r := range results {
+			if r.Success {
+				result.Applied++
+			} else {
+				result.Failed++
+			}
+		}
+		result.Resources = append(result.Resources, results...)
+	}
+	return result, nil
+}
+
+func (m *MultiClusterClient) applyBundleToCluster(ctx context.Context, cluster string, docs []*unstructured.Unstructured, dryRun bool) []BundleResourceResult {
+	results := make([]BundleResourceResult, len(docs))
+	for i, doc := range docs {
+		// applyManifest modifies its argument and the documents are shared
+		// across the concurrently applied clusters.
+		obj := doc.DeepCopy()
+		err := m.applyBundleDocument(ctx, cluster, obj, dryRun)
+		results[i] = BundleResourceResult{
+			Cluster:   cluster,
+			Kind:      obj.GetKind(),
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			Success:   err == nil,
+		}
+		if err != nil {
+			results[i].Error = err.Error()
+		}
+	}
+	return results
+}
+
+func (m *MultiClusterClient) applyBundleDocument(ctx context.Context, cluster string, obj *unstructured.Unstructured, dryRun bool) (err error) {
+	defer observeClusterRequest(cluster, "ApplyBundle", time.Now(), &err)
+	return m.applyManifest(ctx, cluster, obj, dryRun)
+}
+
+// bundleApplyOrder ranks kind by depApplyOrder; kinds that are not
+// dependencies (workloads, custom resources) sort after all of them.
+func bundleApplyOrder(kind string) int {
+	if order, ok := depApplyOrder[DependencyKind(kind)]; ok {
+		return order
+	}
+	return len(depApplyOrder)
+}
+
+// decodeManifests decodes every non-empty document of a multi-document
+// YAML or JSON stream.
+func decodeManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), yamlDecoderBufferSize)
+	var docs []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		err := decoder.Decode(&obj.Object)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: document %d: %v", ErrInvalidManifest, len(docs)+1, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if len(docs) == MaxBundleDocuments {
+			return nil, fmt.Errorf("%w: bundle exceeds the limit of %d documents", ErrInvalidManifest, MaxBundleDocuments)
+		}
+		docs = append(docs, obj)
+	}
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("%w: bundle is empty", ErrInvalidManifest)
+	}
+	return docs, nil
+}
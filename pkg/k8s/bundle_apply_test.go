@@ -0,0 +1,138 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var configMapsGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+// addBundleTestCluster registers an empty cluster whose discovery serves
+// ConfigMaps and Deployments and whose fake apply creates the object.
+func addBundleTestCluster(m *MultiClusterClient, name string) *dynfake.FakeDynamicClient {
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}}},
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}}},
+	}
+	dynClient := dynfake.NewSimpleDynamicClient(runtime.NewScheme())
+	dynClient.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+		return true, obj, dynClient.Tracker().Create(patch.GetResource(), obj, patch.GetNamespace())
+	})
+	m.clients[name] = clientset
+	m.dynamicClients[name] = dynClient
+	return dynClient
+}
+
+const testBundle = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: web-config
+data:
+  MODE: prod
+`
+
+func TestApplyBundle_CreatesEveryDocument(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	dynClient := addBundleTestCluster(m, "c1")
+	ctx := context.Background()
+
+	result, err := m.ApplyBundle(ctx, []string{"c1"}, []byte(testBundle), ApplyOptions{Namespace: "shop"})
+	if err != nil {
+		t.Fatalf("ApplyBundle: %v", err)
+	}
+	if result.Applied != 2 || result.Failed != 0 {
+		t.Fatalf("result = %+v, want 2 applied", result)
+	}
+	if result.Resources[0].Kind != "ConfigMap" {
+		t.Errorf("ConfigMap should be applied before the Deployment, got order %+v", result.Resources)
+	}
+	if _, err := dynClient.Resource(configMapsGVR).Namespace("shop").Get(ctx, "web-config", metav1.GetOptions{}); err != nil {
+		t.Errorf("configmap not created: %v", err)
+	}
+	if _, err := dynClient.Resource(deploymentsGVR).Namespace("shop").Get(ctx, "web", metav1.GetOptions{}); err != nil {
+		t.Errorf("deployment not created: %v", err)
+	}
+}
+
+func TestApplyBundle_PerClusterResults(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	addBundleTestCluster(m, "c1")
+
+	result, err := m.ApplyBundle(context.Background(), []string{"c1", "missing"}, []byte(testBundle), ApplyOptions{Namespace: "shop"})
+	if err != nil {
+		t.Fatalf("ApplyBundle: %v", err)
+	}
+	if result.Applied != 2 || result.Failed != 2 {
+		t.Errorf("result = %+v, want 2 applied on c1 and 2 failed on missing", result)
+	}
+	for _, r := range result.Resources {
+		if r.Cluster == "missing" && (r.Success || r.Error == "") {
+			t.Errorf("resource on unknown cluster = %+v, want an error", r)
+		}
+	}
+}
+
+func TestApplyBundle_RejectsInvalidBundle(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	addBundleTestCluster(m, "c1")
+
+	for name, bundle := range map[string]string{
+		"empty":        "---\n",
+		"missing kind": "apiVersion: v1\nmetadata:\n  name: x\n",
+		"malformed":    "kind: [\n",
+	} {
+		if _, err := m.ApplyBundle(context.Background(), []string{"c1"}, []byte(bundle), ApplyOptions{}); !errors.Is(err, ErrInvalidManifest) {
+			t.Errorf("%s: err = %v, want ErrInvalidManifest", name, err)
+		}
+	}
+	if _, err := m.ApplyBundle(context.Background(), nil, []byte(testBundle), ApplyOptions{}); err == nil {
+		t.Error("expected an error without target clusters")
+	}
+}
+
+func TestApplyBundle_DryRunPersistsNothing(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	dynClient := addBundleTestCluster(m, "c1")
+	dynClient.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchActionImpl)
+		if opts := patch.PatchOptions; len(opts.DryRun) != 1 || opts.DryRun[0] != metav1.DryRunAll {
+			t.Errorf("patch options = %+v, want DryRun=All", opts)
+		}
+		return true, &unstructured.Unstructured{}, nil
+	})
+
+	result, err := m.ApplyBundle(context.Background(), []string{"c1"}, []byte(testBundle), ApplyOptions{Namespace: "shop", DryRun: true})
+	if err != nil || result.Applied != 2 {
+		t.Fatalf("dry run: err=%v result=%+v", err, result)
+	}
+	if _, err := dynClient.Resource(configMapsGVR).Namespace("shop").Get(context.Background(), "web-config", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("dry run should not create the configmap, get err = %v", err)
+	}
+}
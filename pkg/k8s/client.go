@@ -29,6 +29,7 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -39,6 +40,7 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"golang.org/x/sync/singleflight"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
@@ -125,11 +127,38 @@ type MultiClusterClient struct {
 	// multiple times (closing a closed channel panics).
 	watching        bool
 	stopWatchOnce   sync.Once
-	onReload        func()               // Callback when config is reloaded
+	onReload        func()                // Callback when config is reloaded
+	reloadSubs      map[int]chan struct{} // SubscribeReload channels, keyed by subscription id
+	nextReloadSub   int
 	onWatchError    func(error)          // Callback when watchLoop encounters an error (#5569)
 	inClusterConfig *rest.Config         // In-cluster config when running inside k8s
 	inClusterName   string               // Detected friendly name for in-cluster (e.g. "fmaas-vllm-d")
 	slowClusters    map[string]time.Time // clusters that recently timed out (reduced timeout)
+	gpuMetrics      GPUMetricsSource     // live DCGM utilization for GetGPUNodes; nil disables it
+	fleetSummary    *FleetSummary        // last GetFleetSummary result, served for fleetSummaryCacheTTL
+	fleetSummaryAt  time.Time
+	retryAttempts   int             // tries per core List call on transient errors, see withRetry
+	restartTrends   *restartTracker // per-pod restart history behind PodIssue.RestartTrend
+	clusterDomain   string          // DNS domain for Service.DNSNames, see SetClusterDomain
+	ownershipKeys   *OwnershipKeys  // keys behind Deployment/Service.Ownership; nil uses DefaultOwnershipKeys
+	// impersonate is the identity this client acts as; set only on views
+	// returned by Impersonating, which caches them in impersonated.
+	impersonate  rest.ImpersonationConfig
+	impersonated map[string]*impersonatedView
+	// discoveryCaches holds each cluster's cached discovery and RESTMapper,
+	// see discoveryCacheFor. Dropped on kubeconfig reload.
+	discoveryCaches map[string]*discoveryCache
+	// manualClusters holds the clusters registered with AddCluster; shared
+	// with impersonating views.
+	manualClusters *manualClusterSet
+	// inventoryHub is the context whose ManagedClusters ListClusters merges
+	// in; inventory is its last list, fetched at inventoryAt.
+	inventoryHub string
+	inventory    []ClusterInfo
+	inventoryAt  time.Time
+	// healthFlight collapses concurrent health probes of one context, see
+	// clusterHealth.
+	healthFlight singleflight.Group
 }
 
 // IsInCluster returns true if the server is running inside a Kubernetes cluster
@@ -172,6 +201,7 @@ func (m *MultiClusterClient) SetClient(cluster string, client kubernetes.Interfa
 		m.clients = make(map[string]kubernetes.Interface)
 	}
 	m.clients[cluster] = client
+	delete(m.discoveryCaches, cluster)
 }
 
 // SetRawConfig sets the raw kubeconfig (for testing)
@@ -202,6 +232,7 @@ func (m *MultiClusterClient) InjectClient(contextName string, client kubernetes.
 		m.clients = make(map[string]kubernetes.Interface)
 	}
 	m.clients[contextName] = client
+	delete(m.discoveryCaches, contextName)
 }
 
 // InjectDynamicClient injects a dynamic client for a cluster (for testing)
@@ -238,6 +269,7 @@ func (m *MultiClusterClient) Reload() error {
 	}
 	m.mu.Lock()
 	m.rawConfig = config
+	m.resetDiscoveryCaches()
 	m.mu.Unlock()
 	return nil
 }
@@ -257,6 +289,14 @@ type ClusterInfo struct {
 	NodeCount      int    `json:"nodeCount,omitempty"`
 	PodCount       int    `json:"podCount,omitempty"`
 	IsCurrent      bool   `json:"isCurrent,omitempty"`
+	// InventoryOnly marks a cluster known only from the inventory hub. The
+	// console has no credentials for it, so it is listed but never queried.
+	InventoryOnly bool `json:"inventoryOnly,omitempty"`
+	// DisplayName, Tags and Environment are user-managed metadata filled in
+	// from the store by the API layer; empty when none is saved.
+	DisplayName string   `json:"displayName,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Environment string   `json:"environment,omitempty"`
 }
 
 // ClusterHealth represents cluster health status
@@ -265,7 +305,7 @@ type ClusterHealth struct {
 	Healthy      bool   `json:"healthy"`
 	Reachable    bool   `json:"reachable"`
 	LastSeen     string `json:"lastSeen,omitempty"`
-	ErrorType    string `json:"errorType,omitempty"` // timeout, auth, network, certificate, unknown
+	ErrorType    string `json:"errorType,omitempty"` // timeout, ratelimit, auth, credential-plugin, network, certificate, unknown
 	ErrorMessage string `json:"errorMessage,omitempty"`
 	APIServer    string `json:"apiServer,omitempty"`
 	NodeCount    int    `json:"nodeCount"`
@@ -305,6 +345,13 @@ type PodInfo struct {
 	Labels      map[string]string `json:"labels,omitempty"`
 	Annotations map[string]string `json:"annotations,omitempty"`
 	Containers  []ContainerInfo   `json:"containers,omitempty"`
+	// InitContainers and EphemeralContainers are reported separately from
+	// Containers, so a pod stuck in Init:CrashLoopBackOff shows which init
+	// container is failing.
+	InitContainers      []ContainerInfo `json:"initContainers,omitempty"`
+	EphemeralContainers []ContainerInfo `json:"ephemeralContainers,omitempty"`
+	// QOSClass is Guaranteed, Burstable or BestEffort.
+	QOSClass string `json:"qosClass,omitempty"`
 }
 
 // ContainerInfo represents container information
@@ -316,6 +363,10 @@ type ContainerInfo struct {
 	Reason       string `json:"reason,omitempty"`
 	Message      string `json:"message,omitempty"`
 	GPURequested int    `json:"gpuRequested,omitempty"` // Number of GPUs requested by this container
+	// Requests and Limits map resource names to quantities from the
+	// container spec, e.g. {"cpu": "250m", "memory": "128Mi"}.
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
 }
 
 // PodIssue represents a pod with issues
@@ -327,6 +378,40 @@ type PodIssue struct {
 	Reason    string   `json:"reason,omitempty"`
 	Issues    []string `json:"issues"`
 	Restarts  int      `json:"restarts"`
+	// RestartTrend is RestartTrendIncreasing when the restart count rose
+	// within the last restartTrendWindow across successive scans, and
+	// RestartsInWindow is by how much.
+	RestartTrend     string `json:"restartTrend,omitempty"`
+	RestartsInWindow int    `json:"restartsInWindow,omitempty"`
+}
+
+// ImagePullIssue is a container that cannot start because its image could not
+// be pulled. Message carries the kubelet's registry error (e.g. "manifest
+// unknown", "unauthorized") so the UI can show an actionable reason.
+type ImagePullIssue struct {
+	Pod       string `json:"pod"`
+	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster,omitempty"`
+	Container string `json:"container"`
+	Init      bool   `json:"init,omitempty"`
+	Image     string `json:"image"`
+	Reason    string `json:"reason"` // ImagePullBackOff, ErrImagePull, InvalidImageName, ...
+	Message   string `json:"message,omitempty"`
+}
+
+// OOMEvent is the most recent OOM kill of a container, with the memory limit
+// it was running under so users can correlate OOMs with limits. MemoryLimit
+// is empty (and MemoryLimitBytes zero) when the container has no limit.
+type OOMEvent struct {
+	Pod              string    `json:"pod"`
+	Namespace        string    `json:"namespace"`
+	Cluster          string    `json:"cluster,omitempty"`
+	Container        string    `json:"container"`
+	MemoryLimit      string    `json:"memoryLimit,omitempty"`
+	MemoryLimitBytes int64     `json:"memoryLimitBytes,omitempty"`
+	RestartCount     int32     `json:"restartCount"`
+	TerminatedAt     time.Time `json:"terminatedAt"`
+	Current          bool      `json:"current,omitempty"` // the container's current state is the OOM termination
 }
 
 // Event represents a Kubernetes event
@@ -373,6 +458,14 @@ type GPUTaint struct {
 	Effect string `json:"effect"` // NoSchedule or NoExecute
 }
 
+// MIGProfile is one NVIDIA Multi-Instance GPU slice type advertised by a
+// node under the "mixed" MIG strategy (resource nvidia.com/mig-<profile>).
+type MIGProfile struct {
+	Profile   string `json:"profile"`   // e.g. "1g.5gb"
+	Count     int    `json:"count"`     // allocatable slices of this profile
+	Allocated int    `json:"allocated"` // slices requested by pods on the node
+}
+
 // GPUNode represents a node with accelerator resources (GPU, TPU, AIU, XPU)
 type GPUNode struct {
 	Name            string          `json:"name"`
@@ -392,6 +485,13 @@ type GPUNode struct {
 	MIGCapable         bool   `json:"migCapable,omitempty"`         // Whether MIG is supported
 	MIGStrategy        string `json:"migStrategy,omitempty"`        // MIG strategy if enabled
 	Manufacturer       string `json:"manufacturer,omitempty"`       // Manufacturer (NVIDIA, AMD, Intel, Google)
+	// MIG slices by profile; only populated when MIGStrategy is "mixed".
+	MIGDevices []MIGProfile `json:"migDevices,omitempty"`
+	// Live utilization from the DCGM exporter (see gpu_metrics.go). Zero
+	// with GPUMetricsAvailable=false when DCGM is not configured or reachable.
+	GPUUtilizationPercent float64 `json:"gpuUtilizationPercent,omitempty"`
+	GPUMemoryUsedMB       int     `json:"gpuMemoryUsedMB,omitempty"`
+	GPUMetricsAvailable   bool    `json:"gpuMetricsAvailable"`
 }
 
 // NodeCondition represents a node condition status
@@ -533,6 +633,8 @@ type Deployment struct {
 	Age               string            `json:"age,omitempty"`
 	Labels            map[string]string `json:"labels,omitempty"`
 	Annotations       map[string]string `json:"annotations,omitempty"`
+	// Ownership is read from the annotations and labels, see OwnershipKeys.
+	Ownership *Ownership `json:"ownership,omitempty"`
 }
 
 // ServicePortDetail is a structured view of a ServicePort that preserves
@@ -585,10 +687,15 @@ type Service struct {
 	// detect orphaned services (selector present but no matching pods,
 	// issue #6164) and services with an empty selector that are not
 	// ExternalName (config bug, issue #6166). nil for ExternalName.
-	Selector    map[string]string `json:"selector,omitempty"`
+	Selector map[string]string `json:"selector,omitempty"`
+	// DNSNames are the in-cluster DNS names of the service; headless
+	// services add a *.<name>.<namespace>.svc.<domain> per-pod pattern.
+	DNSNames    []string          `json:"dnsNames,omitempty"`
 	Age         string            `json:"age,omitempty"`
 	Labels      map[string]string `json:"labels,omitempty"`
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// Ownership is read from the annotations and labels, see OwnershipKeys.
+	Ownership *Ownership `json:"ownership,omitempty"`
 }
 
 // LoadBalancer provisioning status values. Defined as exported constants so
@@ -603,6 +710,21 @@ const (
 	LBStatusReady = "Ready"
 )
 
+// EndpointInfo is a single backend address of a Service, read from its
+// EndpointSlices. Ready follows the EndpointSlice convention: a nil ready
+// condition is treated as ready.
+type EndpointInfo struct {
+	Address     string   `json:"address"`
+	Ready       bool     `json:"ready"`
+	Serving     bool     `json:"serving"`
+	Terminating bool     `json:"terminating,omitempty"`
+	PodName     string   `json:"podName,omitempty"` // targetRef name when targetRef.kind is Pod
+	NodeName    string   `json:"nodeName,omitempty"`
+	Zone        string   `json:"zone,omitempty"`
+	Ports       []string `json:"ports,omitempty"` // "name:port/protocol"
+	SliceName   string   `json:"sliceName"`
+}
+
 // Job represents a Kubernetes job
 type Job struct {
 	Name        string            `json:"name"`
@@ -627,11 +749,24 @@ type HPA struct {
 	CurrentReplicas int32             `json:"currentReplicas"`
 	TargetCPU       string            `json:"targetCPU,omitempty"`
 	CurrentCPU      string            `json:"currentCPU,omitempty"`
+	Metrics         []HPAMetric       `json:"metrics,omitempty"`
 	Age             string            `json:"age,omitempty"`
 	Labels          map[string]string `json:"labels,omitempty"`
 	Annotations     map[string]string `json:"annotations,omitempty"`
 }
 
+// Namespace represents a Kubernetes Namespace with an optional pod count.
+// PodCount is nil unless the caller asked for counts, so the UI can tell
+// "not requested" apart from "empty namespace".
+type Namespace struct {
+	Name     string            `json:"name"`
+	Cluster  string            `json:"cluster,omitempty"`
+	Status   string            `json:"status"` // Active, Terminating
+	Age      string            `json:"age,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	PodCount *int              `json:"podCount,omitempty"`
+}
+
 // ConfigMap represents a Kubernetes ConfigMap
 type ConfigMap struct {
 	Name        string            `json:"name"`
@@ -698,15 +833,21 @@ type PV struct {
 
 // ReplicaSet represents a Kubernetes ReplicaSet
 type ReplicaSet struct {
-	Name          string            `json:"name"`
-	Namespace     string            `json:"namespace"`
-	Cluster       string            `json:"cluster,omitempty"`
-	Replicas      int32             `json:"replicas"`
-	ReadyReplicas int32             `json:"readyReplicas"`
-	OwnerName     string            `json:"ownerName,omitempty"`
-	OwnerKind     string            `json:"ownerKind,omitempty"`
-	Age           string            `json:"age,omitempty"`
-	Labels        map[string]string `json:"labels,omitempty"`
+	Name          string `json:"name"`
+	Namespace     string `json:"namespace"`
+	Cluster       string `json:"cluster,omitempty"`
+	Replicas      int32  `json:"replicas"`
+	ReadyReplicas int32  `json:"readyReplicas"`
+	OwnerName     string `json:"ownerName,omitempty"`
+	OwnerKind     string `json:"ownerKind,omitempty"`
+	// OwnerDeployment is the name of the controlling Deployment, empty for
+	// ReplicaSets not managed by a Deployment. Used to group rollouts in the UI.
+	OwnerDeployment string `json:"ownerDeployment,omitempty"`
+	// Revision is the deployment.kubernetes.io/revision annotation set by
+	// the Deployment controller; empty for standalone ReplicaSets.
+	Revision string            `json:"revision,omitempty"`
+	Age      string            `json:"age,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
 }
 
 // StatefulSet represents a Kubernetes StatefulSet
@@ -851,7 +992,15 @@ func NewMultiClusterClient(kubeconfig string) (*MultiClusterClient, error) {
 		cacheTTL:       clusterCacheTTL,
 		cacheTime:      make(map[string]time.Time),
 		slowClusters:   make(map[string]time.Time),
+		gpuMetrics:     gpuMetricsSourceFromEnv(),
+		retryAttempts:  retryAttemptsFromEnv(),
+		restartTrends:  newRestartTracker(),
+		clusterDomain:  clusterDomainFromEnv(),
+		manualClusters: newManualClusterSet(),
+		inventoryHub:   inventoryHubFromEnv(),
 	}
+	ownershipKeys := ownershipKeysFromEnv()
+	client.ownershipKeys = &ownershipKeys
 
 	// Try to detect if we're running in-cluster.
 	// kubeconfig may be empty when running inside a container without a
@@ -947,6 +1096,8 @@ func (m *MultiClusterClient) LoadConfig() error {
 			m.configs = make(map[string]*rest.Config)
 			m.healthCache = make(map[string]*ClusterHealth)
 			m.cacheTime = make(map[string]time.Time)
+			m.impersonated = nil
+			m.resetDiscoveryCaches()
 			return nil
 		}
 	}
@@ -963,6 +1114,8 @@ func (m *MultiClusterClient) LoadConfig() error {
 	m.configs = make(map[string]*rest.Config)
 	m.healthCache = make(map[string]*ClusterHealth)
 	m.cacheTime = make(map[string]time.Time)
+	m.impersonated = nil
+	m.resetDiscoveryCaches()
 	return nil
 }
 
@@ -1017,15 +1170,141 @@ func (m *MultiClusterClient) RemoveContext(contextName string) error {
 	}
 
 	// Clear cached clients for the removed context
+	m.forgetContextLocked(contextName)
+
+	m.rawConfig = config
+	slog.Info("Removed kubeconfig context", "context", contextName)
+	return nil
+}
+
+// DeleteContext removes a context exactly like RemoveContext, then notifies
+// reload listeners so cluster lists refresh without waiting for the file
+// watcher's debounce.
+func (m *MultiClusterClient) DeleteContext(contextName string) error {
+	if err := m.RemoveContext(contextName); err != nil {
+		return err
+	}
+	m.notifyReload()
+	return nil
+}
+
+// RenameContext renames a kubeconfig context in place, keeping its cluster
+// and user entries, and follows the rename in current-context. Renaming onto
+// an existing context is refused so no entry is silently overwritten.
+func (m *MultiClusterClient) RenameContext(oldName, newName string) error {
+	if oldName == "" || newName == "" {
+		return fmt.Errorf("both old and new context names are required")
+	}
+	if oldName == newName {
+		return nil
+	}
+
+	m.mu.Lock()
+	config, err := clientcmd.LoadFromFile(m.kubeconfig)
+	if err != nil {
+		m.mu.Unlock()
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	ctx, ok := config.Contexts[oldName]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("%w: %q", ErrContextNotFound, oldName)
+	}
+	if _, exists := config.Contexts[newName]; exists {
+		m.mu.Unlock()
+		return fmt.Errorf("context %q already exists", newName)
+	}
+
+	delete(config.Contexts, oldName)
+	config.Contexts[newName] = ctx
+	if config.CurrentContext == oldName {
+		config.CurrentContext = newName
+	}
+
+	if err := clientcmd.WriteToFile(*config, m.kubeconfig); err != nil {
+		m.mu.Unlock()
+		return fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+
+	// Cached clients are keyed by context name; drop the old entries and
+	// let the new name build fresh ones on first use.
+	m.forgetContextLocked(oldName)
+	m.rawConfig = config
+	m.mu.Unlock()
+
+	slog.Info("Renamed kubeconfig context", "from", oldName, "to", newName)
+	m.notifyReload()
+	return nil
+}
+
+// ErrContextNotFound is returned when a kubeconfig context name does not exist.
+var ErrContextNotFound = errors.New("context not found")
+
+// SetCurrentContext switches the kubeconfig's current-context and persists
+// it, so ListClusters reports the new context as IsCurrent.
+func (m *MultiClusterClient) SetCurrentContext(name string) error {
+	if name == "" {
+		return fmt.Errorf("context name is required")
+	}
+
+	m.mu.Lock()
+	config, err := clientcmd.LoadFromFile(m.kubeconfig)
+	if err != nil {
+		m.mu.Unlock()
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	if _, ok := config.Contexts[name]; !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("%w: %q", ErrContextNotFound, name)
+	}
+	if config.CurrentContext == name {
+		m.rawConfig = config
+		m.mu.Unlock()
+		return nil
+	}
+
+	previous := config.CurrentContext
+	config.CurrentContext = name
+	if err := clientcmd.WriteToFile(*config, m.kubeconfig); err != nil {
+		m.mu.Unlock()
+		return fmt.Errorf("failed to write kubeconfig: %w", err)
+	}
+	m.rawConfig = config
+	m.mu.Unlock()
+
+	slog.Info("Switched kubeconfig current-context", "from", previous, "to", name)
+	m.notifyReload()
+	return nil
+}
+
+// forgetContextLocked drops every cached client and health entry for a
+// context. Callers must hold m.mu.
+func (m *MultiClusterClient) forgetContextLocked(contextName string) {
 	delete(m.clients, contextName)
 	delete(m.dynamicClients, contextName)
 	delete(m.configs, contextName)
 	delete(m.healthCache, contextName)
 	delete(m.cacheTime, contextName)
+	delete(m.discoveryCaches, contextName)
+}
 
-	m.rawConfig = config
-	slog.Info("Removed kubeconfig context", "context", contextName)
-	return nil
+// notifyReload invokes the SetOnReload callback, if any, without holding m.mu,
+// and signals every SubscribeReload channel. Sends never block: a subscriber
+// that has not drained its previous notification gets the two coalesced.
+func (m *MultiClusterClient) notifyReload() {
+	m.mu.RLock()
+	callback := m.onReload
+	for _, ch := range m.reloadSubs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+	m.mu.RUnlock()
+	if callback != nil {
+		callback()
+	}
 }
 
 // StartWatching starts watching the kubeconfig file for changes.
@@ -1142,12 +1421,7 @@ func (m *MultiClusterClient) reloadAndNotify() {
 	m.mu.Unlock()
 
 	// Notify listeners
-	m.mu.RLock()
-	callback := m.onReload
-	m.mu.RUnlock()
-	if callback != nil {
-		callback()
-	}
+	m.notifyReload()
 }
 
 // watchLoop runs until stopCh is closed. stopCh and watcher are passed in
@@ -1187,6 +1461,16 @@ func (m *MultiClusterClient) watchLoop(stopCh <-chan struct{}, watcher *fsnotify
 			}
 			// Check if this event is for our kubeconfig file
 			if event.Name == m.kubeconfig || filepath.Base(event.Name) == filepath.Base(m.kubeconfig) {
+				// Atomic saves (write temp file, rename over the original)
+				// replace the inode the file watch was attached to, so the
+				// watch is gone after the rename. Re-add the path as soon as
+				// it exists again instead of waiting for the debounced reload.
+				if event.Op&(fsnotify.Create|fsnotify.Rename|fsnotify.Remove) != 0 {
+					rewatchKubeconfig(watcher, m.kubeconfig)
+				}
+				// The file and directory watches report the same change, and
+				// editors emit rename+create+write bursts; triggerReload's
+				// debounce coalesces all of them into a single reload.
 				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
 					// Update lastModTime so the poller doesn't double-trigger
 					if info, err := os.Stat(m.kubeconfig); err == nil {
@@ -1225,6 +1509,21 @@ func (m *MultiClusterClient) watchLoop(stopCh <-chan struct{}, watcher *fsnotify
 	}
 }
 
+// rewatchKubeconfig re-adds path to watcher after its inode was replaced.
+// It is a no-op while the path does not exist (mid-rename); the directory
+// watch reports the Create that follows, which calls back in here.
+func rewatchKubeconfig(watcher *fsnotify.Watcher, path string) {
+	if _, err := os.Stat(path); err != nil {
+		return
+	}
+	// Remove fails harmlessly when the old watch already went away with
+	// the replaced inode.
+	_ = watcher.Remove(path)
+	if err := watcher.Add(path); err != nil {
+		slog.Warn("could not re-watch kubeconfig file after rename", "path", path, "error", err)
+	}
+}
+
 // StopWatching stops watching the kubeconfig file.
 //
 // issue 6469 — Safe to call multiple times. Previously a second call
@@ -1263,6 +1562,35 @@ func (m *MultiClusterClient) SetOnReload(callback func()) {
 	m.onReload = callback
 }
 
+// SubscribeReload returns a channel that receives a value after each
+// kubeconfig reload, and a function that unsubscribes and closes the
+// channel. Unlike SetOnReload, any number of subsystems can subscribe. The
+// channel is buffered by one and notifications coalesce, so a slow
+// subscriber sees at least one signal after the latest reload rather than
+// one per reload.
+func (m *MultiClusterClient) SubscribeReload() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	m.mu.Lock()
+	if m.reloadSubs == nil {
+		m.reloadSubs = make(map[int]chan struct{})
+	}
+	id := m.nextReloadSub
+	m.nextReloadSub++
+	m.reloadSubs[id] = ch
+	m.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			m.mu.Lock()
+			delete(m.reloadSubs, id)
+			m.mu.Unlock()
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
+
 // SetOnWatchError sets a callback invoked when the kubeconfig watcher encounters
 // an error (e.g., reload failure). Allows callers to monitor watcher health (#5569).
 func (m *MultiClusterClient) SetOnWatchError(callback func(error)) {
@@ -1276,10 +1604,12 @@ func (m *MultiClusterClient) ListClusters(ctx context.Context) ([]ClusterInfo, e
 	m.mu.RLock()
 	rawConfig := m.rawConfig
 	inClusterConfig := m.inClusterConfig
+	manual := m.manualClusters.list()
 	m.mu.RUnlock()
 
 	if rawConfig == nil && inClusterConfig == nil {
-		if err := m.LoadConfig(); err != nil {
+		// Manual clusters are listed even without a usable kubeconfig.
+		if err := m.LoadConfig(); err != nil && len(manual) == 0 {
 			return nil, err
 		}
 		m.mu.RLock()
@@ -1288,7 +1618,11 @@ func (m *MultiClusterClient) ListClusters(ctx context.Context) ([]ClusterInfo, e
 		m.mu.RUnlock()
 	}
 
-	var clusters []ClusterInfo
+	clusters := manual
+	manualNames := make(map[string]bool, len(manual))
+	for _, c := range manual {
+		manualNames[c.Name] = true
+	}
 
 	// If we have in-cluster config, add the local cluster with detected name
 	if inClusterConfig != nil {
@@ -1310,6 +1644,9 @@ func (m *MultiClusterClient) ListClusters(ctx context.Context) ([]ClusterInfo, e
 		currentContext := rawConfig.CurrentContext
 
 		for contextName, contextInfo := range rawConfig.Contexts {
+			if manualNames[contextName] {
+				continue // registered manually before the kubeconfig gained it
+			}
 			clusterInfo, exists := rawConfig.Clusters[contextInfo.Cluster]
 			server := ""
 			if exists {
@@ -1320,19 +1657,7 @@ func (m *MultiClusterClient) ListClusters(ctx context.Context) ([]ClusterInfo, e
 			user := contextInfo.AuthInfo
 
 			// Detect auth method from kubeconfig AuthInfo
-			authMethod := "unknown"
-			if ai, ok := rawConfig.AuthInfos[contextInfo.AuthInfo]; ok && ai != nil {
-				switch {
-				case ai.Exec != nil:
-					authMethod = "exec"
-				case ai.Token != "" || ai.TokenFile != "":
-					authMethod = "token"
-				case len(ai.ClientCertificateData) > 0 || ai.ClientCertificate != "":
-					authMethod = "certificate"
-				case ai.AuthProvider != nil:
-					authMethod = "auth-provider"
-				}
-			}
+			authMethod := authMethodOf(rawConfig.AuthInfos[contextInfo.AuthInfo])
 
 			clusters = append(clusters, ClusterInfo{
 				Name:       contextName,
@@ -1346,6 +1671,25 @@ func (m *MultiClusterClient) ListClusters(ctx context.Context) ([]ClusterInfo, e
 		}
 	}
 
+	// Add the hub's inventory; a cluster already listed under the same name
+	// or server keeps its kubeconfig, manual or in-cluster entry, which can
+	// be reached.
+	if inventory := m.inventoryClusters(ctx); len(inventory) > 0 {
+		listed := make(map[string]bool, len(clusters))
+		servers := make(map[string]bool, len(clusters))
+		for _, c := range clusters {
+			listed[c.Name] = true
+			if c.Server != "" {
+				servers[c.Server] = true
+			}
+		}
+		for _, c := range inventory {
+			if !listed[c.Name] && (c.Server == "" || !servers[c.Server]) {
+				clusters = append(clusters, c)
+			}
+		}
+	}
+
 	// Sort by name
 	sort.Slice(clusters, func(i, j int) bool {
 		return clusters[i].Name < clusters[j].Name
@@ -1355,7 +1699,8 @@ func (m *MultiClusterClient) ListClusters(ctx context.Context) ([]ClusterInfo, e
 }
 
 // DeduplicatedClusters returns one cluster per unique server URL, preferring
-// short/user-friendly context names over auto-generated OpenShift names.
+// short/user-friendly context names over auto-generated OpenShift names. A
+// cluster that can be queried always wins over an inventory-only one.
 // This prevents double-counting when the same physical cluster is reachable
 // via multiple kubeconfig contexts (e.g. "vllm-d" and
 // "default/api-fmaas-vllm-d-fmaas-res-ibm-com:6443/...").
@@ -1383,8 +1728,8 @@ func (m *MultiClusterClient) DeduplicatedClusters(ctx context.Context) ([]Cluste
 			serverGroups[cl.Server] = &group{primary: cl}
 			continue
 		}
-		// Pick the shorter/friendlier name as primary
-		if isBetterClusterName(cl.Name, g.primary.Name) {
+		// Pick the queryable, then the shorter/friendlier name as primary
+		if isBetterCluster(cl, g.primary) {
 			g.others = append(g.others, g.primary.Name)
 			g.primary = cl
 		} else {
@@ -1422,6 +1767,9 @@ func (m *MultiClusterClient) WarmupHealthCache() {
 	slog.Info("[Warmup] probing clusters for reachability", "clusterCount", len(clusters))
 	var wg sync.WaitGroup
 	for _, cl := range clusters {
+		if cl.InventoryOnly {
+			continue
+		}
 		wg.Add(1)
 		go func(name, ctxName string) {
 			defer wg.Done()
@@ -1534,6 +1882,7 @@ func (m *MultiClusterClient) WarmupHealthCache() {
 // blocking on timeouts). Clusters with no cached health data are treated as
 // healthy (unknown = try them). This prevents spawning goroutines for clusters
 // known to be unreachable, eliminating 15-30s timeout waste per offline cluster.
+// Inventory-only clusters are in neither list; they cannot be queried.
 func (m *MultiClusterClient) HealthyClusters(ctx context.Context) (healthy []ClusterInfo, offline []ClusterInfo, err error) {
 	all, err := m.DeduplicatedClusters(ctx)
 	if err != nil {
@@ -1543,6 +1892,9 @@ func (m *MultiClusterClient) HealthyClusters(ctx context.Context) (healthy []Clu
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 	for _, cl := range all {
+		if cl.InventoryOnly {
+			continue
+		}
 		if h, ok := m.healthCache[cl.Context]; ok && !h.Reachable {
 			cl.NeverConnected = h.LastSeen == ""
 			offline = append(offline, cl)
@@ -1573,6 +1925,16 @@ func (m *MultiClusterClient) IsSlow(clusterName string) bool {
 	return false
 }
 
+// isBetterCluster reports whether candidate should replace current as the
+// primary entry of their server: a queryable cluster beats an inventory-only
+// one, and otherwise the friendlier name wins.
+func isBetterCluster(candidate, current ClusterInfo) bool {
+	if candidate.InventoryOnly != current.InventoryOnly {
+		return !candidate.InventoryOnly
+	}
+	return isBetterClusterName(candidate.Name, current.Name)
+}
+
 // isBetterClusterName returns true if candidate is a better (more user-friendly)
 // name than current. Prefers shorter names without slashes or port numbers.
 func isBetterClusterName(candidate, current string) bool {
@@ -1587,6 +1949,25 @@ func isBetterClusterName(candidate, current string) bool {
 	return len(candidate) < len(current)
 }
 
+// authMethodOf classifies how a kubeconfig AuthInfo authenticates: exec,
+// token, certificate, auth-provider, or unknown (including a nil AuthInfo).
+func authMethodOf(ai *api.AuthInfo) string {
+	switch {
+	case ai == nil:
+		return "unknown"
+	case ai.Exec != nil:
+		return "exec"
+	case ai.Token != "" || ai.TokenFile != "":
+		return "token"
+	case len(ai.ClientCertificateData) > 0 || ai.ClientCertificate != "":
+		return "certificate"
+	case ai.AuthProvider != nil:
+		return "auth-provider"
+	default:
+		return "unknown"
+	}
+}
+
 // GetClient returns a kubernetes client for the specified context.
 //
 // #9334 — Client construction (especially `clientcmd…ClientConfig()` for
@@ -1607,6 +1988,7 @@ func (m *MultiClusterClient) GetClient(contextName string) (kubernetes.Interface
 	inClusterConfig := m.inClusterConfig
 	kubeconfigPath := m.kubeconfig
 	inClusterName := m.inClusterName
+	manualConfig, isManual := m.manualClusters.get(contextName)
 	m.mu.RUnlock()
 
 	// Build the client OUTSIDE the lock so concurrent callers for distinct
@@ -1619,7 +2001,9 @@ func (m *MultiClusterClient) GetClient(contextName string) (kubernetes.Interface
 
 	// Handle in-cluster context specially — accept both "in-cluster" and the detected name
 	isInCluster := inClusterConfig != nil && (contextName == "in-cluster" || contextName == inClusterName)
-	if isInCluster {
+	if isManual {
+		config = manualConfig
+	} else if isInCluster {
 		config = rest.CopyConfig(inClusterConfig)
 	} else {
 		config, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
@@ -1634,6 +2018,7 @@ func (m *MultiClusterClient) GetClient(contextName string) (kubernetes.Interface
 	// Set reasonable timeouts — large OpenShift clusters (18+ nodes) can return
 	// 800KB+ node payloads that take >10s over higher-latency links
 	config.Timeout = k8sClientTimeout
+	m.applyImpersonation(config)
 
 	client, err := kubernetes.NewForConfig(config)
 	if err != nil {
@@ -1685,6 +2070,7 @@ func (m *MultiClusterClient) GetDynamicClient(contextName string) (dynamic.Inter
 	inClusterConfig := m.inClusterConfig
 	kubeconfigPath := m.kubeconfig
 	inClusterName := m.inClusterName
+	manualConfig, isManual := m.manualClusters.get(contextName)
 	m.mu.RUnlock()
 
 	// Build the client OUTSIDE the lock so concurrent callers for distinct
@@ -1698,7 +2084,9 @@ func (m *MultiClusterClient) GetDynamicClient(contextName string) (dynamic.Inter
 	} else {
 		var err error
 		isInCluster := inClusterConfig != nil && (contextName == "in-cluster" || contextName == inClusterName)
-		if isInCluster {
+		if isManual {
+			config = manualConfig
+		} else if isInCluster {
 			config = rest.CopyConfig(inClusterConfig)
 		} else {
 			config, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
@@ -1710,6 +2098,7 @@ func (m *MultiClusterClient) GetDynamicClient(contextName string) (dynamic.Inter
 			}
 		}
 		config.Timeout = k8sClientTimeout
+		m.applyImpersonation(config)
 	}
 
 	client, err := dynamic.NewForConfig(config)
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -55,10 +57,11 @@ func (m *MultiClusterClient) getGPUNodesWithPods(ctx context.Context, contextNam
 			"cluster", contextName, "error", allPodsErr)
 	}
 	// Track allocations by node and accelerator type
-	gpuAllocationByNode := make(map[string]int) // GPU allocations
-	tpuAllocationByNode := make(map[string]int) // TPU allocations
-	aiuAllocationByNode := make(map[string]int) // AIU (IBM AIU) allocations
-	xpuAllocationByNode := make(map[string]int) // XPU allocations
+	gpuAllocationByNode := make(map[string]int)            // GPU allocations
+	tpuAllocationByNode := make(map[string]int)            // TPU allocations
+	aiuAllocationByNode := make(map[string]int)            // AIU (IBM AIU) allocations
+	xpuAllocationByNode := make(map[string]int)            // XPU allocations
+	migAllocationByNode := make(map[string]map[string]int) // MIG slices by profile
 	if allPods != nil {
 		for _, pod := range allPods.Items {
 			nodeName := pod.Spec.NodeName
@@ -84,6 +87,15 @@ func (m *MultiClusterClient) getGPUNodesWithPods(ctx context.Context, contextNam
 				if aiuReq, ok := container.Resources.Requests["ibm.com/aiu"]; ok {
 					aiuAllocationByNode[nodeName] += int(aiuReq.Value())
 				}
+				// Check NVIDIA MIG slice requests (mixed strategy)
+				for name, qty := range container.Resources.Requests {
+					if profile, ok := strings.CutPrefix(string(name), migResourcePrefix); ok {
+						if migAllocationByNode[nodeName] == nil {
+							migAllocationByNode[nodeName] = make(map[string]int)
+						}
+						migAllocationByNode[nodeName][profile] += int(qty.Value())
+					}
+				}
 			}
 		}
 	}
@@ -106,19 +118,41 @@ func (m *MultiClusterClient) getGPUNodesWithPods(ctx context.Context, contextNam
 		// AIUs (IBM)
 		ibmAIUQty, hasIBMAIU := node.Status.Allocatable["ibm.com/aiu"]
 
-		hasAnyAccelerator := hasNvidiaGPU || hasAMDGPU || hasIntelGPU || hasTPU || hasGaudi || hasGaudi2 || hasIntelGaudi || hasXPU || hasIBMAIU
+		// MIG slices (NVIDIA, mixed strategy). A fully partitioned GPU
+		// advertises nvidia.com/gpu: 0 and only mig-* resources.
+		migDevices := migProfilesForNode(&node, migAllocationByNode[node.Name])
+		hasMIG := len(migDevices) > 0
+
+		hasAnyAccelerator := hasMIG || hasNvidiaGPU || hasAMDGPU || hasIntelGPU || hasTPU || hasGaudi || hasGaudi2 || hasIntelGaudi || hasXPU || hasIBMAIU
 		if !hasAnyAccelerator {
 			continue
 		}
 
 		var deviceCount int
+		var migAllocated int // physical GPUs in use through MIG slices
 		var manufacturer string
 		var deviceType string
 		var accelType AcceleratorType
+		// AMD / Intel feature-discovery details; NVIDIA GFD labels are read below.
+		var vendorLabels gpuLabelDetails
 
 		// Check GPUs first
-		if hasNvidiaGPU && nvidiaGPUQty.Value() > 0 {
+		if (hasNvidiaGPU && nvidiaGPUQty.Value() > 0) || hasMIG {
 			deviceCount = int(nvidiaGPUQty.Value())
+			if hasMIG {
+				// MIG-partitioned GPUs are missing from nvidia.com/gpu; GFD
+				// still reports the physical device count.
+				migGPUs := 1
+				physical, err := strconv.Atoi(node.Labels["nvidia.com/gpu.count"])
+				if err != nil {
+					slog.Debug("[GPUNodes] invalid nvidia.com/gpu.count label, assuming one MIG GPU",
+						"cluster", contextName, "node", node.Name, "error", err)
+				} else if physical-deviceCount > migGPUs {
+					migGPUs = physical - deviceCount
+				}
+				deviceCount += migGPUs
+				migAllocated = migAllocatedGPUs(migDevices, migGPUs)
+			}
 			manufacturer = "NVIDIA"
 			accelType = AcceleratorGPU
 			// Get GPU type from NVIDIA GPU Feature Discovery labels
@@ -133,8 +167,9 @@ func (m *MultiClusterClient) getGPUNodesWithPods(ctx context.Context, contextNam
 			deviceCount = int(amdGPUQty.Value())
 			manufacturer = "AMD"
 			accelType = AcceleratorGPU
-			if label, ok := node.Labels["amd.com/gpu.product"]; ok {
-				deviceType = label
+			vendorLabels = amdGPULabelDetails(node.Labels)
+			if vendorLabels.Product != "" {
+				deviceType = vendorLabels.Product
 			} else {
 				deviceType = "AMD GPU"
 			}
@@ -142,7 +177,12 @@ func (m *MultiClusterClient) getGPUNodesWithPods(ctx context.Context, contextNam
 			deviceCount = int(intelGPUQty.Value())
 			manufacturer = "Intel"
 			accelType = AcceleratorGPU
-			deviceType = "Intel GPU"
+			vendorLabels = intelGPULabelDetails(node.Labels)
+			if vendorLabels.Product != "" {
+				deviceType = vendorLabels.Product
+			} else {
+				deviceType = "Intel GPU"
+			}
 		} else if hasTPU && tpuQty.Value() > 0 {
 			// Google TPU
 			deviceCount = int(tpuQty.Value())
@@ -245,6 +285,13 @@ func (m *MultiClusterClient) getGPUNodesWithPods(ctx context.Context, contextNam
 			}
 		}
 
+		if vendorLabels.MemoryMB > 0 {
+			gpuMemoryMB = vendorLabels.MemoryMB
+		}
+		if vendorLabels.Family != "" {
+			gpuFamily = vendorLabels.Family
+		}
+
 		// MIG capability
 		if migLabel, ok := node.Labels["nvidia.com/mig.capable"]; ok {
 			migCapable = migLabel == "true"
@@ -255,11 +302,17 @@ func (m *MultiClusterClient) getGPUNodesWithPods(ctx context.Context, contextNam
 			migStrategy = strategyLabel
 		}
 
+		// Per-profile MIG slices are only meaningful under the mixed
+		// strategy; "single" exposes slices as plain nvidia.com/gpu.
+		if migStrategy != "mixed" {
+			migDevices = nil
+		}
+
 		// Get allocated accelerators from pre-computed map based on type
 		var allocated int
 		switch accelType {
 		case AcceleratorGPU:
-			allocated = gpuAllocationByNode[node.Name]
+			allocated = gpuAllocationByNode[node.Name] + migAllocated
 		case AcceleratorTPU:
 			allocated = tpuAllocationByNode[node.Name]
 		case AcceleratorAIU:
@@ -299,12 +352,73 @@ func (m *MultiClusterClient) getGPUNodesWithPods(ctx context.Context, contextNam
 			MIGCapable:         migCapable,
 			MIGStrategy:        migStrategy,
 			Manufacturer:       manufacturer,
+			MIGDevices:         migDevices,
 		})
 	}
 
+	m.enrichGPUUtilization(ctx, contextName, gpuNodes)
+
 	return gpuNodes, allPods, nil
 }
 
+// migResourcePrefix prefixes the extended resources the NVIDIA device plugin
+// advertises for MIG slices under the mixed strategy.
+const migResourcePrefix = "nvidia.com/mig-"
+
+// migProfilesForNode returns the node's allocatable MIG slices by profile,
+// sorted by profile name, with the slices already requested by pods.
+func migProfilesForNode(node *corev1.Node, allocated map[string]int) []MIGProfile {
+	var profiles []MIGProfile
+	for name, qty := range node.Status.Allocatable {
+		profile, ok := strings.CutPrefix(string(name), migResourcePrefix)
+		if !ok || qty.Value() <= 0 {
+			continue
+		}
+		profiles = append(profiles, MIGProfile{
+			Profile:   profile,
+			Count:     int(qty.Value()),
+			Allocated: allocated[profile],
+		})
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Profile < profiles[j].Profile })
+	return profiles
+}
+
+// migAllocatedGPUs converts the MIG slices requested by pods into physical
+// GPUs in use, so a partitioned GPU whose slices are taken does not look
+// free. Slices are weighted by their compute size ("3g.20gb" is three
+// units) and the used share of the node's migGPUs is rounded up.
+func migAllocatedGPUs(profiles []MIGProfile, migGPUs int) int {
+	var total, used int
+	for _, p := range profiles {
+		units := migComputeUnits(p.Profile)
+		total += p.Count * units
+		used += p.Allocated * units
+	}
+	if total == 0 || used == 0 {
+		return 0
+	}
+	allocated := (used*migGPUs + total - 1) / total
+	if allocated > migGPUs {
+		allocated = migGPUs
+	}
+	return allocated
+}
+
+// migComputeUnits returns the compute slice count of a MIG profile such as
+// "2g.10gb", or 1 when the profile name has no parsable prefix.
+func migComputeUnits(profile string) int {
+	prefix, _, ok := strings.Cut(profile, "g.")
+	if !ok {
+		return 1
+	}
+	units, err := strconv.Atoi(prefix)
+	if err != nil || units <= 0 {
+		return 1
+	}
+	return units
+}
+
 // GPU operator namespace names to search for operator pods
 var gpuOperatorNamespaces = []string{
 	"nvidia-gpu-operator",
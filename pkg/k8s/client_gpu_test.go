@@ -406,3 +406,192 @@ func TestDeriveGPUNodeStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGPUNodes_MIGMixedStrategy(t *testing.T) {
+	ctx := context.Background()
+	m := &MultiClusterClient{}
+
+	// Fully partitioned A100: no whole GPUs left, only MIG slices.
+	migNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mig-node",
+			Labels: map[string]string{
+				"nvidia.com/gpu.product":  "NVIDIA-A100-SXM4-40GB",
+				"nvidia.com/gpu.count":    "1",
+				"nvidia.com/mig.capable":  "true",
+				"nvidia.com/mig.strategy": "mixed",
+			},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				"nvidia.com/gpu":         resource.MustParse("0"),
+				"nvidia.com/mig-1g.5gb":  resource.MustParse("7"),
+				"nvidia.com/mig-3g.20gb": resource.MustParse("1"),
+				"nvidia.com/mig-2g.10gb": resource.MustParse("0"),
+				corev1.ResourceCPU:       resource.MustParse("32"),
+			},
+		},
+	}
+	migPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "inference", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: "mig-node",
+			Containers: []corev1.Container{{
+				Name: "model",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{"nvidia.com/mig-1g.5gb": resource.MustParse("2")},
+				},
+			}},
+		},
+	}
+	m.InjectClient("c1", fake.NewSimpleClientset(migNode, migPod))
+
+	nodes, err := m.GetGPUNodes(ctx, "c1")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	node := nodes[0]
+
+	assert.Equal(t, "NVIDIA", node.Manufacturer)
+	assert.Equal(t, 1, node.GPUCount, "physical count comes from the GFD label")
+	assert.Equal(t, 1, node.GPUAllocated, "a GPU with slices in use is not free")
+	assert.Equal(t, []MIGProfile{
+		{Profile: "1g.5gb", Count: 7, Allocated: 2},
+		{Profile: "3g.20gb", Count: 1, Allocated: 0},
+	}, node.MIGDevices)
+}
+
+func TestGetGPUNodes_MIGSingleStrategyHasNoBreakdown(t *testing.T) {
+	m := &MultiClusterClient{}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "single-node",
+			Labels: map[string]string{"nvidia.com/mig.strategy": "single"},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("7")},
+		},
+	}
+	m.InjectClient("c1", fake.NewSimpleClientset(node))
+
+	nodes, err := m.GetGPUNodes(context.Background(), "c1")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Empty(t, nodes[0].MIGDevices)
+}
+
+func TestGetGPUNodes_AMDFeatureDiscoveryLabels(t *testing.T) {
+	m := &MultiClusterClient{}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mi300-node",
+			Labels: map[string]string{
+				"amd.com/gpu.product-name": "AMD_Instinct_MI300X_OAM",
+				"amd.com/gpu.vram":         "192G",
+				"amd.com/gpu.family":       "AI",
+			},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{"amd.com/gpu": resource.MustParse("8")},
+		},
+	}
+	m.InjectClient("c1", fake.NewSimpleClientset(node))
+
+	nodes, err := m.GetGPUNodes(context.Background(), "c1")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "AMD", nodes[0].Manufacturer)
+	assert.Equal(t, 8, nodes[0].GPUCount)
+	assert.Equal(t, "AMD_Instinct_MI300X_OAM", nodes[0].GPUType)
+	assert.Equal(t, 192*1024, nodes[0].GPUMemoryMB)
+	assert.Equal(t, "AI", nodes[0].GPUFamily)
+}
+
+func TestGetGPUNodes_IntelFeatureDiscoveryLabels(t *testing.T) {
+	m := &MultiClusterClient{}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "flex-node",
+			Labels: map[string]string{
+				"gpu.intel.com/product":    "Flex_170",
+				"gpu.intel.com/family":     "Flex_Series",
+				"gpu.intel.com/memory.max": "17179869184",
+			},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{"gpu.intel.com/i915": resource.MustParse("1")},
+		},
+	}
+	bare := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "bare-intel"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{"gpu.intel.com/i915": resource.MustParse("2")},
+		},
+	}
+	m.InjectClient("c1", fake.NewSimpleClientset(node, bare))
+
+	nodes, err := m.GetGPUNodes(context.Background(), "c1")
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	byName := map[string]GPUNode{}
+	for _, n := range nodes {
+		byName[n.Name] = n
+	}
+
+	flex := byName["flex-node"]
+	assert.Equal(t, "Intel", flex.Manufacturer)
+	assert.Equal(t, "Intel Flex 170", flex.GPUType)
+	assert.Equal(t, 16384, flex.GPUMemoryMB)
+	assert.Equal(t, "Flex Series", flex.GPUFamily)
+
+	assert.Equal(t, "Intel", byName["bare-intel"].Manufacturer)
+	assert.Equal(t, "Intel GPU", byName["bare-intel"].GPUType)
+	assert.Zero(t, byName["bare-intel"].GPUMemoryMB)
+	assert.Empty(t, byName["bare-intel"].GPUFamily)
+}
+
+func TestParseVRAMLabelMB(t *testing.T) {
+	tests := map[string]int{
+		"64G":    65536,
+		"192GB":  196608,
+		"16384M": 16384,
+		"1T":     1048576,
+		"":       0,
+		"lots":   0,
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, parseVRAMLabelMB(in), in)
+	}
+}
+
+func TestMIGAllocatedGPUs(t *testing.T) {
+	profiles := []MIGProfile{
+		{Profile: "1g.5gb", Count: 7, Allocated: 0},
+		{Profile: "3g.20gb", Count: 2, Allocated: 1},
+	}
+	assert.Equal(t, 1, migAllocatedGPUs(profiles, 2), "3 of 13 compute units in use rounds up to one GPU")
+	assert.Equal(t, 0, migAllocatedGPUs([]MIGProfile{{Profile: "1g.5gb", Count: 7}}, 1))
+	assert.Equal(t, 2, migAllocatedGPUs([]MIGProfile{{Profile: "1g.5gb", Count: 14, Allocated: 14}}, 2))
+	assert.Equal(t, 1, migComputeUnits("weird-profile"))
+}
+
+func TestGetGPUNodes_MIGInvalidGPUCountLabel(t *testing.T) {
+	m := &MultiClusterClient{}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mig-node",
+			Labels: map[string]string{
+				"nvidia.com/gpu.count":    "n/a",
+				"nvidia.com/mig.strategy": "mixed",
+			},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{"nvidia.com/mig-1g.5gb": resource.MustParse("7")},
+		},
+	}
+	m.InjectClient("c1", fake.NewSimpleClientset(node))
+
+	nodes, err := m.GetGPUNodes(context.Background(), "c1")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, 1, nodes[0].GPUCount, "MIG slices imply at least one GPU")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -29,16 +30,26 @@ func classifyError(errMsg string) string {
 		return "timeout"
 	}
 
-	// Config errors — exec-plugin (client-go credential helper) missing or
-	// misconfigured. Must be checked BEFORE the auth branch, otherwise
-	// messages like `exec: "aws-iam-authenticator": executable file not found
-	// in $PATH` get classified as auth failures and hit the 10-minute
-	// authFailureCacheTTL, hiding a config problem the user can actually fix
-	// (#6508). This is kubeconfig/env misconfiguration, not a credential issue.
-	if strings.Contains(lowerMsg, "executable file not found") ||
+	// Rate-limit errors — apiserver priority & fairness or a fronting proxy
+	// answered 429. Transient, so callers may retry after backing off.
+	// Matched on the status text, not a bare "429" that also appears in
+	// ports, IDs and byte counts; typed errors go through isRetryableError.
+	if strings.Contains(lowerMsg, "too many requests") ||
+		strings.Contains(lowerMsg, "rate limit") {
+		return "ratelimit"
+	}
+
+	// Credential-plugin errors — the kubeconfig's exec credential plugin
+	// (aws, gcloud, tsh, ...) is missing or exited non-zero. Must be checked
+	// BEFORE the auth branch, otherwise messages like `exec:
+	// "aws-iam-authenticator": executable file not found in $PATH` get
+	// classified as auth failures and hit the 10-minute authFailureCacheTTL,
+	// hiding a problem the user can actually fix (#6508).
+	if _, _, ok := credentialPluginFailure(errMsg); ok ||
+		strings.Contains(lowerMsg, "executable file not found") ||
 		(strings.Contains(lowerMsg, "exec:") && strings.Contains(lowerMsg, "not found")) ||
 		strings.Contains(lowerMsg, "executable not found") {
-		return "config"
+		return "credential-plugin"
 	}
 
 	// Auth errors — narrowed to messages that clearly indicate an identity
@@ -60,6 +71,7 @@ func classifyError(errMsg string) string {
 
 	// Network errors
 	if strings.Contains(lowerMsg, "connection refused") ||
+		strings.Contains(lowerMsg, "connection reset") ||
 		strings.Contains(lowerMsg, "no route to host") ||
 		strings.Contains(lowerMsg, "network unreachable") ||
 		strings.Contains(lowerMsg, "dial tcp") ||
@@ -87,40 +99,150 @@ func classifyError(errMsg string) string {
 	return "unknown"
 }
 
+// Patterns of os/exec and client-go errors raised when an exec credential
+// plugin cannot run. The first group is the plugin command.
+var (
+	credentialPluginMissingPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`exec: "([^"]+)": executable file not found`),
+		regexp.MustCompile(`executable (\S+) not found`),
+	}
+	credentialPluginExitPattern = regexp.MustCompile(`executable (\S+) failed with exit code (\d+)`)
+)
+
+// credentialPluginFailure extracts the plugin command from an exec
+// credential plugin error, and whether the plugin ran but exited non-zero
+// (as opposed to not being installed).
+func credentialPluginFailure(errMsg string) (command string, exited, ok bool) {
+	if match := credentialPluginExitPattern.FindStringSubmatch(errMsg); match != nil {
+		return match[1], true, true
+	}
+	for _, pattern := range credentialPluginMissingPatterns {
+		if match := pattern.FindStringSubmatch(errMsg); match != nil {
+			return match[1], false, true
+		}
+	}
+	return "", false, false
+}
+
+// setHealthError records a failed health probe on health. Credential-plugin
+// failures get a message naming the plugin command, falling back to the
+// command configured in the kubeconfig when the error does not include it.
+func (m *MultiClusterClient) setHealthError(health *ClusterHealth, contextName string, err error) {
+	errMsg := err.Error()
+	health.Healthy = false
+	health.Reachable = false
+	health.ErrorType = classifyError(errMsg)
+	health.ErrorMessage = errMsg
+	if health.ErrorType != "credential-plugin" {
+		return
+	}
+
+	command, exited, _ := credentialPluginFailure(errMsg)
+	if command == "" {
+		command = m.execPluginCommand(contextName)
+	}
+	switch {
+	case command == "":
+		health.ErrorMessage = "credential plugin could not be run: " + errMsg
+	case exited:
+		health.ErrorMessage = fmt.Sprintf("credential plugin %q failed; run it in a terminal to see why (for example an expired login): %s", command, errMsg)
+	default:
+		health.ErrorMessage = fmt.Sprintf("credential plugin %q is not installed or not in the console's PATH", command)
+	}
+}
+
+// execPluginCommand returns the exec credential plugin command configured
+// for contextName in the kubeconfig, or "" when it uses none.
+func (m *MultiClusterClient) execPluginCommand(contextName string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.rawConfig == nil {
+		return ""
+	}
+	ctx, ok := m.rawConfig.Contexts[contextName]
+	if !ok || ctx == nil {
+		return ""
+	}
+	if ai, ok := m.rawConfig.AuthInfos[ctx.AuthInfo]; ok && ai != nil && ai.Exec != nil {
+		return ai.Exec.Command
+	}
+	return ""
+}
+
 // GetClusterHealth returns health status for a cluster
 func (m *MultiClusterClient) GetClusterHealth(ctx context.Context, contextName string) (*ClusterHealth, error) {
-	// Check cache — also save previous cached data for fallback on partial failures.
-	// Auth-failed clusters use a longer TTL to avoid repeatedly triggering exec
-	// credential plugins (e.g. tsh) that flood stderr with relogin errors (#3158).
-	var prevCached *ClusterHealth
-	m.mu.RLock()
-	if health, ok := m.healthCache[contextName]; ok {
-		ttl := m.cacheTTL
-		if health.ErrorType == "auth" {
-			ttl = authFailureCacheTTL
-		}
-		if time.Since(m.cacheTime[contextName]) < ttl {
-			m.mu.RUnlock()
-			return health, nil
+	return m.clusterHealth(ctx, contextName, false)
+}
+
+// clusterHealth is GetClusterHealth; skipCache probes the cluster even when
+// a fresh cached result exists. The result is cached either way. Concurrent
+// probes of one context (e.g. several dashboard widgets hitting a cold
+// cache) are collapsed into a single scan whose result they all share.
+//
+// The shared scan is detached from the caller that started it and bounded
+// by its own timeout, so one caller giving up (or a short per-cluster
+// deadline) does not fail everyone who joined; each caller stops waiting
+// when its own ctx is done.
+func (m *MultiClusterClient) clusterHealth(ctx context.Context, contextName string, skipCache bool) (*ClusterHealth, error) {
+	health, fresh := m.cachedClusterHealth(contextName)
+	if fresh && !skipCache {
+		return health, nil
+	}
+	timeout := perClusterHealthTimeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) > timeout {
+		timeout = time.Until(deadline)
+	}
+	ch := m.healthFlight.DoChan(contextName, func() (interface{}, error) {
+		probeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		return m.probeClusterHealth(probeCtx, contextName, health)
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
 		}
-		prevCached = health
+		return res.Val.(*ClusterHealth), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
-	m.mu.RUnlock()
+}
+
+// cachedClusterHealth returns the cached health of contextName, nil when
+// there is none, and whether it is still within its TTL. Auth-failed
+// clusters use a longer TTL to avoid repeatedly triggering exec credential
+// plugins (e.g. tsh) that flood stderr with relogin errors (#3158).
+// Credential-plugin failures keep the normal TTL so a fresh plugin login
+// shows up promptly (#6508).
+func (m *MultiClusterClient) cachedClusterHealth(contextName string) (*ClusterHealth, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	health, ok := m.healthCache[contextName]
+	if !ok {
+		return nil, false
+	}
+	ttl := m.cacheTTL
+	if health.ErrorType == "auth" {
+		ttl = authFailureCacheTTL
+	}
+	return health, time.Since(m.cacheTime[contextName]) < ttl
+}
 
+// probeClusterHealth scans contextName and caches the result unless the
+// failure is transient. prevCached, the previous cached result if any, fills
+// in values a partial failure could not fetch.
+func (m *MultiClusterClient) probeClusterHealth(ctx context.Context, contextName string, prevCached *ClusterHealth) (*ClusterHealth, error) {
 	now := time.Now().Format(time.RFC3339)
 
 	client, err := m.GetClient(contextName)
 	if err != nil {
-		errMsg := err.Error()
-		return &ClusterHealth{
-			Cluster:      contextName,
-			Healthy:      false,
-			Reachable:    false,
-			ErrorType:    classifyError(errMsg),
-			ErrorMessage: errMsg,
-			Issues:       []string{fmt.Sprintf("Failed to connect: %v", err)},
-			CheckedAt:    now,
-		}, nil
+		health := &ClusterHealth{
+			Cluster:   contextName,
+			Issues:    []string{fmt.Sprintf("Failed to connect: %v", err)},
+			CheckedAt: now,
+		}
+		m.setHealthError(health, contextName, err)
+		return health, nil
 	}
 
 	health := &ClusterHealth{
@@ -144,28 +266,34 @@ func (m *MultiClusterClient) GetClusterHealth(ctx context.Context, contextName s
 		wg       sync.WaitGroup
 	)
 
+	attempts := m.getRetryAttempts()
 	wg.Add(3)
 	go func() {
 		defer wg.Done()
-		nodes, nodesErr = client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		nodesErr = withRetry(ctx, attempts, func() (err error) {
+			nodes, err = client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+			return err
+		})
 	}()
 	go func() {
 		defer wg.Done()
-		pods, podsErr = client.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+		podsErr = withRetry(ctx, attempts, func() (err error) {
+			pods, err = client.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+			return err
+		})
 	}()
 	go func() {
 		defer wg.Done()
-		pvcs, pvcsErr = client.CoreV1().PersistentVolumeClaims("").List(ctx, metav1.ListOptions{})
+		pvcsErr = withRetry(ctx, attempts, func() (err error) {
+			pvcs, err = client.CoreV1().PersistentVolumeClaims("").List(ctx, metav1.ListOptions{})
+			return err
+		})
 	}()
 	wg.Wait()
 
 	// Process nodes - determines reachability
 	if nodesErr != nil {
-		errMsg := nodesErr.Error()
-		health.Healthy = false
-		health.Reachable = false
-		health.ErrorType = classifyError(errMsg)
-		health.ErrorMessage = errMsg
+		m.setHealthError(health, contextName, nodesErr)
 		health.Issues = append(health.Issues, fmt.Sprintf("Failed to list nodes: %v", nodesErr))
 	} else if nodes != nil {
 		health.NodeCount = len(nodes.Items)
@@ -291,7 +419,7 @@ func (m *MultiClusterClient) GetClusterHealth(ctx context.Context, contextName s
 	// Only cache successful results or non-transient configuration/auth errors.
 	// We don't cache transient failures (timeout, network) so the next
 	// request retries immediately. (#3158)
-	if health.Reachable || health.ErrorType == "auth" || health.ErrorType == "config" {
+	if health.Reachable || health.ErrorType == "auth" || health.ErrorType == "credential-plugin" {
 		m.mu.Lock()
 		m.healthCache[contextName] = health
 		m.cacheTime[contextName] = time.Now()
@@ -440,6 +568,22 @@ func (m *MultiClusterClient) GetCachedHealth() map[string]*ClusterHealth {
 	return result
 }
 
+// ClusterHealthScanOptions bounds a GetAllClusterHealthWithOptions scan.
+type ClusterHealthScanOptions struct {
+	// MaxConcurrency caps the clusters probed at once. Zero probes every
+	// cluster at once.
+	MaxConcurrency int
+	// PerClusterTimeout bounds each cluster's probe. Zero uses
+	// perClusterHealthTimeout.
+	PerClusterTimeout time.Duration
+	// SkipCache probes every cluster even when its cached health is fresh.
+	SkipCache bool
+}
+
+// DefaultClusterHealthScanOptions is what GetAllClusterHealth scans with:
+// every cluster at once, perClusterHealthTimeout each, cache honoured.
+var DefaultClusterHealthScanOptions = ClusterHealthScanOptions{PerClusterTimeout: perClusterHealthTimeout}
+
 // GetAllClusterHealth returns health status for all clusters.
 //
 // A global deadline (totalHealthTimeout) bounds the whole call — one slow
@@ -449,10 +593,35 @@ func (m *MultiClusterClient) GetCachedHealth() map[string]*ClusterHealth {
 // "timeout" and Healthy=false so the caller still gets an entry per cluster
 // instead of waiting indefinitely or silently dropping slow clusters (#6506).
 func (m *MultiClusterClient) GetAllClusterHealth(ctx context.Context) ([]ClusterHealth, error) {
-	clusters, err := m.ListClusters(ctx)
+	return m.GetAllClusterHealthWithOptions(ctx, DefaultClusterHealthScanOptions)
+}
+
+// GetAllClusterHealthWithOptions is GetAllClusterHealth with the scan's
+// concurrency, per-cluster timeout and cache use set by opts. Clusters
+// waiting for a concurrency slot when the global deadline fires are
+// reported as timed out like slow ones.
+func (m *MultiClusterClient) GetAllClusterHealthWithOptions(ctx context.Context, opts ClusterHealthScanOptions) ([]ClusterHealth, error) {
+	listed, err := m.ListClusters(ctx)
 	if err != nil {
 		return nil, err
 	}
+	// Inventory-only clusters cannot be probed; their health comes from
+	// the hub.
+	clusters := make([]ClusterInfo, 0, len(listed))
+	for _, c := range listed {
+		if !c.InventoryOnly {
+			clusters = append(clusters, c)
+		}
+	}
+
+	perClusterTimeout := opts.PerClusterTimeout
+	if perClusterTimeout <= 0 {
+		perClusterTimeout = perClusterHealthTimeout
+	}
+	var sem chan struct{}
+	if opts.MaxConcurrency > 0 {
+		sem = make(chan struct{}, opts.MaxConcurrency)
+	}
 
 	deadlineCtx, cancel := context.WithTimeout(ctx, totalHealthTimeout)
 	defer cancel()
@@ -477,14 +646,22 @@ func (m *MultiClusterClient) GetAllClusterHealth(ctx context.Context) ([]Cluster
 			// expensive health probe. Without this, goroutines that haven't
 			// begun probing yet still launch full k8s API calls even after
 			// the global deadline fires, leaking until the probe completes.
+			if sem != nil {
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-deadlineCtx.Done():
+					return
+				}
+			}
 			select {
 			case <-deadlineCtx.Done():
 				return
 			default:
 			}
-			perCtx, perCancel := context.WithTimeout(deadlineCtx, perClusterHealthTimeout)
+			perCtx, perCancel := context.WithTimeout(deadlineCtx, perClusterTimeout)
 			defer perCancel()
-			health, _ := m.GetClusterHealth(perCtx, c.Name)
+			health, _ := m.clusterHealth(perCtx, c.Name, opts.SkipCache)
 			mu.Lock()
 			slots[idx].health = health
 			slots[idx].done = true
@@ -338,3 +338,69 @@ func TestGetGPUNodeHealth_SinglePodList(t *testing.T) {
 		t.Errorf("#9339 regression: cluster-wide Pods(\"\") listed %d times, want 1", got)
 	}
 }
+
+func TestGetAllClusterHealthWithOptions_SkipCacheAndConcurrency(t *testing.T) {
+	const clusters = 6
+	const maxConcurrency = 2
+	m := &MultiClusterClient{
+		clients:     make(map[string]kubernetes.Interface),
+		healthCache: make(map[string]*ClusterHealth),
+		cacheTime:   make(map[string]time.Time),
+		cacheTTL:    time.Minute,
+		rawConfig:   &api.Config{Contexts: map[string]*api.Context{}},
+	}
+
+	var probes, inflight, peakInflight int32
+	for i := 0; i < clusters; i++ {
+		name := clusterName(i)
+		m.rawConfig.Contexts[name] = &api.Context{Cluster: name}
+		fc := k8sfake.NewSimpleClientset()
+		fc.PrependReactor("list", "nodes", func(action clienttesting.Action) (bool, k8sruntime.Object, error) {
+			atomic.AddInt32(&probes, 1)
+			cur := atomic.AddInt32(&inflight, 1)
+			for {
+				peak := atomic.LoadInt32(&peakInflight)
+				if cur <= peak || atomic.CompareAndSwapInt32(&peakInflight, peak, cur) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			atomic.AddInt32(&inflight, -1)
+			return true, &corev1.NodeList{Items: []corev1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "n"}}}}, nil
+		})
+		m.clients[name] = fc
+	}
+
+	if _, err := m.GetAllClusterHealth(context.Background()); err != nil {
+		t.Fatalf("GetAllClusterHealth: %v", err)
+	}
+	if got := atomic.LoadInt32(&probes); got != clusters {
+		t.Fatalf("first scan probed %d clusters, want %d", got, clusters)
+	}
+
+	// A cached rescan probes nothing; SkipCache probes every cluster again.
+	if _, err := m.GetAllClusterHealth(context.Background()); err != nil {
+		t.Fatalf("cached GetAllClusterHealth: %v", err)
+	}
+	if got := atomic.LoadInt32(&probes); got != clusters {
+		t.Fatalf("cached scan probed %d more clusters, want none", got-clusters)
+	}
+
+	atomic.StoreInt32(&peakInflight, 0)
+	results, err := m.GetAllClusterHealthWithOptions(context.Background(), ClusterHealthScanOptions{
+		MaxConcurrency: maxConcurrency,
+		SkipCache:      true,
+	})
+	if err != nil {
+		t.Fatalf("GetAllClusterHealthWithOptions: %v", err)
+	}
+	if len(results) != clusters {
+		t.Fatalf("got %d results, want %d", len(results), clusters)
+	}
+	if got := atomic.LoadInt32(&probes); got != 2*clusters {
+		t.Errorf("SkipCache scan probed %d clusters, want %d", got-clusters, clusters)
+	}
+	if peak := atomic.LoadInt32(&peakInflight); peak > maxConcurrency {
+		t.Errorf("peak concurrent probes = %d, want at most %d", peak, maxConcurrency)
+	}
+}
@@ -3,6 +3,11 @@ package k8s
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -25,12 +30,16 @@ func TestClassifyError_Detailed(t *testing.T) {
 		{"Auth 401", "error: 401 Unauthorized", "auth"},
 		{"Auth 403", "forbidden access to resource", "auth"},
 		{"Auth Credentials", "failed to get credentials", "auth"},
-		{"Config Exec Missing", "exec: \"tsh\": executable file not found in $PATH", "config"},
-		{"Config File Not Found", "executable not found in path", "config"},
+		{"Credential Plugin Missing", "exec: \"tsh\": executable file not found in $PATH", "credential-plugin"},
+		{"Credential Plugin Not Found", "executable not found in path", "credential-plugin"},
+		{"Credential Plugin Exited", "getting credentials: exec: executable tsh failed with exit code 1", "credential-plugin"},
 		{"Timeout I/O", "dial tcp: i/o timeout", "timeout"},
 		{"Timeout Deadline", "context deadline exceeded", "timeout"},
 		{"Network Refused", "connection refused", "network"},
 		{"Network Lookup", "no such host: api.cluster.local", "network"},
+		{"Network Reset", "read tcp 10.0.0.1:443: connection reset by peer", "network"},
+		{"Rate Limit 429", "the server has received too many requests and has asked us to try again later (429)", "ratelimit"},
+		{"Bare 429 Is Not Rate Limit", "pod web-429 is not ready", "unknown"},
 		{"Cert X509", "x509: certificate signed by unknown authority", "certificate"},
 		{"Not Found Cluster", "cluster \"dev\" not found", "not_found"},
 		{"Unknown Error", "something went wrong", "unknown"},
@@ -115,6 +124,109 @@ func TestGetClusterHealth_Success(t *testing.T) {
 	}
 }
 
+func TestGetClusterHealth_CollapsesConcurrentProbes(t *testing.T) {
+	m := &MultiClusterClient{
+		clients:     make(map[string]kubernetes.Interface),
+		healthCache: make(map[string]*ClusterHealth),
+		cacheTime:   make(map[string]time.Time),
+		cacheTTL:    1 * time.Minute,
+	}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+		}},
+	}
+	client := k8sfake.NewSimpleClientset(node)
+
+	// Hold the first node list open until every caller has started.
+	var nodeLists atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	client.PrependReactor("list", "nodes", func(clienttesting.Action) (bool, k8sruntime.Object, error) {
+		if nodeLists.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return false, nil, nil
+	})
+	m.clients["c1"] = client
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([]*ClusterHealth, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = m.GetClusterHealth(context.Background(), "c1")
+		}(i)
+	}
+	<-started
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := nodeLists.Load(); n != 1 {
+		t.Errorf("nodes listed %d times, want 1", n)
+	}
+	for i, h := range results {
+		if h == nil || h.NodeCount != 1 {
+			t.Errorf("caller %d got %+v, want the shared 1-node result", i, h)
+		}
+	}
+}
+
+func TestGetClusterHealth_CancelledCallerDoesNotFailJoinedCallers(t *testing.T) {
+	m := &MultiClusterClient{
+		clients:     make(map[string]kubernetes.Interface),
+		healthCache: make(map[string]*ClusterHealth),
+		cacheTime:   make(map[string]time.Time),
+		cacheTTL:    1 * time.Minute,
+	}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+		}},
+	}
+	client := k8sfake.NewSimpleClientset(node)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	client.PrependReactor("list", "nodes", func(clienttesting.Action) (bool, k8sruntime.Object, error) {
+		once.Do(func() { close(started) })
+		<-release
+		return false, nil, nil
+	})
+	m.clients["c1"] = client
+
+	// The first caller starts the probe, then gives up while it runs.
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := m.GetClusterHealth(firstCtx, "c1")
+		firstErr <- err
+	}()
+	<-started
+
+	joined := make(chan *ClusterHealth, 1)
+	go func() {
+		h, _ := m.GetClusterHealth(context.Background(), "c1")
+		joined <- h
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancelFirst()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled caller got %v, want context.Canceled", err)
+	}
+
+	close(release)
+	if h := <-joined; h == nil || !h.Reachable || h.NodeCount != 1 {
+		t.Errorf("joined caller got %+v, want the 1-node result", h)
+	}
+}
+
 func TestGetClusterHealth_AuthFailureCaching(t *testing.T) {
 	// This test captures the current bug: auth failures are NOT cached.
 	m := &MultiClusterClient{
@@ -153,6 +265,28 @@ func TestGetClusterHealth_AuthFailureCaching(t *testing.T) {
 	}
 }
 
+func TestCachedClusterHealth_CredentialPluginUsesShortTTL(t *testing.T) {
+	m := &MultiClusterClient{
+		healthCache: map[string]*ClusterHealth{
+			"plugin": {Cluster: "plugin", ErrorType: "credential-plugin"},
+			"auth":   {Cluster: "auth", ErrorType: "auth"},
+		},
+		cacheTime: make(map[string]time.Time),
+		cacheTTL:  1 * time.Minute,
+	}
+	checkedAt := time.Now().Add(-2 * time.Minute)
+	m.cacheTime["plugin"] = checkedAt
+	m.cacheTime["auth"] = checkedAt
+
+	// A plugin re-login must not hide behind authFailureCacheTTL (#6508).
+	if _, fresh := m.cachedClusterHealth("plugin"); fresh {
+		t.Error("credential-plugin failure should expire after cacheTTL")
+	}
+	if _, fresh := m.cachedClusterHealth("auth"); !fresh {
+		t.Error("auth failure should stay cached for authFailureCacheTTL")
+	}
+}
+
 func TestGetAllClusterHealth_Deadline(t *testing.T) {
 	m := &MultiClusterClient{
 		clients:     make(map[string]kubernetes.Interface),
@@ -271,3 +405,70 @@ func TestGetClusterHealth_PartialFailures(t *testing.T) {
 		t.Errorf("Expected fresh NodeCount 1, got %d", health.NodeCount)
 	}
 }
+
+func TestGetClusterHealth_MissingCredentialPlugin(t *testing.T) {
+	const missingPlugin = "kc-test-missing-credential-plugin"
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	kubeconfig := `apiVersion: v1
+kind: Config
+current-context: eks
+contexts:
+  - name: eks
+    context: {cluster: eks, user: eks-user}
+clusters:
+  - name: eks
+    cluster: {server: "https://127.0.0.1:1"}
+users:
+  - name: eks-user
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1beta1
+        command: ` + missingPlugin + `
+        interactiveMode: Never
+`
+	if err := os.WriteFile(path, []byte(kubeconfig), 0600); err != nil {
+		t.Fatalf("write kubeconfig: %v", err)
+	}
+	m, err := NewMultiClusterClient(path)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+	if err := m.LoadConfig(); err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	health, err := m.GetClusterHealth(context.Background(), "eks")
+	if err != nil {
+		t.Fatalf("GetClusterHealth: %v", err)
+	}
+	if health.Reachable {
+		t.Fatal("cluster with a missing credential plugin should be unreachable")
+	}
+	if health.ErrorType != "credential-plugin" {
+		t.Errorf("ErrorType = %q, want credential-plugin (message %q)", health.ErrorType, health.ErrorMessage)
+	}
+	if !strings.Contains(health.ErrorMessage, missingPlugin) {
+		t.Errorf("ErrorMessage %q should name the missing command %q", health.ErrorMessage, missingPlugin)
+	}
+}
+
+func TestCredentialPluginFailure(t *testing.T) {
+	tests := []struct {
+		msg     string
+		command string
+		exited  bool
+		ok      bool
+	}{
+		{`exec: "aws-iam-authenticator": executable file not found in $PATH`, "aws-iam-authenticator", false, true},
+		{"getting credentials: exec: executable gke-gcloud-auth-plugin not found", "gke-gcloud-auth-plugin", false, true},
+		{"getting credentials: exec: executable tsh failed with exit code 1", "tsh", true, true},
+		{"connection refused", "", false, false},
+	}
+	for _, tt := range tests {
+		command, exited, ok := credentialPluginFailure(tt.msg)
+		if command != tt.command || exited != tt.exited || ok != tt.ok {
+			t.Errorf("credentialPluginFailure(%q) = (%q, %v, %v), want (%q, %v, %v)",
+				tt.msg, command, exited, ok, tt.command, tt.exited, tt.ok)
+		}
+	}
+}
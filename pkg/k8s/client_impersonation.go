@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// maxImpersonatedViews bounds the per-identity view cache; the least
+// recently used view is evicted when a new identity would exceed it.
+const maxImpersonatedViews = 256
+
+// ErrNoImpersonationIdentity is returned by Impersonating when there is no
+// user to act as. Callers must refuse the request rather than fall back to
+// the console's own credentials.
+var ErrNoImpersonationIdentity = errors.New("no user identity to impersonate")
+
+// impersonatedView is a cached Impersonating view and when it was last
+// handed out, in Unix nanoseconds.
+type impersonatedView struct {
+	client   *MultiClusterClient
+	lastUsed atomic.Int64
+}
+
+// Impersonating returns a view of m whose clients act as user (and groups)
+// through the Impersonate-User / Impersonate-Group headers, so cluster RBAC is
+// evaluated for the end user instead of the console's own credentials.
+//
+// The view shares m's kubeconfig but keeps its own clients and health cache,
+// so users never see results cached for another identity. Up to
+// maxImpersonatedViews views are cached per identity; all are dropped when
+// the kubeconfig is reloaded. An empty user returns
+// ErrNoImpersonationIdentity.
+func (m *MultiClusterClient) Impersonating(user string, groups ...string) (*MultiClusterClient, error) {
+	if user == "" {
+		return nil, ErrNoImpersonationIdentity
+	}
+	if m == nil {
+		return nil, nil
+	}
+	key := impersonationKey(user, groups)
+	now := time.Now().UnixNano()
+
+	m.mu.RLock()
+	entry, ok := m.impersonated[key]
+	m.mu.RUnlock()
+	if ok {
+		entry.lastUsed.Store(now)
+		return entry.client, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if entry, ok := m.impersonated[key]; ok {
+		entry.lastUsed.Store(now)
+		return entry.client, nil
+	}
+	view := &MultiClusterClient{
+		kubeconfig:      m.kubeconfig,
+		clients:         make(map[string]kubernetes.Interface),
+		dynamicClients:  make(map[string]dynamic.Interface),
+		configs:         make(map[string]*rest.Config),
+		rawConfig:       m.rawConfig,
+		healthCache:     make(map[string]*ClusterHealth),
+		cacheTTL:        m.cacheTTL,
+		cacheTime:       make(map[string]time.Time),
+		inClusterConfig: m.inClusterConfig,
+		inClusterName:   m.inClusterName,
+		slowClusters:    make(map[string]time.Time),
+		gpuMetrics:      m.gpuMetrics,
+		retryAttempts:   m.retryAttempts,
+		restartTrends:   newRestartTracker(),
+		clusterDomain:   m.clusterDomain,
+		ownershipKeys:   m.ownershipKeys,
+		manualClusters:  m.manualClusters,
+		inventoryHub:    m.inventoryHub,
+		impersonate:     rest.ImpersonationConfig{UserName: user, Groups: append([]string(nil), groups...)},
+	}
+	if m.impersonated == nil {
+		m.impersonated = make(map[string]*impersonatedView)
+	}
+	if len(m.impersonated) >= maxImpersonatedViews {
+		m.evictOldestImpersonatedLocked()
+	}
+	entry = &impersonatedView{client: view}
+	entry.lastUsed.Store(now)
+	m.impersonated[key] = entry
+	return view, nil
+}
+
+// evictOldestImpersonatedLocked drops the least recently used view. The
+// caller must hold m.mu for writing.
+func (m *MultiClusterClient) evictOldestImpersonatedLocked() {
+	oldestKey := ""
+	var oldest int64
+	for key, entry := range m.impersonated {
+		if used := entry.lastUsed.Load(); oldestKey == "" || used < oldest {
+			oldestKey, oldest = key, used
+		}
+	}
+	delete(m.impersonated, oldestKey)
+}
+
+// ImpersonatedUser returns the user this client acts as, or "" when it uses
+// the console's own credentials.
+func (m *MultiClusterClient) ImpersonatedUser() string {
+	if m == nil {
+		return ""
+	}
+	return m.impersonate.UserName
+}
+
+// applyImpersonation sets the view's impersonation identity on a freshly
+// built rest config.
+func (m *MultiClusterClient) applyImpersonation(config *rest.Config) {
+	if m.impersonate.UserName != "" {
+		config.Impersonate = m.impersonate
+	}
+}
+
+// impersonationKey identifies an impersonated identity in the view cache.
+// Names are NUL-separated, a byte real user and group names do not contain.
+func impersonationKey(user string, groups []string) string {
+	return user + "\x00" + strings.Join(groups, "\x00")
+}
@@ -0,0 +1,204 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func newImpersonationTestClient(t *testing.T) *MultiClusterClient {
+	t.Helper()
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	cfg := &api.Config{
+		CurrentContext: "ctx",
+		Contexts:       map[string]*api.Context{"ctx": {Cluster: "c", AuthInfo: "u"}},
+		Clusters:       map[string]*api.Cluster{"c": {Server: "https://c.example.com"}},
+		AuthInfos:      map[string]*api.AuthInfo{"u": {Token: "console-token"}},
+	}
+	if err := clientcmd.WriteToFile(*cfg, kubeconfig); err != nil {
+		t.Fatalf("WriteToFile: %v", err)
+	}
+	m, err := NewMultiClusterClient(kubeconfig)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+	if err := m.LoadConfig(); err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	return m
+}
+
+// mustImpersonate returns m.Impersonating(user, groups...), failing the test
+// on error.
+func mustImpersonate(t *testing.T, m *MultiClusterClient, user string, groups ...string) *MultiClusterClient {
+	t.Helper()
+	view, err := m.Impersonating(user, groups...)
+	if err != nil {
+		t.Fatalf("Impersonating(%q): %v", user, err)
+	}
+	return view
+}
+
+func TestImpersonating_AppliesImpersonationConfig(t *testing.T) {
+	m := newImpersonationTestClient(t)
+	view := mustImpersonate(t, m, "alice", "devs")
+
+	config, err := view.GetRestConfig("ctx")
+	if err != nil {
+		t.Fatalf("GetRestConfig: %v", err)
+	}
+	if config.Impersonate.UserName != "alice" {
+		t.Errorf("Impersonate.UserName = %q, want alice", config.Impersonate.UserName)
+	}
+	if len(config.Impersonate.Groups) != 1 || config.Impersonate.Groups[0] != "devs" {
+		t.Errorf("Impersonate.Groups = %v, want [devs]", config.Impersonate.Groups)
+	}
+	if config.BearerToken != "console-token" {
+		t.Errorf("impersonating client should authenticate with the console's credentials, got token %q", config.BearerToken)
+	}
+	if _, err := view.GetDynamicClient("ctx"); err != nil {
+		t.Fatalf("GetDynamicClient: %v", err)
+	}
+
+	base, err := m.GetRestConfig("ctx")
+	if err != nil {
+		t.Fatalf("GetRestConfig: %v", err)
+	}
+	if base.Impersonate.UserName != "" {
+		t.Errorf("shared client should not impersonate, got %q", base.Impersonate.UserName)
+	}
+
+	if again := mustImpersonate(t, m, "alice", "devs"); again != view {
+		t.Error("Impersonating should reuse the view for the same identity")
+	}
+	if view, err := m.Impersonating(""); !errors.Is(err, ErrNoImpersonationIdentity) || view != nil {
+		t.Errorf("Impersonating with an empty user should fail closed, got view %p err %v", view, err)
+	}
+}
+
+func TestImpersonating_DynamicClientBuiltFirst(t *testing.T) {
+	m := newImpersonationTestClient(t)
+	view := mustImpersonate(t, m, "bob")
+
+	if _, err := view.GetDynamicClient("ctx"); err != nil {
+		t.Fatalf("GetDynamicClient: %v", err)
+	}
+	view.mu.RLock()
+	config := view.configs["ctx"]
+	view.mu.RUnlock()
+	if config == nil || config.Impersonate.UserName != "bob" {
+		t.Fatalf("dynamic client config should impersonate bob, got %+v", config)
+	}
+}
+
+func TestImpersonating_HealthCacheIsPerIdentity(t *testing.T) {
+	m := newImpersonationTestClient(t)
+	alice := mustImpersonate(t, m, "alice")
+	bob := mustImpersonate(t, m, "bob")
+
+	alice.mu.Lock()
+	alice.healthCache["ctx"] = &ClusterHealth{Cluster: "ctx", Healthy: true, Reachable: true}
+	alice.mu.Unlock()
+
+	if got := bob.GetCachedHealth(); len(got) != 0 {
+		t.Errorf("bob should not see alice's cached health, got %v", got)
+	}
+	if got := m.GetCachedHealth(); len(got) != 0 {
+		t.Errorf("shared client should not see alice's cached health, got %v", got)
+	}
+	if got := alice.GetCachedHealth(); got["ctx"] == nil {
+		t.Error("alice should see the health cached for alice")
+	}
+}
+
+func TestImpersonating_KeepsOwnershipKeys(t *testing.T) {
+	m := newImpersonationTestClient(t)
+	keys, err := ParseOwnershipKeys("team:example.com/squad")
+	if err != nil {
+		t.Fatalf("ParseOwnershipKeys: %v", err)
+	}
+	m.ownershipKeys = &keys
+
+	if got := mustImpersonate(t, m, "alice").getOwnershipKeys(); !reflect.DeepEqual(got, keys) {
+		t.Errorf("impersonated view should use the configured ownership keys, got %+v", got)
+	}
+}
+
+func TestImpersonating_ViewsDroppedOnReload(t *testing.T) {
+	m := newImpersonationTestClient(t)
+	view := mustImpersonate(t, m, "alice")
+	if err := m.LoadConfig(); err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if mustImpersonate(t, m, "alice") == view {
+		t.Error("reloading the kubeconfig should drop impersonated views")
+	}
+}
+
+func TestImpersonating_EvictsLeastRecentlyUsedView(t *testing.T) {
+	m := newImpersonationTestClient(t)
+	first := mustImpersonate(t, m, "user-0")
+	for i := 1; i < maxImpersonatedViews; i++ {
+		mustImpersonate(t, m, fmt.Sprintf("user-%d", i))
+	}
+	// Pin the use order: user-1 oldest, user-0 most recent.
+	for i := 1; i < maxImpersonatedViews; i++ {
+		m.impersonated[impersonationKey(fmt.Sprintf("user-%d", i), nil)].lastUsed.Store(int64(i))
+	}
+	m.impersonated[impersonationKey("user-0", nil)].lastUsed.Store(int64(maxImpersonatedViews))
+
+	mustImpersonate(t, m, "newcomer")
+	if len(m.impersonated) != maxImpersonatedViews {
+		t.Fatalf("cache size = %d, want %d", len(m.impersonated), maxImpersonatedViews)
+	}
+	if _, ok := m.impersonated[impersonationKey("user-1", nil)]; ok {
+		t.Error("least recently used view should have been evicted")
+	}
+	if mustImpersonate(t, m, "user-0") != first {
+		t.Error("recently used view should have been kept")
+	}
+}
+
+func TestImpersonating_ViewsKeptWhenManualClusterChanges(t *testing.T) {
+	m := newImpersonationTestClient(t)
+	view := mustImpersonate(t, m, "alice")
+	if _, err := view.GetClient("ctx"); err != nil {
+		t.Fatalf("GetClient(ctx): %v", err)
+	}
+	if err := m.AddCluster("edge-1", &rest.Config{Host: "https://edge-1.example.com"}); err != nil {
+		t.Fatalf("AddCluster: %v", err)
+	}
+	if _, err := view.GetClient("edge-1"); err != nil {
+		t.Fatalf("GetClient(edge-1): %v", err)
+	}
+
+	if err := m.AddCluster("edge-1", &rest.Config{Host: "https://edge-1b.example.com"}); err != nil {
+		t.Fatalf("AddCluster (replace): %v", err)
+	}
+	if mustImpersonate(t, m, "alice") != view {
+		t.Fatal("changing a manual cluster should keep impersonated views")
+	}
+	view.mu.RLock()
+	_, stale := view.configs["edge-1"]
+	_, kept := view.clients["ctx"]
+	view.mu.RUnlock()
+	if stale {
+		t.Error("the view should have dropped its client of the replaced cluster")
+	}
+	if !kept {
+		t.Error("the view should keep its clients of other clusters")
+	}
+	config, err := view.GetRestConfig("edge-1")
+	if err != nil {
+		t.Fatalf("GetRestConfig(edge-1): %v", err)
+	}
+	if config.Host != "https://edge-1b.example.com" || config.Impersonate.UserName != "alice" {
+		t.Errorf("view config = host %s as %q, want the replaced host as alice", config.Host, config.Impersonate.UserName)
+	}
+}
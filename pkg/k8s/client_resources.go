@@ -2,6 +2,7 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sort"
@@ -10,27 +11,110 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// PodPhaseNotReady is a GetPodsByPhase filter matching Running pods with at
+// least one container that is not ready. It has no field selector of its
+// own, so readiness is checked client-side after listing Running pods.
+const PodPhaseNotReady = "NotReady"
+
+// ErrInvalidPodPhase is returned for a phase filter that is neither a pod
+// phase nor PodPhaseNotReady.
+var ErrInvalidPodPhase = errors.New("invalid pod phase filter")
+
+// podPhaseFilters maps the lower-cased filter value to its canonical form.
+var podPhaseFilters = map[string]string{
+	"pending":   string(corev1.PodPending),
+	"running":   string(corev1.PodRunning),
+	"succeeded": string(corev1.PodSucceeded),
+	"failed":    string(corev1.PodFailed),
+	"unknown":   string(corev1.PodUnknown),
+	"notready":  PodPhaseNotReady,
+}
+
+// NormalizePodPhaseFilter returns the canonical form of a GetPodsByPhase
+// filter (matching is case-insensitive). An empty filter stays empty.
+func NormalizePodPhaseFilter(phase string) (string, error) {
+	if phase == "" {
+		return "", nil
+	}
+	canonical, ok := podPhaseFilters[strings.ToLower(phase)]
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrInvalidPodPhase, phase)
+	}
+	return canonical, nil
+}
+
 func (m *MultiClusterClient) GetPods(ctx context.Context, contextName, namespace string) ([]PodInfo, error) {
+	return m.GetPodsByPhase(ctx, contextName, namespace, "")
+}
+
+// GetPodsByPhase is GetPods restricted to one phase. Pod phases are sent to
+// the apiserver as a status.phase field selector; PodPhaseNotReady lists
+// Running pods and drops the fully ready ones. An empty phase returns all pods.
+func (m *MultiClusterClient) GetPodsByPhase(ctx context.Context, contextName, namespace, phase string) (_ []PodInfo, err error) {
+	defer observeClusterRequest(contextName, "GetPods", time.Now(), &err)
+	phase, err = NormalizePodPhaseFilter(phase)
+	if err != nil {
+		return nil, err
+	}
+	listOpts := metav1.ListOptions{}
+	switch phase {
+	case "":
+	case PodPhaseNotReady:
+		listOpts.FieldSelector = "status.phase=" + string(corev1.PodRunning)
+	default:
+		listOpts.FieldSelector = "status.phase=" + phase
+	}
+
+	var keep func(pod *corev1.Pod, ready, total int) bool
+	if phase == PodPhaseNotReady {
+		keep = func(pod *corev1.Pod, ready, total int) bool {
+			return pod.Status.Phase == corev1.PodRunning && ready < total
+		}
+	}
+	return m.listPodInfos(ctx, contextName, namespace, listOpts, keep)
+}
+
+// GetPodsOnNode returns the pods scheduled on nodeName in every namespace,
+// selected with a spec.nodeName field selector.
+func (m *MultiClusterClient) GetPodsOnNode(ctx context.Context, contextName, nodeName string) (_ []PodInfo, err error) {
+	defer observeClusterRequest(contextName, "GetPodsOnNode", time.Now(), &err)
+	listOpts := metav1.ListOptions{FieldSelector: "spec.nodeName=" + nodeName}
+	// Re-check the node in case the selector is not honored (fake clients).
+	keep := func(pod *corev1.Pod, _, _ int) bool { return pod.Spec.NodeName == nodeName }
+	return m.listPodInfos(ctx, contextName, metav1.NamespaceAll, listOpts, keep)
+}
+
+// listPodInfos lists pods in namespace with listOpts and converts them to
+// PodInfo. When keep is set, pods it rejects are left out; it is given the
+// number of ready containers and the total.
+func (m *MultiClusterClient) listPodInfos(ctx context.Context, contextName, namespace string, listOpts metav1.ListOptions, keep func(pod *corev1.Pod, ready, total int) bool) ([]PodInfo, error) {
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
 	}
 
-	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	var pods *corev1.PodList
+	err = withRetry(ctx, m.getRetryAttempts(), func() (err error) {
+		pods, err = client.CoreV1().Pods(namespace).List(ctx, listOpts)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	now := time.Now()
 	var result []PodInfo
 	for _, pod := range pods.Items {
 		ready := 0
 		total := len(pod.Spec.Containers)
 		restarts := 0
+		m.restartTrends.observe(restartTrackerKey(contextName, pod.Namespace, pod.Name), podRestartCount(&pod), now)
 
 		// Build container status map
 		statusMap := make(map[string]corev1.ContainerStatus)
@@ -41,62 +125,117 @@ func (m *MultiClusterClient) GetPods(ctx context.Context, contextName, namespace
 			}
 			restarts += int(cs.RestartCount)
 		}
+		if keep != nil && !keep(&pod, ready, total) {
+			continue
+		}
 
 		// Build container info
 		var containers []ContainerInfo
 		for _, c := range pod.Spec.Containers {
-			ci := ContainerInfo{
-				Name:  c.Name,
-				Image: c.Image,
-			}
-			if cs, ok := statusMap[c.Name]; ok {
-				ci.Ready = cs.Ready
-				if cs.State.Running != nil {
-					ci.State = "running"
-				} else if cs.State.Waiting != nil {
-					ci.State = "waiting"
-					ci.Reason = cs.State.Waiting.Reason
-					ci.Message = cs.State.Waiting.Message
-				} else if cs.State.Terminated != nil {
-					ci.State = "terminated"
-					ci.Reason = cs.State.Terminated.Reason
-					ci.Message = cs.State.Terminated.Message
-				}
+			containers = append(containers, buildContainerInfo(c, statusMap))
+		}
+		var initContainers []ContainerInfo
+		if len(pod.Spec.InitContainers) > 0 {
+			initStatuses := containerStatusMap(pod.Status.InitContainerStatuses)
+			for _, c := range pod.Spec.InitContainers {
+				initContainers = append(initContainers, buildContainerInfo(c, initStatuses))
 			}
-			// Check for GPU / accelerator resource requests using the shared
-			// SumGPURequested helper (pkg/k8s/gpu_resources.go). Sums across ALL
-			// known GPU resource names so containers requesting more than one
-			// accelerator type (e.g., nvidia.com/gpu=1 + habana.ai/gaudi=2) are
-			// counted correctly. Previously each matching name overwrote the
-			// previous, so the final value depended on map iteration order
-			// (flagged on PR Issue 9204 follow-up review).
-			ci.GPURequested = SumGPURequested(c.Resources.Requests)
-			if ci.GPURequested == 0 {
-				ci.GPURequested = SumGPURequested(c.Resources.Limits)
+		}
+		var ephemeralContainers []ContainerInfo
+		if len(pod.Spec.EphemeralContainers) > 0 {
+			ephemeralStatuses := containerStatusMap(pod.Status.EphemeralContainerStatuses)
+			for _, ec := range pod.Spec.EphemeralContainers {
+				ephemeralContainers = append(ephemeralContainers, buildContainerInfo(corev1.Container(ec.EphemeralContainerCommon), ephemeralStatuses))
 			}
-			containers = append(containers, ci)
 		}
 
 		result = append(result, PodInfo{
-			Name:        pod.Name,
-			Namespace:   pod.Namespace,
-			Cluster:     contextName,
-			Status:      string(pod.Status.Phase),
-			Ready:       fmt.Sprintf("%d/%d", ready, total),
-			Restarts:    restarts,
-			Age:         formatDuration(time.Since(pod.CreationTimestamp.Time)),
-			Node:        pod.Spec.NodeName,
-			Labels:      pod.Labels,
-			Annotations: pod.Annotations,
-			Containers:  containers,
+			Name:                pod.Name,
+			Namespace:           pod.Namespace,
+			Cluster:             contextName,
+			Status:              string(pod.Status.Phase),
+			Ready:               fmt.Sprintf("%d/%d", ready, total),
+			Restarts:            restarts,
+			Age:                 formatDuration(time.Since(pod.CreationTimestamp.Time)),
+			Node:                pod.Spec.NodeName,
+			Labels:              pod.Labels,
+			Annotations:         pod.Annotations,
+			Containers:          containers,
+			InitContainers:      initContainers,
+			EphemeralContainers: ephemeralContainers,
+			QOSClass:            string(podQOSClass(&pod)),
 		})
 	}
 
 	return result, nil
 }
 
+// containerStatusMap indexes container statuses by container name.
+func containerStatusMap(statuses []corev1.ContainerStatus) map[string]corev1.ContainerStatus {
+	m := make(map[string]corev1.ContainerStatus, len(statuses))
+	for _, cs := range statuses {
+		m[cs.Name] = cs
+	}
+	return m
+}
+
+// buildContainerInfo converts container c and its status, looked up by name
+// in statusMap, to ContainerInfo.
+func buildContainerInfo(c corev1.Container, statusMap map[string]corev1.ContainerStatus) ContainerInfo {
+	ci := ContainerInfo{
+		Name:     c.Name,
+		Image:    c.Image,
+		Requests: resourceListStrings(c.Resources.Requests),
+		Limits:   resourceListStrings(c.Resources.Limits),
+	}
+	if cs, ok := statusMap[c.Name]; ok {
+		ci.Ready = cs.Ready
+		if cs.State.Running != nil {
+			ci.State = "running"
+		} else if cs.State.Waiting != nil {
+			ci.State = "waiting"
+			ci.Reason = cs.State.Waiting.Reason
+			ci.Message = cs.State.Waiting.Message
+		} else if cs.State.Terminated != nil {
+			ci.State = "terminated"
+			ci.Reason = cs.State.Terminated.Reason
+			ci.Message = cs.State.Terminated.Message
+		}
+	}
+	// Check for GPU / accelerator resource requests using the shared
+	// SumGPURequested helper (pkg/k8s/gpu_resources.go). Sums across ALL
+	// known GPU resource names so containers requesting more than one
+	// accelerator type (e.g., nvidia.com/gpu=1 + habana.ai/gaudi=2) are
+	// counted correctly. Previously each matching name overwrote the
+	// previous, so the final value depended on map iteration order
+	// (flagged on PR Issue 9204 follow-up review).
+	ci.GPURequested = SumGPURequested(c.Resources.Requests)
+	if ci.GPURequested == 0 {
+		ci.GPURequested = SumGPURequested(c.Resources.Limits)
+	}
+	return ci
+}
+
+// PodCountExceeds reports whether the cluster holds more than limit pods
+// across all namespaces. It asks the apiserver for at most limit+1 pods, so
+// the check stays cheap on clusters far larger than the limit.
+func (m *MultiClusterClient) PodCountExceeds(ctx context.Context, contextName string, limit int) (_ bool, err error) {
+	defer observeClusterRequest(contextName, "PodCountExceeds", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return false, err
+	}
+
+	pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{Limit: int64(limit) + 1})
+	if err != nil {
+		return false, err
+	}
+	return len(pods.Items) > limit || pods.Continue != "", nil
+}
+
 // FindPodIssues returns pods with issues
-func (m *MultiClusterClient) FindPodIssues(ctx context.Context, contextName, namespace string) ([]PodIssue, error) {
+func (m *MultiClusterClient) FindPodIssues(ctx context.Context, contextName, namespace string) (_ []PodIssue, err error) {
+	defer observeClusterRequest(contextName, "FindPodIssues", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -130,6 +269,10 @@ func (m *MultiClusterClient) FindPodIssues(ctx context.Context, contextName, nam
 
 		var podIssues []string
 		restarts := 0
+		trend, restartsInWindow := m.restartTrends.observe(restartTrackerKey(contextName, pod.Namespace, pod.Name), podRestartCount(&pod), now)
+		if trend == RestartTrendIncreasing {
+			podIssues = append(podIssues, fmt.Sprintf("Restarts increasing (+%d in %s)", restartsInWindow, restartTrendWindow))
+		}
 
 		// Determine effective status (mirrors kubectl logic)
 		effectiveStatus := string(pod.Status.Phase)
@@ -232,12 +375,14 @@ func (m *MultiClusterClient) FindPodIssues(ctx context.Context, contextName, nam
 
 		if len(podIssues) > 0 {
 			issues = append(issues, PodIssue{
-				Name:      pod.Name,
-				Namespace: pod.Namespace,
-				Cluster:   contextName,
-				Status:    effectiveStatus,
-				Restarts:  restarts,
-				Issues:    podIssues,
+				Name:             pod.Name,
+				Namespace:        pod.Namespace,
+				Cluster:          contextName,
+				Status:           effectiveStatus,
+				Restarts:         restarts,
+				Issues:           podIssues,
+				RestartTrend:     trend,
+				RestartsInWindow: restartsInWindow,
 			})
 		}
 	}
@@ -245,8 +390,132 @@ func (m *MultiClusterClient) FindPodIssues(ctx context.Context, contextName, nam
 	return issues, nil
 }
 
+// imagePullWaitingReasons are the container Waiting reasons that mean the
+// kubelet could not obtain the container image.
+var imagePullWaitingReasons = map[string]bool{
+	"ImagePullBackOff":    true,
+	"ErrImagePull":        true,
+	"InvalidImageName":    true,
+	"ErrImageNeverPull":   true,
+	"RegistryUnavailable": true,
+}
+
+// GetImagePullIssues returns every container (including init containers) that
+// is waiting on an image pull failure, together with the image and the
+// registry error message from the container status.
+func (m *MultiClusterClient) GetImagePullIssues(ctx context.Context, contextName, namespace string) (_ []ImagePullIssue, err error) {
+	defer observeClusterRequest(contextName, "GetImagePullIssues", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []ImagePullIssue
+	for _, pod := range pods.Items {
+		collect := func(statuses []corev1.ContainerStatus, specs []corev1.Container, init bool) {
+			for _, cs := range statuses {
+				if cs.State.Waiting == nil || !imagePullWaitingReasons[cs.State.Waiting.Reason] {
+					continue
+				}
+				// Prefer the spec image: the status image may be empty or
+				// rewritten before the pull has ever succeeded.
+				image := cs.Image
+				for _, c := range specs {
+					if c.Name == cs.Name && c.Image != "" {
+						image = c.Image
+						break
+					}
+				}
+				issues = append(issues, ImagePullIssue{
+					Pod:       pod.Name,
+					Namespace: pod.Namespace,
+					Cluster:   contextName,
+					Container: cs.Name,
+					Init:      init,
+					Image:     image,
+					Reason:    cs.State.Waiting.Reason,
+					Message:   cs.State.Waiting.Message,
+				})
+			}
+		}
+		collect(pod.Status.InitContainerStatuses, pod.Spec.InitContainers, true)
+		collect(pod.Status.ContainerStatuses, pod.Spec.Containers, false)
+	}
+
+	return issues, nil
+}
+
+// GetOOMKilledPods returns one OOMEvent per container whose current or last
+// termination reason is OOMKilled, newest first. The memory limit is read
+// from the container spec so the event shows what the container was allowed.
+func (m *MultiClusterClient) GetOOMKilledPods(ctx context.Context, contextName, namespace string) (_ []OOMEvent, err error) {
+	defer observeClusterRequest(contextName, "GetOOMKilledPods", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var events []OOMEvent
+	for _, pod := range pods.Items {
+		limits := make(map[string]resource.Quantity)
+		for _, specs := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+			for _, c := range specs {
+				if mem, ok := c.Resources.Limits[corev1.ResourceMemory]; ok {
+					limits[c.Name] = mem
+				}
+			}
+		}
+
+		statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			terminated := cs.State.Terminated
+			current := true
+			if terminated == nil || terminated.Reason != "OOMKilled" {
+				terminated = cs.LastTerminationState.Terminated
+				current = false
+			}
+			if terminated == nil || terminated.Reason != "OOMKilled" {
+				continue
+			}
+
+			event := OOMEvent{
+				Pod:          pod.Name,
+				Namespace:    pod.Namespace,
+				Cluster:      contextName,
+				Container:    cs.Name,
+				RestartCount: cs.RestartCount,
+				TerminatedAt: terminated.FinishedAt.Time,
+				Current:      current,
+			}
+			if mem, ok := limits[cs.Name]; ok {
+				event.MemoryLimit = mem.String()
+				event.MemoryLimitBytes = mem.Value()
+			}
+			events = append(events, event)
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].TerminatedAt.After(events[j].TerminatedAt)
+	})
+	return events, nil
+}
+
 // GetEvents returns events from a cluster
-func (m *MultiClusterClient) GetEvents(ctx context.Context, contextName, namespace string, limit int, fieldSelectors ...string) ([]Event, error) {
+func (m *MultiClusterClient) GetEvents(ctx context.Context, contextName, namespace string, limit int, fieldSelectors ...string) (_ []Event, err error) {
+	defer observeClusterRequest(contextName, "GetEvents", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -297,7 +566,8 @@ func (m *MultiClusterClient) GetEvents(ctx context.Context, contextName, namespa
 }
 
 // GetWarningEvents returns warning events from a cluster
-func (m *MultiClusterClient) GetWarningEvents(ctx context.Context, contextName, namespace string, limit int) ([]Event, error) {
+func (m *MultiClusterClient) GetWarningEvents(ctx context.Context, contextName, namespace string, limit int) (_ []Event, err error) {
+	defer observeClusterRequest(contextName, "GetWarningEvents", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -347,7 +617,8 @@ func (m *MultiClusterClient) GetWarningEvents(ctx context.Context, contextName,
 
 // GetGPUNodes returns nodes with GPU resources
 
-func (m *MultiClusterClient) GetNodes(ctx context.Context, contextName string) ([]NodeInfo, error) {
+func (m *MultiClusterClient) GetNodes(ctx context.Context, contextName string) (_ []NodeInfo, err error) {
+	defer observeClusterRequest(contextName, "GetNodes", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -482,24 +753,7 @@ func (m *MultiClusterClient) GetNodes(ctx context.Context, contextName string) (
 		}
 
 		// Get labels (filter out some verbose ones, but keep topology labels for region detection)
-		info.Labels = make(map[string]string)
-		for k, v := range node.Labels {
-			// Always include topology labels needed for region/zone detection
-			if strings.HasPrefix(k, "topology.kubernetes.io/") ||
-				strings.HasPrefix(k, "failure-domain.beta.kubernetes.io/") ||
-				strings.Contains(k, "region") ||
-				strings.Contains(k, "zone") {
-				info.Labels[k] = v
-				continue
-			}
-			// Skip very long or system labels
-			if !strings.HasPrefix(k, "node.kubernetes.io/") &&
-				!strings.HasPrefix(k, "kubernetes.io/") &&
-				!strings.HasPrefix(k, "beta.kubernetes.io/") &&
-				len(v) < 100 {
-				info.Labels[k] = v
-			}
-		}
+		info.Labels = filterNodeLabels(node.Labels)
 
 		// Get taints
 		for _, taint := range node.Spec.Taints {
@@ -525,10 +779,36 @@ func (m *MultiClusterClient) GetNodes(ctx context.Context, contextName string) (
 	return nodeInfos, nil
 }
 
+// filterNodeLabels drops verbose and system node labels (kubernetes.io/,
+// node.kubernetes.io/, beta.kubernetes.io/ and values of 100+ characters)
+// but always keeps topology labels needed for region/zone detection.
+func filterNodeLabels(nodeLabels map[string]string) map[string]string {
+	filtered := make(map[string]string)
+	for k, v := range nodeLabels {
+		// Always include topology labels needed for region/zone detection
+		if strings.HasPrefix(k, "topology.kubernetes.io/") ||
+			strings.HasPrefix(k, "failure-domain.beta.kubernetes.io/") ||
+			strings.Contains(k, "region") ||
+			strings.Contains(k, "zone") {
+			filtered[k] = v
+			continue
+		}
+		// Skip very long or system labels
+		if !strings.HasPrefix(k, "node.kubernetes.io/") &&
+			!strings.HasPrefix(k, "kubernetes.io/") &&
+			!strings.HasPrefix(k, "beta.kubernetes.io/") &&
+			len(v) < 100 {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
+
 // GetFlatcarNodes returns information about nodes running Flatcar Container Linux
 // in the given cluster. Detection is based on OSImage containing "flatcar"
 // (case-insensitive).
-func (m *MultiClusterClient) GetFlatcarNodes(ctx context.Context, contextName string) ([]FlatcarNodeInfo, error) {
+func (m *MultiClusterClient) GetFlatcarNodes(ctx context.Context, contextName string) (_ []FlatcarNodeInfo, err error) {
+	defer observeClusterRequest(contextName, "GetFlatcarNodes", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -555,7 +835,8 @@ func (m *MultiClusterClient) GetFlatcarNodes(ctx context.Context, contextName st
 }
 
 // FindDeploymentIssues returns deployments with issues
-func (m *MultiClusterClient) FindDeploymentIssues(ctx context.Context, contextName, namespace string) ([]DeploymentIssue, error) {
+func (m *MultiClusterClient) FindDeploymentIssues(ctx context.Context, contextName, namespace string) (_ []DeploymentIssue, err error) {
+	defer observeClusterRequest(contextName, "FindDeploymentIssues", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -623,7 +904,8 @@ func (m *MultiClusterClient) FindDeploymentIssues(ctx context.Context, contextNa
 }
 
 // GetDeployments returns all deployments with rollout status
-func (m *MultiClusterClient) GetDeployments(ctx context.Context, contextName, namespace string) ([]Deployment, error) {
+func (m *MultiClusterClient) GetDeployments(ctx context.Context, contextName, namespace string) (_ []Deployment, err error) {
+	defer observeClusterRequest(contextName, "GetDeployments", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -634,6 +916,7 @@ func (m *MultiClusterClient) GetDeployments(ctx context.Context, contextName, na
 		return nil, err
 	}
 
+	ownershipKeys := m.getOwnershipKeys()
 	var result []Deployment
 	for _, deploy := range deployments.Items {
 		// Kubernetes defaults Replicas to 1 when unset
@@ -697,6 +980,7 @@ func (m *MultiClusterClient) GetDeployments(ctx context.Context, contextName, na
 			Age:               age,
 			Labels:            deploy.Labels,
 			Annotations:       deploy.Annotations,
+			Ownership:         ExtractOwnership(deploy.Annotations, deploy.Labels, ownershipKeys),
 		})
 	}
 
@@ -704,7 +988,8 @@ func (m *MultiClusterClient) GetDeployments(ctx context.Context, contextName, na
 }
 
 // GetServices returns all services in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetServices(ctx context.Context, contextName, namespace string) ([]Service, error) {
+func (m *MultiClusterClient) GetServices(ctx context.Context, contextName, namespace string) (_ []Service, err error) {
+	defer observeClusterRequest(contextName, "GetServices", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -737,6 +1022,8 @@ func (m *MultiClusterClient) GetServices(ctx context.Context, contextName, names
 			"cluster", contextName, "namespace", namespace, "error", epErr)
 	}
 
+	clusterDomain := m.getClusterDomain()
+	ownershipKeys := m.getOwnershipKeys()
 	var result []Service
 	for _, svc := range services.Items {
 		// Build ports list. We populate both the legacy flat []string
@@ -799,17 +1086,105 @@ func (m *MultiClusterClient) GetServices(ctx context.Context, contextName, names
 			Endpoints:   endpointReadyCounts[svc.Namespace+"/"+svc.Name],
 			LBStatus:    lbStatus,
 			Selector:    svc.Spec.Selector,
+			DNSNames:    serviceDNSNames(&svc, clusterDomain),
 			Age:         age,
 			Labels:      svc.Labels,
 			Annotations: svc.Annotations,
+			Ownership:   ExtractOwnership(svc.Annotations, svc.Labels, ownershipKeys),
 		})
 	}
 
 	return result, nil
 }
 
+// GetServiceEndpoints returns the backend addresses of a Service from its
+// discovery.k8s.io/v1 EndpointSlices, including not-ready addresses, so the
+// UI can show which pods back the service and why it may have no traffic.
+func (m *MultiClusterClient) GetServiceEndpoints(ctx context.Context, contextName, namespace, serviceName string) (_ []EndpointInfo, err error) {
+	defer observeClusterRequest(contextName, "GetServiceEndpoints", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirm the Service exists so callers get a NotFound instead of an
+	// empty list for a typo'd name.
+	if _, err := client.CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+
+	slices, err := client.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + serviceName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]EndpointInfo, 0)
+	for _, slice := range slices.Items {
+		var ports []string
+		for _, p := range slice.Ports {
+			port := ""
+			if p.Name != nil && *p.Name != "" {
+				port = *p.Name + ":"
+			}
+			if p.Port != nil {
+				port += fmt.Sprintf("%d", *p.Port)
+			}
+			if p.Protocol != nil {
+				port += "/" + string(*p.Protocol)
+			}
+			ports = append(ports, port)
+		}
+
+		for _, ep := range slice.Endpoints {
+			ready := ep.Conditions.Ready == nil || *ep.Conditions.Ready
+			serving := ready
+			if ep.Conditions.Serving != nil {
+				serving = *ep.Conditions.Serving
+			}
+			terminating := ep.Conditions.Terminating != nil && *ep.Conditions.Terminating
+
+			var podName, nodeName, zone string
+			if ep.TargetRef != nil && ep.TargetRef.Kind == "Pod" {
+				podName = ep.TargetRef.Name
+			}
+			if ep.NodeName != nil {
+				nodeName = *ep.NodeName
+			}
+			if ep.Zone != nil {
+				zone = *ep.Zone
+			}
+
+			for _, addr := range ep.Addresses {
+				result = append(result, EndpointInfo{
+					Address:     addr,
+					Ready:       ready,
+					Serving:     serving,
+					Terminating: terminating,
+					PodName:     podName,
+					NodeName:    nodeName,
+					Zone:        zone,
+					Ports:       ports,
+					SliceName:   slice.Name,
+				})
+			}
+		}
+	}
+
+	// Ready endpoints first, then by address for a stable display order.
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Ready != result[j].Ready {
+			return result[i].Ready
+		}
+		return result[i].Address < result[j].Address
+	})
+	return result, nil
+}
+
 // GetJobs returns all jobs in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetJobs(ctx context.Context, contextName, namespace string) ([]Job, error) {
+func (m *MultiClusterClient) GetJobs(ctx context.Context, contextName, namespace string) (_ []Job, err error) {
+	defer observeClusterRequest(contextName, "GetJobs", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -873,7 +1248,8 @@ func (m *MultiClusterClient) GetJobs(ctx context.Context, contextName, namespace
 }
 
 // GetHPAs returns all HPAs in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetHPAs(ctx context.Context, contextName, namespace string) ([]HPA, error) {
+func (m *MultiClusterClient) GetHPAs(ctx context.Context, contextName, namespace string) (_ []HPA, err error) {
+	defer observeClusterRequest(contextName, "GetHPAs", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -926,6 +1302,7 @@ func (m *MultiClusterClient) GetHPAs(ctx context.Context, contextName, namespace
 			CurrentReplicas: hpa.Status.CurrentReplicas,
 			TargetCPU:       targetCPU,
 			CurrentCPU:      currentCPU,
+			Metrics:         hpaMetrics(&hpa),
 			Age:             age,
 			Labels:          hpa.Labels,
 			Annotations:     hpa.Annotations,
@@ -935,8 +1312,53 @@ func (m *MultiClusterClient) GetHPAs(ctx context.Context, contextName, namespace
 	return result, nil
 }
 
+// GetNamespaces returns all namespaces in a cluster. When withCounts is true
+// each namespace's pods are listed to populate PodCount; this costs one extra
+// API call per namespace, so callers should only request it for detail views.
+// A per-namespace pod listing failure leaves that namespace's PodCount nil
+// rather than failing the whole call.
+func (m *MultiClusterClient) GetNamespaces(ctx context.Context, contextName string, withCounts bool) (_ []Namespace, err error) {
+	defer observeClusterRequest(contextName, "GetNamespaces", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	nsList, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Namespace, 0, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		item := Namespace{
+			Name:    ns.Name,
+			Cluster: contextName,
+			Status:  string(ns.Status.Phase),
+			Age:     formatAge(ns.CreationTimestamp.Time),
+			Labels:  ns.Labels,
+		}
+		if withCounts {
+			pods, err := client.CoreV1().Pods(ns.Name).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				slog.Warn("[Namespaces] failed to count pods", "cluster", contextName, "namespace", ns.Name, "error", err)
+			} else {
+				count := len(pods.Items)
+				item.PodCount = &count
+			}
+		}
+		result = append(result, item)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
 // GetConfigMaps returns all ConfigMaps in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetConfigMaps(ctx context.Context, contextName, namespace string) ([]ConfigMap, error) {
+func (m *MultiClusterClient) GetConfigMaps(ctx context.Context, contextName, namespace string) (_ []ConfigMap, err error) {
+	defer observeClusterRequest(contextName, "GetConfigMaps", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -967,7 +1389,8 @@ func (m *MultiClusterClient) GetConfigMaps(ctx context.Context, contextName, nam
 }
 
 // GetSecrets returns all Secrets in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetSecrets(ctx context.Context, contextName, namespace string) ([]Secret, error) {
+func (m *MultiClusterClient) GetSecrets(ctx context.Context, contextName, namespace string) (_ []Secret, err error) {
+	defer observeClusterRequest(contextName, "GetSecrets", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -998,8 +1421,36 @@ func (m *MultiClusterClient) GetSecrets(ctx context.Context, contextName, namesp
 	return result, nil
 }
 
+// ErrSecretKeyNotFound is returned by GetSecretValue when the secret exists
+// but has no entry for the requested key.
+var ErrSecretKeyNotFound = errors.New("secret key not found")
+
+// GetSecretValue returns the decoded value of a single key of a Secret.
+// client-go already base64-decodes .data, so the bytes are returned as-is.
+// Only the requested key is ever read out; there is deliberately no way to
+// fetch every value of a Secret in one call.
+func (m *MultiClusterClient) GetSecretValue(ctx context.Context, contextName, namespace, name, key string) (_ string, err error) {
+	defer observeClusterRequest(contextName, "GetSecretValue", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("%w: %q in %s/%s", ErrSecretKeyNotFound, key, namespace, name)
+	}
+	return string(value), nil
+}
+
 // GetServiceAccounts returns ServiceAccounts from a cluster
-func (m *MultiClusterClient) GetServiceAccounts(ctx context.Context, contextName, namespace string) ([]ServiceAccount, error) {
+func (m *MultiClusterClient) GetServiceAccounts(ctx context.Context, contextName, namespace string) (_ []ServiceAccount, err error) {
+	defer observeClusterRequest(contextName, "GetServiceAccounts", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1043,7 +1494,8 @@ func (m *MultiClusterClient) GetServiceAccounts(ctx context.Context, contextName
 }
 
 // GetPVCs returns all PersistentVolumeClaims in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetPVCs(ctx context.Context, contextName, namespace string) ([]PVC, error) {
+func (m *MultiClusterClient) GetPVCs(ctx context.Context, contextName, namespace string) (_ []PVC, err error) {
+	defer observeClusterRequest(contextName, "GetPVCs", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1096,7 +1548,8 @@ func (m *MultiClusterClient) GetPVCs(ctx context.Context, contextName, namespace
 }
 
 // GetPVs returns all PersistentVolumes
-func (m *MultiClusterClient) GetPVs(ctx context.Context, contextName string) ([]PV, error) {
+func (m *MultiClusterClient) GetPVs(ctx context.Context, contextName string) (_ []PV, err error) {
+	defer observeClusterRequest(contextName, "GetPVs", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1156,7 +1609,8 @@ func (m *MultiClusterClient) GetPVs(ctx context.Context, contextName string) ([]
 }
 
 // GetReplicaSets returns all ReplicaSets in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetReplicaSets(ctx context.Context, contextName, namespace string) ([]ReplicaSet, error) {
+func (m *MultiClusterClient) GetReplicaSets(ctx context.Context, contextName, namespace string) (_ []ReplicaSet, err error) {
+	defer observeClusterRequest(contextName, "GetReplicaSets", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1173,21 +1627,32 @@ func (m *MultiClusterClient) GetReplicaSets(ctx context.Context, contextName, na
 		if rs.Spec.Replicas != nil {
 			replicas = *rs.Spec.Replicas
 		}
-		ownerName, ownerKind := "", ""
-		if len(rs.OwnerReferences) > 0 {
-			ownerName = rs.OwnerReferences[0].Name
-			ownerKind = rs.OwnerReferences[0].Kind
+		// Prefer the controller reference; fall back to the first owner for
+		// objects that were adopted without the controller flag set.
+		ownerName, ownerKind, ownerDeployment := "", "", ""
+		owner := metav1.GetControllerOf(&rs)
+		if owner == nil && len(rs.OwnerReferences) > 0 {
+			owner = &rs.OwnerReferences[0]
+		}
+		if owner != nil {
+			ownerName = owner.Name
+			ownerKind = owner.Kind
+			if owner.Kind == "Deployment" {
+				ownerDeployment = owner.Name
+			}
 		}
 		result = append(result, ReplicaSet{
-			Name:          rs.Name,
-			Namespace:     rs.Namespace,
-			Cluster:       contextName,
-			Replicas:      replicas,
-			ReadyReplicas: rs.Status.ReadyReplicas,
-			OwnerName:     ownerName,
-			OwnerKind:     ownerKind,
-			Age:           formatAge(rs.CreationTimestamp.Time),
-			Labels:        rs.Labels,
+			Name:            rs.Name,
+			Namespace:       rs.Namespace,
+			Cluster:         contextName,
+			Replicas:        replicas,
+			ReadyReplicas:   rs.Status.ReadyReplicas,
+			OwnerName:       ownerName,
+			OwnerKind:       ownerKind,
+			OwnerDeployment: ownerDeployment,
+			Revision:        rs.Annotations["deployment.kubernetes.io/revision"],
+			Age:             formatAge(rs.CreationTimestamp.Time),
+			Labels:          rs.Labels,
 		})
 	}
 
@@ -1195,7 +1660,8 @@ func (m *MultiClusterClient) GetReplicaSets(ctx context.Context, contextName, na
 }
 
 // GetStatefulSets returns all StatefulSets in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetStatefulSets(ctx context.Context, contextName, namespace string) ([]StatefulSet, error) {
+func (m *MultiClusterClient) GetStatefulSets(ctx context.Context, contextName, namespace string) (_ []StatefulSet, err error) {
+	defer observeClusterRequest(contextName, "GetStatefulSets", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1240,7 +1706,8 @@ func (m *MultiClusterClient) GetStatefulSets(ctx context.Context, contextName, n
 }
 
 // GetDaemonSets returns all DaemonSets in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetDaemonSets(ctx context.Context, contextName, namespace string) ([]DaemonSet, error) {
+func (m *MultiClusterClient) GetDaemonSets(ctx context.Context, contextName, namespace string) (_ []DaemonSet, err error) {
+	defer observeClusterRequest(contextName, "GetDaemonSets", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1277,7 +1744,8 @@ func (m *MultiClusterClient) GetDaemonSets(ctx context.Context, contextName, nam
 }
 
 // GetCronJobs returns all CronJobs in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetCronJobs(ctx context.Context, contextName, namespace string) ([]CronJob, error) {
+func (m *MultiClusterClient) GetCronJobs(ctx context.Context, contextName, namespace string) (_ []CronJob, err error) {
+	defer observeClusterRequest(contextName, "GetCronJobs", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1315,7 +1783,8 @@ func (m *MultiClusterClient) GetCronJobs(ctx context.Context, contextName, names
 }
 
 // GetIngresses returns all Ingresses in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetIngresses(ctx context.Context, contextName, namespace string) ([]Ingress, error) {
+func (m *MultiClusterClient) GetIngresses(ctx context.Context, contextName, namespace string) (_ []Ingress, err error) {
+	defer observeClusterRequest(contextName, "GetIngresses", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1363,7 +1832,8 @@ func (m *MultiClusterClient) GetIngresses(ctx context.Context, contextName, name
 }
 
 // GetNetworkPolicies returns all NetworkPolicies in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetNetworkPolicies(ctx context.Context, contextName, namespace string) ([]NetworkPolicy, error) {
+func (m *MultiClusterClient) GetNetworkPolicies(ctx context.Context, contextName, namespace string) (_ []NetworkPolicy, err error) {
+	defer observeClusterRequest(contextName, "GetNetworkPolicies", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1405,7 +1875,8 @@ func (m *MultiClusterClient) GetNetworkPolicies(ctx context.Context, contextName
 }
 
 // GetResourceQuotas returns all ResourceQuotas in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetResourceQuotas(ctx context.Context, contextName, namespace string) ([]ResourceQuota, error) {
+func (m *MultiClusterClient) GetResourceQuotas(ctx context.Context, contextName, namespace string) (_ []ResourceQuota, err error) {
+	defer observeClusterRequest(contextName, "GetResourceQuotas", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1447,7 +1918,8 @@ func (m *MultiClusterClient) GetResourceQuotas(ctx context.Context, contextName,
 }
 
 // GetLimitRanges returns all LimitRanges in a namespace or all namespaces if namespace is empty
-func (m *MultiClusterClient) GetLimitRanges(ctx context.Context, contextName, namespace string) ([]LimitRange, error) {
+func (m *MultiClusterClient) GetLimitRanges(ctx context.Context, contextName, namespace string) (_ []LimitRange, err error) {
+	defer observeClusterRequest(contextName, "GetLimitRanges", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1526,7 +1998,8 @@ type ResourceQuotaSpec struct {
 }
 
 // CreateOrUpdateResourceQuota creates or updates a ResourceQuota in a namespace
-func (m *MultiClusterClient) CreateOrUpdateResourceQuota(ctx context.Context, contextName string, spec ResourceQuotaSpec) (*ResourceQuota, error) {
+func (m *MultiClusterClient) CreateOrUpdateResourceQuota(ctx context.Context, contextName string, spec ResourceQuotaSpec) (_ *ResourceQuota, err error) {
+	defer observeClusterRequest(contextName, "CreateOrUpdateResourceQuota", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return nil, err
@@ -1623,7 +2096,8 @@ func (m *MultiClusterClient) CreateOrUpdateResourceQuota(ctx context.Context, co
 }
 
 // DeleteResourceQuota deletes a ResourceQuota from a namespace
-func (m *MultiClusterClient) DeleteResourceQuota(ctx context.Context, contextName, namespace, name string) error {
+func (m *MultiClusterClient) DeleteResourceQuota(ctx context.Context, contextName, namespace, name string) (err error) {
+	defer observeClusterRequest(contextName, "DeleteResourceQuota", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return err
@@ -1639,7 +2113,8 @@ func (m *MultiClusterClient) DeleteResourceQuota(ctx context.Context, contextNam
 
 // EnsureNamespaceExists creates a namespace if it doesn't already exist.
 // Used by GPU reservation flow to auto-create namespaces for users who don't have direct K8s RBAC.
-func (m *MultiClusterClient) EnsureNamespaceExists(ctx context.Context, contextName, namespace string) error {
+func (m *MultiClusterClient) EnsureNamespaceExists(ctx context.Context, contextName, namespace string) (err error) {
+	defer observeClusterRequest(contextName, "EnsureNamespaceExists", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return err
@@ -1649,7 +2124,7 @@ func (m *MultiClusterClient) EnsureNamespaceExists(ctx context.Context, contextN
 	if err == nil {
 		return nil // already exists
 	}
-	if !errors.IsNotFound(err) {
+	if !apierrors.IsNotFound(err) {
 		return fmt.Errorf("failed to check namespace %s: %w", namespace, err)
 	}
 
@@ -1662,17 +2137,20 @@ func (m *MultiClusterClient) EnsureNamespaceExists(ctx context.Context, contextN
 		},
 	}
 	_, err = client.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
-	if err != nil && errors.IsAlreadyExists(err) {
+	if err != nil && apierrors.IsAlreadyExists(err) {
 		return nil
 	}
 	return err
 }
 
-// GetPodLogs returns logs from a pod
-func (m *MultiClusterClient) GetPodLogs(ctx context.Context, contextName, namespace, podName, container string, tailLines int64) (string, error) {
+// GetPodLogs returns logs from a pod. When limitBytes is positive at most
+// limitBytes bytes are returned, and truncated reports whether the log was
+// longer than that.
+func (m *MultiClusterClient) GetPodLogs(ctx context.Context, contextName, namespace, podName, container string, tailLines, limitBytes int64) (_ string, truncated bool, err error) {
+	defer observeClusterRequest(contextName, "GetPodLogs", time.Now(), &err)
 	client, err := m.GetClient(contextName)
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 
 	opts := &corev1.PodLogOptions{}
@@ -1682,14 +2160,23 @@ func (m *MultiClusterClient) GetPodLogs(ctx context.Context, contextName, namesp
 	if container != "" {
 		opts.Container = container
 	}
+	if limitBytes > 0 {
+		// Ask for one byte more than the limit so a log of exactly
+		// limitBytes is not reported as truncated.
+		requested := limitBytes + 1
+		opts.LimitBytes = &requested
+	}
 
 	req := client.CoreV1().Pods(namespace).GetLogs(podName, opts)
 	logs, err := req.DoRaw(ctx)
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 
-	return string(logs), nil
+	if limitBytes > 0 && int64(len(logs)) > limitBytes {
+		return string(logs[:limitBytes]), true, nil
+	}
+	return string(logs), false, nil
 }
 
 // formatAge formats a time.Time as a human-readable age string
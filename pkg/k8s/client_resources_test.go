@@ -2,14 +2,19 @@ package k8s
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestFindPodIssues_OOMandCrashLoop(t *testing.T) {
@@ -126,6 +131,185 @@ func TestGetPods_ResourceParsing(t *testing.T) {
 	}
 }
 
+func TestGetPods_RequestsLimitsAndQOS(t *testing.T) {
+	resources := func(req, lim corev1.ResourceList) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: req, Limits: lim}
+	}
+	cpuMem := func(cpu, mem string) corev1.ResourceList {
+		return corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(mem),
+		}
+	}
+	pod := func(name string, containers ...corev1.Container) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{Containers: containers},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	m := &MultiClusterClient{clients: map[string]kubernetes.Interface{
+		"test-cluster": k8sfake.NewSimpleClientset(
+			pod("guaranteed", corev1.Container{Name: "app", Resources: resources(cpuMem("500m", "256Mi"), cpuMem("500m", "256Mi"))}),
+			// Limits only: requests default to the limits.
+			pod("limits-only", corev1.Container{Name: "app", Resources: resources(nil, cpuMem("1", "1Gi"))}),
+			pod("burstable",
+				corev1.Container{Name: "app", Resources: resources(cpuMem("250m", "128Mi"), cpuMem("1", "512Mi"))},
+				corev1.Container{Name: "sidecar"}),
+			pod("best-effort", corev1.Container{Name: "app"}),
+		),
+	}}
+
+	pods, err := m.GetPods(context.Background(), "test-cluster", "default")
+	if err != nil {
+		t.Fatalf("GetPods failed: %v", err)
+	}
+	byName := make(map[string]PodInfo, len(pods))
+	for _, p := range pods {
+		byName[p.Name] = p
+	}
+
+	wantQOS := map[string]string{
+		"guaranteed":  "Guaranteed",
+		"limits-only": "Guaranteed",
+		"burstable":   "Burstable",
+		"best-effort": "BestEffort",
+	}
+	for name, want := range wantQOS {
+		if got := byName[name].QOSClass; got != want {
+			t.Errorf("pod %s: QOSClass = %q, want %q", name, got, want)
+		}
+	}
+
+	app := byName["burstable"].Containers[0]
+	if app.Requests["cpu"] != "250m" || app.Requests["memory"] != "128Mi" {
+		t.Errorf("requests = %v, want cpu=250m memory=128Mi", app.Requests)
+	}
+	if app.Limits["cpu"] != "1" || app.Limits["memory"] != "512Mi" {
+		t.Errorf("limits = %v, want cpu=1 memory=512Mi", app.Limits)
+	}
+	if sidecar := byName["burstable"].Containers[1]; sidecar.Requests != nil || sidecar.Limits != nil {
+		t.Errorf("sidecar without resources: requests = %v, limits = %v, want nil", sidecar.Requests, sidecar.Limits)
+	}
+}
+
+func TestGetPods_InitAndEphemeralContainers(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "stuck", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "migrate", Image: "migrate:1"}},
+			Containers:     []corev1.Container{{Name: "app", Image: "app:1"}},
+			EphemeralContainers: []corev1.EphemeralContainer{{
+				EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger", Image: "busybox"},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "migrate",
+				RestartCount: 4,
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "CrashLoopBackOff",
+					Message: "back-off 40s restarting failed container",
+				}},
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "app",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}},
+			}},
+			EphemeralContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "debugger",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}
+	m := &MultiClusterClient{clients: map[string]kubernetes.Interface{
+		"test-cluster": k8sfake.NewSimpleClientset(pod),
+	}}
+
+	pods, err := m.GetPods(context.Background(), "test-cluster", "default")
+	if err != nil {
+		t.Fatalf("GetPods failed: %v", err)
+	}
+	if len(pods) != 1 {
+		t.Fatalf("Expected 1 pod, got %d", len(pods))
+	}
+	p := pods[0]
+
+	if len(p.InitContainers) != 1 {
+		t.Fatalf("Expected 1 init container, got %d", len(p.InitContainers))
+	}
+	initC := p.InitContainers[0]
+	if initC.Name != "migrate" || initC.State != "waiting" || initC.Reason != "CrashLoopBackOff" {
+		t.Errorf("init container = %+v, want migrate waiting in CrashLoopBackOff", initC)
+	}
+	if len(p.Containers) != 1 || p.Containers[0].Reason != "PodInitializing" {
+		t.Errorf("containers = %+v, want app waiting in PodInitializing", p.Containers)
+	}
+	if len(p.EphemeralContainers) != 1 || p.EphemeralContainers[0].Name != "debugger" || p.EphemeralContainers[0].State != "running" {
+		t.Errorf("ephemeral containers = %+v, want debugger running", p.EphemeralContainers)
+	}
+	if p.Ready != "0/1" {
+		t.Errorf("Ready = %q, want 0/1 (init and ephemeral containers not counted)", p.Ready)
+	}
+}
+
+func TestGetPodsByPhase_SendsFieldSelector(t *testing.T) {
+	clientset := k8sfake.NewSimpleClientset()
+	m := &MultiClusterClient{
+		clients: map[string]kubernetes.Interface{"test-cluster": clientset},
+	}
+
+	if _, err := m.GetPodsByPhase(context.Background(), "test-cluster", "default", "failed"); err != nil {
+		t.Fatalf("GetPodsByPhase failed: %v", err)
+	}
+
+	var fieldSelector string
+	for _, action := range clientset.Actions() {
+		if list, ok := action.(k8stesting.ListAction); ok && action.GetResource().Resource == "pods" {
+			fieldSelector = list.GetListRestrictions().Fields.String()
+		}
+	}
+	if fieldSelector != "status.phase=Failed" {
+		t.Errorf("field selector = %q, want status.phase=Failed", fieldSelector)
+	}
+}
+
+func TestGetPodsByPhase_NotReadyFiltersClientSide(t *testing.T) {
+	pod := func(name string, phase corev1.PodPhase, ready ...bool) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+		for i, r := range ready {
+			c := corev1.Container{Name: strings.Repeat("c", i+1)}
+			p.Spec.Containers = append(p.Spec.Containers, c)
+			p.Status.ContainerStatuses = append(p.Status.ContainerStatuses, corev1.ContainerStatus{Name: c.Name, Ready: r})
+		}
+		return p
+	}
+	m := &MultiClusterClient{
+		clients: map[string]kubernetes.Interface{"test-cluster": k8sfake.NewSimpleClientset(
+			pod("all-ready", corev1.PodRunning, true, true),
+			pod("half-ready", corev1.PodRunning, true, false),
+			pod("starting", corev1.PodPending, false),
+		)},
+	}
+
+	pods, err := m.GetPodsByPhase(context.Background(), "test-cluster", "default", PodPhaseNotReady)
+	if err != nil {
+		t.Fatalf("GetPodsByPhase failed: %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "half-ready" {
+		t.Errorf("pods = %+v, want only half-ready", pods)
+	}
+
+	if _, err := m.GetPodsByPhase(context.Background(), "test-cluster", "default", "Crashing"); !errors.Is(err, ErrInvalidPodPhase) {
+		t.Errorf("err = %v, want ErrInvalidPodPhase", err)
+	}
+}
+
 func TestGetEvents_Sorting(t *testing.T) {
 	m := &MultiClusterClient{
 		clients: make(map[string]kubernetes.Interface),
@@ -175,3 +359,321 @@ func TestGetEvents_Sorting(t *testing.T) {
 		t.Errorf("Expected modern event last, got %s", events[2].Message)
 	}
 }
+
+func TestGetNamespaces_StatusAndCounts(t *testing.T) {
+	m := &MultiClusterClient{
+		clients: make(map[string]kubernetes.Interface),
+	}
+
+	active := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Labels: map[string]string{"team": "web"}},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+	}
+	terminating := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "old"},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+	}
+	pod1 := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "apps"}}
+	pod2 := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p2", Namespace: "apps"}}
+
+	m.clients["test-cluster"] = k8sfake.NewSimpleClientset(active, terminating, pod1, pod2)
+
+	namespaces, err := m.GetNamespaces(context.Background(), "test-cluster", false)
+	if err != nil {
+		t.Fatalf("GetNamespaces failed: %v", err)
+	}
+	if len(namespaces) != 2 {
+		t.Fatalf("Expected 2 namespaces, got %d", len(namespaces))
+	}
+	if namespaces[0].Name != "apps" || namespaces[0].Status != "Active" {
+		t.Errorf("Expected apps/Active first, got %s/%s", namespaces[0].Name, namespaces[0].Status)
+	}
+	if namespaces[1].Name != "old" || namespaces[1].Status != "Terminating" {
+		t.Errorf("Expected old/Terminating second, got %s/%s", namespaces[1].Name, namespaces[1].Status)
+	}
+	if namespaces[0].PodCount != nil {
+		t.Errorf("Expected nil PodCount without withCounts, got %d", *namespaces[0].PodCount)
+	}
+	if namespaces[0].Labels["team"] != "web" {
+		t.Errorf("Expected labels to be preserved, got %v", namespaces[0].Labels)
+	}
+
+	namespaces, err = m.GetNamespaces(context.Background(), "test-cluster", true)
+	if err != nil {
+		t.Fatalf("GetNamespaces with counts failed: %v", err)
+	}
+	if namespaces[0].PodCount == nil || *namespaces[0].PodCount != 2 {
+		t.Errorf("Expected 2 pods in apps, got %v", namespaces[0].PodCount)
+	}
+	if namespaces[1].PodCount == nil || *namespaces[1].PodCount != 0 {
+		t.Errorf("Expected 0 pods in old, got %v", namespaces[1].PodCount)
+	}
+}
+
+func TestGetServiceEndpoints_ReadyAndNotReady(t *testing.T) {
+	m := &MultiClusterClient{
+		clients: make(map[string]kubernetes.Interface),
+	}
+
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	ready := true
+	notReady := false
+	port := int32(8080)
+	protocol := corev1.ProtocolTCP
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-abc12",
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "web"},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: "web-1"},
+			},
+			{
+				Addresses:  []string{"10.0.0.2"},
+				Conditions: discoveryv1.EndpointConditions{Ready: &notReady},
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: "web-2"},
+			},
+		},
+		Ports: []discoveryv1.EndpointPort{{Port: &port, Protocol: &protocol}},
+	}
+	// Slice for another service must not leak into the result.
+	other := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api-xyz98",
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "api"},
+		},
+		Endpoints: []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.9"}}},
+	}
+
+	m.clients["test-cluster"] = k8sfake.NewSimpleClientset(svc, slice, other)
+
+	endpoints, err := m.GetServiceEndpoints(context.Background(), "test-cluster", "default", "web")
+	if err != nil {
+		t.Fatalf("GetServiceEndpoints failed: %v", err)
+	}
+	if len(endpoints) != 2 {
+		t.Fatalf("Expected 2 endpoints, got %d", len(endpoints))
+	}
+	if !endpoints[0].Ready || endpoints[0].PodName != "web-1" {
+		t.Errorf("Expected ready web-1 first, got %+v", endpoints[0])
+	}
+	if endpoints[1].Ready || endpoints[1].PodName != "web-2" {
+		t.Errorf("Expected not-ready web-2 second, got %+v", endpoints[1])
+	}
+	if len(endpoints[0].Ports) != 1 || endpoints[0].Ports[0] != "8080/TCP" {
+		t.Errorf("Expected port 8080/TCP, got %v", endpoints[0].Ports)
+	}
+
+	if _, err := m.GetServiceEndpoints(context.Background(), "test-cluster", "default", "missing"); err == nil {
+		t.Error("Expected error for missing service")
+	}
+}
+
+func TestGetImagePullIssues_BackOffMessage(t *testing.T) {
+	m := &MultiClusterClient{
+		clients: make(map[string]kubernetes.Interface),
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "bad-image", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "app", Image: "registry.example.com/app:v9"},
+				{Name: "sidecar", Image: "busybox"},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "app",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason:  "ImagePullBackOff",
+							Message: "Back-off pulling image \"registry.example.com/app:v9\": manifest unknown",
+						},
+					},
+				},
+				{
+					Name:  "sidecar",
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+			},
+		},
+	}
+
+	m.clients["test-cluster"] = k8sfake.NewSimpleClientset(pod)
+
+	issues, err := m.GetImagePullIssues(context.Background(), "test-cluster", "default")
+	if err != nil {
+		t.Fatalf("GetImagePullIssues failed: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 image pull issue, got %d", len(issues))
+	}
+	issue := issues[0]
+	if issue.Pod != "bad-image" || issue.Container != "app" {
+		t.Errorf("Expected bad-image/app, got %s/%s", issue.Pod, issue.Container)
+	}
+	if issue.Image != "registry.example.com/app:v9" {
+		t.Errorf("Expected image from spec, got %s", issue.Image)
+	}
+	if issue.Reason != "ImagePullBackOff" {
+		t.Errorf("Expected ImagePullBackOff reason, got %s", issue.Reason)
+	}
+	if !strings.Contains(issue.Message, "manifest unknown") {
+		t.Errorf("Expected registry error in message, got %q", issue.Message)
+	}
+}
+
+func TestGetOOMKilledPods_LimitAndHistory(t *testing.T) {
+	m := &MultiClusterClient{
+		clients: make(map[string]kubernetes.Interface),
+	}
+
+	finished := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "hungry", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "app",
+				RestartCount: 4,
+				State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						Reason:     "OOMKilled",
+						ExitCode:   137,
+						FinishedAt: finished,
+					},
+				},
+			}},
+		},
+	}
+	healthy := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "fine", Namespace: "default"},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "app", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}},
+		},
+	}
+
+	m.clients["test-cluster"] = k8sfake.NewSimpleClientset(pod, healthy)
+
+	events, err := m.GetOOMKilledPods(context.Background(), "test-cluster", "default")
+	if err != nil {
+		t.Fatalf("GetOOMKilledPods failed: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 OOM event, got %d", len(events))
+	}
+	ev := events[0]
+	if ev.Pod != "hungry" || ev.Container != "app" {
+		t.Errorf("Expected hungry/app, got %s/%s", ev.Pod, ev.Container)
+	}
+	if ev.MemoryLimit != "256Mi" || ev.MemoryLimitBytes != 256*1024*1024 {
+		t.Errorf("Expected 256Mi limit, got %s (%d bytes)", ev.MemoryLimit, ev.MemoryLimitBytes)
+	}
+	if ev.RestartCount != 4 {
+		t.Errorf("Expected restart count 4, got %d", ev.RestartCount)
+	}
+	if !ev.TerminatedAt.Equal(finished.Time) {
+		t.Errorf("Expected terminatedAt %v, got %v", finished.Time, ev.TerminatedAt)
+	}
+	if ev.Current {
+		t.Error("Expected OOM from last termination state, not current")
+	}
+}
+
+func TestPodCountExceeds(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.clients["test-cluster"] = k8sfake.NewSimpleClientset(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "kube-system"}},
+	)
+
+	if exceeds, err := m.PodCountExceeds(context.Background(), "test-cluster", 2); err != nil || exceeds {
+		t.Errorf("PodCountExceeds(2) = %v, %v; want false, nil", exceeds, err)
+	}
+	if exceeds, err := m.PodCountExceeds(context.Background(), "test-cluster", 1); err != nil || !exceeds {
+		t.Errorf("PodCountExceeds(1) = %v, %v; want true, nil", exceeds, err)
+	}
+}
+
+func TestGetSecretValue(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.clients["test-cluster"] = k8sfake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("s3cr3t"), "user": []byte("admin")},
+	})
+
+	value, err := m.GetSecretValue(context.Background(), "test-cluster", "default", "db", "password")
+	if err != nil || value != "s3cr3t" {
+		t.Errorf("GetSecretValue(password) = %q, %v; want s3cr3t, nil", value, err)
+	}
+
+	_, err = m.GetSecretValue(context.Background(), "test-cluster", "default", "db", "missing")
+	if !errors.Is(err, ErrSecretKeyNotFound) {
+		t.Errorf("missing key error = %v, want ErrSecretKeyNotFound", err)
+	}
+
+	if _, err = m.GetSecretValue(context.Background(), "test-cluster", "default", "nope", "password"); err == nil {
+		t.Error("expected error for missing secret")
+	}
+}
+
+func TestGetPodsOnNode(t *testing.T) {
+	pod := func(name, namespace, node string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       corev1.PodSpec{NodeName: node, Containers: []corev1.Container{{Name: "c"}}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	clientset := k8sfake.NewSimpleClientset(
+		pod("web-1", "default", "node-a"),
+		pod("dns", "kube-system", "node-a"),
+		pod("web-2", "default", "node-b"),
+	)
+	m := &MultiClusterClient{
+		clients: map[string]kubernetes.Interface{"test-cluster": clientset},
+	}
+
+	pods, err := m.GetPodsOnNode(context.Background(), "test-cluster", "node-a")
+	if err != nil {
+		t.Fatalf("GetPodsOnNode failed: %v", err)
+	}
+	names := make([]string, 0, len(pods))
+	for _, p := range pods {
+		if p.Node != "node-a" {
+			t.Errorf("pod %s is on %s, want node-a", p.Name, p.Node)
+		}
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	if strings.Join(names, ",") != "dns,web-1" {
+		t.Errorf("pods = %v, want dns and web-1 from every namespace", names)
+	}
+
+	for _, action := range clientset.Actions() {
+		if list, ok := action.(k8stesting.ListAction); ok {
+			if got := list.GetListRestrictions().Fields.String(); got != "spec.nodeName=node-a" {
+				t.Errorf("field selector = %q, want spec.nodeName=node-a", got)
+			}
+		}
+	}
+}
@@ -2,6 +2,9 @@ package k8s
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"sort"
 	"testing"
 	"time"
@@ -18,6 +21,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
 )
 
@@ -959,6 +963,70 @@ func TestGetHPAs(t *testing.T) {
 	}
 }
 
+func TestGetHPAs_CPUAndMemoryMetrics(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+
+	cpuTarget, cpuCurrent := int32(70), int32(45)
+	memTarget, memCurrent := resource.MustParse("512Mi"), resource.MustParse("300Mi")
+	// The controller reports utilization for every resource metric; a value
+	// target must still be shown its current value.
+	memUtilization := int32(58)
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web"},
+			MaxReplicas:    10,
+			Metrics: []autoscalingv2.MetricSpec{
+				{Type: autoscalingv2.ResourceMetricSourceType, Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &cpuTarget},
+				}},
+				{Type: autoscalingv2.ResourceMetricSourceType, Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceMemory,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &memTarget},
+				}},
+			},
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentMetrics: []autoscalingv2.MetricStatus{
+				{Type: autoscalingv2.ResourceMetricSourceType, Resource: &autoscalingv2.ResourceMetricStatus{
+					Name:    corev1.ResourceMemory,
+					Current: autoscalingv2.MetricValueStatus{AverageValue: &memCurrent, AverageUtilization: &memUtilization},
+				}},
+				{Type: autoscalingv2.ResourceMetricSourceType, Resource: &autoscalingv2.ResourceMetricStatus{
+					Name:    corev1.ResourceCPU,
+					Current: autoscalingv2.MetricValueStatus{AverageUtilization: &cpuCurrent},
+				}},
+			},
+		},
+	}
+	m.clients["c1"] = k8sfake.NewSimpleClientset(hpa)
+
+	hpas, err := m.GetHPAs(context.Background(), "c1", "default")
+	if err != nil {
+		t.Fatalf("GetHPAs failed: %v", err)
+	}
+	if len(hpas) != 1 {
+		t.Fatalf("Expected 1 HPA, got %d", len(hpas))
+	}
+	got := hpas[0]
+	if got.TargetCPU != "70%" || got.CurrentCPU != "45%" {
+		t.Errorf("TargetCPU/CurrentCPU = %q/%q, want 70%%/45%%", got.TargetCPU, got.CurrentCPU)
+	}
+	want := []HPAMetric{
+		{Type: "Resource", Name: "cpu", Target: "70%", Current: "45%"},
+		{Type: "Resource", Name: "memory", Target: "512Mi", Current: "300Mi"},
+	}
+	if len(got.Metrics) != len(want) {
+		t.Fatalf("Metrics = %+v, want %+v", got.Metrics, want)
+	}
+	for i := range want {
+		if got.Metrics[i] != want[i] {
+			t.Errorf("Metrics[%d] = %+v, want %+v", i, got.Metrics[i], want[i])
+		}
+	}
+}
+
 func TestGetConfigMapsAndSecrets(t *testing.T) {
 	m, _ := NewMultiClusterClient("")
 
@@ -1044,6 +1112,47 @@ func TestGetReplicaSets(t *testing.T) {
 	}
 }
 
+func TestGetReplicaSets_OwnerDeployment(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	isController := true
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web-5d8f7c9b6",
+			Namespace:   "default",
+			Annotations: map[string]string{"deployment.kubernetes.io/revision": "3"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "web",
+				Controller: &isController,
+			}},
+		},
+	}
+	standalone := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "default"},
+	}
+	m.clients["c1"] = k8sfake.NewSimpleClientset(rs, standalone)
+
+	rss, err := m.GetReplicaSets(context.Background(), "c1", "default")
+	if err != nil {
+		t.Fatalf("GetReplicaSets failed: %v", err)
+	}
+	byName := make(map[string]ReplicaSet)
+	for _, r := range rss {
+		byName[r.Name] = r
+	}
+	owned := byName["web-5d8f7c9b6"]
+	if owned.OwnerDeployment != "web" || owned.OwnerKind != "Deployment" {
+		t.Errorf("Expected owner Deployment web, got %s/%s", owned.OwnerKind, owned.OwnerDeployment)
+	}
+	if owned.Revision != "3" {
+		t.Errorf("Expected revision 3, got %q", owned.Revision)
+	}
+	if byName["standalone"].OwnerDeployment != "" {
+		t.Errorf("Expected no owner deployment for standalone RS, got %q", byName["standalone"].OwnerDeployment)
+	}
+}
+
 func TestGetServiceAccounts(t *testing.T) {
 	m, _ := NewMultiClusterClient("")
 	sa := &corev1.ServiceAccount{
@@ -1129,9 +1238,10 @@ func TestClassifyError(t *testing.T) {
 		// #6508 — exec-plugin missing must NOT be classified as auth, because
 		// auth errors get the 10-minute authFailureCacheTTL which hides a
 		// fixable config problem behind a stale "unreachable" entry.
-		{`exec: "aws-iam-authenticator": executable file not found in $PATH`, "config"},
-		{"exec plugin: executable not found", "config"},
-		{"executable file not found", "config"},
+		{`exec: "aws-iam-authenticator": executable file not found in $PATH`, "credential-plugin"},
+		{"exec plugin: executable not found", "credential-plugin"},
+		{"executable file not found", "credential-plugin"},
+		{"getting credentials: exec: executable tsh failed with exit code 1", "credential-plugin"},
 		// Real auth errors still classify as auth
 		{"getting credentials: token request failed", "auth"},
 		{"credentials not provided", "auth"},
@@ -1232,3 +1342,157 @@ func TestGetAllClusterHealth(t *testing.T) {
 func runScheme() *k8sruntime.Scheme {
 	return k8sruntime.NewScheme()
 }
+
+// writeTwoContextKubeconfig writes a kubeconfig with contexts "prod" (current)
+// and "staging" and returns its path.
+func writeTwoContextKubeconfig(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	const body = `apiVersion: v1
+kind: Config
+current-context: prod
+contexts:
+  - name: prod
+    context: {cluster: prod, user: prod-user}
+  - name: staging
+    context: {cluster: staging, user: staging-user}
+clusters:
+  - name: prod
+    cluster: {server: https://prod.example.com}
+  - name: staging
+    cluster: {server: https://staging.example.com}
+users:
+  - name: prod-user
+    user: {}
+  - name: staging-user
+    user: {}
+`
+	if err := os.WriteFile(path, []byte(body), 0600); err != nil {
+		t.Fatalf("write kubeconfig: %v", err)
+	}
+	return path
+}
+
+func TestRenameContext_Persists(t *testing.T) {
+	path := writeTwoContextKubeconfig(t)
+	m, err := NewMultiClusterClient(path)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+	reloads := 0
+	m.SetOnReload(func() { reloads++ })
+
+	if err := m.RenameContext("prod", "production"); err != nil {
+		t.Fatalf("RenameContext: %v", err)
+	}
+
+	onDisk, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("reload kubeconfig: %v", err)
+	}
+	if _, ok := onDisk.Contexts["prod"]; ok {
+		t.Error("old context name should be gone")
+	}
+	renamed, ok := onDisk.Contexts["production"]
+	if !ok {
+		t.Fatal("renamed context missing from kubeconfig")
+	}
+	if renamed.Cluster != "prod" || renamed.AuthInfo != "prod-user" {
+		t.Errorf("renamed context lost its cluster/user: %+v", renamed)
+	}
+	if onDisk.CurrentContext != "production" {
+		t.Errorf("current-context = %q, want production", onDisk.CurrentContext)
+	}
+	if reloads != 1 {
+		t.Errorf("reload callback fired %d times, want 1", reloads)
+	}
+}
+
+func TestRenameContext_RejectsExistingName(t *testing.T) {
+	path := writeTwoContextKubeconfig(t)
+	m, err := NewMultiClusterClient(path)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+
+	if err := m.RenameContext("prod", "staging"); err == nil {
+		t.Fatal("expected error renaming onto an existing context")
+	}
+	if err := m.RenameContext("missing", "other"); err == nil {
+		t.Fatal("expected error renaming a missing context")
+	}
+
+	onDisk, _ := clientcmd.LoadFromFile(path)
+	if onDisk.Contexts["staging"].Cluster != "staging" {
+		t.Error("existing staging context must not be overwritten")
+	}
+}
+
+func TestDeleteContext_Persists(t *testing.T) {
+	path := writeTwoContextKubeconfig(t)
+	m, err := NewMultiClusterClient(path)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+	reloads := 0
+	m.SetOnReload(func() { reloads++ })
+
+	if err := m.DeleteContext("staging"); err != nil {
+		t.Fatalf("DeleteContext: %v", err)
+	}
+
+	onDisk, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("reload kubeconfig: %v", err)
+	}
+	if _, ok := onDisk.Contexts["staging"]; ok {
+		t.Error("deleted context still present")
+	}
+	if _, ok := onDisk.Clusters["staging"]; ok {
+		t.Error("unreferenced cluster entry should be removed")
+	}
+	if _, ok := onDisk.Contexts["prod"]; !ok {
+		t.Error("other contexts must survive")
+	}
+	if reloads != 1 {
+		t.Errorf("reload callback fired %d times, want 1", reloads)
+	}
+
+	if err := m.DeleteContext("prod"); err == nil {
+		t.Error("deleting the current context should fail")
+	}
+}
+
+func TestSetCurrentContext_FlipsAndPersists(t *testing.T) {
+	path := writeTwoContextKubeconfig(t)
+	m, err := NewMultiClusterClient(path)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+
+	if err := m.SetCurrentContext("staging"); err != nil {
+		t.Fatalf("SetCurrentContext: %v", err)
+	}
+
+	clusters, err := m.ListClusters(context.Background())
+	if err != nil {
+		t.Fatalf("ListClusters: %v", err)
+	}
+	for _, cl := range clusters {
+		if want := cl.Context == "staging"; cl.IsCurrent != want {
+			t.Errorf("cluster %q IsCurrent = %v, want %v", cl.Context, cl.IsCurrent, want)
+		}
+	}
+
+	// A fresh reload from disk must see the new current-context.
+	if err := m.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if got := m.rawConfig.CurrentContext; got != "staging" {
+		t.Errorf("current-context after reload = %q, want staging", got)
+	}
+
+	if err := m.SetCurrentContext("missing"); !errors.Is(err, ErrContextNotFound) {
+		t.Errorf("expected ErrContextNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DeploymentSnapshot is the subset of a Deployment compared by DiffClusters.
+type DeploymentSnapshot struct {
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Images    []string `json:"images"` // "container=image", sorted by container name
+	Replicas  int32    `json:"replicas"`
+}
+
+// DeploymentChange is a Deployment present in both clusters whose images or
+// replica count differ. Fields lists what changed ("images", "replicas").
+type DeploymentChange struct {
+	Name      string             `json:"name"`
+	Namespace string             `json:"namespace"`
+	A         DeploymentSnapshot `json:"a"`
+	B         DeploymentSnapshot `json:"b"`
+	Fields    []string           `json:"fields"`
+}
+
+// ClusterDiff is the result of comparing Deployments between two clusters.
+// Added are only in ClusterB, Removed are only in ClusterA, so the diff reads
+// as "what applying B over A would change" (e.g. staging -> prod).
+type ClusterDiff struct {
+	ClusterA  string               `json:"clusterA"`
+	ClusterB  string               `json:"clusterB"`
+	Namespace string               `json:"namespace,omitempty"`
+	Added     []DeploymentSnapshot `json:"added"`
+	Removed   []DeploymentSnapshot `json:"removed"`
+	Changed   []DeploymentChange   `json:"changed"`
+	Unchanged int                  `json:"unchanged"`
+}
+
+// DiffClusters compares the Deployments of two clusters by namespace/name,
+// container images and desired replicas. An empty namespace compares all
+// namespaces.
+func (m *MultiClusterClient) DiffClusters(ctx context.Context, clusterA, clusterB, namespace string) (*ClusterDiff, error) {
+	if clusterA == "" || clusterB == "" {
+		return nil, fmt.Errorf("both clusters are required")
+	}
+
+	var snapA, snapB map[string]DeploymentSnapshot
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		snapA, err = m.deploymentSnapshots(gctx, clusterA, namespace)
+		if err != nil {
+			return fmt.Errorf("cluster %s: %w", clusterA, err)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		var err error
+		snapB, err = m.deploymentSnapshots(gctx, clusterB, namespace)
+		if err != nil {
+			return fmt.Errorf("cluster %s: %w", clusterB, err)
+		}
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	diff := &ClusterDiff{
+		ClusterA:  clusterA,
+		ClusterB:  clusterB,
+		Namespace: namespace,
+		Added:     []DeploymentSnapshot{},
+		Removed:   []DeploymentSnapshot{},
+		Changed:   []DeploymentChange{},
+	}
+
+	for key, a := range snapA {
+		b, ok := snapB[key]
+		if !ok {
+			diff.Removed = append(diff.Removed, a)
+			continue
+		}
+		var fields []string
+		if strings.Join(a.Images, ",") != strings.Join(b.Images, ",") {
+			fields = append(fields, "images")
+		}
+		if a.Replicas != b.Replicas {
+			fields = append(fields, "replicas")
+		}
+		if len(fields) == 0 {
+			diff.Unchanged++
+			continue
+		}
+		diff.Changed = append(diff.Changed, DeploymentChange{
+			Name:      a.Name,
+			Namespace: a.Namespace,
+			A:         a,
+			B:         b,
+			Fields:    fields,
+		})
+	}
+	for key, b := range snapB {
+		if _, ok := snapA[key]; !ok {
+			diff.Added = append(diff.Added, b)
+		}
+	}
+
+	sortSnapshots := func(s []DeploymentSnapshot) {
+		sort.Slice(s, func(i, j int) bool {
+			if s[i].Namespace != s[j].Namespace {
+				return s[i].Namespace < s[j].Namespace
+			}
+			return s[i].Name < s[j].Name
+		})
+	}
+	sortSnapshots(diff.Added)
+	sortSnapshots(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool {
+		if diff.Changed[i].Namespace != diff.Changed[j].Namespace {
+			return diff.Changed[i].Namespace < diff.Changed[j].Namespace
+		}
+		return diff.Changed[i].Name < diff.Changed[j].Name
+	})
+	return diff, nil
+}
+
+// deploymentSnapshots lists Deployments in a cluster keyed by namespace/name.
+func (m *MultiClusterClient) deploymentSnapshots(ctx context.Context, contextName, namespace string) (map[string]DeploymentSnapshot, error) {
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments, err := client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]DeploymentSnapshot, len(deployments.Items))
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		result[d.Namespace+"/"+d.Name] = snapshotDeployment(d)
+	}
+	return result, nil
+}
+
+// snapshotDeployment extracts the compared fields from a Deployment.
+func snapshotDeployment(d *appsv1.Deployment) DeploymentSnapshot {
+	// Kubernetes defaults Replicas to 1 when unset
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	images := make([]string, 0, len(d.Spec.Template.Spec.Containers))
+	for _, c := range d.Spec.Template.Spec.Containers {
+		images = append(images, c.Name+"="+c.Image)
+	}
+	sort.Strings(images)
+	return DeploymentSnapshot{
+		Name:      d.Name,
+		Namespace: d.Namespace,
+		Images:    images,
+		Replicas:  replicas,
+	}
+}
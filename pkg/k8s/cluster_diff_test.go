@@ -0,0 +1,72 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// diffTestDeployment builds a single-container Deployment in "apps".
+func diffTestDeployment(name, image string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "apps"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "main", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+func TestDiffClusters_AddedRemovedChanged(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "staging", "prod")
+
+	m.InjectClient("staging", fake.NewSimpleClientset(
+		diffTestDeployment("web", "web:v2", 2),
+		diffTestDeployment("api", "api:v1", 3),
+		diffTestDeployment("worker", "worker:v1", 1),
+		diffTestDeployment("preview", "preview:v1", 1),
+	))
+	m.InjectClient("prod", fake.NewSimpleClientset(
+		diffTestDeployment("web", "web:v1", 2),
+		diffTestDeployment("api", "api:v1", 5),
+		diffTestDeployment("worker", "worker:v1", 1),
+		diffTestDeployment("legacy", "legacy:v1", 1),
+	))
+
+	diff, err := m.DiffClusters(context.Background(), "staging", "prod", "apps")
+	require.NoError(t, err)
+
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "legacy", diff.Added[0].Name)
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, "preview", diff.Removed[0].Name)
+	assert.Equal(t, 1, diff.Unchanged)
+
+	require.Len(t, diff.Changed, 2)
+	assert.Equal(t, "api", diff.Changed[0].Name)
+	assert.Equal(t, []string{"replicas"}, diff.Changed[0].Fields)
+	assert.Equal(t, "web", diff.Changed[1].Name)
+	assert.Equal(t, []string{"images"}, diff.Changed[1].Fields)
+	assert.Equal(t, []string{"main=web:v2"}, diff.Changed[1].A.Images)
+	assert.Equal(t, []string{"main=web:v1"}, diff.Changed[1].B.Images)
+}
+
+func TestDiffClusters_UnknownCluster(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "staging")
+	m.InjectClient("staging", fake.NewSimpleClientset())
+
+	_, err := m.DiffClusters(context.Background(), "staging", "missing", "")
+	assert.Error(t, err)
+}
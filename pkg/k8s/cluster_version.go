@@ -0,0 +1,109 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Platform values reported in ClusterVersion.Platform. PlatformUnknown means
+// none of the heuristics matched, which is normal for vanilla/kubeadm clusters.
+const (
+	PlatformOpenShift = "openshift"
+	PlatformEKS       = "eks"
+	PlatformGKE       = "gke"
+	PlatformAKS       = "aks"
+	PlatformK3s       = "k3s"
+	PlatformUnknown   = ""
+)
+
+// clusterVersionNodeSample is how many nodes GetClusterVersion inspects for
+// provider labels. Managed node pools label every node, so a few suffice.
+const clusterVersionNodeSample = 5
+
+// ClusterVersion is the Kubernetes server version of a cluster plus a
+// best-effort guess at its distribution.
+type ClusterVersion struct {
+	Cluster    string `json:"cluster"`
+	GitVersion string `json:"gitVersion"`
+	Major      string `json:"major"`
+	Minor      string `json:"minor"`
+	Platform   string `json:"platform,omitempty"`
+}
+
+// gitVersionPlatformMarkers maps substrings that distributions embed in the
+// server gitVersion (e.g. "v1.29.4-eks-036c24b") to their platform.
+var gitVersionPlatformMarkers = []struct {
+	marker, platform string
+}{
+	{"-eks-", PlatformEKS},
+	{"-gke.", PlatformGKE},
+	{"+k3s", PlatformK3s},
+}
+
+// nodeLabelPlatformMarkers maps node label keys set by managed providers to
+// their platform.
+var nodeLabelPlatformMarkers = []struct {
+	label, platform string
+}{
+	{"node.openshift.io/os_id", PlatformOpenShift},
+	{"eks.amazonaws.com/nodegroup", PlatformEKS},
+	{"cloud.google.com/gke-nodepool", PlatformGKE},
+	{"kubernetes.azure.com/cluster", PlatformAKS},
+}
+
+// GetClusterVersion returns the server version reported by discovery and
+// guesses the platform from, in order: served openshift.io API groups, the
+// gitVersion suffix, and provider labels on a sample of nodes. Only the
+// version lookup can fail the call; the platform checks are best-effort.
+func (m *MultiClusterClient) GetClusterVersion(ctx context.Context, contextName string) (_ *ClusterVersion, err error) {
+	defer observeClusterRequest(contextName, "GetClusterVersion", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	result := &ClusterVersion{
+		Cluster:    contextName,
+		GitVersion: info.GitVersion,
+		Major:      info.Major,
+		Minor:      info.Minor,
+	}
+
+	if groups, gErr := client.Discovery().ServerGroups(); gErr == nil {
+		for _, g := range groups.Groups {
+			if strings.HasSuffix(g.Name, ".openshift.io") {
+				result.Platform = PlatformOpenShift
+				return result, nil
+			}
+		}
+	}
+
+	for _, p := range gitVersionPlatformMarkers {
+		if strings.Contains(info.GitVersion, p.marker) {
+			result.Platform = p.platform
+			return result, nil
+		}
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: clusterVersionNodeSample})
+	if err != nil {
+		// Listing nodes needs cluster-scope RBAC; the version alone is still useful.
+		return result, nil
+	}
+	for _, node := range nodes.Items {
+		for _, p := range nodeLabelPlatformMarkers {
+			if _, ok := node.Labels[p.label]; ok {
+				result.Platform = p.platform
+				return result, nil
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetClusterVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		gitVersion   string
+		groups       []string
+		nodeLabels   map[string]string
+		wantPlatform string
+	}{
+		{name: "vanilla", gitVersion: "v1.30.2", wantPlatform: PlatformUnknown},
+		{name: "eks from version", gitVersion: "v1.29.4-eks-036c24b", wantPlatform: PlatformEKS},
+		{name: "gke from version", gitVersion: "v1.28.9-gke.1000000", wantPlatform: PlatformGKE},
+		{name: "openshift from api group", gitVersion: "v1.28.6+f1618d5", groups: []string{"config.openshift.io/v1"}, wantPlatform: PlatformOpenShift},
+		{name: "aks from node label", gitVersion: "v1.29.2", nodeLabels: map[string]string{"kubernetes.azure.com/cluster": "MC_rg"}, wantPlatform: PlatformAKS},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var clientset *k8sfake.Clientset
+			if tt.nodeLabels != nil {
+				clientset = k8sfake.NewSimpleClientset(&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: tt.nodeLabels},
+				})
+			} else {
+				clientset = k8sfake.NewSimpleClientset()
+			}
+			disc := clientset.Discovery().(*fakediscovery.FakeDiscovery)
+			disc.FakedServerVersion = &version.Info{GitVersion: tt.gitVersion, Major: "1", Minor: "29"}
+			for _, gv := range tt.groups {
+				disc.Resources = append(disc.Resources, &metav1.APIResourceList{GroupVersion: gv})
+			}
+
+			m, _ := NewMultiClusterClient("")
+			m.clients["c1"] = clientset
+
+			got, err := m.GetClusterVersion(context.Background(), "c1")
+			if err != nil {
+				t.Fatalf("GetClusterVersion: %v", err)
+			}
+			if got.GitVersion != tt.gitVersion {
+				t.Errorf("gitVersion = %q, want %q", got.GitVersion, tt.gitVersion)
+			}
+			if got.Major != "1" || got.Minor != "29" || got.Cluster != "c1" {
+				t.Errorf("unexpected version fields: %+v", got)
+			}
+			if got.Platform != tt.wantPlatform {
+				t.Errorf("platform = %q, want %q", got.Platform, tt.wantPlatform)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package k8s
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ErrInvalidConfigMapData is returned by UpdateConfigMapData when a key is
+// not a valid ConfigMap key, appears in both data and binaryData, or a
+// binaryData value is not valid base64. The request never reaches the API
+// server in that case.
+var ErrInvalidConfigMapData = errors.New("invalid configmap data")
+
+// ConfigMapData is the editable content of a ConfigMap. BinaryData values
+// are standard base64 so they survive JSON. ResourceVersion is returned by
+// GetConfigMapData; passing it back to UpdateConfigMapData makes the update
+// fail with a conflict if someone else changed the ConfigMap in between.
+type ConfigMapData struct {
+	Cluster         string            `json:"cluster,omitempty"`
+	Namespace       string            `json:"namespace,omitempty"`
+	Name            string            `json:"name,omitempty"`
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
+	Immutable       bool              `json:"immutable,omitempty"`
+	Data            map[string]string `json:"data"`
+	BinaryData      map[string]string `json:"binaryData"`
+}
+
+// GetConfigMapData returns the data and base64-encoded binaryData of a
+// single ConfigMap.
+func (m *MultiClusterClient) GetConfigMapData(ctx context.Context, contextName, namespace, name string) (_ *ConfigMapData, err error) {
+	defer observeClusterRequest(contextName, "GetConfigMapData", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConfigMapData{
+		Cluster:         contextName,
+		Namespace:       cm.Namespace,
+		Name:            cm.Name,
+		ResourceVersion: cm.ResourceVersion,
+		Immutable:       cm.Immutable != nil && *cm.Immutable,
+		Data:            make(map[string]string, len(cm.Data)),
+		BinaryData:      make(map[string]string, len(cm.BinaryData)),
+	}
+	for k, v := range cm.Data {
+		result.Data[k] = v
+	}
+	for k, v := range cm.BinaryData {
+		result.BinaryData[k] = base64.StdEncoding.EncodeToString(v)
+	}
+	return result, nil
+}
+
+// UpdateConfigMapData replaces the data and binaryData of a ConfigMap with
+// the given content; keys missing from update are removed. Labels,
+// annotations and the rest of the object are left untouched.
+func (m *MultiClusterClient) UpdateConfigMapData(ctx context.Context, contextName, namespace, name string, update *ConfigMapData) (err error) {
+	defer observeClusterRequest(contextName, "UpdateConfigMapData", time.Now(), &err)
+	if update == nil {
+		return fmt.Errorf("%w: no data given", ErrInvalidConfigMapData)
+	}
+	binary, err := decodeConfigMapData(update)
+	if err != nil {
+		return err
+	}
+
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return err
+	}
+
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if update.ResourceVersion != "" {
+		cm.ResourceVersion = update.ResourceVersion
+	}
+	cm.Data = update.Data
+	cm.BinaryData = binary
+
+	_, err = client.CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// decodeConfigMapData validates keys and decodes the base64 binaryData
+// values of update.
+func decodeConfigMapData(update *ConfigMapData) (map[string][]byte, error) {
+	for key := range update.Data {
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return nil, fmt.Errorf("%w: data key %q: %s", ErrInvalidConfigMapData, key, strings.Join(errs, "; "))
+		}
+	}
+
+	var binary map[string][]byte
+	if len(update.BinaryData) > 0 {
+		binary = make(map[string][]byte, len(update.BinaryData))
+	}
+	for key, encoded := range update.BinaryData {
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return nil, fmt.Errorf("%w: binaryData key %q: %s", ErrInvalidConfigMapData, key, strings.Join(errs, "; "))
+		}
+		if _, dup := update.Data[key]; dup {
+			return nil, fmt.Errorf("%w: key %q is present in both data and binaryData", ErrInvalidConfigMapData, key)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: binaryData key %q is not valid base64", ErrInvalidConfigMapData, key)
+		}
+		binary[key] = decoded
+	}
+	return binary, nil
+}
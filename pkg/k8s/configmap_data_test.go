@@ -0,0 +1,97 @@
+package k8s
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestConfigMapData_RoundTrip(t *testing.T) {
+	binaryValue := []byte{0x00, 0xff, 0x10, 0x80}
+	m, _ := NewMultiClusterClient("")
+	fakeClient := k8sfake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Labels: map[string]string{"team": "a"}},
+		Data:       map[string]string{"config.yaml": "replicas: 1\n", "stale": "x"},
+		BinaryData: map[string][]byte{"logo.png": binaryValue},
+	})
+	m.clients["test-cluster"] = fakeClient
+	ctx := context.Background()
+
+	got, err := m.GetConfigMapData(ctx, "test-cluster", "default", "app")
+	if err != nil {
+		t.Fatalf("GetConfigMapData: %v", err)
+	}
+	if got.Data["config.yaml"] != "replicas: 1\n" {
+		t.Errorf("data = %v", got.Data)
+	}
+	if got.BinaryData["logo.png"] != base64.StdEncoding.EncodeToString(binaryValue) {
+		t.Errorf("binaryData = %v, want base64 of %v", got.BinaryData, binaryValue)
+	}
+
+	// Edit a string key, drop another, and replace the binary value.
+	newBinary := []byte{0x01, 0x02, 0x03}
+	got.Data["config.yaml"] = "replicas: 3\n"
+	delete(got.Data, "stale")
+	got.BinaryData["logo.png"] = base64.StdEncoding.EncodeToString(newBinary)
+	if err := m.UpdateConfigMapData(ctx, "test-cluster", "default", "app", got); err != nil {
+		t.Fatalf("UpdateConfigMapData: %v", err)
+	}
+
+	cm, err := fakeClient.CoreV1().ConfigMaps("default").Get(ctx, "app", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get configmap: %v", err)
+	}
+	if cm.Data["config.yaml"] != "replicas: 3\n" {
+		t.Errorf("updated data = %v", cm.Data)
+	}
+	if _, ok := cm.Data["stale"]; ok {
+		t.Errorf("stale key should have been removed: %v", cm.Data)
+	}
+	if string(cm.BinaryData["logo.png"]) != string(newBinary) {
+		t.Errorf("updated binaryData = %v, want %v", cm.BinaryData["logo.png"], newBinary)
+	}
+	if cm.Labels["team"] != "a" {
+		t.Errorf("labels should be preserved, got %v", cm.Labels)
+	}
+
+	again, err := m.GetConfigMapData(ctx, "test-cluster", "default", "app")
+	if err != nil {
+		t.Fatalf("GetConfigMapData after update: %v", err)
+	}
+	if again.Data["config.yaml"] != "replicas: 3\n" || again.BinaryData["logo.png"] != base64.StdEncoding.EncodeToString(newBinary) {
+		t.Errorf("round trip mismatch: %+v", again)
+	}
+}
+
+func TestUpdateConfigMapData_Invalid(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.clients["test-cluster"] = k8sfake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+	})
+
+	tests := []struct {
+		name   string
+		update *ConfigMapData
+	}{
+		{"nil", nil},
+		{"bad data key", &ConfigMapData{Data: map[string]string{"a/b": "x"}}},
+		{"bad base64", &ConfigMapData{BinaryData: map[string]string{"blob": "not base64!"}}},
+		{"duplicate key", &ConfigMapData{
+			Data:       map[string]string{"k": "v"},
+			BinaryData: map[string]string{"k": base64.StdEncoding.EncodeToString([]byte("v"))},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.UpdateConfigMapData(context.Background(), "test-cluster", "default", "app", tt.update)
+			if !errors.Is(err, ErrInvalidConfigMapData) {
+				t.Errorf("error = %v, want ErrInvalidConfigMapData", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package k8s
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"k8s.io/client-go/rest"
+)
+
+// CA sources reported in ContextDetails.CASource.
+const (
+	CASourceFile = "file" // certificate-authority path
+	CASourceData = "data" // inline certificate-authority-data
+	CASourceNone = "none" // system trust store
+)
+
+// ContextDetails is the connection configuration of one kubeconfig context,
+// for debugging TLS and connectivity errors. It never carries credentials or
+// certificate contents.
+type ContextDetails struct {
+	Name                  string `json:"name"`
+	Cluster               string `json:"cluster,omitempty"` // kubeconfig cluster entry
+	Server                string `json:"server"`
+	InsecureSkipTLSVerify bool   `json:"insecureSkipTLSVerify"`
+	CASource              string `json:"caSource"`         // file, data or none
+	CAFile                string `json:"caFile,omitempty"` // set when CASource is file
+	TLSServerName         string `json:"tlsServerName,omitempty"`
+	// ProxyURL is the kubeconfig proxy-url; EnvironmentProxy is the proxy
+	// HTTPS_PROXY/HTTP_PROXY/NO_PROXY select for Server when none is set.
+	// Passwords in either are redacted.
+	ProxyURL         string `json:"proxyUrl,omitempty"`
+	EnvironmentProxy string `json:"environmentProxy,omitempty"`
+	AuthMethod       string `json:"authMethod,omitempty"` // exec, token, certificate, auth-provider, unknown
+}
+
+// GetContextDetails returns the server, TLS and proxy settings of the named
+// kubeconfig context (or of the in-cluster config). It returns
+// ErrContextNotFound when no such context exists.
+func (m *MultiClusterClient) GetContextDetails(name string) (*ContextDetails, error) {
+	m.mu.RLock()
+	rawConfig := m.rawConfig
+	inClusterConfig := m.inClusterConfig
+	inClusterName := m.inClusterName
+	m.mu.RUnlock()
+
+	if rawConfig == nil && inClusterConfig == nil {
+		if err := m.LoadConfig(); err != nil {
+			return nil, err
+		}
+		m.mu.RLock()
+		rawConfig = m.rawConfig
+		m.mu.RUnlock()
+	}
+
+	if inClusterConfig != nil && (name == "in-cluster" || name == inClusterName) {
+		return inClusterContextDetails(name, inClusterConfig), nil
+	}
+	if rawConfig == nil {
+		return nil, fmt.Errorf("%w: %q", ErrContextNotFound, name)
+	}
+	contextInfo, ok := rawConfig.Contexts[name]
+	if !ok || contextInfo == nil {
+		return nil, fmt.Errorf("%w: %q", ErrContextNotFound, name)
+	}
+
+	details := &ContextDetails{Name: name, Cluster: contextInfo.Cluster, CASource: CASourceNone}
+	if cluster, ok := rawConfig.Clusters[contextInfo.Cluster]; ok && cluster != nil {
+		details.Server = cluster.Server
+		details.InsecureSkipTLSVerify = cluster.InsecureSkipTLSVerify
+		details.TLSServerName = cluster.TLSServerName
+		details.ProxyURL = redactURL(cluster.ProxyURL)
+		switch {
+		case len(cluster.CertificateAuthorityData) > 0:
+			details.CASource = CASourceData
+		case cluster.CertificateAuthority != "":
+			details.CASource = CASourceFile
+			details.CAFile = cluster.CertificateAuthority
+		}
+	}
+	details.AuthMethod = authMethodOf(rawConfig.AuthInfos[contextInfo.AuthInfo])
+	if details.ProxyURL == "" {
+		details.EnvironmentProxy = environmentProxy(details.Server)
+	}
+	return details, nil
+}
+
+// inClusterContextDetails describes the ServiceAccount connection used when
+// the console runs inside a cluster.
+func inClusterContextDetails(name string, config *rest.Config) *ContextDetails {
+	details := &ContextDetails{
+		Name:                  name,
+		Server:                config.Host,
+		InsecureSkipTLSVerify: config.Insecure,
+		CASource:              CASourceNone,
+		TLSServerName:         config.ServerName,
+		AuthMethod:            "token",
+	}
+	switch {
+	case len(config.CAData) > 0:
+		details.CASource = CASourceData
+	case config.CAFile != "":
+		details.CASource = CASourceFile
+		details.CAFile = config.CAFile
+	}
+	details.EnvironmentProxy = environmentProxy(details.Server)
+	return details
+}
+
+// environmentProxy returns the proxy the standard environment variables
+// select for server, or "" for a direct connection.
+func environmentProxy(server string) string {
+	if server == "" {
+		return ""
+	}
+	req, err := http.NewRequest(http.MethodGet, server, nil)
+	if err != nil {
+		return ""
+	}
+	proxy, err := http.ProxyFromEnvironment(req)
+	if err != nil || proxy == nil {
+		return ""
+	}
+	return proxy.Redacted()
+}
+
+// redactURL masks the password in raw, which may embed proxy credentials.
+func redactURL(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		// An unparsable URL may still hold credentials; do not echo it.
+		return "<invalid URL>"
+	}
+	return u.Redacted()
+}
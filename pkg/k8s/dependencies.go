@@ -887,14 +887,24 @@ func (m *MultiClusterClient) findMatchingHPAs(
 	workloadName := workloadObj.GetName()
 	workloadKind := workloadObj.GetKind()
 
-	hpaList, err := dynClient.Resource(gvrHPAs).Namespace(namespace).List(ctx, metav1.ListOptions{})
-	if err != nil {
+	// Pick the served HPA version from cached discovery rather than learning
+	// it from a failed autoscaling/v2 list on every resolve.
+	gvr := gvrHPAs
+	if mapper, err := m.GetRESTMapper(cluster); err == nil {
+		gk := schema.GroupKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}
+		if mapping, err := mapper.RESTMapping(gk, "v2", "v1"); err == nil {
+			gvr = mapping.Resource
+		}
+	}
+
+	hpaList, err := dynClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil && gvr.Version != "v1" {
 		// autoscaling/v2 may not be available; try v1
 		gvrHPAv1 := schema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}
 		hpaList, err = dynClient.Resource(gvrHPAv1).Namespace(namespace).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			return deps
-		}
+	}
+	if err != nil {
+		return deps
 	}
 
 	for _, hpa := range hpaList.Items {
@@ -0,0 +1,152 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// CompatibilityWarning describes a resource in a deploy bundle whose API
+// version is not served by the target cluster. FallbackVersion names another
+// served version of the same group that has the resource (e.g. autoscaling/v1
+// when autoscaling/v2 is missing); empty means the resource is not served at
+// all and the apply will fail.
+type CompatibilityWarning struct {
+	Cluster         string `json:"cluster"`
+	Kind            string `json:"kind"`
+	Name            string `json:"name"`
+	GroupVersion    string `json:"groupVersion"`
+	Resource        string `json:"resource"`
+	FallbackVersion string `json:"fallbackVersion,omitempty"`
+	Message         string `json:"message"`
+}
+
+// CheckDeployCompatibility uses discovery on targetCluster to confirm that
+// the workload and every dependency in bundle are served at the API version
+// the deploy will apply them with. It returns one warning per unserved
+// resource; an empty result means the bundle is compatible. Discovery
+// failures other than "group/version not found" are returned as errors.
+func (m *MultiClusterClient) CheckDeployCompatibility(ctx context.Context, targetCluster string, bundle *DependencyBundle) (_ []CompatibilityWarning, err error) {
+	defer observeClusterRequest(targetCluster, "CheckDeployCompatibility", time.Now(), &err)
+	if bundle == nil {
+		return nil, fmt.Errorf("no dependency bundle given")
+	}
+	disc, err := m.cachedDiscovery(targetCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	type item struct {
+		kind, name string
+		gvr        schema.GroupVersionResource
+	}
+	var items []item
+	if w := bundle.Workload; w != nil {
+		if gvr, ok := workloadGVRByKind[w.GetKind()]; ok {
+			items = append(items, item{kind: w.GetKind(), name: w.GetName(), gvr: gvr})
+		}
+	}
+	for _, dep := range bundle.Dependencies {
+		items = append(items, item{kind: string(dep.Kind), name: dep.Name, gvr: dep.GVR})
+	}
+
+	served := make(map[schema.GroupVersionResource]bool)
+	warnings := make([]CompatibilityWarning, 0)
+	for _, it := range items {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		ok, err := isResourceServed(disc, it.gvr, served)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			continue
+		}
+
+		gv := it.gvr.GroupVersion().String()
+		fallback, err := findFallbackVersion(disc, it.gvr, served)
+		if err != nil {
+			return nil, err
+		}
+		w := CompatibilityWarning{
+			Cluster:         targetCluster,
+			Kind:            it.kind,
+			Name:            it.name,
+			GroupVersion:    gv,
+			Resource:        it.gvr.Resource,
+			FallbackVersion: fallback,
+		}
+		if fallback != "" {
+			w.Message = fmt.Sprintf("%s/%s: %s is not served by cluster %s (only %s is); the apply will fail until the manifest is converted",
+				it.kind, it.name, gv, targetCluster, fallback)
+		} else {
+			w.Message = fmt.Sprintf("%s/%s: %s %s is not served by cluster %s",
+				it.kind, it.name, gv, it.gvr.Resource, targetCluster)
+		}
+		warnings = append(warnings, w)
+	}
+	return warnings, nil
+}
+
+// isResourceServed reports whether gvr is listed by discovery. Results are
+// memoized in served so a bundle with many Secrets costs one lookup.
+func isResourceServed(disc discovery.DiscoveryInterface, gvr schema.GroupVersionResource, served map[schema.GroupVersionResource]bool) (bool, error) {
+	if ok, cached := served[gvr]; cached {
+		return ok, nil
+	}
+	resources, err := serverResourcesForGroupVersion(disc, gvr.GroupVersion().String())
+	if isDiscoveryNotFound(err) {
+		served[gvr] = false
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to discover %s: %w", gvr.GroupVersion(), err)
+	}
+	ok := false
+	for _, r := range resources.APIResources {
+		if r.Name == gvr.Resource {
+			ok = true
+			break
+		}
+	}
+	served[gvr] = ok
+	return ok, nil
+}
+
+// findFallbackVersion returns another served version of gvr's group that has
+// the same resource, preferring the server's preferred version, or "" when
+// there is none.
+func findFallbackVersion(disc discovery.DiscoveryInterface, gvr schema.GroupVersionResource, served map[schema.GroupVersionResource]bool) (string, error) {
+	groups, err := disc.ServerGroups()
+	if err != nil {
+		return "", fmt.Errorf("failed to discover API groups: %w", err)
+	}
+	for _, g := range groups.Groups {
+		if g.Name != gvr.Group {
+			continue
+		}
+		versions := g.Versions
+		if g.PreferredVersion.Version != "" {
+			versions = append([]metav1.GroupVersionForDiscovery{g.PreferredVersion}, versions...)
+		}
+		for _, v := range versions {
+			if v.Version == gvr.Version {
+				continue
+			}
+			candidate := schema.GroupVersionResource{Group: gvr.Group, Version: v.Version, Resource: gvr.Resource}
+			ok, err := isResourceServed(disc, candidate, served)
+			if err != nil {
+				return "", err
+			}
+			if ok {
+				return v.GroupVersion, nil
+			}
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,84 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCheckDeployCompatibility_MissingHPAv2(t *testing.T) {
+	// The target serves Deployments, ConfigMaps and autoscaling/v1 only.
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}}},
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}}},
+		{GroupVersion: "autoscaling/v1", APIResources: []metav1.APIResource{{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Namespaced: true}}},
+	}
+	m, _ := NewMultiClusterClient("")
+	m.clients["old-cluster"] = clientset
+
+	workload := &unstructured.Unstructured{}
+	workload.SetKind("Deployment")
+	workload.SetName("web")
+	bundle := &DependencyBundle{
+		Workload: workload,
+		Dependencies: []Dependency{
+			{Kind: DepConfigMap, Name: "cfg", Namespace: "default", GVR: gvrConfigMaps},
+			{Kind: DepHPA, Name: "web", Namespace: "default", GVR: gvrHPAs},
+			{Kind: DepPDB, Name: "web", Namespace: "default", GVR: gvrPDBs},
+		},
+	}
+
+	warnings, err := m.CheckDeployCompatibility(context.Background(), "old-cluster", bundle)
+	if err != nil {
+		t.Fatalf("CheckDeployCompatibility: %v", err)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("warnings = %+v, want HPA and PDB", warnings)
+	}
+
+	hpa := warnings[0]
+	if hpa.Kind != string(DepHPA) || hpa.GroupVersion != "autoscaling/v2" {
+		t.Errorf("warnings[0] = %+v, want autoscaling/v2 HPA", hpa)
+	}
+	if hpa.FallbackVersion != "autoscaling/v1" {
+		t.Errorf("fallback = %q, want autoscaling/v1", hpa.FallbackVersion)
+	}
+	if !strings.Contains(hpa.Message, "HorizontalPodAutoscaler/web") {
+		t.Errorf("message should name the resource: %q", hpa.Message)
+	}
+
+	pdb := warnings[1]
+	if pdb.Kind != string(DepPDB) || pdb.FallbackVersion != "" {
+		t.Errorf("warnings[1] = %+v, want unserved PDB without fallback", pdb)
+	}
+}
+
+func TestCheckDeployCompatibility_AllServed(t *testing.T) {
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}}},
+		{GroupVersion: "autoscaling/v2", APIResources: []metav1.APIResource{{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Namespaced: true}}},
+	}
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = clientset
+
+	workload := &unstructured.Unstructured{}
+	workload.SetKind("Deployment")
+	bundle := &DependencyBundle{
+		Workload:     workload,
+		Dependencies: []Dependency{{Kind: DepHPA, Name: "web", Namespace: "default", GVR: gvrHPAs}},
+	}
+
+	warnings, err := m.CheckDeployCompatibility(context.Background(), "c1", bundle)
+	if err != nil {
+		t.Fatalf("CheckDeployCompatibility: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %+v, want none", warnings)
+	}
+}
@@ -0,0 +1,163 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// lastAppliedConfigAnnotation holds the manifest of the last `kubectl apply`,
+// including the apiVersion the user wrote.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Sources reported in DeprecatedUsage.Source.
+const (
+	DeprecatedSourceLastApplied   = "last-applied-configuration"
+	DeprecatedSourceManagedFields = "managed-fields"
+)
+
+// deprecatedAPI is one row of the deprecation table: Kind served at
+// APIVersion is deprecated in DeprecatedIn and removed in RemovedIn.
+// Objects are listed through ListGVR (a version the cluster still serves)
+// and checked for writers that used APIVersion. Replacement is empty when
+// the API has no successor.
+type deprecatedAPI struct {
+	APIVersion   string
+	Kind         string
+	DeprecatedIn string
+	RemovedIn    string
+	Replacement  string
+	ListGVR      schema.GroupVersionResource
+}
+
+// deprecatedAPIs is the built-in deprecation table, from the upstream
+// Kubernetes deprecated API migration guide. Add a row when a new removal
+// is announced; nothing else needs to change.
+var deprecatedAPIs = []deprecatedAPI{
+	// Removed in 1.16
+	{"extensions/v1beta1", "Deployment", "1.9", "1.16", "apps/v1", gvrDeployments},
+	{"apps/v1beta1", "Deployment", "1.9", "1.16", "apps/v1", gvrDeployments},
+	{"apps/v1beta2", "Deployment", "1.9", "1.16", "apps/v1", gvrDeployments},
+	{"extensions/v1beta1", "DaemonSet", "1.9", "1.16", "apps/v1", gvrDaemonSets},
+	{"apps/v1beta2", "DaemonSet", "1.9", "1.16", "apps/v1", gvrDaemonSets},
+	{"apps/v1beta1", "StatefulSet", "1.9", "1.16", "apps/v1", gvrStatefulSets},
+	{"apps/v1beta2", "StatefulSet", "1.9", "1.16", "apps/v1", gvrStatefulSets},
+	{"extensions/v1beta1", "NetworkPolicy", "1.9", "1.16", "networking.k8s.io/v1", gvrNetworkPolicies},
+
+	// Removed in 1.22
+	{"extensions/v1beta1", "Ingress", "1.14", "1.22", "networking.k8s.io/v1", gvrIngresses},
+	{"networking.k8s.io/v1beta1", "Ingress", "1.19", "1.22", "networking.k8s.io/v1", gvrIngresses},
+	{"rbac.authorization.k8s.io/v1beta1", "ClusterRole", "1.17", "1.22", "rbac.authorization.k8s.io/v1", gvrClusterRoles},
+	{"rbac.authorization.k8s.io/v1beta1", "ClusterRoleBinding", "1.17", "1.22", "rbac.authorization.k8s.io/v1", gvrClusterRoleBindings},
+	{"rbac.authorization.k8s.io/v1beta1", "Role", "1.17", "1.22", "rbac.authorization.k8s.io/v1", gvrRoles},
+	{"rbac.authorization.k8s.io/v1beta1", "RoleBinding", "1.17", "1.22", "rbac.authorization.k8s.io/v1", gvrRoleBindings},
+	{"apiextensions.k8s.io/v1beta1", "CustomResourceDefinition", "1.16", "1.22", "apiextensions.k8s.io/v1", gvrCRDs},
+	{"admissionregistration.k8s.io/v1beta1", "ValidatingWebhookConfiguration", "1.16", "1.22", "admissionregistration.k8s.io/v1", gvrValidatingWebhooks},
+	{"admissionregistration.k8s.io/v1beta1", "MutatingWebhookConfiguration", "1.16", "1.22", "admissionregistration.k8s.io/v1", gvrMutatingWebhooks},
+
+	// Removed in 1.25
+	{"batch/v1beta1", "CronJob", "1.21", "1.25", "batch/v1", schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}},
+	{"policy/v1beta1", "PodDisruptionBudget", "1.21", "1.25", "policy/v1", gvrPDBs},
+	{"autoscaling/v2beta1", "HorizontalPodAutoscaler", "1.22", "1.25", "autoscaling/v2", gvrHPAs},
+
+	// Removed in 1.26
+	{"autoscaling/v2beta2", "HorizontalPodAutoscaler", "1.23", "1.26", "autoscaling/v2", gvrHPAs},
+}
+
+// DeprecatedUsage is a resource last written with a deprecated apiVersion.
+// Source says where the apiVersion was found: the kubectl last-applied
+// annotation or the managedFields entry of the client that wrote it.
+type DeprecatedUsage struct {
+	Cluster      string `json:"cluster"`
+	Kind         string `json:"kind"`
+	Namespace    string `json:"namespace,omitempty"`
+	Name         string `json:"name"`
+	APIVersion   string `json:"apiVersion"`
+	DeprecatedIn string `json:"deprecatedIn"`
+	RemovedIn    string `json:"removedIn"`
+	Replacement  string `json:"replacement,omitempty"`
+	Source       string `json:"source"`
+}
+
+// ScanDeprecatedAPIs flags resources on contextName that were applied or
+// written with an apiVersion listed in the deprecation table. The API
+// server converts objects to whatever version is requested, so the version
+// a manifest uses is recovered from the last-applied annotation and from
+// managedFields. Kinds the cluster does not serve are skipped.
+func (m *MultiClusterClient) ScanDeprecatedAPIs(ctx context.Context, contextName string) (_ []DeprecatedUsage, err error) {
+	defer observeClusterRequest(contextName, "ScanDeprecatedAPIs", time.Now(), &err)
+	dynClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group rows by the GVR used to list, so each kind is listed once.
+	var order []schema.GroupVersionResource
+	rowsByGVR := make(map[schema.GroupVersionResource][]deprecatedAPI)
+	for _, row := range deprecatedAPIs {
+		if _, ok := rowsByGVR[row.ListGVR]; !ok {
+			order = append(order, row.ListGVR)
+		}
+		rowsByGVR[row.ListGVR] = append(rowsByGVR[row.ListGVR], row)
+	}
+
+	usages := make([]DeprecatedUsage, 0)
+	for _, gvr := range order {
+		list, err := dynClient.Resource(gvr).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+				continue
+			}
+			return nil, err
+		}
+		for i := range list.Items {
+			usages = append(usages, deprecatedUsagesFor(contextName, &list.Items[i], rowsByGVR[gvr])...)
+		}
+	}
+	return usages, nil
+}
+
+// deprecatedUsagesFor returns the usages of obj matching rows (all rows
+// share obj's kind), at most one per deprecated apiVersion.
+func deprecatedUsagesFor(cluster string, obj *unstructured.Unstructured, rows []deprecatedAPI) []DeprecatedUsage {
+	// apiVersion -> where it was seen. last-applied wins over managedFields.
+	seen := make(map[string]string)
+	if raw := obj.GetAnnotations()[lastAppliedConfigAnnotation]; raw != "" {
+		var applied struct {
+			APIVersion string `json:"apiVersion"`
+		}
+		if json.Unmarshal([]byte(raw), &applied) == nil && applied.APIVersion != "" {
+			seen[applied.APIVersion] = DeprecatedSourceLastApplied
+		}
+	}
+	for _, mf := range obj.GetManagedFields() {
+		if _, ok := seen[mf.APIVersion]; !ok && mf.APIVersion != "" {
+			seen[mf.APIVersion] = DeprecatedSourceManagedFields
+		}
+	}
+
+	var usages []DeprecatedUsage
+	for _, row := range rows {
+		source, ok := seen[row.APIVersion]
+		if !ok {
+			continue
+		}
+		usages = append(usages, DeprecatedUsage{
+			Cluster:      cluster,
+			Kind:         row.Kind,
+			Namespace:    obj.GetNamespace(),
+			Name:         obj.GetName(),
+			APIVersion:   row.APIVersion,
+			DeprecatedIn: row.DeprecatedIn,
+			RemovedIn:    row.RemovedIn,
+			Replacement:  row.Replacement,
+			Source:       source,
+		})
+	}
+	return usages
+}
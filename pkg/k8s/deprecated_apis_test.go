@@ -0,0 +1,93 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestScanDeprecatedAPIs(t *testing.T) {
+	// Applied by kubectl with a removed Ingress version.
+	oldIngress := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "Ingress",
+		"metadata": map[string]interface{}{
+			"name":      "legacy",
+			"namespace": "web",
+			"annotations": map[string]interface{}{
+				lastAppliedConfigAnnotation: `{"apiVersion":"extensions/v1beta1","kind":"Ingress","metadata":{"name":"legacy"}}`,
+			},
+		},
+	}}
+	// Written by a controller using autoscaling/v2beta2.
+	oldHPA := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "autoscaling/v2",
+		"kind":       "HorizontalPodAutoscaler",
+		"metadata":   map[string]interface{}{"name": "api", "namespace": "web"},
+	}}
+	oldHPA.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "helm", APIVersion: "autoscaling/v2beta2"}})
+	// Current apiVersion everywhere: must not be flagged.
+	currentIngress := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "Ingress",
+		"metadata": map[string]interface{}{
+			"name":      "modern",
+			"namespace": "web",
+			"annotations": map[string]interface{}{
+				lastAppliedConfigAnnotation: `{"apiVersion":"networking.k8s.io/v1","kind":"Ingress"}`,
+			},
+		},
+	}}
+
+	gvrMap := buildTestGVRMap()
+	gvrMap[schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}] = "CronJobList"
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrMap, oldIngress, oldHPA, currentIngress)
+
+	usages, err := m.ScanDeprecatedAPIs(context.Background(), "c1")
+	if err != nil {
+		t.Fatalf("ScanDeprecatedAPIs: %v", err)
+	}
+	if len(usages) != 2 {
+		t.Fatalf("usages = %+v, want the legacy Ingress and HPA", usages)
+	}
+
+	byName := map[string]DeprecatedUsage{}
+	for _, u := range usages {
+		byName[u.Name] = u
+	}
+	ing, ok := byName["legacy"]
+	if !ok {
+		t.Fatalf("legacy Ingress not flagged: %+v", usages)
+	}
+	if ing.APIVersion != "extensions/v1beta1" || ing.RemovedIn != "1.22" || ing.Replacement != "networking.k8s.io/v1" {
+		t.Errorf("ingress usage = %+v", ing)
+	}
+	if ing.Source != DeprecatedSourceLastApplied || ing.Namespace != "web" || ing.Cluster != "c1" {
+		t.Errorf("ingress usage ref = %+v", ing)
+	}
+
+	hpa, ok := byName["api"]
+	if !ok {
+		t.Fatalf("HPA not flagged: %+v", usages)
+	}
+	if hpa.APIVersion != "autoscaling/v2beta2" || hpa.Source != DeprecatedSourceManagedFields || hpa.RemovedIn != "1.26" {
+		t.Errorf("hpa usage = %+v", hpa)
+	}
+}
+
+func TestDeprecatedAPITable_ListGVRMatchesReplacement(t *testing.T) {
+	for _, row := range deprecatedAPIs {
+		if row.Replacement != "" && row.ListGVR.GroupVersion().String() != row.Replacement {
+			t.Errorf("%s %s lists via %s but replacement is %s", row.APIVersion, row.Kind, row.ListGVR.GroupVersion(), row.Replacement)
+		}
+		if row.DeprecatedIn == "" || row.RemovedIn == "" {
+			t.Errorf("%s %s is missing version info", row.APIVersion, row.Kind)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package k8s
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+)
+
+// discoveryCache is the memory-cached discovery of one cluster and the
+// RESTMapper built on it. The first lookup fetches every served group
+// version; later lookups are answered from memory until the kubeconfig is
+// reloaded.
+type discoveryCache struct {
+	discovery discovery.CachedDiscoveryInterface
+	mapper    *restmapper.DeferredDiscoveryRESTMapper
+
+	resetMu   sync.Mutex
+	lastReset time.Time
+}
+
+// mapperResetInterval is the least time between two RESTMapper refreshes
+// of one cluster, so repeated lookups of a name that is not served don't
+// rediscover every API group each time.
+const mapperResetInterval = 30 * time.Second
+
+// resetMapper refreshes the RESTMapper so resources installed since it was
+// filled are found. It reports false, without refreshing, when the mapper
+// was refreshed less than mapperResetInterval ago.
+func (dc *discoveryCache) resetMapper() bool {
+	dc.resetMu.Lock()
+	defer dc.resetMu.Unlock()
+	if time.Since(dc.lastReset) < mapperResetInterval {
+		return false
+	}
+	dc.lastReset = time.Now()
+	dc.mapper.Reset()
+	return true
+}
+
+// discoveryCacheFor returns the discovery cache of contextName, building it
+// on the cluster's client on first use.
+func (m *MultiClusterClient) discoveryCacheFor(contextName string) (*discoveryCache, error) {
+	m.mu.RLock()
+	dc, ok := m.discoveryCaches[contextName]
+	m.mu.RUnlock()
+	if ok {
+		return dc, nil
+	}
+
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	cached := memory.NewMemCacheClient(client.Discovery())
+	dc = &discoveryCache{discovery: cached, mapper: restmapper.NewDeferredDiscoveryRESTMapper(cached)}
+
+	// Same first-writer-wins insertion as GetClient.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.discoveryCaches[contextName]; ok {
+		return existing, nil
+	}
+	if m.discoveryCaches == nil {
+		m.discoveryCaches = make(map[string]*discoveryCache)
+	}
+	m.discoveryCaches[contextName] = dc
+	return dc, nil
+}
+
+// GetRESTMapper returns the cached RESTMapper of contextName, for GVK to GVR
+// resolution and version fallback without a discovery round trip per lookup.
+func (m *MultiClusterClient) GetRESTMapper(contextName string) (apimeta.ResettableRESTMapper, error) {
+	dc, err := m.discoveryCacheFor(contextName)
+	if err != nil {
+		return nil, err
+	}
+	return dc.mapper, nil
+}
+
+// cachedDiscovery returns the memory-cached discovery client of contextName.
+func (m *MultiClusterClient) cachedDiscovery(contextName string) (discovery.CachedDiscoveryInterface, error) {
+	dc, err := m.discoveryCacheFor(contextName)
+	if err != nil {
+		return nil, err
+	}
+	return dc.discovery, nil
+}
+
+// resetDiscoveryCaches drops every cluster's discovery cache. Callers hold
+// m.mu.
+func (m *MultiClusterClient) resetDiscoveryCaches() {
+	m.discoveryCaches = nil
+}
+
+// serverResourcesForGroupVersion returns the resources disc lists for
+// groupVersion. When disc is cached and does not know the group at all (e.g.
+// a CRD installed after the cache was filled) it is refreshed once before
+// the group version is reported as not found; a missing version of a known
+// group, like autoscaling/v2 on an old cluster, is answered from the cache.
+func serverResourcesForGroupVersion(disc discovery.DiscoveryInterface, groupVersion string) (*metav1.APIResourceList, error) {
+	resources, err := disc.ServerResourcesForGroupVersion(groupVersion)
+	cached, ok := disc.(discovery.CachedDiscoveryInterface)
+	if !ok || !isDiscoveryNotFound(err) {
+		return resources, err
+	}
+	gv, parseErr := schema.ParseGroupVersion(groupVersion)
+	if parseErr != nil || groupServed(cached, gv.Group) {
+		return resources, err
+	}
+	cached.Invalidate()
+	return disc.ServerResourcesForGroupVersion(groupVersion)
+}
+
+// groupServed reports whether disc lists the API group.
+func groupServed(disc discovery.DiscoveryInterface, group string) bool {
+	groups, err := disc.ServerGroups()
+	if err != nil {
+		return false
+	}
+	for _, g := range groups.Groups {
+		if g.Name == group {
+			return true
+		}
+	}
+	return false
+}
+
+// isDiscoveryNotFound reports whether err means the group version is not
+// served, from the apiserver or from a memory-cached discovery client.
+func isDiscoveryNotFound(err error) bool {
+	return k8serrors.IsNotFound(err) || errors.Is(err, memory.ErrCacheNotFound)
+}
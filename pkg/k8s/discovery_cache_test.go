@@ -0,0 +1,115 @@
+package k8s
+
+import (
+	"sync/atomic"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+// countingDiscovery counts the ServerGroups calls a discovery cache fill
+// makes.
+type countingDiscovery struct {
+	*fakediscovery.FakeDiscovery
+	groupCalls atomic.Int32
+}
+
+func (d *countingDiscovery) ServerGroups() (*metav1.APIGroupList, error) {
+	d.groupCalls.Add(1)
+	return d.FakeDiscovery.ServerGroups()
+}
+
+// countingClientset is a fake clientset whose Discovery is disc.
+type countingClientset struct {
+	kubernetes.Interface
+	disc *countingDiscovery
+}
+
+func (c *countingClientset) Discovery() discovery.DiscoveryInterface { return c.disc }
+
+func newCountingClientset() *countingClientset {
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}}},
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true}}},
+		{GroupVersion: "autoscaling/v1", APIResources: []metav1.APIResource{{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Namespaced: true}}},
+	}
+	return &countingClientset{
+		Interface: clientset,
+		disc:      &countingDiscovery{FakeDiscovery: clientset.Discovery().(*fakediscovery.FakeDiscovery)},
+	}
+}
+
+func TestGetRESTMapper_QueriesDiscoveryOnce(t *testing.T) {
+	clientset := newCountingClientset()
+	m, _ := NewMultiClusterClient("")
+	m.SetClient("c1", clientset)
+
+	lookups := []struct {
+		gk       schema.GroupKind
+		versions []string
+		want     schema.GroupVersionResource
+	}{
+		{schema.GroupKind{Group: "apps", Kind: "Deployment"}, nil, schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}},
+		{schema.GroupKind{Kind: "ConfigMap"}, []string{"v1"}, schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}},
+		// The v2 -> v1 HPA fallback resolves from the cache too.
+		{schema.GroupKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}, []string{"v2", "v1"}, schema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}},
+	}
+	for i := 0; i < 2; i++ {
+		mapper, err := m.GetRESTMapper("c1")
+		if err != nil {
+			t.Fatalf("GetRESTMapper: %v", err)
+		}
+		for _, l := range lookups {
+			mapping, err := mapper.RESTMapping(l.gk, l.versions...)
+			if err != nil {
+				t.Fatalf("RESTMapping(%v): %v", l.gk, err)
+			}
+			if mapping.Resource != l.want {
+				t.Errorf("RESTMapping(%v) = %v, want %v", l.gk, mapping.Resource, l.want)
+			}
+		}
+	}
+	if n := clientset.disc.groupCalls.Load(); n != 1 {
+		t.Errorf("discovery ServerGroups called %d times, want 1", n)
+	}
+
+	// Replacing the cluster's client drops its cache.
+	m.SetClient("c1", clientset)
+	mapper, err := m.GetRESTMapper("c1")
+	if err != nil {
+		t.Fatalf("GetRESTMapper: %v", err)
+	}
+	if _, err := mapper.RESTMapping(schema.GroupKind{Group: "apps", Kind: "Deployment"}); err != nil {
+		t.Fatalf("RESTMapping after reset: %v", err)
+	}
+	if n := clientset.disc.groupCalls.Load(); n != 2 {
+		t.Errorf("discovery ServerGroups called %d times after reset, want 2", n)
+	}
+}
+
+func TestServerResourcesForGroupVersion_KnownGroupMissingVersion(t *testing.T) {
+	clientset := newCountingClientset()
+	m, _ := NewMultiClusterClient("")
+	m.SetClient("c1", clientset)
+
+	disc, err := m.cachedDiscovery("c1")
+	if err != nil {
+		t.Fatalf("cachedDiscovery: %v", err)
+	}
+	if _, err := serverResourcesForGroupVersion(disc, "autoscaling/v1"); err != nil {
+		t.Fatalf("autoscaling/v1: %v", err)
+	}
+	// autoscaling/v2 is missing from a group the cache knows: no refresh.
+	if _, err := serverResourcesForGroupVersion(disc, "autoscaling/v2"); !isDiscoveryNotFound(err) {
+		t.Fatalf("autoscaling/v2 error = %v, want not found", err)
+	}
+	if n := clientset.disc.groupCalls.Load(); n != 1 {
+		t.Errorf("discovery ServerGroups called %d times, want 1", n)
+	}
+}
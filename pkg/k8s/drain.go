@@ -0,0 +1,290 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// defaultDrainNodeTimeout bounds how long a single node may take to
+	// evict its pods before it is reported as timed out.
+	defaultDrainNodeTimeout = 5 * time.Minute
+	// drainPollInterval is how often evictions blocked by a
+	// PodDisruptionBudget are retried and evicted pods are re-checked.
+	drainPollInterval = 2 * time.Second
+	// mirrorPodAnnotation marks static pods managed by the kubelet; they
+	// cannot be evicted through the API server.
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// Node drain outcomes reported in NodeDrainResult.Status.
+const (
+	DrainStatusCordoned = "cordoned" // cordon-only run (DrainOptions.CordonOnly)
+	DrainStatusDrained  = "drained"
+	DrainStatusTimeout  = "timeout"
+	DrainStatusFailed   = "failed"
+)
+
+// DrainOptions controls DrainClusterGroup.
+type DrainOptions struct {
+	// Clusters are the group's member clusters, resolved by the caller from
+	// the static member list or the dynamic group's last evaluation.
+	Clusters []string `json:"clusters"`
+	// PerNodeTimeout bounds eviction of a single node. Zero uses
+	// defaultDrainNodeTimeout.
+	PerNodeTimeout time.Duration `json:"perNodeTimeout,omitempty"`
+	// GracePeriodSeconds overrides each pod's termination grace period when set.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+	// CordonOnly marks nodes unschedulable without evicting pods.
+	CordonOnly bool `json:"cordonOnly,omitempty"`
+	// Progress, when set, is called once per node as soon as its outcome is
+	// known. Calls are serialized so the callback need not be thread-safe.
+	Progress func(NodeDrainResult) `json:"-"`
+}
+
+// NodeDrainResult is the outcome of cordoning and draining a single node.
+type NodeDrainResult struct {
+	Cluster string `json:"cluster"`
+	Node    string `json:"node"`
+	Status  string `json:"status"`
+	Evicted int    `json:"evicted"`
+	// Remaining lists pods still on the node when the drain stopped,
+	// typically blocked by a PodDisruptionBudget.
+	Remaining []string `json:"remaining,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// DrainGroupResult aggregates the per-node outcomes of DrainClusterGroup.
+type DrainGroupResult struct {
+	Group           string            `json:"group"`
+	Clusters        []string          `json:"clusters"`
+	Nodes           []NodeDrainResult `json:"nodes"`
+	Succeeded       int               `json:"succeeded"`
+	Failed          int               `json:"failed"`
+	SkippedClusters map[string]string `json:"skippedClusters,omitempty"` // cluster -> reason
+}
+
+// DrainClusterGroup cordons and drains every node in every cluster of a
+// cluster group. Clusters are processed in parallel; nodes within a cluster
+// are drained one at a time so the cluster never loses more than one node's
+// capacity at once.
+//
+// Pods are removed through the Eviction API, so PodDisruptionBudgets are
+// honoured: an eviction refused by a PDB is retried until PerNodeTimeout
+// elapses, after which the node is reported as timed out with the pods that
+// could not be moved. A node only counts as drained once its evicted pods
+// have actually been deleted, not merely when the evictions were admitted. DaemonSet-managed and mirror pods are left in place,
+// matching `kubectl drain --ignore-daemonsets`.
+func (m *MultiClusterClient) DrainClusterGroup(ctx context.Context, groupName string, opts DrainOptions) (*DrainGroupResult, error) {
+	if len(opts.Clusters) == 0 {
+		return nil, fmt.Errorf("cluster group %q has no member clusters", groupName)
+	}
+	if opts.PerNodeTimeout <= 0 {
+		opts.PerNodeTimeout = defaultDrainNodeTimeout
+	}
+
+	result := &DrainGroupResult{
+		Group:    groupName,
+		Clusters: opts.Clusters,
+		Nodes:    []NodeDrainResult{},
+	}
+
+	var mu sync.Mutex
+	record := func(r NodeDrainResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		result.Nodes = append(result.Nodes, r)
+		if r.Status == DrainStatusDrained || r.Status == DrainStatusCordoned {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+		if opts.Progress != nil {
+			opts.Progress(r)
+		}
+	}
+	skip := func(cluster string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if result.SkippedClusters == nil {
+			result.SkippedClusters = make(map[string]string)
+		}
+		result.SkippedClusters[cluster] = err.Error()
+	}
+
+	var wg sync.WaitGroup
+	for _, cluster := range opts.Clusters {
+		wg.Add(1)
+		go func(cluster string) {
+			defer wg.Done()
+			client, err := m.GetClient(cluster)
+			if err != nil {
+				skip(cluster, err)
+				return
+			}
+			nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				skip(cluster, err)
+				return
+			}
+			for _, node := range nodes.Items {
+				if ctx.Err() != nil {
+					return
+				}
+				record(drainNode(ctx, client, cluster, node.Name, opts))
+			}
+		}(cluster)
+	}
+	wg.Wait()
+
+	sort.Slice(result.Nodes, func(i, j int) bool {
+		if result.Nodes[i].Cluster != result.Nodes[j].Cluster {
+			return result.Nodes[i].Cluster < result.Nodes[j].Cluster
+		}
+		return result.Nodes[i].Node < result.Nodes[j].Node
+	})
+	return result, nil
+}
+
+// drainNode cordons a node and, unless opts.CordonOnly, evicts its pods.
+func drainNode(ctx context.Context, client kubernetes.Interface, cluster, nodeName string, opts DrainOptions) NodeDrainResult {
+	res := NodeDrainResult{Cluster: cluster, Node: nodeName}
+
+	cordonPatch := []byte(`{"spec":{"unschedulable":true}}`)
+	if _, err := client.CoreV1().Nodes().Patch(ctx, nodeName, types.StrategicMergePatchType, cordonPatch, metav1.PatchOptions{}); err != nil {
+		res.Status = DrainStatusFailed
+		res.Error = fmt.Sprintf("cordon failed: %v", err)
+		return res
+	}
+	if opts.CordonOnly {
+		res.Status = DrainStatusCordoned
+		return res
+	}
+
+	nodeCtx, cancel := context.WithTimeout(ctx, opts.PerNodeTimeout)
+	defer cancel()
+
+	pending, err := drainablePods(nodeCtx, client, nodeName)
+	if err != nil {
+		res.Status = DrainStatusFailed
+		res.Error = fmt.Sprintf("list pods failed: %v", err)
+		return res
+	}
+
+	// evicted holds pods whose eviction was admitted but which may still be
+	// terminating; the node only counts as drained once they are gone.
+	evicted := make(map[string]corev1.Pod)
+	for {
+		var blocked []corev1.Pod
+		for _, pod := range pending {
+			err := client.PolicyV1().Evictions(pod.Namespace).Evict(nodeCtx, &policyv1.Eviction{
+				ObjectMeta:    metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+				DeleteOptions: &metav1.DeleteOptions{GracePeriodSeconds: opts.GracePeriodSeconds},
+			})
+			switch {
+			case err == nil:
+				res.Evicted++
+				evicted[pod.Namespace+"/"+pod.Name] = pod
+			case k8serrors.IsNotFound(err):
+				res.Evicted++
+			case k8serrors.IsTooManyRequests(err):
+				// PodDisruptionBudget would be violated — retry after the
+				// budget's other pods have recovered.
+				blocked = append(blocked, pod)
+			default:
+				slog.Warn("[Drain] eviction failed", "cluster", cluster, "node", nodeName,
+					"pod", pod.Namespace+"/"+pod.Name, "error", err)
+				blocked = append(blocked, pod)
+			}
+		}
+		pending = blocked
+		for key, pod := range evicted {
+			if podGone(nodeCtx, client, pod) {
+				delete(evicted, key)
+			}
+		}
+		if len(pending) == 0 && len(evicted) == 0 {
+			break
+		}
+
+		select {
+		case <-nodeCtx.Done():
+			res.Status = DrainStatusTimeout
+			if len(pending) > 0 {
+				res.Error = "timed out waiting for evictions (PodDisruptionBudget may be blocking)"
+			} else {
+				res.Error = "timed out waiting for evicted pods to terminate"
+			}
+			for _, pod := range pending {
+				res.Remaining = append(res.Remaining, pod.Namespace+"/"+pod.Name)
+			}
+			for key := range evicted {
+				res.Remaining = append(res.Remaining, key)
+			}
+			sort.Strings(res.Remaining)
+			return res
+		case <-time.After(drainPollInterval):
+		}
+	}
+
+	res.Status = DrainStatusDrained
+	return res
+}
+
+// podGone reports whether an evicted pod has been deleted. A pod recreated
+// under the same name (e.g. by a StatefulSet) has a new UID and counts as
+// gone. Lookup errors other than NotFound count as still present so the
+// node is re-checked on the next poll.
+func podGone(ctx context.Context, client kubernetes.Interface, pod corev1.Pod) bool {
+	current, err := client.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return true
+	}
+	if err != nil {
+		return false
+	}
+	return current.UID != pod.UID
+}
+
+// drainablePods returns the pods on a node that a drain should evict:
+// everything except DaemonSet-managed pods, mirror pods and pods that have
+// already finished.
+func drainablePods(ctx context.Context, client kubernetes.Interface, nodeName string) ([]corev1.Pod, error) {
+	pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []corev1.Pod
+	for _, pod := range pods.Items {
+		// Some clients (and fakes) ignore field selectors; filter again.
+		if pod.Spec.NodeName != nodeName {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+			continue
+		}
+		if owner := metav1.GetControllerOf(&pod); owner != nil && owner.Kind == "DaemonSet" {
+			continue
+		}
+		result = append(result, pod)
+	}
+	return result, nil
+}
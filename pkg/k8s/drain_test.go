@@ -0,0 +1,142 @@
+package k8s
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// drainTestNode builds a schedulable node.
+func drainTestNode(name string) *corev1.Node {
+	return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
+
+// evictionDeletesPod makes the fake clientset delete the pod on eviction, as
+// the API server would once the eviction is admitted.
+func evictionDeletesPod(cs *fake.Clientset) {
+	cs.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+		err := cs.Tracker().Delete(corev1.SchemeGroupVersion.WithResource("pods"), eviction.Namespace, eviction.Name)
+		return true, nil, err
+	})
+}
+
+func TestDrainClusterGroup_CordonsAllNodes(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "edge-1", "edge-2")
+
+	cs1 := fake.NewSimpleClientset(drainTestNode("e1-a"), drainTestNode("e1-b"),
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "e1-a"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		})
+	evictionDeletesPod(cs1)
+	cs2 := fake.NewSimpleClientset(drainTestNode("e2-a"))
+	m.InjectClient("edge-1", cs1)
+	m.InjectClient("edge-2", cs2)
+
+	var mu sync.Mutex
+	var progressed []string
+	res, err := m.DrainClusterGroup(context.Background(), "edge", DrainOptions{
+		Clusters:       []string{"edge-1", "edge-2"},
+		PerNodeTimeout: 5 * time.Second,
+		Progress: func(r NodeDrainResult) {
+			mu.Lock()
+			defer mu.Unlock()
+			progressed = append(progressed, r.Cluster+"/"+r.Node)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, res.Succeeded)
+	assert.Equal(t, 0, res.Failed)
+	assert.Len(t, progressed, 3)
+
+	for _, tc := range []struct {
+		cs   *fake.Clientset
+		node string
+	}{{cs1, "e1-a"}, {cs1, "e1-b"}, {cs2, "e2-a"}} {
+		node, err := tc.cs.CoreV1().Nodes().Get(context.Background(), tc.node, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.True(t, node.Spec.Unschedulable, "node %s should be cordoned", tc.node)
+	}
+
+	pods, err := cs1.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items, "pod on drained node should be evicted")
+	assert.Equal(t, 1, res.Nodes[0].Evicted)
+}
+
+func TestDrainClusterGroup_SkipsDaemonSetPods(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "c1")
+
+	isController := true
+	ds := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node-exporter",
+			Namespace: "monitoring",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1", Kind: "DaemonSet", Name: "node-exporter", Controller: &isController,
+			}},
+		},
+		Spec:   corev1.PodSpec{NodeName: "n1"},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	cs := fake.NewSimpleClientset(drainTestNode("n1"), ds)
+	evictionDeletesPod(cs)
+	m.InjectClient("c1", cs)
+
+	res, err := m.DrainClusterGroup(context.Background(), "g", DrainOptions{Clusters: []string{"c1"}})
+	require.NoError(t, err)
+	require.Len(t, res.Nodes, 1)
+	assert.Equal(t, DrainStatusDrained, res.Nodes[0].Status)
+	assert.Equal(t, 0, res.Nodes[0].Evicted)
+}
+
+func TestDrainClusterGroup_NoClusters(t *testing.T) {
+	m := &MultiClusterClient{}
+	_, err := m.DrainClusterGroup(context.Background(), "empty", DrainOptions{})
+	assert.Error(t, err)
+}
+
+func TestDrainClusterGroup_WaitsForEvictedPods(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "c1")
+
+	cs := fake.NewSimpleClientset(drainTestNode("n1"), &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "slow", Namespace: "default", UID: "slow-uid"},
+		Spec:       corev1.PodSpec{NodeName: "n1"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	})
+	// Admit the eviction but leave the pod in place, as a pod stuck in a
+	// long termination grace period would be.
+	cs.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return action.GetSubresource() == "eviction", nil, nil
+	})
+	m.InjectClient("c1", cs)
+
+	res, err := m.DrainClusterGroup(context.Background(), "g", DrainOptions{
+		Clusters:       []string{"c1"},
+		PerNodeTimeout: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Nodes, 1)
+	assert.Equal(t, DrainStatusTimeout, res.Nodes[0].Status)
+	assert.Equal(t, 1, res.Nodes[0].Evicted)
+	assert.Equal(t, []string{"default/slow"}, res.Nodes[0].Remaining)
+	assert.Equal(t, 1, res.Failed)
+}
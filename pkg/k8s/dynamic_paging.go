@@ -0,0 +1,80 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// dynamicListPageSize is the page size of full dynamic-client listings, so a
+// cluster with a very large custom resource set is read a page at a time
+// rather than in one response.
+const dynamicListPageSize = 500
+
+// listDynamicPaged lists one page of gvr in namespace (every namespace when
+// empty) with opts.Limit and opts.Continue, returning the page and the
+// continue token of the next one, empty after the last page.
+func listDynamicPaged(ctx context.Context, dynClient dynamic.Interface, gvr schema.GroupVersionResource,
+	namespace string, opts metav1.ListOptions) (*unstructured.UnstructuredList, string, error) {
+	var list *unstructured.UnstructuredList
+	var err error
+	if namespace == "" {
+		list, err = dynClient.Resource(gvr).List(ctx, opts)
+	} else {
+		list, err = dynClient.Resource(gvr).Namespace(namespace).List(ctx, opts)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return list, list.GetContinue(), nil
+}
+
+// eachDynamicPage lists every object of gvr in namespace in pages of
+// dynamicListPageSize, handing each page to fn before fetching the next.
+func eachDynamicPage(ctx context.Context, dynClient dynamic.Interface, gvr schema.GroupVersionResource,
+	namespace string, fn func(*unstructured.UnstructuredList) error) error {
+	opts := metav1.ListOptions{Limit: dynamicListPageSize}
+	for {
+		page, next, err := listDynamicPaged(ctx, dynClient, gvr, namespace, opts)
+		if err != nil {
+			return err
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+		if next == "" {
+			return nil
+		}
+		opts.Continue = next
+	}
+}
+
+// EachCustomResourcePage lists every object of gvr in namespace (every
+// namespace when empty) a page of dynamicListPageSize at a time, handing each
+// page to fn before fetching the next.
+func (m *MultiClusterClient) EachCustomResourcePage(ctx context.Context, contextName, namespace string,
+	gvr schema.GroupVersionResource, fn func(*unstructured.UnstructuredList) error) (err error) {
+	defer observeClusterRequest(contextName, "ListCustomResources", time.Now(), &err)
+	dynClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return err
+	}
+	return eachDynamicPage(ctx, dynClient, gvr, namespace, fn)
+}
+
+// ListCustomResources returns one page of gvr's objects in namespace (every
+// namespace when empty) of at most limit items (no limit when zero), starting
+// at continueToken, together with the token of the next page.
+func (m *MultiClusterClient) ListCustomResources(ctx context.Context, contextName, namespace string,
+	gvr schema.GroupVersionResource, limit int64, continueToken string) (_ *unstructured.UnstructuredList, _ string, err error) {
+	defer observeClusterRequest(contextName, "ListCustomResources", time.Now(), &err)
+	dynClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return nil, "", err
+	}
+	return listDynamicPaged(ctx, dynClient, gvr, namespace, metav1.ListOptions{Limit: limit, Continue: continueToken})
+}
@@ -0,0 +1,95 @@
+package k8s
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// pagedResource serves List calls from a fixed item set, honouring Limit and
+// Continue the way the apiserver does; the fake dynamic client ignores both.
+type pagedResource struct {
+	dynamic.NamespaceableResourceInterface
+	items []unstructured.Unstructured
+	calls []metav1.ListOptions
+}
+
+func (r *pagedResource) Namespace(string) dynamic.ResourceInterface { return r }
+
+func (r *pagedResource) List(_ context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	r.calls = append(r.calls, opts)
+	start := 0
+	if opts.Continue != "" {
+		start, _ = strconv.Atoi(opts.Continue)
+	}
+	end := len(r.items)
+	if opts.Limit > 0 && start+int(opts.Limit) < end {
+		end = start + int(opts.Limit)
+	}
+	list := &unstructured.UnstructuredList{Items: r.items[start:end]}
+	if end < len(r.items) {
+		list.SetContinue(strconv.Itoa(end))
+	}
+	return list, nil
+}
+
+type pagedDynamicClient struct {
+	dynamic.Interface
+	resource *pagedResource
+}
+
+func (c pagedDynamicClient) Resource(schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return c.resource
+}
+
+func TestListCustomResources_Paging(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	res := &pagedResource{}
+	for _, name := range []string{"a", "b", "c"} {
+		obj := unstructured.Unstructured{}
+		obj.SetName(name)
+		res.items = append(res.items, obj)
+	}
+	m := &MultiClusterClient{dynamicClients: map[string]dynamic.Interface{"c1": pagedDynamicClient{resource: res}}}
+
+	first, next, err := m.ListCustomResources(context.Background(), "c1", "", gvr, 2, "")
+	if err != nil {
+		t.Fatalf("first page: %v", err)
+	}
+	if len(first.Items) != 2 || next == "" {
+		t.Fatalf("first page = %d items, continue %q; want 2 items and a token", len(first.Items), next)
+	}
+
+	second, next, err := m.ListCustomResources(context.Background(), "c1", "", gvr, 2, next)
+	if err != nil {
+		t.Fatalf("second page: %v", err)
+	}
+	if len(second.Items) != 1 || second.Items[0].GetName() != "c" || next != "" {
+		t.Fatalf("second page = %+v, continue %q; want only c and no token", second.Items, next)
+	}
+
+	res.calls = nil
+	var names []string
+	err = m.EachCustomResourcePage(context.Background(), "c1", "ns", gvr, func(page *unstructured.UnstructuredList) error {
+		for i := range page.Items {
+			names = append(names, page.Items[i].GetName())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("EachCustomResourcePage: %v", err)
+	}
+	if len(names) != 3 {
+		t.Errorf("EachCustomResourcePage saw %v, want a, b and c", names)
+	}
+	for _, call := range res.calls {
+		if call.Limit != dynamicListPageSize {
+			t.Errorf("List called with Limit %d, want %d", call.Limit, dynamicListPageSize)
+		}
+	}
+}
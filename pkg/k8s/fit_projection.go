@@ -0,0 +1,212 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// FitConstraints narrows the nodes ProjectFit considers to those a pod with
+// this node selector and these tolerations could be scheduled on.
+type FitConstraints struct {
+	NodeSelector map[string]string   `json:"nodeSelector,omitempty"`
+	Tolerations  []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// NodeFit is how many replicas fit on one node with its free capacity today.
+type NodeFit struct {
+	Node     string `json:"node"`
+	Capacity int    `json:"capacity"`
+}
+
+// FitProjection answers "can N replicas of this pod fit on the cluster".
+// Fits is the number of requested replicas that fit, at most Replicas; Nodes
+// lists the nodes with room for at least one replica, roomiest first.
+type FitProjection struct {
+	Cluster     string              `json:"cluster"`
+	PodRequests corev1.ResourceList `json:"podRequests"`
+	Replicas    int                 `json:"replicas"`
+	Fits        int                 `json:"fits"`
+	FitsAll     bool                `json:"fitsAll"`
+	Nodes       []NodeFit           `json:"nodes"`
+}
+
+// ProjectFit projects how many of replicas pods requesting podRequests fit on
+// the cluster. Each schedulable, Ready node offers its allocatable capacity
+// minus the requests of the pods already running on it (including the pod
+// count limit). Nodes with NoSchedule or NoExecute taints the pod does not
+// tolerate are skipped, as are nodes not matching the optional node selector.
+//
+// This is a capacity estimate, not a scheduler simulation: affinity, topology
+// spread and priority-based preemption are not taken into account.
+func (m *MultiClusterClient) ProjectFit(ctx context.Context, contextName string, podRequests corev1.ResourceList, replicas int, constraints ...FitConstraints) (*FitProjection, error) {
+	if replicas <= 0 {
+		return nil, fmt.Errorf("replicas must be greater than zero")
+	}
+	if len(podRequests) == 0 {
+		return nil, fmt.Errorf("podRequests must not be empty")
+	}
+	for name, qty := range podRequests {
+		if qty.Sign() < 0 {
+			return nil, fmt.Errorf("request for %s must not be negative", name)
+		}
+	}
+	var constraint FitConstraints
+	if len(constraints) > 0 {
+		constraint = constraints[0]
+	}
+
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]corev1.ResourceList)
+	podCount := make(map[string]int64)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		podCount[pod.Spec.NodeName]++
+		nodeUsed := used[pod.Spec.NodeName]
+		if nodeUsed == nil {
+			nodeUsed = corev1.ResourceList{}
+			used[pod.Spec.NodeName] = nodeUsed
+		}
+		for _, c := range pod.Spec.Containers {
+			for name, qty := range c.Resources.Requests {
+				sum := nodeUsed[name]
+				sum.Add(qty)
+				nodeUsed[name] = sum
+			}
+		}
+	}
+
+	selector := labels.SelectorFromSet(constraint.NodeSelector)
+	result := &FitProjection{
+		Cluster:     contextName,
+		PodRequests: podRequests,
+		Replicas:    replicas,
+		Nodes:       []NodeFit{},
+	}
+	total := 0
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if node.Spec.Unschedulable || !isNodeReady(node) {
+			continue
+		}
+		if !selector.Matches(labels.Set(node.Labels)) || !toleratesNodeTaints(node, constraint.Tolerations) {
+			continue
+		}
+		capacity := nodeFitCapacity(node, used[node.Name], podCount[node.Name], podRequests)
+		if capacity <= 0 {
+			continue
+		}
+		result.Nodes = append(result.Nodes, NodeFit{Node: node.Name, Capacity: capacity})
+		total += capacity
+	}
+
+	sort.Slice(result.Nodes, func(i, j int) bool {
+		a, b := result.Nodes[i], result.Nodes[j]
+		if a.Capacity != b.Capacity {
+			return a.Capacity > b.Capacity
+		}
+		return a.Node < b.Node
+	})
+	result.Fits = min(total, replicas)
+	result.FitsAll = result.Fits == replicas
+	return result, nil
+}
+
+// nodeFitCapacity returns how many pods requesting podRequests fit in the
+// node's allocatable capacity after used, bounded by its pod limit.
+func nodeFitCapacity(node *corev1.Node, used corev1.ResourceList, pods int64, podRequests corev1.ResourceList) int {
+	capacity := int64(-1)
+	if maxPods, ok := node.Status.Allocatable[corev1.ResourcePods]; ok {
+		capacity = max(maxPods.Value()-pods, 0)
+	}
+	for name, req := range podRequests {
+		if req.IsZero() {
+			continue
+		}
+		alloc, ok := node.Status.Allocatable[name]
+		if !ok {
+			return 0
+		}
+		free := alloc.DeepCopy()
+		if u, ok := used[name]; ok {
+			free.Sub(u)
+		}
+		n := freeMultiples(free, req)
+		if capacity < 0 || n < capacity {
+			capacity = n
+		}
+	}
+	// Requests that are all zero (and no pod limit) do not bound the count;
+	// report 0 rather than an infinite capacity.
+	return int(max(capacity, 0))
+}
+
+// freeMultiples returns how many times req fits in free, in milli-units so
+// fractional CPU requests are counted exactly.
+func freeMultiples(free, req resource.Quantity) int64 {
+	if free.Sign() <= 0 {
+		return 0
+	}
+	return free.MilliValue() / req.MilliValue()
+}
+
+// toleratesNodeTaints reports whether tolerations tolerate every NoSchedule
+// and NoExecute taint on node. PreferNoSchedule taints do not block a pod.
+func toleratesNodeTaints(node *corev1.Node, tolerations []corev1.Toleration) bool {
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for j := range tolerations {
+			if toleratesTaint(&tolerations[j], taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// toleratesTaint applies the core toleration matching rules: an empty key
+// with Exists matches every taint, an empty effect matches every effect.
+func toleratesTaint(t *corev1.Toleration, taint *corev1.Taint) bool {
+	if t.Effect != "" && t.Effect != taint.Effect {
+		return false
+	}
+	if t.Key != "" && t.Key != taint.Key {
+		return false
+	}
+	switch t.Operator {
+	case corev1.TolerationOpExists:
+		return true
+	case "", corev1.TolerationOpEqual:
+		return t.Key != "" && t.Value == taint.Value
+	default:
+		return false
+	}
+}
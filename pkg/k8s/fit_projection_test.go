@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fitTestNode builds a Ready node with the given allocatable CPU and memory.
+func fitTestNode(name, cpu, memory string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": "general"}},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+				corev1.ResourcePods:   resource.MustParse("110"),
+			},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+// fitTestPod builds a running pod on node requesting cpu and memory.
+func fitTestPod(name, node, cpu, memory string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{{
+				Name: "app",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func fitTestRequests() corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("500m"),
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+}
+
+func TestProjectFit_CountsFreeCapacity(t *testing.T) {
+	tainted := fitTestNode("tainted", "8", "32Gi")
+	tainted.Spec.Taints = []corev1.Taint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}
+	cordoned := fitTestNode("cordoned", "8", "32Gi")
+	cordoned.Spec.Unschedulable = true
+
+	m := &MultiClusterClient{}
+	m.InjectClient("c1", fake.NewSimpleClientset(
+		// 4 CPU free, 16Gi -> CPU bound: 8 replicas
+		fitTestNode("big", "4", "16Gi"),
+		// 2 CPU, 4Gi with 1 CPU / 2Gi used -> 1 CPU, 2Gi free -> memory bound: 2 replicas
+		fitTestNode("small", "2", "4Gi"),
+		fitTestPod("busy", "small", "1", "2Gi"),
+		// Finished pods no longer hold their requests.
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "default"},
+			Spec: corev1.PodSpec{NodeName: "big", Containers: []corev1.Container{{
+				Name:      "job",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}},
+			}}},
+			Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+		},
+		tainted,
+		cordoned,
+	))
+
+	projection, err := m.ProjectFit(context.Background(), "c1", fitTestRequests(), 20)
+	require.NoError(t, err)
+	assert.Equal(t, 10, projection.Fits)
+	assert.False(t, projection.FitsAll)
+	assert.Equal(t, []NodeFit{{Node: "big", Capacity: 8}, {Node: "small", Capacity: 2}}, projection.Nodes)
+
+	projection, err = m.ProjectFit(context.Background(), "c1", fitTestRequests(), 5)
+	require.NoError(t, err)
+	assert.Equal(t, 5, projection.Fits)
+	assert.True(t, projection.FitsAll)
+}
+
+func TestProjectFit_Constraints(t *testing.T) {
+	tainted := fitTestNode("tainted", "8", "32Gi")
+	tainted.Spec.Taints = []corev1.Taint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}
+	other := fitTestNode("other-pool", "4", "16Gi")
+	other.Labels["pool"] = "batch"
+
+	m := &MultiClusterClient{}
+	m.InjectClient("c1", fake.NewSimpleClientset(fitTestNode("general", "2", "16Gi"), tainted, other))
+
+	projection, err := m.ProjectFit(context.Background(), "c1", fitTestRequests(), 100, FitConstraints{
+		NodeSelector: map[string]string{"pool": "general"},
+		Tolerations:  []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "gpu", Effect: corev1.TaintEffectNoSchedule}},
+	})
+	require.NoError(t, err)
+	// general: 4 replicas (CPU bound); tainted: 16 (tolerated); other-pool excluded by the selector.
+	assert.Equal(t, []NodeFit{{Node: "tainted", Capacity: 16}, {Node: "general", Capacity: 4}}, projection.Nodes)
+	assert.Equal(t, 20, projection.Fits)
+}
+
+func TestProjectFit_PodLimit(t *testing.T) {
+	node := fitTestNode("n1", "64", "256Gi")
+	node.Status.Allocatable[corev1.ResourcePods] = resource.MustParse("2")
+
+	m := &MultiClusterClient{}
+	m.InjectClient("c1", fake.NewSimpleClientset(node, fitTestPod("p1", "n1", "100m", "128Mi")))
+
+	projection, err := m.ProjectFit(context.Background(), "c1", fitTestRequests(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, projection.Fits)
+}
+
+func TestProjectFit_InvalidInput(t *testing.T) {
+	m := &MultiClusterClient{}
+	_, err := m.ProjectFit(context.Background(), "c1", fitTestRequests(), 0)
+	assert.Error(t, err)
+	_, err = m.ProjectFit(context.Background(), "c1", nil, 1)
+	assert.Error(t, err)
+}
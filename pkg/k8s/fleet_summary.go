@@ -0,0 +1,177 @@
+package k8s
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxConcurrentFleetSummaryQueries bounds how many clusters
+	// GetFleetSummary scans at once, matching the RBAC fan-out limit.
+	maxConcurrentFleetSummaryQueries = 5
+	// perClusterFleetSummaryTimeout caps one cluster's detector pass so a
+	// slow cluster only drops its own counts.
+	perClusterFleetSummaryTimeout = 15 * time.Second
+	// fleetSummaryCacheTTL is how long a computed summary is served before
+	// the detectors run again. The dashboard polls this endpoint.
+	fleetSummaryCacheTTL = 30 * time.Second
+	// fleetSummaryTopClusters is how many offending clusters are listed.
+	fleetSummaryTopClusters = 5
+)
+
+// ClusterIssueCounts is one cluster's contribution to a FleetSummary.
+type ClusterIssueCounts struct {
+	Cluster            string `json:"cluster"`
+	Healthy            bool   `json:"healthy"`
+	Reachable          bool   `json:"reachable"`
+	Pods               int    `json:"pods"`
+	PodIssues          int    `json:"podIssues"`
+	DeploymentIssues   int    `json:"deploymentIssues"`
+	SecurityIssues     int    `json:"securityIssues"`
+	SecurityHighIssues int    `json:"securityHighIssues"`
+	WarningEvents      int    `json:"warningEvents"`
+	TotalIssues        int    `json:"totalIssues"`
+}
+
+// FleetSummary is the fleet-wide issue rollup shown on the dashboard.
+type FleetSummary struct {
+	TotalClusters       int                  `json:"totalClusters"`
+	UnhealthyClusters   int                  `json:"unhealthyClusters"`
+	UnreachableClusters int                  `json:"unreachableClusters"`
+	TotalPods           int                  `json:"totalPods"`
+	PodIssues           int                  `json:"podIssues"`
+	DeploymentIssues    int                  `json:"deploymentIssues"`
+	SecurityIssues      int                  `json:"securityIssues"`
+	SecurityHighIssues  int                  `json:"securityHighIssues"`
+	WarningEvents       int                  `json:"warningEvents"`
+	TopClusters         []ClusterIssueCounts `json:"topClusters"`
+	GeneratedAt         string               `json:"generatedAt"`
+}
+
+// GetFleetSummary runs the pod, deployment, security and warning-event
+// detectors across every cluster and returns aggregate counts plus the most
+// affected clusters. Results are cached for fleetSummaryCacheTTL.
+func (m *MultiClusterClient) GetFleetSummary(ctx context.Context) (*FleetSummary, error) {
+	m.mu.RLock()
+	cached, cachedAt := m.fleetSummary, m.fleetSummaryAt
+	m.mu.RUnlock()
+	if cached != nil && time.Since(cachedAt) < fleetSummaryCacheTTL {
+		return cached, nil
+	}
+
+	clusters, err := m.DeduplicatedClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]ClusterIssueCounts, len(clusters))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentFleetSummaryQueries)
+	for i, cluster := range clusters {
+		i, cluster := i, cluster // capture per-iteration
+		g.Go(func() error {
+			clusterCtx, cancel := context.WithTimeout(gctx, perClusterFleetSummaryTimeout)
+			defer cancel()
+			counts[i] = m.clusterIssueCounts(clusterCtx, cluster.Name)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	summary := &FleetSummary{
+		TotalClusters: len(clusters),
+		GeneratedAt:   time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, c := range counts {
+		if !c.Healthy {
+			summary.UnhealthyClusters++
+		}
+		if !c.Reachable {
+			summary.UnreachableClusters++
+		}
+		summary.TotalPods += c.Pods
+		summary.PodIssues += c.PodIssues
+		summary.DeploymentIssues += c.DeploymentIssues
+		summary.SecurityIssues += c.SecurityIssues
+		summary.SecurityHighIssues += c.SecurityHighIssues
+		summary.WarningEvents += c.WarningEvents
+	}
+
+	// Rank by issue count; unreachable clusters outrank quiet healthy ones.
+	sort.SliceStable(counts, func(i, j int) bool {
+		if counts[i].TotalIssues != counts[j].TotalIssues {
+			return counts[i].TotalIssues > counts[j].TotalIssues
+		}
+		if counts[i].Healthy != counts[j].Healthy {
+			return !counts[i].Healthy
+		}
+		return counts[i].Cluster < counts[j].Cluster
+	})
+	summary.TopClusters = make([]ClusterIssueCounts, 0, fleetSummaryTopClusters)
+	for _, c := range counts {
+		if len(summary.TopClusters) == fleetSummaryTopClusters {
+			break
+		}
+		if c.TotalIssues == 0 && c.Healthy {
+			continue
+		}
+		summary.TopClusters = append(summary.TopClusters, c)
+	}
+
+	m.mu.Lock()
+	m.fleetSummary = summary
+	m.fleetSummaryAt = time.Now()
+	m.mu.Unlock()
+	return summary, nil
+}
+
+// clusterIssueCounts runs the detectors against one cluster. Detector errors
+// are logged and counted as zero so one failing check does not hide the rest.
+func (m *MultiClusterClient) clusterIssueCounts(ctx context.Context, contextName string) ClusterIssueCounts {
+	c := ClusterIssueCounts{Cluster: contextName}
+
+	health, err := m.GetClusterHealth(ctx, contextName)
+	if err != nil || health == nil {
+		slog.Warn("[FleetSummary] health check failed", "cluster", contextName, "error", err)
+		return c
+	}
+	c.Healthy = health.Healthy
+	c.Reachable = health.Reachable
+	c.Pods = health.PodCount
+	if !health.Reachable {
+		return c
+	}
+
+	if issues, err := m.FindPodIssues(ctx, contextName, ""); err == nil {
+		c.PodIssues = len(issues)
+	} else {
+		slog.Warn("[FleetSummary] pod issue scan failed", "cluster", contextName, "error", err)
+	}
+	if issues, err := m.FindDeploymentIssues(ctx, contextName, ""); err == nil {
+		c.DeploymentIssues = len(issues)
+	} else {
+		slog.Warn("[FleetSummary] deployment issue scan failed", "cluster", contextName, "error", err)
+	}
+	if issues, err := m.CheckSecurityIssues(ctx, contextName, ""); err == nil {
+		c.SecurityIssues = len(issues)
+		for _, issue := range issues {
+			if issue.Severity == "high" {
+				c.SecurityHighIssues++
+			}
+		}
+	} else {
+		slog.Warn("[FleetSummary] security scan failed", "cluster", contextName, "error", err)
+	}
+	if events, err := m.GetWarningEvents(ctx, contextName, "", 0); err == nil {
+		c.WarningEvents = len(events)
+	} else {
+		slog.Warn("[FleetSummary] warning event scan failed", "cluster", contextName, "error", err)
+	}
+
+	c.TotalIssues = c.PodIssues + c.DeploymentIssues + c.SecurityIssues + c.WarningEvents
+	return c
+}
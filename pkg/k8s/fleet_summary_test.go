@@ -0,0 +1,88 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func fleetReadyNode(name string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+// fleetCleanPod is a running, non-root pod that no detector flags.
+func fleetCleanPod(name string) *corev1.Pod {
+	nonRoot := true
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName:        "n1",
+			SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: &nonRoot},
+			Containers:      []corev1.Container{{Name: "app"}},
+		},
+		Status: corev1.PodStatus{
+			Phase:             corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "app", Ready: true, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}},
+		},
+	}
+}
+
+func TestGetFleetSummary_TwoClusters(t *testing.T) {
+	m, err := NewMultiClusterClient("")
+	require.NoError(t, err)
+	injectTestClusters(m, "east", "west")
+
+	crashing := fleetCleanPod("crashing")
+	crashing.Status.ContainerStatuses[0].Ready = false
+	crashing.Status.ContainerStatuses[0].State = corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+	}
+	privileged := fleetCleanPod("privileged")
+	priv := true
+	privileged.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{Privileged: &priv}
+	replicas := int32(3)
+	stuck := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: 1},
+	}
+	warning := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "crashing.1", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "crashing", Namespace: "default"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "BackOff",
+	}
+	m.InjectClient("east", k8sfake.NewSimpleClientset(fleetReadyNode("n1"), crashing, privileged, stuck, warning))
+	m.InjectClient("west", k8sfake.NewSimpleClientset(fleetReadyNode("n1"), fleetCleanPod("web")))
+
+	summary, err := m.GetFleetSummary(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, summary.TotalClusters)
+	assert.Equal(t, 0, summary.UnhealthyClusters)
+	assert.Equal(t, 0, summary.UnreachableClusters)
+	assert.Equal(t, 1, summary.PodIssues)
+	assert.Equal(t, 1, summary.DeploymentIssues)
+	assert.Equal(t, 1, summary.SecurityIssues)
+	assert.Equal(t, 1, summary.WarningEvents)
+
+	require.Len(t, summary.TopClusters, 1, "clean healthy clusters are not listed")
+	assert.Equal(t, "east", summary.TopClusters[0].Cluster)
+	assert.Equal(t, 4, summary.TopClusters[0].TotalIssues)
+
+	// A second call within the TTL is served from cache.
+	again, err := m.GetFleetSummary(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, summary, again)
+}
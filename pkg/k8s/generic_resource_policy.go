@@ -0,0 +1,66 @@
+package k8s
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// secretsResource is denied through the generic resource endpoints unless
+// secrets are explicitly revealed: those endpoints return spec and status
+// verbatim, which for a Secret is its data.
+var secretsResource = schema.GroupResource{Resource: "secrets"}
+
+// GenericResourcePolicy decides which resources the generic resource
+// endpoints may read or delete: the backend's custom resource list, resource
+// YAML and resource diff, and kc-agent's resource delete and namespace
+// export. Resources are matched by group and plural name; the version is
+// ignored. The zero value allows everything except Secrets.
+type GenericResourcePolicy struct {
+	// allow, when non-empty, is the only set of resources served.
+	allow map[schema.GroupResource]bool
+	// deny is always refused, even when also allowed.
+	deny          map[schema.GroupResource]bool
+	revealSecrets bool
+}
+
+// NewGenericResourcePolicy builds a policy from comma-separated lists of
+// resources in kubectl's "resource.group" form, e.g.
+// "scaledobjects.keda.sh,kafkas.kafka.strimzi.io"; core resources have no
+// group ("configmaps"). Secrets stay denied unless revealSecrets is set.
+func NewGenericResourcePolicy(allow, deny string, revealSecrets bool) GenericResourcePolicy {
+	return GenericResourcePolicy{
+		allow:         parseGroupResources(allow),
+		deny:          parseGroupResources(deny),
+		revealSecrets: revealSecrets,
+	}
+}
+
+// Allowed reports whether the resource of gvr may be served.
+func (p GenericResourcePolicy) Allowed(gvr schema.GroupVersionResource) bool {
+	gr := gvr.GroupResource()
+	if p.deny[gr] {
+		return false
+	}
+	if gr == secretsResource && !p.revealSecrets {
+		return false
+	}
+	return len(p.allow) == 0 || p.allow[gr]
+}
+
+// parseGroupResources parses a comma-separated "resource.group" list,
+// dropping blanks.
+func parseGroupResources(raw string) map[schema.GroupResource]bool {
+	var set map[schema.GroupResource]bool
+	for _, s := range strings.Split(raw, ",") {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if s == "" {
+			continue
+		}
+		if set == nil {
+			set = make(map[schema.GroupResource]bool)
+		}
+		set[schema.ParseGroupResource(s)] = true
+	}
+	return set
+}
@@ -0,0 +1,26 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestGenericResourcePolicy_Allowed(t *testing.T) {
+	secrets := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	kafkas := schema.GroupVersionResource{Group: "kafka.strimzi.io", Version: "v1beta2", Resource: "kafkas"}
+
+	var zero GenericResourcePolicy
+	assert.False(t, zero.Allowed(secrets))
+	assert.True(t, zero.Allowed(kafkas))
+
+	allowList := NewGenericResourcePolicy(" kafkas.kafka.strimzi.io , ", "", false)
+	assert.True(t, allowList.Allowed(kafkas))
+	assert.False(t, allowList.Allowed(configMaps), "not on the allow list")
+
+	revealed := NewGenericResourcePolicy("", "", true)
+	assert.True(t, revealed.Allowed(secrets))
+	assert.False(t, NewGenericResourcePolicy("", "secrets", true).Allowed(secrets), "deny wins over reveal")
+}
@@ -0,0 +1,81 @@
+package k8s
+
+import (
+	"strconv"
+	"strings"
+)
+
+// bytesPerMB converts byte counts reported by feature-discovery labels to MB.
+const bytesPerMB = 1024 * 1024
+
+// gpuLabelDetails is the subset of a vendor's feature-discovery labels that
+// maps onto GPUNode. Zero values mean the label was absent or unparseable.
+type gpuLabelDetails struct {
+	Product  string
+	MemoryMB int
+	Family   string
+}
+
+// amdGPULabelDetails reads labels published by the AMD GPU node labeller
+// (amd.com/gpu.*), falling back to the older beta.amd.com/gpu.* prefix.
+func amdGPULabelDetails(labels map[string]string) gpuLabelDetails {
+	get := func(key string) string {
+		if v := labels["amd.com/gpu."+key]; v != "" {
+			return v
+		}
+		return labels["beta.amd.com/gpu."+key]
+	}
+
+	d := gpuLabelDetails{Family: get("family")}
+	for _, key := range []string{"product", "product-name"} {
+		if v := get(key); v != "" {
+			d.Product = v
+			break
+		}
+	}
+	d.MemoryMB = parseVRAMLabelMB(get("vram"))
+	return d
+}
+
+// intelGPULabelDetails reads labels published by the Intel GPU device
+// plugin's node-feature-discovery rules (gpu.intel.com/*). memory.max is in
+// bytes; family and product use underscores for spaces (e.g. "Flex_Series").
+func intelGPULabelDetails(labels map[string]string) gpuLabelDetails {
+	d := gpuLabelDetails{
+		Family: strings.ReplaceAll(labels["gpu.intel.com/family"], "_", " "),
+	}
+	if product := labels["gpu.intel.com/product"]; product != "" {
+		d.Product = "Intel " + strings.ReplaceAll(product, "_", " ")
+	}
+	if raw := labels["gpu.intel.com/memory.max"]; raw != "" {
+		if b, err := strconv.ParseInt(raw, 10, 64); err == nil && b > 0 {
+			d.MemoryMB = int(b / bytesPerMB)
+		}
+	}
+	return d
+}
+
+// parseVRAMLabelMB parses the AMD labeller's VRAM size ("64G", "16384M",
+// "192GB") into MB. Units are binary, matching how the labeller reports them.
+func parseVRAMLabelMB(v string) int {
+	v = strings.TrimSuffix(strings.ToUpper(strings.TrimSpace(v)), "B")
+	if v == "" {
+		return 0
+	}
+	multiplier := 1
+	switch v[len(v)-1] {
+	case 'T':
+		multiplier = 1024 * 1024
+		v = v[:len(v)-1]
+	case 'G':
+		multiplier = 1024
+		v = v[:len(v)-1]
+	case 'M':
+		v = v[:len(v)-1]
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		return 0
+	}
+	return n * multiplier
+}
@@ -0,0 +1,295 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Environment variables that enable live GPU utilization on GetGPUNodes.
+// Both are optional; with neither set GPUNode.GPUMetricsAvailable stays false.
+const (
+	// dcgmPrometheusURLEnvVar points at a Prometheus that scrapes the NVIDIA
+	// DCGM exporter (e.g. http://prometheus.monitoring:9090).
+	dcgmPrometheusURLEnvVar = "DCGM_PROMETHEUS_URL"
+	// dcgmScrapeSelectorEnvVar enables scraping DCGM exporter pods directly
+	// through the API server pod proxy. The value is the exporter pods'
+	// label selector; "true" uses defaultDCGMPodSelector.
+	dcgmScrapeSelectorEnvVar = "DCGM_EXPORTER_SELECTOR"
+	// dcgmPrometheusClusterLabelEnvVar names the series label that carries
+	// the kubeconfig context name on a Prometheus shared by several
+	// clusters. Defaults to defaultDCGMClusterLabel; set it to an empty
+	// string for a Prometheus that only scrapes a single cluster.
+	dcgmPrometheusClusterLabelEnvVar = "DCGM_PROMETHEUS_CLUSTER_LABEL"
+)
+
+const (
+	// defaultDCGMPodSelector matches the exporter DaemonSet deployed by the
+	// NVIDIA GPU Operator.
+	defaultDCGMPodSelector = "app=nvidia-dcgm-exporter"
+	// defaultDCGMClusterLabel is the conventional external label identifying
+	// the source cluster on a federated or multi-cluster Prometheus.
+	defaultDCGMClusterLabel = "cluster"
+	// dcgmExporterPort is the exporter's default metrics port.
+	dcgmExporterPort = "9400"
+	// gpuMetricsTimeout bounds the whole metrics lookup so an unreachable
+	// exporter or Prometheus never stalls the GPU node inventory.
+	gpuMetricsTimeout = 5 * time.Second
+	// maxPrometheusResponseBytes caps how much of a Prometheus response is read.
+	maxPrometheusResponseBytes = 10 << 20
+)
+
+// DCGM field names consumed for per-node utilization.
+const (
+	dcgmMetricGPUUtil = "DCGM_FI_DEV_GPU_UTIL" // GPU utilization (%), per device
+	dcgmMetricFBUsed  = "DCGM_FI_DEV_FB_USED"  // framebuffer memory used (MiB), per device
+)
+
+// NodeGPUMetrics is the live utilization of all GPUs on one node.
+type NodeGPUMetrics struct {
+	UtilizationPercent float64 // mean across the node's devices
+	MemoryUsedMB       int     // sum across the node's devices
+}
+
+// GPUMetricsSource returns live GPU metrics keyed by node name. A source that
+// cannot reach its backend returns an error; GetGPUNodes then leaves the
+// utilization fields zero with GPUMetricsAvailable=false.
+type GPUMetricsSource interface {
+	NodeGPUMetrics(ctx context.Context, m *MultiClusterClient, contextName string) (map[string]NodeGPUMetrics, error)
+}
+
+// SetGPUMetricsSource overrides the metrics source used to enrich
+// GetGPUNodes. nil disables utilization lookups.
+func (m *MultiClusterClient) SetGPUMetricsSource(src GPUMetricsSource) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gpuMetrics = src
+}
+
+// gpuMetricsSourceFromEnv builds the metrics source configured by the
+// environment, preferring Prometheus when both are set.
+func gpuMetricsSourceFromEnv() GPUMetricsSource {
+	if promURL := strings.TrimSpace(os.Getenv(dcgmPrometheusURLEnvVar)); promURL != "" {
+		clusterLabel, ok := os.LookupEnv(dcgmPrometheusClusterLabelEnvVar)
+		if !ok {
+			clusterLabel = defaultDCGMClusterLabel
+		}
+		return &prometheusGPUMetrics{
+			baseURL:      strings.TrimRight(promURL, "/"),
+			clusterLabel: strings.TrimSpace(clusterLabel),
+			httpClient:   &http.Client{Timeout: gpuMetricsTimeout},
+		}
+	}
+	if selector := strings.TrimSpace(os.Getenv(dcgmScrapeSelectorEnvVar)); selector != "" {
+		if selector == "true" {
+			selector = defaultDCGMPodSelector
+		}
+		return &dcgmPodScraper{selector: selector}
+	}
+	return nil
+}
+
+// enrichGPUUtilization fills the utilization fields on NVIDIA nodes from the
+// configured metrics source. Failures are logged and otherwise ignored.
+func (m *MultiClusterClient) enrichGPUUtilization(ctx context.Context, contextName string, nodes []GPUNode) {
+	m.mu.RLock()
+	src := m.gpuMetrics
+	m.mu.RUnlock()
+	if src == nil || len(nodes) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gpuMetricsTimeout)
+	defer cancel()
+
+	metrics, err := src.NodeGPUMetrics(ctx, m, contextName)
+	if err != nil {
+		slog.Debug("[GPUNodes] DCGM metrics unavailable", "cluster", contextName, "error", err)
+		return
+	}
+	for i := range nodes {
+		if nodes[i].Manufacturer != "NVIDIA" {
+			continue
+		}
+		if nm, ok := metrics[nodes[i].Name]; ok {
+			nodes[i].GPUUtilizationPercent = nm.UtilizationPercent
+			nodes[i].GPUMemoryUsedMB = nm.MemoryUsedMB
+			nodes[i].GPUMetricsAvailable = true
+		}
+	}
+}
+
+// dcgmPodScraper reads each DCGM exporter pod's /metrics through the API
+// server pod proxy. The exporter runs as a DaemonSet, so each pod reports the
+// devices of the node it is scheduled on.
+type dcgmPodScraper struct {
+	selector string
+}
+
+func (s *dcgmPodScraper) NodeGPUMetrics(ctx context.Context, m *MultiClusterClient, contextName string) (map[string]NodeGPUMetrics, error) {
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{LabelSelector: s.selector})
+	if err != nil {
+		return nil, fmt.Errorf("list dcgm exporter pods: %w", err)
+	}
+
+	out := make(map[string]NodeGPUMetrics)
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		raw, err := client.CoreV1().Pods(pod.Namespace).ProxyGet("http", pod.Name, dcgmExporterPort, "/metrics", nil).DoRaw(ctx)
+		if err != nil {
+			slog.Debug("[GPUNodes] DCGM scrape failed", "cluster", contextName, "pod", pod.Name, "error", err)
+			continue
+		}
+		nm, err := parseDCGMNodeMetrics(raw)
+		if err != nil {
+			slog.Debug("[GPUNodes] DCGM parse failed", "cluster", contextName, "pod", pod.Name, "error", err)
+			continue
+		}
+		out[pod.Spec.NodeName] = nm
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no DCGM exporter metrics found (selector %q)", s.selector)
+	}
+	return out, nil
+}
+
+// parseDCGMNodeMetrics aggregates one exporter's per-device samples.
+func parseDCGMNodeMetrics(raw []byte) (NodeGPUMetrics, error) {
+	parser := expfmt.NewTextParser(model.LegacyValidation)
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(raw))
+	if err != nil {
+		return NodeGPUMetrics{}, err
+	}
+
+	var nm NodeGPUMetrics
+	if family, ok := families[dcgmMetricGPUUtil]; ok && len(family.Metric) > 0 {
+		var sum float64
+		for _, metric := range family.Metric {
+			sum += metric.GetGauge().GetValue()
+		}
+		nm.UtilizationPercent = sum / float64(len(family.Metric))
+	} else {
+		return NodeGPUMetrics{}, fmt.Errorf("%s not exported", dcgmMetricGPUUtil)
+	}
+	if family, ok := families[dcgmMetricFBUsed]; ok {
+		var used float64
+		for _, metric := range family.Metric {
+			used += metric.GetGauge().GetValue()
+		}
+		nm.MemoryUsedMB = int(used)
+	}
+	return nm, nil
+}
+
+// prometheusGPUMetrics queries a Prometheus server that scrapes the DCGM
+// exporter. DCGM labels samples with the node's host name in "Hostname".
+// Node names are only unique within a cluster, so when clusterLabel is set
+// the queries are restricted to series whose clusterLabel equals the
+// context name.
+type prometheusGPUMetrics struct {
+	baseURL      string
+	clusterLabel string
+	httpClient   *http.Client
+}
+
+func (p *prometheusGPUMetrics) NodeGPUMetrics(ctx context.Context, _ *MultiClusterClient, contextName string) (map[string]NodeGPUMetrics, error) {
+	util, err := p.query(ctx, "avg by (Hostname) ("+p.series(dcgmMetricGPUUtil, contextName)+")")
+	if err != nil {
+		return nil, err
+	}
+	if len(util) == 0 {
+		return nil, fmt.Errorf("prometheus has no %s series for cluster %q", dcgmMetricGPUUtil, contextName)
+	}
+	used, err := p.query(ctx, "sum by (Hostname) ("+p.series(dcgmMetricFBUsed, contextName)+")")
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]NodeGPUMetrics, len(util))
+	for node, v := range util {
+		out[node] = NodeGPUMetrics{UtilizationPercent: v, MemoryUsedMB: int(used[node])}
+	}
+	return out, nil
+}
+
+// series returns the selector for metric, scoped to contextName when a
+// cluster label is configured. strconv.Quote produces a valid PromQL string
+// literal for any context name.
+func (p *prometheusGPUMetrics) series(metric, contextName string) string {
+	if p.clusterLabel == "" {
+		return metric
+	}
+	return metric + "{" + p.clusterLabel + "=" + strconv.Quote(contextName) + "}"
+}
+
+// query runs an instant query and returns the result vector keyed by the
+// Hostname label.
+func (p *prometheusGPUMetrics) query(ctx context.Context, promQL string) (map[string]float64, error) {
+	reqURL := p.baseURL + "/api/v1/query?query=" + url.QueryEscape(promQL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("prometheus query: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("prometheus query returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Status string `json:"status"`
+		Data   struct {
+			Result []struct {
+				Metric map[string]string `json:"metric"`
+				Value  [2]interface{}    `json:"value"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxPrometheusResponseBytes)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode prometheus response: %w", err)
+	}
+	if body.Status != "success" {
+		return nil, fmt.Errorf("prometheus query status %q", body.Status)
+	}
+
+	out := make(map[string]float64, len(body.Data.Result))
+	for _, r := range body.Data.Result {
+		node := r.Metric["Hostname"]
+		raw, ok := r.Value[1].(string)
+		if node == "" || !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			continue
+		}
+		out[node] = v
+	}
+	return out, nil
+}
@@ -0,0 +1,158 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// stubGPUMetrics is a GPUMetricsSource returning canned values.
+type stubGPUMetrics struct {
+	metrics map[string]NodeGPUMetrics
+	err     error
+}
+
+func (s *stubGPUMetrics) NodeGPUMetrics(context.Context, *MultiClusterClient, string) (map[string]NodeGPUMetrics, error) {
+	return s.metrics, s.err
+}
+
+func gpuMetricsTestClient() *MultiClusterClient {
+	nvidia := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-1", Labels: map[string]string{"nvidia.com/gpu.product": "NVIDIA A100"}},
+		Status:     corev1.NodeStatus{Allocatable: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}},
+	}
+	amd := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "amd-1"},
+		Status:     corev1.NodeStatus{Allocatable: corev1.ResourceList{"amd.com/gpu": resource.MustParse("2")}},
+	}
+	return &MultiClusterClient{
+		clients: map[string]kubernetes.Interface{"c1": fake.NewSimpleClientset(nvidia, amd)},
+	}
+}
+
+func TestGetGPUNodes_DCGMUtilization(t *testing.T) {
+	m := gpuMetricsTestClient()
+	m.SetGPUMetricsSource(&stubGPUMetrics{metrics: map[string]NodeGPUMetrics{
+		"gpu-1": {UtilizationPercent: 73.5, MemoryUsedMB: 40960},
+		// DCGM is NVIDIA-only; a stray sample for another node is ignored.
+		"amd-1": {UtilizationPercent: 10, MemoryUsedMB: 1},
+	}})
+
+	nodes, err := m.GetGPUNodes(context.Background(), "c1")
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	byName := map[string]GPUNode{}
+	for _, n := range nodes {
+		byName[n.Name] = n
+	}
+	assert.True(t, byName["gpu-1"].GPUMetricsAvailable)
+	assert.InDelta(t, 73.5, byName["gpu-1"].GPUUtilizationPercent, 0.001)
+	assert.Equal(t, 40960, byName["gpu-1"].GPUMemoryUsedMB)
+
+	assert.False(t, byName["amd-1"].GPUMetricsAvailable)
+	assert.Zero(t, byName["amd-1"].GPUUtilizationPercent)
+}
+
+func TestGetGPUNodes_DCGMUnavailable(t *testing.T) {
+	m := gpuMetricsTestClient()
+	m.SetGPUMetricsSource(&stubGPUMetrics{err: errors.New("connection refused")})
+
+	nodes, err := m.GetGPUNodes(context.Background(), "c1")
+	require.NoError(t, err, "metrics failures must not fail the inventory")
+	for _, n := range nodes {
+		assert.False(t, n.GPUMetricsAvailable)
+		assert.Zero(t, n.GPUUtilizationPercent)
+		assert.Zero(t, n.GPUMemoryUsedMB)
+	}
+}
+
+func TestParseDCGMNodeMetrics(t *testing.T) {
+	raw := `# HELP DCGM_FI_DEV_GPU_UTIL GPU utilization (in %).
+# TYPE DCGM_FI_DEV_GPU_UTIL gauge
+DCGM_FI_DEV_GPU_UTIL{gpu="0",Hostname="gpu-1"} 80
+DCGM_FI_DEV_GPU_UTIL{gpu="1",Hostname="gpu-1"} 40
+# HELP DCGM_FI_DEV_FB_USED Framebuffer memory used (in MiB).
+# TYPE DCGM_FI_DEV_FB_USED gauge
+DCGM_FI_DEV_FB_USED{gpu="0",Hostname="gpu-1"} 1000
+DCGM_FI_DEV_FB_USED{gpu="1",Hostname="gpu-1"} 500
+`
+	nm, err := parseDCGMNodeMetrics([]byte(raw))
+	require.NoError(t, err)
+	assert.InDelta(t, 60, nm.UtilizationPercent, 0.001)
+	assert.Equal(t, 1500, nm.MemoryUsedMB)
+
+	_, err = parseDCGMNodeMetrics([]byte("# no samples\n"))
+	assert.Error(t, err)
+}
+
+func TestPrometheusGPUMetrics(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query().Get("query")
+		queries = append(queries, q)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case !strings.Contains(q, `{cluster="c1"}`):
+			// Series from other clusters must never be matched.
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{"Hostname":"gpu-1"},"value":[1700000000,"99"]}]}}`))
+		case strings.Contains(q, dcgmMetricGPUUtil):
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{"Hostname":"gpu-1"},"value":[1700000000,"55.5"]}]}}`))
+		case strings.Contains(q, dcgmMetricFBUsed):
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{"Hostname":"gpu-1"},"value":[1700000000,"2048"]}]}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	src := &prometheusGPUMetrics{baseURL: srv.URL, clusterLabel: defaultDCGMClusterLabel, httpClient: srv.Client()}
+	metrics, err := src.NodeGPUMetrics(context.Background(), nil, "c1")
+	require.NoError(t, err)
+	assert.Equal(t, NodeGPUMetrics{UtilizationPercent: 55.5, MemoryUsedMB: 2048}, metrics["gpu-1"])
+	assert.Equal(t, []string{
+		`avg by (Hostname) (DCGM_FI_DEV_GPU_UTIL{cluster="c1"})`,
+		`sum by (Hostname) (DCGM_FI_DEV_FB_USED{cluster="c1"})`,
+	}, queries)
+}
+
+func TestPrometheusGPUMetrics_Series(t *testing.T) {
+	scoped := &prometheusGPUMetrics{clusterLabel: "k8s_cluster"}
+	assert.Equal(t, `DCGM_FI_DEV_GPU_UTIL{k8s_cluster="a\"b"}`, scoped.series(dcgmMetricGPUUtil, `a"b`))
+
+	unscoped := &prometheusGPUMetrics{}
+	assert.Equal(t, dcgmMetricGPUUtil, unscoped.series(dcgmMetricGPUUtil, "c1"))
+}
+
+func TestGPUMetricsSourceFromEnv(t *testing.T) {
+	t.Setenv(dcgmPrometheusURLEnvVar, "")
+	t.Setenv(dcgmScrapeSelectorEnvVar, "")
+	assert.Nil(t, gpuMetricsSourceFromEnv())
+
+	t.Setenv(dcgmScrapeSelectorEnvVar, "true")
+	scraper, ok := gpuMetricsSourceFromEnv().(*dcgmPodScraper)
+	require.True(t, ok)
+	assert.Equal(t, defaultDCGMPodSelector, scraper.selector)
+
+	t.Setenv(dcgmPrometheusURLEnvVar, "http://prometheus:9090/")
+	prom, ok := gpuMetricsSourceFromEnv().(*prometheusGPUMetrics)
+	require.True(t, ok, "Prometheus takes precedence")
+	assert.Equal(t, "http://prometheus:9090", prom.baseURL)
+	assert.Equal(t, defaultDCGMClusterLabel, prom.clusterLabel)
+
+	t.Setenv(dcgmPrometheusClusterLabelEnvVar, "")
+	prom, ok = gpuMetricsSourceFromEnv().(*prometheusGPUMetrics)
+	require.True(t, ok)
+	assert.Empty(t, prom.clusterLabel, "an empty label disables cluster scoping")
+}
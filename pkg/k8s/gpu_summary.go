@@ -0,0 +1,129 @@
+package k8s
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxConcurrentGPUSummaryQueries bounds how many clusters GetGPUSummary
+	// queries at once, matching the RBAC fan-out limit.
+	maxConcurrentGPUSummaryQueries = 5
+	// perClusterGPUSummaryTimeout caps a single cluster's GetGPUNodes call so
+	// one slow cluster only drops its own rollup from the summary.
+	perClusterGPUSummaryTimeout = 15 * time.Second
+)
+
+// GPUAllocation is a total/allocated/free accelerator count.
+type GPUAllocation struct {
+	Total     int `json:"total"`
+	Allocated int `json:"allocated"`
+	Free      int `json:"free"`
+}
+
+func (a *GPUAllocation) add(total, allocated int) {
+	a.Total += total
+	a.Allocated += allocated
+	a.Free = a.Total - a.Allocated
+	if a.Free < 0 {
+		a.Free = 0
+	}
+}
+
+// ClusterGPUSummary is the GPU rollup for one cluster.
+type ClusterGPUSummary struct {
+	Cluster string `json:"cluster"`
+	Nodes   int    `json:"nodes"`
+	GPUAllocation
+}
+
+// GPUSummary aggregates GPU capacity and allocation across all clusters.
+type GPUSummary struct {
+	TotalGPUs      int                      `json:"totalGPUs"`
+	AllocatedGPUs  int                      `json:"allocatedGPUs"`
+	FreeGPUs       int                      `json:"freeGPUs"`
+	ByType         map[string]GPUAllocation `json:"byType"`
+	ByManufacturer map[string]GPUAllocation `json:"byManufacturer"`
+	Clusters       []ClusterGPUSummary      `json:"clusters"`
+	// FailedClusters lists clusters whose GPU nodes could not be listed;
+	// their capacity is missing from the totals.
+	FailedClusters []string `json:"failedClusters,omitempty"`
+}
+
+// GetGPUSummary aggregates GetGPUNodes across every reachable cluster into
+// fleet-wide totals, per-type and per-manufacturer breakdowns and
+// per-cluster rollups. Clusters that fail are listed in FailedClusters
+// rather than failing the whole summary.
+func (m *MultiClusterClient) GetGPUSummary(ctx context.Context) (*GPUSummary, error) {
+	clusters, _, err := m.HealthyClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perCluster := make([][]GPUNode, len(clusters))
+	failed := make([]bool, len(clusters))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentGPUSummaryQueries)
+	for i, cluster := range clusters {
+		i, cluster := i, cluster // capture per-iteration
+		g.Go(func() error {
+			clusterCtx, cancel := context.WithTimeout(gctx, perClusterGPUSummaryTimeout)
+			defer cancel()
+
+			nodes, err := m.GetGPUNodes(clusterCtx, cluster.Name)
+			if err != nil {
+				slog.Warn("[GPUSummary] failed to list GPU nodes", "cluster", cluster.Name, "error", err)
+				failed[i] = true
+				return nil
+			}
+			perCluster[i] = nodes
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	summary := &GPUSummary{
+		ByType:         make(map[string]GPUAllocation),
+		ByManufacturer: make(map[string]GPUAllocation),
+		Clusters:       make([]ClusterGPUSummary, 0, len(clusters)),
+	}
+	var fleet GPUAllocation
+	for i, cluster := range clusters {
+		if failed[i] {
+			summary.FailedClusters = append(summary.FailedClusters, cluster.Name)
+			continue
+		}
+		rollup := ClusterGPUSummary{Cluster: cluster.Name, Nodes: len(perCluster[i])}
+		for _, node := range perCluster[i] {
+			rollup.add(node.GPUCount, node.GPUAllocated)
+			fleet.add(node.GPUCount, node.GPUAllocated)
+
+			byType := summary.ByType[node.GPUType]
+			byType.add(node.GPUCount, node.GPUAllocated)
+			summary.ByType[node.GPUType] = byType
+
+			manufacturer := node.Manufacturer
+			if manufacturer == "" {
+				manufacturer = "Unknown"
+			}
+			byMfr := summary.ByManufacturer[manufacturer]
+			byMfr.add(node.GPUCount, node.GPUAllocated)
+			summary.ByManufacturer[manufacturer] = byMfr
+		}
+		summary.Clusters = append(summary.Clusters, rollup)
+	}
+	sort.Slice(summary.Clusters, func(i, j int) bool {
+		return summary.Clusters[i].Cluster < summary.Clusters[j].Cluster
+	})
+	sort.Strings(summary.FailedClusters)
+
+	summary.TotalGPUs = fleet.Total
+	summary.AllocatedGPUs = fleet.Allocated
+	summary.FreeGPUs = fleet.Free
+	return summary, nil
+}
@@ -0,0 +1,74 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func summaryGPUNode(name, product, resourceName, count string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"nvidia.com/gpu.product": product}},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{corev1.ResourceName(resourceName): resource.MustParse(count)},
+		},
+	}
+}
+
+func summaryGPUPod(name, node, resourceName, count string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceName(resourceName): resource.MustParse(count)},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestGetGPUSummary_TwoClusters(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "east", "west")
+
+	m.InjectClient("east", fake.NewSimpleClientset(
+		summaryGPUNode("a100-1", "NVIDIA-A100", "nvidia.com/gpu", "8"),
+		summaryGPUNode("a100-2", "NVIDIA-A100", "nvidia.com/gpu", "8"),
+		summaryGPUPod("train", "a100-1", "nvidia.com/gpu", "6"),
+		summaryGPUPod("serve", "a100-2", "nvidia.com/gpu", "2"),
+	))
+	amd := summaryGPUNode("mi300-1", "", "amd.com/gpu", "4")
+	amd.Labels = map[string]string{"amd.com/gpu.product-name": "MI300X"}
+	m.InjectClient("west", fake.NewSimpleClientset(
+		summaryGPUNode("a100-3", "NVIDIA-A100", "nvidia.com/gpu", "4"),
+		amd,
+		summaryGPUPod("infer", "mi300-1", "amd.com/gpu", "1"),
+	))
+
+	summary, err := m.GetGPUSummary(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 24, summary.TotalGPUs)
+	assert.Equal(t, 9, summary.AllocatedGPUs)
+	assert.Equal(t, 15, summary.FreeGPUs)
+	assert.Empty(t, summary.FailedClusters)
+
+	assert.Equal(t, GPUAllocation{Total: 20, Allocated: 8, Free: 12}, summary.ByType["NVIDIA-A100"])
+	assert.Equal(t, GPUAllocation{Total: 4, Allocated: 1, Free: 3}, summary.ByType["MI300X"])
+	assert.Equal(t, GPUAllocation{Total: 20, Allocated: 8, Free: 12}, summary.ByManufacturer["NVIDIA"])
+	assert.Equal(t, GPUAllocation{Total: 4, Allocated: 1, Free: 3}, summary.ByManufacturer["AMD"])
+
+	require.Len(t, summary.Clusters, 2)
+	assert.Equal(t, ClusterGPUSummary{Cluster: "east", Nodes: 2, GPUAllocation: GPUAllocation{Total: 16, Allocated: 8, Free: 8}}, summary.Clusters[0])
+	assert.Equal(t, ClusterGPUSummary{Cluster: "west", Nodes: 2, GPUAllocation: GPUAllocation{Total: 8, Allocated: 1, Free: 7}}, summary.Clusters[1])
+}
@@ -0,0 +1,117 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// hpaRescaleReason is the event reason the HPA controller records on every
+// scale, with a message like "New size: 5; reason: cpu resource utilization
+// (percentage of request) above target".
+const hpaRescaleReason = "SuccessfulRescale"
+
+var hpaNewSizePattern = regexp.MustCompile(`New size: (\d+)`)
+
+// HPAEvent is an event recorded against an HPA. For SuccessfulRescale events
+// the replica change is parsed from the message.
+type HPAEvent struct {
+	Event
+	// DesiredReplicas is the size the HPA scaled to.
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+	// PreviousReplicas is the size before this rescale, taken from the
+	// preceding rescale event when one is still retained.
+	PreviousReplicas *int32 `json:"previousReplicas,omitempty"`
+	// ScaleReason is why the HPA scaled, e.g. "All metrics below target".
+	ScaleReason string `json:"scaleReason,omitempty"`
+}
+
+// GetHPAEvents returns the events involving the HPA name in namespace,
+// newest first: rescales along with failures such as FailedGetResourceMetric.
+func (m *MultiClusterClient) GetHPAEvents(ctx context.Context, contextName, namespace, name string) (_ []HPAEvent, err error) {
+	defer observeClusterRequest(contextName, "GetHPAEvents", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := fields.Set{
+		"involvedObject.kind": "HorizontalPodAutoscaler",
+		"involvedObject.name": name,
+	}.AsSelector().String()
+	list, err := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	// Field selectors are not honoured by every client, so filter again.
+	items := make([]corev1.Event, 0, len(list.Items))
+	for _, e := range list.Items {
+		if e.InvolvedObject.Kind == "HorizontalPodAutoscaler" && e.InvolvedObject.Name == name {
+			items = append(items, e)
+		}
+	}
+	// Oldest first, so each rescale can take its previous size from the one
+	// before it.
+	sort.SliceStable(items, func(i, j int) bool {
+		return EffectiveEventTime(&items[i]).Before(EffectiveEventTime(&items[j]))
+	})
+
+	result := make([]HPAEvent, 0, len(items))
+	var lastSize *int32
+	for i := range items {
+		event := &items[i]
+		e := HPAEvent{Event: Event{
+			Type:      event.Type,
+			Reason:    event.Reason,
+			Message:   event.Message,
+			Object:    fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			Namespace: event.Namespace,
+			Cluster:   contextName,
+			Count:     event.Count,
+		}}
+		if lastSeen := EffectiveEventTime(event); !lastSeen.IsZero() {
+			e.Age = formatDuration(time.Since(lastSeen))
+			e.LastSeen = lastSeen.Format(time.RFC3339)
+		}
+		if !event.FirstTimestamp.IsZero() {
+			e.FirstSeen = event.FirstTimestamp.Time.Format(time.RFC3339)
+		}
+		if event.Reason == hpaRescaleReason {
+			e.DesiredReplicas, e.ScaleReason = parseHPARescaleMessage(event.Message)
+			if e.DesiredReplicas != nil {
+				e.PreviousReplicas = lastSize
+				lastSize = e.DesiredReplicas
+			}
+		}
+		result = append(result, e)
+	}
+
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, nil
+}
+
+// parseHPARescaleMessage extracts the new size and the reason from a
+// SuccessfulRescale message. size is nil when the message has no size.
+func parseHPARescaleMessage(msg string) (size *int32, reason string) {
+	if m := hpaNewSizePattern.FindStringSubmatch(msg); m != nil {
+		if n, err := strconv.ParseInt(m[1], 10, 32); err == nil {
+			v := int32(n)
+			size = &v
+		}
+	}
+	if _, after, ok := strings.Cut(msg, "reason: "); ok {
+		reason = strings.TrimSpace(after)
+	}
+	return size, reason
+}
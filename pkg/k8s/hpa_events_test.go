@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetHPAEvents(t *testing.T) {
+	now := time.Now()
+	event := func(name, object, reason, msg string, at time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "shop"},
+			InvolvedObject: corev1.ObjectReference{Kind: "HorizontalPodAutoscaler", Name: object, Namespace: "shop"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         reason,
+			Message:        msg,
+			LastTimestamp:  metav1.NewTime(at),
+		}
+	}
+	m := &MultiClusterClient{
+		clients: map[string]kubernetes.Interface{"test-cluster": k8sfake.NewSimpleClientset(
+			event("up", "web", "SuccessfulRescale",
+				"New size: 3; reason: cpu resource utilization (percentage of request) above target", now.Add(-10*time.Minute)),
+			event("down", "web", "SuccessfulRescale", "New size: 2; reason: All metrics below target", now.Add(-time.Minute)),
+			event("other", "api", "SuccessfulRescale", "New size: 9; reason: All metrics below target", now),
+		)},
+	}
+
+	events, err := m.GetHPAEvents(context.Background(), "test-cluster", "shop", "web")
+	if err != nil {
+		t.Fatalf("GetHPAEvents failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("events = %+v, want the two rescales of web", events)
+	}
+	latest := events[0]
+	if latest.Reason != "SuccessfulRescale" || latest.Object != "HorizontalPodAutoscaler/web" {
+		t.Errorf("latest event = %+v, want the SuccessfulRescale of web", latest)
+	}
+	if latest.DesiredReplicas == nil || *latest.DesiredReplicas != 2 ||
+		latest.PreviousReplicas == nil || *latest.PreviousReplicas != 3 {
+		t.Errorf("latest replicas = %v -> %v, want 3 -> 2", latest.PreviousReplicas, latest.DesiredReplicas)
+	}
+	if latest.ScaleReason != "All metrics below target" {
+		t.Errorf("ScaleReason = %q", latest.ScaleReason)
+	}
+	if first := events[1]; first.DesiredReplicas == nil || *first.DesiredReplicas != 3 || first.PreviousReplicas != nil {
+		t.Errorf("first rescale = %+v, want size 3 with no known previous size", first)
+	}
+}
@@ -0,0 +1,160 @@
+package k8s
+
+import (
+	"fmt"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+)
+
+// HPAMetric is one metric an HPA scales on, with its target and, once the
+// controller has observed it, its current value. Utilization targets are
+// percentages ("80%"); value targets are quantities ("512Mi", "100").
+type HPAMetric struct {
+	// Type is the metric source: Resource, ContainerResource, Pods, Object
+	// or External.
+	Type string `json:"type"`
+	// Name is the resource (cpu, memory) or the metric name.
+	Name string `json:"name"`
+	// Container is set for ContainerResource metrics.
+	Container string `json:"container,omitempty"`
+	// Object is the described object of Object metrics, as Kind/name.
+	Object  string `json:"object,omitempty"`
+	Target  string `json:"target,omitempty"`
+	Current string `json:"current,omitempty"`
+}
+
+// hpaMetrics returns every metric in hpa's spec, each paired with its entry
+// in status.currentMetrics when there is one. The current value is rendered
+// in the form of the metric's target, so a value target is compared with a
+// value even when the status also carries a utilization.
+func hpaMetrics(hpa *autoscalingv2.HorizontalPodAutoscaler) []HPAMetric {
+	current := make(map[string]autoscalingv2.MetricValueStatus, len(hpa.Status.CurrentMetrics))
+	for i := range hpa.Status.CurrentMetrics {
+		m, value := hpaMetricFromStatus(&hpa.Status.CurrentMetrics[i])
+		current[hpaMetricKey(m)] = value
+	}
+
+	metrics := make([]HPAMetric, 0, len(hpa.Spec.Metrics))
+	for i := range hpa.Spec.Metrics {
+		m, targetType := hpaMetricFromSpec(&hpa.Spec.Metrics[i])
+		if value, ok := current[hpaMetricKey(m)]; ok {
+			m.Current = formatMetricValue(value, targetType)
+		}
+		metrics = append(metrics, m)
+	}
+	return metrics
+}
+
+// hpaMetricKey identifies a metric across spec and status.
+func hpaMetricKey(m HPAMetric) string {
+	return m.Type + "/" + m.Name + "/" + m.Container + "/" + m.Object
+}
+
+// hpaMetricFromSpec returns the metric of spec and the type of its target.
+func hpaMetricFromSpec(spec *autoscalingv2.MetricSpec) (HPAMetric, autoscalingv2.MetricTargetType) {
+	m := HPAMetric{Type: string(spec.Type)}
+	var target autoscalingv2.MetricTarget
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if spec.Resource != nil {
+			m.Name = string(spec.Resource.Name)
+			target = spec.Resource.Target
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if spec.ContainerResource != nil {
+			m.Name = string(spec.ContainerResource.Name)
+			m.Container = spec.ContainerResource.Container
+			target = spec.ContainerResource.Target
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if spec.Pods != nil {
+			m.Name = spec.Pods.Metric.Name
+			target = spec.Pods.Target
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if spec.Object != nil {
+			m.Name = spec.Object.Metric.Name
+			m.Object = spec.Object.DescribedObject.Kind + "/" + spec.Object.DescribedObject.Name
+			target = spec.Object.Target
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if spec.External != nil {
+			m.Name = spec.External.Metric.Name
+			target = spec.External.Target
+		}
+	}
+	m.Target = formatMetricTarget(target)
+	return m, target.Type
+}
+
+// hpaMetricFromStatus returns the metric of status and its current value.
+func hpaMetricFromStatus(status *autoscalingv2.MetricStatus) (HPAMetric, autoscalingv2.MetricValueStatus) {
+	m := HPAMetric{Type: string(status.Type)}
+	var value autoscalingv2.MetricValueStatus
+	switch status.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if status.Resource != nil {
+			m.Name = string(status.Resource.Name)
+			value = status.Resource.Current
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if status.ContainerResource != nil {
+			m.Name = string(status.ContainerResource.Name)
+			m.Container = status.ContainerResource.Container
+			value = status.ContainerResource.Current
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if status.Pods != nil {
+			m.Name = status.Pods.Metric.Name
+			value = status.Pods.Current
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if status.Object != nil {
+			m.Name = status.Object.Metric.Name
+			m.Object = status.Object.DescribedObject.Kind + "/" + status.Object.DescribedObject.Name
+			value = status.Object.Current
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if status.External != nil {
+			m.Name = status.External.Metric.Name
+			value = status.External.Current
+		}
+	}
+	return m, value
+}
+
+// formatMetricTarget renders whichever of the target's fields its type uses.
+func formatMetricTarget(t autoscalingv2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *t.AverageUtilization)
+	case t.AverageValue != nil:
+		return t.AverageValue.String()
+	case t.Value != nil:
+		return t.Value.String()
+	}
+	return ""
+}
+
+// formatMetricValue renders the field of a current metric value that
+// targetType compares against, falling back to whichever field is set,
+// utilization first, when that one is missing.
+func formatMetricValue(v autoscalingv2.MetricValueStatus, targetType autoscalingv2.MetricTargetType) string {
+	switch {
+	case targetType == autoscalingv2.UtilizationMetricType && v.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *v.AverageUtilization)
+	case targetType == autoscalingv2.AverageValueMetricType && v.AverageValue != nil:
+		return v.AverageValue.String()
+	case targetType == autoscalingv2.ValueMetricType && v.Value != nil:
+		return v.Value.String()
+	}
+	switch {
+	case v.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *v.AverageUtilization)
+	case v.AverageValue != nil:
+		return v.AverageValue.String()
+	case v.Value != nil:
+		return v.Value.String()
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+package k8s
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ClusterSourceInventory is the ClusterInfo.Source of clusters read from
+	// the inventory hub's ManagedCluster objects.
+	ClusterSourceInventory = "inventory"
+	// inventoryHubEnvVar names the kubeconfig context of the KubeStellar hub
+	// (its inventory and transport space) whose ManagedClusters are listed.
+	inventoryHubEnvVar = "K8S_INVENTORY_HUB_CONTEXT"
+	// inventoryCacheTTL is how long a ManagedCluster list is served before
+	// the hub is queried again; ListClusters runs on most requests.
+	inventoryCacheTTL = 30 * time.Second
+	// inventoryListTimeout caps one hub query so a slow hub does not stall
+	// ListClusters.
+	inventoryListTimeout = 5 * time.Second
+	// managedClusterAvailable is the condition the registration agent keeps
+	// True while the managed cluster's klusterlet reports in.
+	managedClusterAvailable = "ManagedClusterConditionAvailable"
+)
+
+// managedClusterGVR is the Open Cluster Management ManagedCluster, the
+// inventory KubeStellar registers its workload execution clusters in.
+var managedClusterGVR = schema.GroupVersionResource{
+	Group:    "cluster.open-cluster-management.io",
+	Version:  "v1",
+	Resource: "managedclusters",
+}
+
+// inventoryHubFromEnv reads K8S_INVENTORY_HUB_CONTEXT. Empty disables
+// inventory discovery.
+func inventoryHubFromEnv() string {
+	return strings.TrimSpace(os.Getenv(inventoryHubEnvVar))
+}
+
+// SetInventoryHub sets the context whose ManagedClusters ListClusters merges
+// in with source "inventory". An empty name disables inventory discovery.
+func (m *MultiClusterClient) SetInventoryHub(contextName string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inventoryHub = contextName
+	m.inventory = nil
+	m.inventoryAt = time.Time{}
+}
+
+// inventoryClusters returns the ManagedClusters registered on the inventory
+// hub, cached for inventoryCacheTTL. A hub without the ManagedCluster CRD
+// has no inventory; any other failure is logged and the last good list is
+// served, so a flaky hub does not make its clusters flicker.
+func (m *MultiClusterClient) inventoryClusters(ctx context.Context) []ClusterInfo {
+	m.mu.RLock()
+	hub, cached, cachedAt := m.inventoryHub, m.inventory, m.inventoryAt
+	m.mu.RUnlock()
+	if hub == "" {
+		return nil
+	}
+	if !cachedAt.IsZero() && time.Since(cachedAt) < inventoryCacheTTL {
+		return cached
+	}
+
+	clusters, err := m.listManagedClusters(ctx, hub)
+	if err != nil {
+		slog.Warn("[Inventory] failed to list managed clusters", "hub", hub, "error", err)
+		return cached
+	}
+	m.mu.Lock()
+	m.inventory, m.inventoryAt = clusters, time.Now()
+	m.mu.Unlock()
+	return clusters
+}
+
+// listManagedClusters lists hub's ManagedClusters as ClusterInfos.
+func (m *MultiClusterClient) listManagedClusters(ctx context.Context, hub string) (_ []ClusterInfo, err error) {
+	defer observeClusterRequest(hub, "ListManagedClusters", time.Now(), &err)
+	client, err := m.GetDynamicClient(hub)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, inventoryListTimeout)
+	defer cancel()
+	list, err := client.Resource(managedClusterGVR).List(ctx, metav1.ListOptions{})
+	if isCRDNotInstalled(err) {
+		return []ClusterInfo{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	clusters := make([]ClusterInfo, 0, len(list.Items))
+	for i := range list.Items {
+		clusters = append(clusters, parseManagedCluster(&list.Items[i]))
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Name < clusters[j].Name })
+	return clusters, nil
+}
+
+// parseManagedCluster converts a ManagedCluster to a ClusterInfo. Health
+// follows the Available condition; without one it is unknown. The console
+// has no credentials for it, so it is marked InventoryOnly.
+func parseManagedCluster(obj *unstructured.Unstructured) ClusterInfo {
+	info := ClusterInfo{
+		Name:          obj.GetName(),
+		Context:       obj.GetName(),
+		Source:        ClusterSourceInventory,
+		HealthUnknown: true,
+		InventoryOnly: true,
+	}
+	configs, _, _ := unstructured.NestedSlice(obj.Object, "spec", "managedClusterClientConfigs")
+	for _, c := range configs {
+		if cfg, ok := c.(map[string]interface{}); ok {
+			if url, _ := cfg["url"].(string); url != "" {
+				info.Server = url
+				break
+			}
+		}
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["type"] != managedClusterAvailable {
+			continue
+		}
+		status, _ := cond["status"].(string)
+		info.HealthUnknown = status == string(metav1.ConditionUnknown)
+		info.Healthy = status == string(metav1.ConditionTrue)
+	}
+	return info
+}
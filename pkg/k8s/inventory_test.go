@@ -0,0 +1,155 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func newManagedCluster(name, server, available string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cluster.open-cluster-management.io/v1",
+		"kind":       "ManagedCluster",
+		"metadata":   map[string]interface{}{"name": name},
+		"spec": map[string]interface{}{
+			"hubAcceptsClient": true,
+			"managedClusterClientConfigs": []interface{}{
+				map[string]interface{}{"url": server},
+			},
+		},
+	}}
+	if available != "" {
+		obj.Object["status"] = map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "ManagedClusterJoined", "status": "True"},
+				map[string]interface{}{"type": managedClusterAvailable, "status": available},
+			},
+		}
+	}
+	return obj
+}
+
+func TestListClusters_MergesInventory(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.rawConfig = &api.Config{
+		CurrentContext: "hub",
+		Contexts: map[string]*api.Context{
+			"hub":    {Cluster: "hub"},
+			"edge-1": {Cluster: "edge-1"},
+		},
+		Clusters: map[string]*api.Cluster{
+			"hub":    {Server: "https://hub.example:6443"},
+			"edge-1": {Server: "https://edge-1.example:6443"},
+		},
+	}
+	m.dynamicClients["hub"] = dynfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{managedClusterGVR: "ManagedClusterList"},
+		newManagedCluster("edge-1", "https://edge-1.inventory:6443", "True"),
+		newManagedCluster("edge-2", "https://edge-2.example:6443", "False"),
+		newManagedCluster("edge-3", "https://edge-3.example:6443", ""),
+	)
+	m.SetInventoryHub("hub")
+
+	clusters, err := m.ListClusters(context.Background())
+	if err != nil {
+		t.Fatalf("ListClusters: %v", err)
+	}
+	byName := make(map[string]ClusterInfo)
+	for _, c := range clusters {
+		if _, dup := byName[c.Name]; dup {
+			t.Errorf("cluster %s listed twice", c.Name)
+		}
+		byName[c.Name] = c
+	}
+	if len(byName) != 4 {
+		t.Errorf("listed %v, want hub, edge-1, edge-2 and edge-3", clusters)
+	}
+	// edge-1 is in the kubeconfig too; the kubeconfig entry wins.
+	if got := byName["edge-1"]; got.Source != "kubeconfig" || got.Server != "https://edge-1.example:6443" {
+		t.Errorf("edge-1 = %+v, want the kubeconfig entry", got)
+	}
+	if got := byName["edge-2"]; got.Source != ClusterSourceInventory || got.Server != "https://edge-2.example:6443" || got.Healthy || got.HealthUnknown {
+		t.Errorf("edge-2 = %+v, want unhealthy inventory cluster", got)
+	}
+	if got := byName["edge-3"]; got.Source != ClusterSourceInventory || !got.HealthUnknown || !got.InventoryOnly {
+		t.Errorf("edge-3 = %+v, want inventory-only cluster with unknown health", got)
+	}
+
+	healthy, offline, err := m.HealthyClusters(context.Background())
+	if err != nil {
+		t.Fatalf("HealthyClusters: %v", err)
+	}
+	for _, c := range append(healthy, offline...) {
+		if c.InventoryOnly {
+			t.Errorf("HealthyClusters returned inventory-only cluster %s", c.Name)
+		}
+	}
+}
+
+func TestListClusters_InventoryNeverReplacesReachableCluster(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.rawConfig = &api.Config{
+		CurrentContext: "hub",
+		Contexts: map[string]*api.Context{
+			"hub":           {Cluster: "hub"},
+			"kind-cluster1": {Cluster: "kind-cluster1"},
+		},
+		Clusters: map[string]*api.Cluster{
+			"hub":           {Server: "https://hub.example:6443"},
+			"kind-cluster1": {Server: "https://cluster1.example:6443"},
+		},
+	}
+	// Same server as kind-cluster1, under a shorter name.
+	m.dynamicClients["hub"] = dynfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{managedClusterGVR: "ManagedClusterList"},
+		newManagedCluster("cluster1", "https://cluster1.example:6443", "True"),
+	)
+	m.SetInventoryHub("hub")
+
+	clusters, err := m.DeduplicatedClusters(context.Background())
+	if err != nil {
+		t.Fatalf("DeduplicatedClusters: %v", err)
+	}
+	var names []string
+	for _, c := range clusters {
+		names = append(names, c.Name)
+	}
+	if len(names) != 2 || names[0] != "hub" || names[1] != "kind-cluster1" {
+		t.Errorf("clusters = %v, want [hub kind-cluster1]", names)
+	}
+}
+
+func TestIsBetterCluster_PrefersQueryable(t *testing.T) {
+	reachable := ClusterInfo{Name: "kind-cluster1"}
+	inventory := ClusterInfo{Name: "cluster1", InventoryOnly: true}
+	if isBetterCluster(inventory, reachable) {
+		t.Error("an inventory-only cluster must not replace a queryable one")
+	}
+	if !isBetterCluster(reachable, inventory) {
+		t.Error("a queryable cluster should replace an inventory-only one")
+	}
+}
+
+func TestListClusters_InventoryWithoutHub(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.rawConfig = &api.Config{
+		Contexts: map[string]*api.Context{"kind-dev": {Cluster: "kind-dev"}},
+		Clusters: map[string]*api.Cluster{"kind-dev": {Server: "https://127.0.0.1:6443"}},
+	}
+	m.SetInventoryHub("")
+
+	clusters, err := m.ListClusters(context.Background())
+	if err != nil {
+		t.Fatalf("ListClusters: %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].Name != "kind-dev" {
+		t.Errorf("clusters = %+v, want only kind-dev", clusters)
+	}
+}
@@ -0,0 +1,74 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetJobLogs returns the logs of every pod the Job has run, keyed by pod
+// name. Pods are found through the Job's selector, so retried (failed) and
+// completed pods are included as long as they still exist. Pending pods have
+// no logs yet and are skipped. A pod whose logs cannot be read (e.g. it was
+// evicted and its node is gone) is left out rather than failing the call,
+// unless no pod's logs could be read at all.
+func (m *MultiClusterClient) GetJobLogs(ctx context.Context, contextName, namespace, jobName string, tailLines int64) (_ map[string]string, err error) {
+	defer observeClusterRequest(contextName, "GetJobLogs", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := client.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if job.Spec.Selector == nil {
+		return nil, fmt.Errorf("job %s/%s has no pod selector", namespace, jobName)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on job %s/%s: %w", namespace, jobName, err)
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	opts := corev1.PodLogOptions{}
+	if tailLines > 0 {
+		opts.TailLines = &tailLines
+	}
+
+	logs := make(map[string]string, len(pods.Items))
+	var firstErr error
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodPending {
+			continue
+		}
+		podOpts := opts
+		// Job pods may carry sidecars; the first container is the job's own.
+		if len(pod.Spec.Containers) > 1 {
+			podOpts.Container = pod.Spec.Containers[0].Name
+		}
+		raw, err := client.CoreV1().Pods(namespace).GetLogs(pod.Name, &podOpts).DoRaw(ctx)
+		if err != nil {
+			slog.Warn("[GetJobLogs] failed to read pod logs", "cluster", contextName, "namespace", namespace, "job", jobName, "pod", pod.Name, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		logs[pod.Name] = string(raw)
+	}
+
+	if len(logs) == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+	return logs, nil
+}
@@ -0,0 +1,68 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetJobLogs_AggregatesPods(t *testing.T) {
+	selector := map[string]string{"batch.kubernetes.io/job-name": "migrate"}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "default"},
+		Spec:       batchv1.JobSpec{Selector: &metav1.LabelSelector{MatchLabels: selector}},
+	}
+	jobPod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: selector},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "migrate"}}},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	other := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default", Labels: map[string]string{"app": "web"}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = k8sfake.NewSimpleClientset(job,
+		jobPod("migrate-failed", corev1.PodFailed),
+		jobPod("migrate-done", corev1.PodSucceeded),
+		jobPod("migrate-pending", corev1.PodPending),
+		other,
+	)
+
+	logs, err := m.GetJobLogs(context.Background(), "c1", "default", "migrate", 50)
+	if err != nil {
+		t.Fatalf("GetJobLogs: %v", err)
+	}
+	if len(logs) != 2 {
+		t.Fatalf("logs keyed by %v, want the failed and completed pods", logKeys(logs))
+	}
+	for _, name := range []string{"migrate-failed", "migrate-done"} {
+		if logs[name] == "" {
+			t.Errorf("missing logs for pod %s", name)
+		}
+	}
+}
+
+func TestGetJobLogs_JobNotFound(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = k8sfake.NewSimpleClientset()
+
+	if _, err := m.GetJobLogs(context.Background(), "c1", "default", "missing", 0); err == nil {
+		t.Fatal("expected error for missing job")
+	}
+}
+
+func logKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,116 @@
+package k8s
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Cardinality caps for GetClusterLabelTaxonomy. Labels such as hostnames or
+// instance IDs would otherwise turn the autocomplete list into a dump of
+// every node in the fleet.
+const (
+	maxTaxonomyKeys           = 200
+	maxTaxonomyValuesPerLabel = 50
+)
+
+// GetClusterLabelTaxonomy returns every node label key found across the
+// healthy clusters with the distinct values it takes, for autocomplete in the
+// cluster-group query builder (whose label selector matches node labels).
+// Labels are filtered like GetNodes. Keys and values are sorted before the
+// caps (maxTaxonomyKeys, maxTaxonomyValuesPerLabel) are applied, so the same
+// fleet always yields the same taxonomy. Only node labels are offered: the
+// group query's label selector is matched against them and nothing else.
+// Clusters that fail to list their nodes are skipped.
+func (m *MultiClusterClient) GetClusterLabelTaxonomy(ctx context.Context) (map[string][]string, error) {
+	clusters, _, err := m.HealthyClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	values := make(map[string]map[string]struct{})
+	for _, cl := range clusters {
+		wg.Add(1)
+		go func(clusterName string) {
+			defer wg.Done()
+			clusterCtx, cancel := context.WithTimeout(ctx, perClusterHealthTimeout)
+			defer cancel()
+			nodeLabels, err := m.clusterNodeLabels(clusterCtx, clusterName)
+			if err != nil {
+				slog.Warn("[LabelTaxonomy] skipping cluster", "cluster", clusterName, "error", err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, set := range nodeLabels {
+				for k, v := range filterNodeLabels(set) {
+					addTaxonomyValue(values, k, v)
+				}
+			}
+		}(cl.Name)
+	}
+	wg.Wait()
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) > maxTaxonomyKeys {
+		keys = keys[:maxTaxonomyKeys]
+	}
+	taxonomy := make(map[string][]string, len(keys))
+	for _, k := range keys {
+		taxonomy[k] = sortedTaxonomyValues(values[k])
+	}
+	return taxonomy, nil
+}
+
+// addTaxonomyValue records value under key, ignoring empty values.
+func addTaxonomyValue(values map[string]map[string]struct{}, key, value string) {
+	if value == "" {
+		return
+	}
+	set, ok := values[key]
+	if !ok {
+		set = make(map[string]struct{})
+		values[key] = set
+	}
+	set[value] = struct{}{}
+}
+
+// sortedTaxonomyValues returns the sorted values of set, capped at
+// maxTaxonomyValuesPerLabel.
+func sortedTaxonomyValues(set map[string]struct{}) []string {
+	list := make([]string, 0, len(set))
+	for v := range set {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	if len(list) > maxTaxonomyValuesPerLabel {
+		list = list[:maxTaxonomyValuesPerLabel]
+	}
+	return list
+}
+
+// clusterNodeLabels returns the labels of every node in a cluster.
+func (m *MultiClusterClient) clusterNodeLabels(ctx context.Context, contextName string) ([]map[string]string, error) {
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]map[string]string, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		result = append(result, node.Labels)
+	}
+	return result, nil
+}
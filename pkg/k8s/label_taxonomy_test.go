@@ -0,0 +1,90 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func taxonomyTestNode(name string, labels map[string]string) *corev1.Node {
+	return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestGetClusterLabelTaxonomy_MergesClusters(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "east", "west")
+	m.InjectClient("east", fake.NewSimpleClientset(
+		taxonomyTestNode("e1", map[string]string{
+			"env":                         "prod",
+			"topology.kubernetes.io/zone": "us-east-1a",
+			"kubernetes.io/hostname":      "e1",
+		}),
+		taxonomyTestNode("e2", map[string]string{"env": "prod", "gpu": "a100"}),
+	))
+	m.InjectClient("west", fake.NewSimpleClientset(
+		taxonomyTestNode("w1", map[string]string{
+			"env":                              "staging",
+			"topology.kubernetes.io/zone":      "us-west-2b",
+			"node.kubernetes.io/instance-type": "m5.large",
+		}),
+	))
+
+	taxonomy, err := m.GetClusterLabelTaxonomy(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"prod", "staging"}, taxonomy["env"])
+	assert.Equal(t, []string{"us-east-1a", "us-west-2b"}, taxonomy["topology.kubernetes.io/zone"])
+	assert.Equal(t, []string{"a100"}, taxonomy["gpu"])
+	assert.NotContains(t, taxonomy, "kubernetes.io/hostname", "system labels are filtered like GetNodes")
+	assert.NotContains(t, taxonomy, "node.kubernetes.io/instance-type")
+	assert.Len(t, taxonomy, 3, "only node labels the group selector can match are offered")
+}
+
+func TestGetClusterLabelTaxonomy_CapsValues(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "big")
+	client := fake.NewSimpleClientset()
+	for i := 0; i < maxTaxonomyValuesPerLabel+10; i++ {
+		_, err := client.CoreV1().Nodes().Create(context.Background(),
+			taxonomyTestNode(fmt.Sprintf("n%d", i), map[string]string{"instance-id": fmt.Sprintf("i-%03d", i)}),
+			metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	m.InjectClient("big", client)
+
+	taxonomy, err := m.GetClusterLabelTaxonomy(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, taxonomy["instance-id"], maxTaxonomyValuesPerLabel)
+	assert.Equal(t, "i-000", taxonomy["instance-id"][0], "the lowest values are kept")
+	assert.Equal(t, fmt.Sprintf("i-%03d", maxTaxonomyValuesPerLabel-1), taxonomy["instance-id"][maxTaxonomyValuesPerLabel-1])
+}
+
+func TestGetClusterLabelTaxonomy_CapsKeysDeterministically(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "a", "b")
+	// Spread the keys over two clusters and many nodes so the order in which
+	// they are discovered varies between runs.
+	for _, cluster := range []string{"a", "b"} {
+		client := fake.NewSimpleClientset()
+		for i := 0; i < maxTaxonomyKeys; i++ {
+			_, err := client.CoreV1().Nodes().Create(context.Background(),
+				taxonomyTestNode(fmt.Sprintf("n%d", i), map[string]string{fmt.Sprintf("%s-key-%03d", cluster, i): "v"}),
+				metav1.CreateOptions{})
+			require.NoError(t, err)
+		}
+		m.InjectClient(cluster, client)
+	}
+
+	taxonomy, err := m.GetClusterLabelTaxonomy(context.Background())
+	require.NoError(t, err)
+	for i := 0; i < maxTaxonomyKeys; i++ {
+		assert.Contains(t, taxonomy, fmt.Sprintf("a-key-%03d", i))
+	}
+	assert.NotContains(t, taxonomy, "b-key-000", "keys beyond the cap are dropped in sorted order")
+}
@@ -0,0 +1,168 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/client-go/rest"
+)
+
+// ClusterSourceManual is the ClusterInfo.Source of clusters registered with
+// AddCluster.
+const ClusterSourceManual = "manual"
+
+// ErrClusterNameTaken is returned by AddCluster for a name already used by
+// a kubeconfig context or the in-cluster config.
+var ErrClusterNameTaken = errors.New("cluster name is already in use")
+
+// manualClusterSet holds the clusters registered with AddCluster.
+// Impersonating views share their parent's set, so a cluster registered
+// after a view was created is visible through it too.
+type manualClusterSet struct {
+	mu      sync.RWMutex
+	configs map[string]*rest.Config
+}
+
+func newManualClusterSet() *manualClusterSet {
+	return &manualClusterSet{configs: make(map[string]*rest.Config)}
+}
+
+// get returns a copy of the config registered as name.
+func (s *manualClusterSet) get(name string) (*rest.Config, bool) {
+	if s == nil {
+		return nil, false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cfg, ok := s.configs[name]
+	if !ok {
+		return nil, false
+	}
+	return rest.CopyConfig(cfg), true
+}
+
+// list returns the registered clusters in name order.
+func (s *manualClusterSet) list() []ClusterInfo {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	clusters := make([]ClusterInfo, 0, len(s.configs))
+	for name, cfg := range s.configs {
+		clusters = append(clusters, ClusterInfo{
+			Name:       name,
+			Context:    name,
+			Server:     cfg.Host,
+			AuthMethod: restConfigAuthMethod(cfg),
+			Source:     ClusterSourceManual,
+		})
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Name < clusters[j].Name })
+	return clusters
+}
+
+// restConfigAuthMethod is authMethodOf for a rest.Config.
+func restConfigAuthMethod(cfg *rest.Config) string {
+	switch {
+	case cfg.ExecProvider != nil:
+		return "exec"
+	case cfg.BearerToken != "" || cfg.BearerTokenFile != "":
+		return "token"
+	case len(cfg.CertData) > 0 || cfg.CertFile != "":
+		return "certificate"
+	case cfg.AuthProvider != nil:
+		return "auth-provider"
+	default:
+		return "unknown"
+	}
+}
+
+// AddCluster registers a cluster that is not in the kubeconfig, e.g. an API
+// server and bearer token read from a Secret, or a cluster discovered from
+// an inventory. It is listed by ListClusters with source "manual" and is
+// reachable through GetClient, GetDynamicClient and every per-cluster call
+// under name. Registered clusters survive kubeconfig reloads; adding an
+// existing manual cluster again replaces its config. cfg is copied.
+func (m *MultiClusterClient) AddCluster(name string, cfg *rest.Config) error {
+	if name == "" {
+		return fmt.Errorf("cluster name is required")
+	}
+	if cfg == nil || cfg.Host == "" {
+		return fmt.Errorf("cluster %s: config with a host is required", name)
+	}
+
+	m.mu.Lock()
+	if m.kubeconfigHasContextLocked(name) || (m.inClusterConfig != nil && (name == "in-cluster" || name == m.inClusterName)) {
+		m.mu.Unlock()
+		return fmt.Errorf("%w: %s", ErrClusterNameTaken, name)
+	}
+	if m.manualClusters == nil {
+		m.manualClusters = newManualClusterSet()
+	}
+	m.manualClusters.mu.Lock()
+	prev, replaced := m.manualClusters.configs[name]
+	m.manualClusters.configs[name] = rest.CopyConfig(cfg)
+	m.manualClusters.mu.Unlock()
+	m.forgetManualClusterLocked(name)
+	m.mu.Unlock()
+
+	// Build the client now so a malformed config fails here, not on first use.
+	if _, err := m.GetClient(name); err != nil {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.manualClusters.mu.Lock()
+		if replaced {
+			m.manualClusters.configs[name] = prev
+		} else {
+			delete(m.manualClusters.configs, name)
+		}
+		m.manualClusters.mu.Unlock()
+		m.forgetManualClusterLocked(name)
+		return err
+	}
+	return nil
+}
+
+// RemoveCluster unregisters a cluster added with AddCluster. It reports
+// whether name was registered.
+func (m *MultiClusterClient) RemoveCluster(name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.manualClusters == nil {
+		return false
+	}
+	m.manualClusters.mu.Lock()
+	_, ok := m.manualClusters.configs[name]
+	delete(m.manualClusters.configs, name)
+	m.manualClusters.mu.Unlock()
+	if ok {
+		m.forgetManualClusterLocked(name)
+	}
+	return ok
+}
+
+// forgetManualClusterLocked drops the cached clients of a manual cluster,
+// including those of impersonating views; the views themselves and their
+// clients of other clusters are kept. Callers must hold m.mu.
+func (m *MultiClusterClient) forgetManualClusterLocked(name string) {
+	m.forgetContextLocked(name)
+	for _, entry := range m.impersonated {
+		view := entry.client
+		view.mu.Lock()
+		view.forgetContextLocked(name)
+		view.mu.Unlock()
+	}
+}
+
+// kubeconfigHasContextLocked reports whether the loaded kubeconfig has a
+// context called name. Callers must hold m.mu.
+func (m *MultiClusterClient) kubeconfigHasContextLocked(name string) bool {
+	if m.rawConfig == nil {
+		return false
+	}
+	_, ok := m.rawConfig.Contexts[name]
+	return ok
+}
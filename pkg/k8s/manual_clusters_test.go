@@ -0,0 +1,96 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestAddCluster_ListedAndReachable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer secret-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api/v1/namespaces/default/pods" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&corev1.PodList{
+			TypeMeta: metav1.TypeMeta{Kind: "PodList", APIVersion: "v1"},
+			Items: []corev1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+			}},
+		})
+	}))
+	defer srv.Close()
+
+	m, _ := NewMultiClusterClient("")
+	m.rawConfig = &api.Config{
+		Contexts: map[string]*api.Context{"kind-dev": {Cluster: "kind-dev"}},
+		Clusters: map[string]*api.Cluster{"kind-dev": {Server: "https://127.0.0.1:6443"}},
+	}
+	if err := m.AddCluster("edge-1", &rest.Config{Host: srv.URL, BearerToken: "secret-token"}); err != nil {
+		t.Fatalf("AddCluster: %v", err)
+	}
+
+	clusters, err := m.ListClusters(context.Background())
+	if err != nil {
+		t.Fatalf("ListClusters: %v", err)
+	}
+	var found *ClusterInfo
+	for i := range clusters {
+		if clusters[i].Name == "edge-1" {
+			found = &clusters[i]
+		}
+	}
+	if found == nil {
+		t.Fatalf("edge-1 not listed in %+v", clusters)
+	}
+	if found.Source != ClusterSourceManual || found.Server != srv.URL || found.AuthMethod != "token" {
+		t.Errorf("edge-1 = %+v, want source manual, server %s, auth token", *found, srv.URL)
+	}
+	if len(clusters) != 2 {
+		t.Errorf("listed %d clusters, want 2", len(clusters))
+	}
+
+	pods, err := m.GetPods(context.Background(), "edge-1", "default")
+	if err != nil {
+		t.Fatalf("GetPods: %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "web" {
+		t.Errorf("pods = %+v, want [web]", pods)
+	}
+
+	if !m.RemoveCluster("edge-1") {
+		t.Fatal("RemoveCluster reported edge-1 as unregistered")
+	}
+	if clusters, _ := m.ListClusters(context.Background()); len(clusters) != 1 {
+		t.Errorf("listed %d clusters after RemoveCluster, want 1", len(clusters))
+	}
+}
+
+func TestAddCluster_Rejects(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.rawConfig = &api.Config{Contexts: map[string]*api.Context{"kind-dev": {Cluster: "kind-dev"}}}
+
+	if err := m.AddCluster("kind-dev", &rest.Config{Host: "https://10.0.0.1:6443"}); !errors.Is(err, ErrClusterNameTaken) {
+		t.Errorf("kubeconfig name: err = %v, want ErrClusterNameTaken", err)
+	}
+	if err := m.AddCluster("edge-1", &rest.Config{}); err == nil {
+		t.Error("expected error for config without host")
+	}
+	if err := m.AddCluster("", &rest.Config{Host: "https://10.0.0.1:6443"}); err == nil {
+		t.Error("expected error for empty name")
+	}
+}
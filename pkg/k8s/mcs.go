@@ -3,13 +3,17 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/kubestellar/console/pkg/api/v1alpha1"
 )
@@ -102,13 +106,15 @@ func (m *MultiClusterClient) ListServiceExportsForCluster(ctx context.Context, c
 		return nil, err
 	}
 
-	var list interface{}
-	if namespace == "" {
-		list, err = dynamicClient.Resource(v1alpha1.ServiceExportGVR).List(ctx, metav1.ListOptions{})
-	} else {
-		list, err = dynamicClient.Resource(v1alpha1.ServiceExportGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-	}
-
+	exports := make([]v1alpha1.ServiceExport, 0)
+	err = eachDynamicPage(ctx, dynamicClient, v1alpha1.ServiceExportGVR, namespace, func(page *unstructured.UnstructuredList) error {
+		parsed, err := m.parseServiceExportsFromList(page, contextName)
+		if err != nil {
+			return err
+		}
+		exports = append(exports, parsed...)
+		return nil
+	})
 	if err != nil {
 		// Only treat "CRD is not installed on this cluster" as a benign empty
 		// list. Real failures (auth, network, server errors) are returned to
@@ -118,8 +124,7 @@ func (m *MultiClusterClient) ListServiceExportsForCluster(ctx context.Context, c
 		}
 		return nil, err
 	}
-
-	return m.parseServiceExportsFromList(list, contextName)
+	return exports, nil
 }
 
 // parseServiceExportsFromList parses ServiceExports from an unstructured list
@@ -194,6 +199,7 @@ func (m *MultiClusterClient) ListServiceImports(ctx context.Context) (*v1alpha1.
 	}
 
 	wg.Wait()
+	m.markServiceImportConflicts(ctx, imports)
 
 	return &v1alpha1.ServiceImportList{
 		Items:         imports,
@@ -209,13 +215,15 @@ func (m *MultiClusterClient) ListServiceImportsForCluster(ctx context.Context, c
 		return nil, err
 	}
 
-	var list interface{}
-	if namespace == "" {
-		list, err = dynamicClient.Resource(v1alpha1.ServiceImportGVR).List(ctx, metav1.ListOptions{})
-	} else {
-		list, err = dynamicClient.Resource(v1alpha1.ServiceImportGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
-	}
-
+	imports := make([]v1alpha1.ServiceImport, 0)
+	err = eachDynamicPage(ctx, dynamicClient, v1alpha1.ServiceImportGVR, namespace, func(page *unstructured.UnstructuredList) error {
+		parsed, err := m.parseServiceImportsFromList(page, contextName)
+		if err != nil {
+			return err
+		}
+		imports = append(imports, parsed...)
+		return nil
+	})
 	if err != nil {
 		// Only treat "CRD is not installed on this cluster" as a benign empty
 		// list. Real failures (auth, network, server errors) are returned to
@@ -225,8 +233,7 @@ func (m *MultiClusterClient) ListServiceImportsForCluster(ctx context.Context, c
 		}
 		return nil, err
 	}
-
-	return m.parseServiceImportsFromList(list, contextName)
+	return imports, nil
 }
 
 // parseServiceImportsFromList parses ServiceImports from an unstructured list
@@ -256,15 +263,21 @@ func (m *MultiClusterClient) parseServiceImportsFromList(list interface{}, conte
 				}
 			}
 
-			// Parse status for source cluster
+			// Parse status for the source clusters
 			if clusters, found, _ := unstructuredNestedSlice(content, "status", "clusters"); found {
-				if len(clusters) > 0 {
-					if cluster, ok := clusters[0].(map[string]interface{}); ok {
-						if name, ok := cluster["cluster"].(string); ok {
+				for _, c := range clusters {
+					cluster, ok := c.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if name, ok := cluster["cluster"].(string); ok && name != "" {
+						if imp.SourceCluster == "" {
 							imp.SourceCluster = name
 						}
+						imp.SourceClusters = append(imp.SourceClusters, name)
 					}
 				}
+				sort.Strings(imp.SourceClusters)
 			}
 
 			// Generate DNS name
@@ -274,6 +287,11 @@ func (m *MultiClusterClient) parseServiceImportsFromList(list interface{}, conte
 			if conditions, found, _ := unstructuredNestedSlice(content, "status", "conditions"); found {
 				imp.Conditions = parseConditions(conditions)
 			}
+			for _, c := range imp.Conditions {
+				if c.Type == mcsConflictCondition && c.Status == "True" {
+					imp.Conflicts = append(imp.Conflicts, conditionText(c))
+				}
+			}
 
 			imports = append(imports, imp)
 		}
@@ -282,6 +300,108 @@ func (m *MultiClusterClient) parseServiceImportsFromList(list interface{}, conte
 	return imports, nil
 }
 
+const (
+	// mcsServiceNameLabel and mcsSourceClusterLabel are set by MCS
+	// implementations on EndpointSlices mirrored for a ServiceImport
+	// (KEP-1645).
+	mcsServiceNameLabel   = "multicluster.kubernetes.io/service-name"
+	mcsSourceClusterLabel = "multicluster.kubernetes.io/source-cluster"
+)
+
+// GetServiceImportEndpoints reports, per source cluster, how many endpoints
+// have been mirrored into contextName for the named ServiceImport and how
+// many of them are ready. Missing MCS CRDs or EndpointSlice support degrade
+// to an empty report instead of an error.
+func (m *MultiClusterClient) GetServiceImportEndpoints(ctx context.Context, contextName, namespace, name string) (*v1alpha1.ServiceImportEndpoints, error) {
+	result := &v1alpha1.ServiceImportEndpoints{
+		Name:      name,
+		Namespace: namespace,
+		Cluster:   contextName,
+		Clusters:  []v1alpha1.ServiceImportClusterEndpoints{},
+	}
+
+	dynamicClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	byCluster := make(map[string]*v1alpha1.ServiceImportClusterEndpoints)
+	clusterEntry := func(source string) *v1alpha1.ServiceImportClusterEndpoints {
+		if e, ok := byCluster[source]; ok {
+			return e
+		}
+		e := &v1alpha1.ServiceImportClusterEndpoints{SourceCluster: source}
+		byCluster[source] = e
+		return e
+	}
+
+	imp, err := dynamicClient.Resource(v1alpha1.ServiceImportGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		result.MCSAvailable = true
+		result.ImportFound = true
+		// Seed with the clusters the import expects so an unmirrored
+		// source shows up with zero slices rather than not at all.
+		if clusters, found, _ := unstructuredNestedSlice(imp.UnstructuredContent(), "status", "clusters"); found {
+			for _, c := range clusters {
+				if cm, ok := c.(map[string]interface{}); ok {
+					if source, ok := cm["cluster"].(string); ok && source != "" {
+						clusterEntry(source)
+					}
+				}
+			}
+		}
+	case isCRDNotInstalled(err):
+		return result, nil
+	case apierrors.IsNotFound(err):
+		result.MCSAvailable = true
+	default:
+		return nil, err
+	}
+
+	slices, err := client.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{mcsServiceNameLabel: name}).String(),
+	})
+	if err != nil {
+		if isCRDNotInstalled(err) || apierrors.IsNotFound(err) {
+			return result, nil
+		}
+		return nil, err
+	}
+
+	for i := range slices.Items {
+		slice := &slices.Items[i]
+		source := slice.Labels[mcsSourceClusterLabel]
+		if source == "" {
+			source = "unknown"
+		}
+		entry := clusterEntry(source)
+		entry.Slices++
+		for _, ep := range slice.Endpoints {
+			// A nil Ready condition means ready, per the EndpointSlice API.
+			if ep.Conditions.Ready == nil || *ep.Conditions.Ready {
+				entry.Ready++
+			} else {
+				entry.NotReady++
+			}
+		}
+	}
+
+	for _, e := range byCluster {
+		result.Clusters = append(result.Clusters, *e)
+		result.TotalReady += e.Ready
+	}
+	sort.Slice(result.Clusters, func(i, j int) bool {
+		return result.Clusters[i].SourceCluster < result.Clusters[j].SourceCluster
+	})
+	result.Reachable = result.TotalReady > 0
+	return result, nil
+}
+
 // CreateServiceExport creates a new ServiceExport to export an existing service
 func (m *MultiClusterClient) CreateServiceExport(ctx context.Context, contextName, namespace, serviceName string) error {
 	dynamicClient, err := m.GetDynamicClient(contextName)
@@ -305,6 +425,34 @@ func (m *MultiClusterClient) CreateServiceExport(ctx context.Context, contextNam
 	return err
 }
 
+// ExportServicesBySelector creates a ServiceExport for every Service in
+// namespace matching labelSelector, so a whole app can join the clusterset at
+// once. It returns the names of the Services it exported; Services that
+// already have a ServiceExport are skipped and not returned.
+func (m *MultiClusterClient) ExportServicesBySelector(ctx context.Context, contextName, namespace, labelSelector string) ([]string, error) {
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	services, err := client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	exported := make([]string, 0, len(services.Items))
+	for _, svc := range services.Items {
+		err := m.CreateServiceExport(ctx, contextName, svc.Namespace, svc.Name)
+		if apierrors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			return exported, fmt.Errorf("failed to export service %s/%s: %w", svc.Namespace, svc.Name, err)
+		}
+		exported = append(exported, svc.Name)
+	}
+	return exported, nil
+}
+
 // DeleteServiceExport deletes a ServiceExport by name
 func (m *MultiClusterClient) DeleteServiceExport(ctx context.Context, contextName, namespace, name string) error {
 	dynamicClient, err := m.GetDynamicClient(contextName)
@@ -400,3 +548,191 @@ func parsePorts(ports []interface{}) []v1alpha1.ServicePort {
 	}
 	return result
 }
+
+// mcsConflictCondition is the condition MCS controllers set when exporting
+// clusters disagree on a service's properties (KEP-1645).
+const mcsConflictCondition = "Conflict"
+
+// conditionText is the message of c, or its reason when it has none.
+func conditionText(c v1alpha1.Condition) string {
+	if c.Message != "" {
+		return c.Message
+	}
+	return c.Reason
+}
+
+// mcsPortLookupConcurrency bounds the exported Service reads made while
+// checking ServiceImports for port conflicts.
+const mcsPortLookupConcurrency = 10
+
+// markServiceImportConflicts compares, for imports that share a namespace
+// and name, the ports of the Service each of their source clusters exports,
+// and appends a Conflicts entry to each of them for every port that is
+// defined differently: a port name mapped to different numbers or
+// protocols, or a port number with different protocols. Such a merged
+// import is ambiguous to consumers. Port sets that merely differ are not a
+// conflict; MCS merges them as a union. A source cluster whose Service
+// cannot be read is left out of the comparison.
+func (m *MultiClusterClient) markServiceImportConflicts(ctx context.Context, imports []v1alpha1.ServiceImport) {
+	type service struct{ source, namespace, name string }
+	groups := make(map[string][]int)
+	sources := make(map[string]map[string]bool)
+	var keys []string
+	for i, imp := range imports {
+		key := imp.Namespace + "/" + imp.Name
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+			sources[key] = make(map[string]bool)
+		}
+		groups[key] = append(groups[key], i)
+		for _, source := range imp.SourceClusters {
+			sources[key][source] = true
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sem := make(chan struct{}, mcsPortLookupConcurrency)
+	ports := make(map[service][]v1alpha1.ServicePort)
+	for _, key := range keys {
+		if len(sources[key]) < 2 {
+			continue
+		}
+		first := imports[groups[key][0]]
+		for source := range sources[key] {
+			svc := service{source: source, namespace: first.Namespace, name: first.Name}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				exported, err := m.exportedServicePorts(ctx, svc.source, svc.namespace, svc.name)
+				if err != nil {
+					slog.Debug("[MCS] skipping source cluster in port conflict check", "cluster", svc.source, "service", svc.namespace+"/"+svc.name, "error", err)
+					return
+				}
+				mu.Lock()
+				ports[svc] = exported
+				mu.Unlock()
+			}()
+		}
+	}
+	wg.Wait()
+
+	for _, key := range keys {
+		first := imports[groups[key][0]]
+		portsBySource := make(map[string][]v1alpha1.ServicePort)
+		for source := range sources[key] {
+			if p, ok := ports[service{source: source, namespace: first.Namespace, name: first.Name}]; ok {
+				portsBySource[source] = p
+			}
+		}
+		conflicts := servicePortConflicts(portsBySource)
+		if len(conflicts) == 0 {
+			continue
+		}
+		for _, i := range groups[key] {
+			imports[i].Conflicts = append(imports[i].Conflicts, conflicts...)
+		}
+	}
+}
+
+// exportedServicePorts returns the ports of the Service namespace/name in
+// contextName, the definition an MCS ServiceExport of it publishes.
+func (m *MultiClusterClient) exportedServicePorts(ctx context.Context, contextName, namespace, name string) ([]v1alpha1.ServicePort, error) {
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := client.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ports := make([]v1alpha1.ServicePort, 0, len(svc.Spec.Ports))
+	for _, p := range svc.Spec.Ports {
+		protocol := string(p.Protocol)
+		if protocol == "" {
+			protocol = "TCP"
+		}
+		port := v1alpha1.ServicePort{Name: p.Name, Protocol: protocol, Port: p.Port}
+		if p.AppProtocol != nil {
+			port.AppProtocol = *p.AppProtocol
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// servicePortConflicts returns the conflicts among the ports each source
+// cluster exports.
+func servicePortConflicts(portsBySource map[string][]v1alpha1.ServicePort) []string {
+	if len(portsBySource) < 2 {
+		return nil
+	}
+
+	// byName: port name -> "port/protocol" -> sources; byNumber: port ->
+	// protocol -> sources.
+	byName := make(map[string]map[string][]string)
+	byNumber := make(map[int32]map[string][]string)
+	add := func(m map[string][]string, def, source string) {
+		m[def] = append(m[def], source)
+	}
+	sources := make([]string, 0, len(portsBySource))
+	for source := range portsBySource {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		for _, p := range portsBySource[source] {
+			def := fmt.Sprintf("%d/%s", p.Port, p.Protocol)
+			if p.Name != "" {
+				if byName[p.Name] == nil {
+					byName[p.Name] = make(map[string][]string)
+				}
+				add(byName[p.Name], def, source)
+			}
+			if byNumber[p.Port] == nil {
+				byNumber[p.Port] = make(map[string][]string)
+			}
+			add(byNumber[p.Port], p.Protocol, source)
+		}
+	}
+
+	var conflicts []string
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if len(byName[name]) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("port %q: %s", name, describePortDefs(byName[name])))
+		}
+	}
+	numbers := make([]int32, 0, len(byNumber))
+	for n := range byNumber {
+		numbers = append(numbers, n)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	for _, n := range numbers {
+		if len(byNumber[n]) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("port %d protocol: %s", n, describePortDefs(byNumber[n])))
+		}
+	}
+	return conflicts
+}
+
+// describePortDefs renders definition -> sources as "def (a, b); def (c)",
+// sorted by definition.
+func describePortDefs(defs map[string][]string) string {
+	keys := make([]string, 0, len(defs))
+	for def := range defs {
+		keys = append(keys, def)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, def := range keys {
+		parts = append(parts, def+" ("+strings.Join(defs[def], ", ")+")")
+	}
+	return strings.Join(parts, "; ")
+}
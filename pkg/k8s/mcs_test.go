@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -331,6 +333,107 @@ func TestMCS_ListServiceImports(t *testing.T) {
 	}
 }
 
+func TestMCS_ListServiceImports_PortConflicts(t *testing.T) {
+	serviceImport := func(sources ...string) *unstructured.Unstructured {
+		clusters := make([]interface{}, 0, len(sources))
+		for _, source := range sources {
+			clusters = append(clusters, map[string]interface{}{"cluster": source})
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "multicluster.x-k8s.io/v1alpha1",
+			"kind":       "ServiceImport",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+			"spec": map[string]interface{}{
+				"type": "ClusterSetIP",
+				"ports": []interface{}{
+					map[string]interface{}{"name": "http", "protocol": "TCP", "port": int64(80)},
+				},
+			},
+			"status": map[string]interface{}{"clusters": clusters},
+		}}
+	}
+	exported := func(port int32) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+				{Name: "http", Protocol: corev1.ProtocolTCP, Port: port},
+			}},
+		}
+	}
+
+	m, _ := NewMultiClusterClient("")
+	// Both consumers import the same merged service, listing its sources
+	// in different orders.
+	m.dynamicClients = map[string]dynamic.Interface{
+		"c1": dynamicfake.NewSimpleDynamicClient(setupScheme(), serviceImport("east", "west")),
+		"c2": dynamicfake.NewSimpleDynamicClient(setupScheme(), serviceImport("west", "east")),
+	}
+	injectTestClusters(m, "c1", "c2")
+	m.SetClient("east", typedfake.NewSimpleClientset(exported(80)))
+	m.SetClient("west", typedfake.NewSimpleClientset(exported(8080)))
+
+	got, err := m.ListServiceImports(context.Background())
+	if err != nil {
+		t.Fatalf("ListServiceImports failed: %v", err)
+	}
+	if len(got.Items) != 2 {
+		t.Fatalf("expected 2 imports, got %d", len(got.Items))
+	}
+	want := `port "http": 80/TCP (east); 8080/TCP (west)`
+	for _, imp := range got.Items {
+		if fmt.Sprint(imp.SourceClusters) != "[east west]" {
+			t.Errorf("%s sourceClusters = %v, want [east west]", imp.Cluster, imp.SourceClusters)
+		}
+		if len(imp.Conflicts) != 1 || imp.Conflicts[0] != want {
+			t.Errorf("%s conflicts = %q, want [%q]", imp.Cluster, imp.Conflicts, want)
+		}
+	}
+}
+
+func TestServicePortConflicts(t *testing.T) {
+	tests := []struct {
+		name  string
+		ports map[string][]v1alpha1.ServicePort
+		want  []string
+	}{
+		{
+			name: "identical ports",
+			ports: map[string][]v1alpha1.ServicePort{
+				"a": {{Port: 53, Protocol: "UDP"}},
+				"b": {{Port: 53, Protocol: "UDP"}},
+			},
+		},
+		{
+			name: "protocol mismatch",
+			ports: map[string][]v1alpha1.ServicePort{
+				"a": {{Port: 53, Protocol: "UDP"}},
+				"b": {{Port: 53, Protocol: "TCP"}},
+			},
+			want: []string{"port 53 protocol: TCP (b); UDP (a)"},
+		},
+		{
+			name: "port sets merged as a union",
+			ports: map[string][]v1alpha1.ServicePort{
+				"a": {{Port: 53, Protocol: "UDP"}},
+				"b": {{Port: 53, Protocol: "UDP"}, {Port: 9153, Protocol: "TCP"}},
+			},
+		},
+		{
+			name: "single source",
+			ports: map[string][]v1alpha1.ServicePort{
+				"a": {{Name: "dns", Port: 53, Protocol: "UDP"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := servicePortConflicts(tt.ports); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("conflicts = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMCS_CreateServiceExport(t *testing.T) {
 	scheme := setupScheme()
 	fakeDyn := dynamicfake.NewSimpleDynamicClient(scheme)
@@ -354,6 +457,49 @@ func TestMCS_CreateServiceExport(t *testing.T) {
 	}
 }
 
+func TestMCS_ExportServicesBySelector(t *testing.T) {
+	service := func(name string, labels map[string]string) *corev1.Service {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+	}
+	app := map[string]string{"app": "shop"}
+	typed := typedfake.NewSimpleClientset(
+		service("frontend", app),
+		service("cart", app),
+		service("checkout", app),
+		service("unrelated", map[string]string{"app": "other"}),
+	)
+	fakeDyn := dynamicfake.NewSimpleDynamicClient(setupScheme())
+
+	m, _ := NewMultiClusterClient("")
+	m.clients = map[string]kubernetes.Interface{"c1": typed}
+	m.dynamicClients = map[string]dynamic.Interface{"c1": fakeDyn}
+
+	exported, err := m.ExportServicesBySelector(context.Background(), "c1", "default", "app=shop")
+	if err != nil {
+		t.Fatalf("ExportServicesBySelector failed: %v", err)
+	}
+	if len(exported) != 3 {
+		t.Fatalf("expected 3 exported services, got %v", exported)
+	}
+	for _, name := range []string{"frontend", "cart", "checkout"} {
+		if _, err := fakeDyn.Resource(v1alpha1.ServiceExportGVR).Namespace("default").Get(context.Background(), name, metav1.GetOptions{}); err != nil {
+			t.Errorf("expected ServiceExport %s: %v", name, err)
+		}
+	}
+	if _, err := fakeDyn.Resource(v1alpha1.ServiceExportGVR).Namespace("default").Get(context.Background(), "unrelated", metav1.GetOptions{}); err == nil {
+		t.Error("service not matching the selector should not be exported")
+	}
+
+	// A second run skips the services that are already exported.
+	exported, err = m.ExportServicesBySelector(context.Background(), "c1", "default", "app=shop")
+	if err != nil {
+		t.Fatalf("second ExportServicesBySelector failed: %v", err)
+	}
+	if len(exported) != 0 {
+		t.Errorf("expected already exported services to be skipped, got %v", exported)
+	}
+}
+
 func TestMCS_DeleteServiceExport(t *testing.T) {
 	scheme := setupScheme()
 	fakeDyn := dynamicfake.NewSimpleDynamicClient(scheme)
@@ -713,3 +859,98 @@ func TestParseServiceImportsFromList_NonUnstructuredList(t *testing.T) {
 		t.Errorf("expected 0 imports for string input, got %d", len(result))
 	}
 }
+
+func TestMCS_GetServiceImportEndpoints(t *testing.T) {
+	imp := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "multicluster.x-k8s.io/v1alpha1",
+			"kind":       "ServiceImport",
+			"metadata": map[string]interface{}{
+				"name":      "web",
+				"namespace": "default",
+			},
+			"spec": map[string]interface{}{"type": "ClusterSetIP"},
+			"status": map[string]interface{}{
+				"clusters": []interface{}{
+					map[string]interface{}{"cluster": "east"},
+					map[string]interface{}{"cluster": "west"},
+					map[string]interface{}{"cluster": "north"},
+				},
+			},
+		},
+	}
+	ready, notReady := true, false
+	mirrored := func(name, source string, readiness ...*bool) *discoveryv1.EndpointSlice {
+		slice := &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					mcsServiceNameLabel:   "web",
+					mcsSourceClusterLabel: source,
+				},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+		}
+		for _, r := range readiness {
+			slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discoveryv1.EndpointConditions{Ready: r},
+			})
+		}
+		return slice
+	}
+	unrelated := mirrored("other", "east", &ready)
+	unrelated.Labels[mcsServiceNameLabel] = "other"
+
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients = map[string]dynamic.Interface{"c1": dynamicfake.NewSimpleDynamicClient(setupScheme(), imp)}
+	m.clients = map[string]kubernetes.Interface{"c1": typedfake.NewSimpleClientset(
+		mirrored("web-east", "east", &ready, nil),
+		mirrored("web-west", "west", &notReady),
+		unrelated,
+	)}
+
+	got, err := m.GetServiceImportEndpoints(context.Background(), "c1", "default", "web")
+	if err != nil {
+		t.Fatalf("GetServiceImportEndpoints failed: %v", err)
+	}
+	if !got.MCSAvailable || !got.ImportFound {
+		t.Fatalf("expected MCS available and import found, got %+v", got)
+	}
+	want := []v1alpha1.ServiceImportClusterEndpoints{
+		{SourceCluster: "east", Slices: 1, Ready: 2},
+		{SourceCluster: "north"},
+		{SourceCluster: "west", Slices: 1, NotReady: 1},
+	}
+	if len(got.Clusters) != len(want) {
+		t.Fatalf("expected %d clusters, got %+v", len(want), got.Clusters)
+	}
+	for i := range want {
+		if got.Clusters[i] != want[i] {
+			t.Errorf("cluster %d = %+v, want %+v", i, got.Clusters[i], want[i])
+		}
+	}
+	if got.TotalReady != 2 || !got.Reachable {
+		t.Errorf("expected 2 ready endpoints and reachable, got %d / %v", got.TotalReady, got.Reachable)
+	}
+}
+
+func TestMCS_GetServiceImportEndpoints_NoMCS(t *testing.T) {
+	fakeDyn := dynamicfake.NewSimpleDynamicClient(setupScheme())
+	fakeDyn.PrependReactor("get", "serviceimports", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("the server could not find the requested resource")
+	})
+
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients = map[string]dynamic.Interface{"c1": fakeDyn}
+	m.clients = map[string]kubernetes.Interface{"c1": typedfake.NewSimpleClientset()}
+
+	got, err := m.GetServiceImportEndpoints(context.Background(), "c1", "default", "web")
+	if err != nil {
+		t.Fatalf("missing MCS CRDs should degrade, got error %v", err)
+	}
+	if got.MCSAvailable || got.Reachable || len(got.Clusters) != 0 {
+		t.Errorf("expected an empty report, got %+v", got)
+	}
+}
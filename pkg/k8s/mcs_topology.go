@@ -0,0 +1,171 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/kubestellar/console/pkg/api/v1alpha1"
+)
+
+// mcsTopologyService accumulates exporting and importing clusters for one
+// namespace/name while GetMCSTopology walks the clusterset.
+type mcsTopologyService struct {
+	name, namespace string
+	exportedFrom    map[string]bool
+	importedInto    map[string]bool
+}
+
+// GetMCSTopology correlates ServiceExports and ServiceImports by
+// namespace/name across every cluster into a graph of "service X exported
+// from [A, B], imported into [C, D]". Clusters without the MCS CRDs are
+// listed in SkippedClusters; per-cluster list failures in ClusterErrors.
+func (m *MultiClusterClient) GetMCSTopology(ctx context.Context) (*v1alpha1.MCSTopology, error) {
+	dedupClusters, err := m.DeduplicatedClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	services := make(map[string]*mcsTopologyService)
+	skipped := make([]string, 0)
+	clusterErrors := make([]v1alpha1.MCSClusterError, 0)
+
+	service := func(namespace, name string) *mcsTopologyService {
+		key := namespace + "/" + name
+		svc, ok := services[key]
+		if !ok {
+			svc = &mcsTopologyService{
+				name:         name,
+				namespace:    namespace,
+				exportedFrom: make(map[string]bool),
+				importedInto: make(map[string]bool),
+			}
+			services[key] = svc
+		}
+		return svc
+	}
+	recordError := func(cluster string, err error) {
+		mu.Lock()
+		clusterErrors = append(clusterErrors, v1alpha1.MCSClusterError{
+			Cluster:   cluster,
+			ErrorType: "list_failed",
+			Message:   err.Error(),
+		})
+		mu.Unlock()
+	}
+
+	for _, c := range dedupClusters {
+		wg.Add(1)
+		go func(cluster string) {
+			defer wg.Done()
+
+			if !m.IsMCSAvailable(ctx, cluster) {
+				mu.Lock()
+				skipped = append(skipped, cluster)
+				mu.Unlock()
+				return
+			}
+
+			exports, err := m.ListServiceExportsForCluster(ctx, cluster, "")
+			if err != nil {
+				recordError(cluster, err)
+				return
+			}
+			imports, err := m.ListServiceImportsForCluster(ctx, cluster, "")
+			if err != nil {
+				recordError(cluster, err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, e := range exports {
+				service(e.Namespace, e.Name).exportedFrom[cluster] = true
+			}
+			for _, i := range imports {
+				service(i.Namespace, i.Name).importedInto[cluster] = true
+			}
+		}(c.Name)
+	}
+	wg.Wait()
+
+	return buildMCSTopology(services, skipped, clusterErrors), nil
+}
+
+// buildMCSTopology turns the per-service cluster sets into sorted services
+// plus the node and edge lists the UI graph renders.
+func buildMCSTopology(services map[string]*mcsTopologyService, skipped []string, clusterErrors []v1alpha1.MCSClusterError) *v1alpha1.MCSTopology {
+	topo := &v1alpha1.MCSTopology{
+		Services:      make([]v1alpha1.MCSTopologyService, 0, len(services)),
+		Nodes:         make([]v1alpha1.MCSTopologyNode, 0),
+		Edges:         make([]v1alpha1.MCSTopologyEdge, 0),
+		ClusterErrors: clusterErrors,
+	}
+	sort.Strings(skipped)
+	if len(skipped) > 0 {
+		topo.SkippedClusters = skipped
+	}
+
+	keys := make([]string, 0, len(services))
+	for key := range services {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	clusterNodes := make(map[string]bool)
+	for _, key := range keys {
+		svc := services[key]
+		entry := v1alpha1.MCSTopologyService{
+			Name:         svc.name,
+			Namespace:    svc.namespace,
+			DNSName:      svc.name + "." + svc.namespace + ".svc.clusterset.local",
+			ExportedFrom: sortedKeys(svc.exportedFrom),
+			ImportedInto: sortedKeys(svc.importedInto),
+		}
+		topo.Services = append(topo.Services, entry)
+
+		serviceID := "service:" + key
+		topo.Nodes = append(topo.Nodes, v1alpha1.MCSTopologyNode{
+			ID:    serviceID,
+			Type:  v1alpha1.MCSTopologyNodeService,
+			Label: key,
+		})
+		for _, cluster := range entry.ExportedFrom {
+			clusterNodes[cluster] = true
+			topo.Edges = append(topo.Edges, v1alpha1.MCSTopologyEdge{
+				Source: "cluster:" + cluster,
+				Target: serviceID,
+				Type:   v1alpha1.MCSTopologyEdgeExport,
+			})
+		}
+		for _, cluster := range entry.ImportedInto {
+			clusterNodes[cluster] = true
+			topo.Edges = append(topo.Edges, v1alpha1.MCSTopologyEdge{
+				Source: serviceID,
+				Target: "cluster:" + cluster,
+				Type:   v1alpha1.MCSTopologyEdgeImport,
+			})
+		}
+	}
+	for _, cluster := range sortedKeys(clusterNodes) {
+		topo.Nodes = append(topo.Nodes, v1alpha1.MCSTopologyNode{
+			ID:    "cluster:" + cluster,
+			Type:  v1alpha1.MCSTopologyNodeCluster,
+			Label: cluster,
+		})
+	}
+	return topo
+}
+
+// sortedKeys returns the keys of set in ascending order, never nil.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,80 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubestellar/console/pkg/api/v1alpha1"
+)
+
+func mcsObject(kind, namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "multicluster.x-k8s.io/v1alpha1",
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+	}}
+}
+
+func TestGetMCSTopology_CorrelatesExportsAndImports(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+
+	noMCS := dynamicfake.NewSimpleDynamicClient(setupScheme())
+	noMCS.PrependReactor("list", "serviceexports", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("the server could not find the requested resource")
+	})
+	m.dynamicClients = map[string]dynamic.Interface{
+		"east":   dynamicfake.NewSimpleDynamicClient(setupScheme(), mcsObject("ServiceExport", "shop", "cart")),
+		"west":   dynamicfake.NewSimpleDynamicClient(setupScheme(), mcsObject("ServiceImport", "shop", "cart")),
+		"legacy": noMCS,
+	}
+	injectTestClusters(m, "east", "west", "legacy")
+
+	topo, err := m.GetMCSTopology(context.Background())
+	if err != nil {
+		t.Fatalf("GetMCSTopology failed: %v", err)
+	}
+
+	if len(topo.Services) != 1 {
+		t.Fatalf("expected 1 correlated service, got %+v", topo.Services)
+	}
+	svc := topo.Services[0]
+	if svc.Namespace != "shop" || svc.Name != "cart" {
+		t.Errorf("unexpected service %s/%s", svc.Namespace, svc.Name)
+	}
+	if len(svc.ExportedFrom) != 1 || svc.ExportedFrom[0] != "east" {
+		t.Errorf("ExportedFrom = %v, want [east]", svc.ExportedFrom)
+	}
+	if len(svc.ImportedInto) != 1 || svc.ImportedInto[0] != "west" {
+		t.Errorf("ImportedInto = %v, want [west]", svc.ImportedInto)
+	}
+	if svc.DNSName != "cart.shop.svc.clusterset.local" {
+		t.Errorf("DNSName = %q", svc.DNSName)
+	}
+
+	wantEdges := []v1alpha1.MCSTopologyEdge{
+		{Source: "cluster:east", Target: "service:shop/cart", Type: v1alpha1.MCSTopologyEdgeExport},
+		{Source: "service:shop/cart", Target: "cluster:west", Type: v1alpha1.MCSTopologyEdgeImport},
+	}
+	if len(topo.Edges) != len(wantEdges) {
+		t.Fatalf("edges = %+v, want %+v", topo.Edges, wantEdges)
+	}
+	for i := range wantEdges {
+		if topo.Edges[i] != wantEdges[i] {
+			t.Errorf("edge %d = %+v, want %+v", i, topo.Edges[i], wantEdges[i])
+		}
+	}
+	// One service node plus the two participating cluster nodes.
+	if len(topo.Nodes) != 3 {
+		t.Errorf("expected 3 nodes, got %+v", topo.Nodes)
+	}
+	if len(topo.SkippedClusters) != 1 || topo.SkippedClusters[0] != "legacy" {
+		t.Errorf("SkippedClusters = %v, want [legacy]", topo.SkippedClusters)
+	}
+}
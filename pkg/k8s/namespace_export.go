@@ -0,0 +1,122 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// exportedWorkloadKinds lists, in output order, the workload kinds
+// ExportNamespace collects. They match what DeployWorkload can re-apply.
+var exportedWorkloadKinds = []string{"Deployment", "StatefulSet", "DaemonSet"}
+
+// ExportOptions controls what ExportNamespace includes in the bundle.
+type ExportOptions struct {
+	// IncludeSecrets keeps Secret dependencies in the bundle. Secrets are
+	// left out by default so a backup can be shared without leaking
+	// credentials.
+	IncludeSecrets bool
+	// Policy, when set, leaves out workloads and dependencies whose
+	// resource it does not allow, with a warning per skipped resource.
+	Policy *GenericResourcePolicy
+}
+
+// ExportNamespace collects every Deployment, StatefulSet and DaemonSet in
+// namespace together with the dependencies ResolveDependencies finds for
+// them, and returns a multi-document YAML bundle that can be re-applied to
+// another cluster. Objects are cleaned the same way DeployWorkload cleans
+// them, dependencies shared by several workloads appear once, and documents
+// are ordered dependencies first (by apply order) then workloads. Warnings
+// from dependency resolution are kept as comments at the top of the bundle.
+func (m *MultiClusterClient) ExportNamespace(ctx context.Context, contextName, namespace string, opts ExportOptions) (out []byte, err error) {
+	defer observeClusterRequest(contextName, "ExportNamespace", time.Now(), &err)
+
+	dynamicClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	deployOpts := &DeployOptions{}
+
+	var workloads []*unstructured.Unstructured
+	var deps []Dependency
+	var warnings []string
+	seen := make(map[string]bool) // "Kind/Namespace/Name"
+	skipped := make(map[schema.GroupResource]bool)
+	allowed := func(gvr schema.GroupVersionResource) bool {
+		if opts.Policy == nil || opts.Policy.Allowed(gvr) {
+			return true
+		}
+		if gr := gvr.GroupResource(); !skipped[gr] {
+			skipped[gr] = true
+			warnings = append(warnings, fmt.Sprintf("%s left out: not allowed by the resource policy", gr))
+		}
+		return false
+	}
+	for _, kind := range exportedWorkloadKinds {
+		if !allowed(workloadGVRByKind[kind]) {
+			continue
+		}
+		list, err := dynamicClient.Resource(workloadGVRByKind[kind]).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("list %s in %s: %w", kind, namespace, err)
+		}
+		items := list.Items
+		sort.Slice(items, func(i, j int) bool { return items[i].GetName() < items[j].GetName() })
+		for i := range items {
+			obj := &items[i]
+			bundle, err := m.ResolveDependencies(ctx, contextName, namespace, obj, deployOpts)
+			if err != nil {
+				return nil, fmt.Errorf("resolve dependencies of %s %s: %w", kind, obj.GetName(), err)
+			}
+			warnings = append(warnings, bundle.Warnings...)
+			for _, dep := range bundle.Dependencies {
+				if dep.Kind == DepSecret && !opts.IncludeSecrets {
+					continue
+				}
+				if !allowed(dep.GVR) {
+					continue
+				}
+				key := fmt.Sprintf("%s/%s/%s", dep.Kind, dep.Namespace, dep.Name)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				deps = append(deps, dep)
+			}
+			workloads = append(workloads, cleanManifestForDeploy(obj, contextName, deployOpts))
+		}
+	}
+
+	sort.SliceStable(deps, func(i, j int) bool {
+		if deps[i].Order != deps[j].Order {
+			return deps[i].Order < deps[j].Order
+		}
+		return deps[i].Name < deps[j].Name
+	})
+
+	var buf bytes.Buffer
+	for _, w := range warnings {
+		fmt.Fprintf(&buf, "# warning: %s\n", w)
+	}
+	docs := make([]*unstructured.Unstructured, 0, len(deps)+len(workloads))
+	for _, dep := range deps {
+		docs = append(docs, dep.Object)
+	}
+	docs = append(docs, workloads...)
+	for _, doc := range docs {
+		data, err := yaml.Marshal(doc.Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s %s: %w", doc.GetKind(), doc.GetName(), err)
+		}
+		buf.WriteString("---\n")
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,110 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newNamespaceExportTestClient(t *testing.T) *MultiClusterClient {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add apps scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop", ResourceVersion: "42"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "web",
+						EnvFrom: []corev1.EnvFromSource{
+							{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-config"}}},
+							{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web-creds"}}},
+						},
+					}},
+				},
+			},
+		},
+	}
+	configMap := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web-config", Namespace: "shop"},
+		Data:       map[string]string{"MODE": "prod"},
+	}
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web-creds", Namespace: "shop"},
+		StringData: map[string]string{"PASSWORD": "hunter2"},
+	}
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = dynfake.NewSimpleDynamicClientWithCustomListKinds(scheme, buildTestGVRMap(), deployment, configMap, secret)
+	return m
+}
+
+func TestExportNamespace_DeploymentAndConfigMap(t *testing.T) {
+	m := newNamespaceExportTestClient(t)
+
+	out, err := m.ExportNamespace(context.Background(), "c1", "shop", ExportOptions{})
+	if err != nil {
+		t.Fatalf("ExportNamespace: %v", err)
+	}
+	bundle := string(out)
+	for _, want := range []string{"kind: Deployment", "name: web", "kind: ConfigMap", "name: web-config"} {
+		if !strings.Contains(bundle, want) {
+			t.Errorf("bundle missing %q:\n%s", want, bundle)
+		}
+	}
+	if strings.Contains(bundle, "kind: Secret") {
+		t.Errorf("Secrets must be excluded by default:\n%s", bundle)
+	}
+	if strings.Contains(bundle, "resourceVersion") {
+		t.Errorf("bundle should be cleaned of server-populated fields:\n%s", bundle)
+	}
+	if strings.Index(bundle, "kind: ConfigMap") > strings.Index(bundle, "kind: Deployment") {
+		t.Errorf("dependencies should precede workloads:\n%s", bundle)
+	}
+}
+
+func TestExportNamespace_IncludeSecrets(t *testing.T) {
+	m := newNamespaceExportTestClient(t)
+
+	out, err := m.ExportNamespace(context.Background(), "c1", "shop", ExportOptions{IncludeSecrets: true})
+	if err != nil {
+		t.Fatalf("ExportNamespace: %v", err)
+	}
+	if !strings.Contains(string(out), "name: web-creds") {
+		t.Errorf("bundle should include the Secret when requested:\n%s", out)
+	}
+}
+
+func TestExportNamespace_PolicyLeavesOutDeniedResources(t *testing.T) {
+	m := newNamespaceExportTestClient(t)
+	policy := NewGenericResourcePolicy("", "configmaps", true)
+
+	out, err := m.ExportNamespace(context.Background(), "c1", "shop", ExportOptions{IncludeSecrets: true, Policy: &policy})
+	if err != nil {
+		t.Fatalf("ExportNamespace: %v", err)
+	}
+	bundle := string(out)
+	if strings.Contains(bundle, "kind: ConfigMap") {
+		t.Errorf("denied ConfigMap must be left out:\n%s", bundle)
+	}
+	if !strings.Contains(bundle, "# warning: configmaps left out") {
+		t.Errorf("bundle should warn about the denied resource:\n%s", bundle)
+	}
+	if !strings.Contains(bundle, "kind: Secret") || !strings.Contains(bundle, "kind: Deployment") {
+		t.Errorf("allowed resources must stay in the bundle:\n%s", bundle)
+	}
+}
@@ -0,0 +1,114 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Node alert severities, most severe first.
+const (
+	NodeAlertCritical = "critical"
+	NodeAlertWarning  = "warning"
+	NodeAlertInfo     = "info"
+)
+
+// NodeAlert is a node condition that needs attention.
+type NodeAlert struct {
+	Cluster string `json:"cluster"`
+	Node    string `json:"node"`
+	// Type is the node condition (NotReady, MemoryPressure, DiskPressure,
+	// PIDPressure) or Unschedulable for cordoned nodes.
+	Type     string `json:"type"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	// Since is when the condition last changed, when known.
+	Since *time.Time `json:"since,omitempty"`
+}
+
+// nodePressureAlerts maps the pressure conditions flagged when True to their
+// severity and message.
+var nodePressureAlerts = map[corev1.NodeConditionType]struct {
+	severity string
+	message  string
+}{
+	corev1.NodeMemoryPressure: {NodeAlertWarning, "Node is low on memory; pods may be evicted"},
+	corev1.NodeDiskPressure:   {NodeAlertWarning, "Node is low on disk space; pods may be evicted and images garbage collected"},
+	corev1.NodePIDPressure:    {NodeAlertWarning, "Node is running out of process IDs"},
+}
+
+var nodeAlertSeverityRank = map[string]int{NodeAlertCritical: 0, NodeAlertWarning: 1, NodeAlertInfo: 2}
+
+// GetNodeAlerts returns an alert for every node in contextName that is not
+// Ready, is under memory, disk or PID pressure, or is cordoned. Alerts are
+// sorted by severity, then node name.
+func (m *MultiClusterClient) GetNodeAlerts(ctx context.Context, contextName string) (_ []NodeAlert, err error) {
+	defer observeClusterRequest(contextName, "GetNodeAlerts", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]NodeAlert, 0)
+	for i := range nodes.Items {
+		alerts = append(alerts, nodeAlerts(contextName, &nodes.Items[i])...)
+	}
+	sort.SliceStable(alerts, func(i, j int) bool {
+		if ri, rj := nodeAlertSeverityRank[alerts[i].Severity], nodeAlertSeverityRank[alerts[j].Severity]; ri != rj {
+			return ri < rj
+		}
+		return alerts[i].Node < alerts[j].Node
+	})
+	return alerts, nil
+}
+
+// nodeAlerts returns the alerts for one node.
+func nodeAlerts(contextName string, node *corev1.Node) []NodeAlert {
+	var alerts []NodeAlert
+	add := func(alertType, severity, message string, cond *corev1.NodeCondition) {
+		a := NodeAlert{Cluster: contextName, Node: node.Name, Type: alertType, Severity: severity, Message: message}
+		if cond != nil && !cond.LastTransitionTime.IsZero() {
+			since := cond.LastTransitionTime.Time
+			a.Since = &since
+		}
+		alerts = append(alerts, a)
+	}
+
+	readySeen := false
+	for i := range node.Status.Conditions {
+		cond := &node.Status.Conditions[i]
+		if cond.Type == corev1.NodeReady {
+			readySeen = true
+			if cond.Status != corev1.ConditionTrue {
+				msg := "Node is not ready"
+				if cond.Status == corev1.ConditionUnknown {
+					msg = "Node stopped reporting status; the kubelet may be down or unreachable"
+				}
+				if cond.Message != "" {
+					msg = fmt.Sprintf("%s: %s", msg, cond.Message)
+				}
+				add("NotReady", NodeAlertCritical, msg, cond)
+			}
+			continue
+		}
+		if p, ok := nodePressureAlerts[cond.Type]; ok && cond.Status == corev1.ConditionTrue {
+			add(string(cond.Type), p.severity, p.message, cond)
+		}
+	}
+	if !readySeen {
+		add("NotReady", NodeAlertCritical, "Node has not reported a Ready condition", nil)
+	}
+	if node.Spec.Unschedulable {
+		add("Unschedulable", NodeAlertInfo, "Node is cordoned; new pods will not be scheduled on it", nil)
+	}
+	return alerts
+}
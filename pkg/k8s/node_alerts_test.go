@@ -0,0 +1,55 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetNodeAlerts(t *testing.T) {
+	node := func(name string, unschedulable bool, conditions ...corev1.NodeCondition) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+			Status:     corev1.NodeStatus{Conditions: conditions},
+		}
+	}
+	ready := corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue}
+	m := &MultiClusterClient{
+		clients: map[string]kubernetes.Interface{"test-cluster": k8sfake.NewSimpleClientset(
+			node("healthy", false, ready,
+				corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse}),
+			node("full-disk", false, ready,
+				corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue, LastTransitionTime: metav1.Now()}),
+			node("down", true,
+				corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionUnknown}),
+		)},
+	}
+
+	alerts, err := m.GetNodeAlerts(context.Background(), "test-cluster")
+	if err != nil {
+		t.Fatalf("GetNodeAlerts failed: %v", err)
+	}
+	if len(alerts) != 3 {
+		t.Fatalf("alerts = %+v, want NotReady and Unschedulable for down plus DiskPressure for full-disk", alerts)
+	}
+	if a := alerts[0]; a.Node != "down" || a.Type != "NotReady" || a.Severity != NodeAlertCritical {
+		t.Errorf("first alert = %+v, want the critical NotReady alert", a)
+	}
+	var disk *NodeAlert
+	for i := range alerts {
+		if alerts[i].Node == "healthy" {
+			t.Errorf("unexpected alert for a healthy node: %+v", alerts[i])
+		}
+		if alerts[i].Type == "DiskPressure" {
+			disk = &alerts[i]
+		}
+	}
+	if disk == nil || disk.Node != "full-disk" || disk.Severity != NodeAlertWarning || disk.Message == "" || disk.Since == nil {
+		t.Errorf("DiskPressure alert = %+v, want a warning for full-disk with message and time", disk)
+	}
+}
@@ -0,0 +1,235 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// kubeRootCAConfigMap is injected into every namespace by the control plane
+// and mounted implicitly through projected service-account volumes, so it is
+// never reported as orphaned.
+const kubeRootCAConfigMap = "kube-root-ca.crt"
+
+// OrphanedResource is a ConfigMap, Secret or PVC that no workload in its
+// namespace references.
+type OrphanedResource struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster"`
+	Age       string `json:"age,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+// podSpecRefs collects the ConfigMap, Secret and PVC names referenced by a
+// pod spec, using the same walkers as ResolveDependencies.
+type podSpecRefs struct {
+	configMaps map[string]bool
+	secrets    map[string]bool
+	pvcs       map[string]bool
+}
+
+// add records every reference in spec.
+func (r *podSpecRefs) add(spec *corev1.PodSpec) error {
+	podSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec)
+	if err != nil {
+		return err
+	}
+
+	allContainers := append(getSlice(podSpec, "containers"), getSlice(podSpec, "initContainers")...)
+	allContainers = append(allContainers, getSlice(podSpec, "ephemeralContainers")...)
+	configMaps, secrets := walkContainerRefs(allContainers)
+	volConfigMaps, volSecrets, volPVCs := walkVolumeRefs(getSlice(podSpec, "volumes"))
+
+	for _, name := range append(configMaps, volConfigMaps...) {
+		r.configMaps[name] = true
+	}
+	for _, name := range append(secrets, volSecrets...) {
+		r.secrets[name] = true
+	}
+	for _, name := range volPVCs {
+		r.pvcs[name] = true
+	}
+	for _, ps := range spec.ImagePullSecrets {
+		r.secrets[ps.Name] = true
+	}
+	return nil
+}
+
+// FindOrphanedResources lists ConfigMaps, Secrets and PVCs in a namespace and
+// reports the ones that no pod or workload template references. Pods and
+// workload templates are both scanned so resources used by a workload scaled
+// to zero (or a suspended CronJob) are not flagged.
+//
+// Service-account token secrets, Helm release secrets, kube-root-ca.crt and
+// anything with an owner reference are skipped: their lifecycle is managed
+// by the control plane or their owner, not by workload references.
+func (m *MultiClusterClient) FindOrphanedResources(ctx context.Context, contextName, namespace string) ([]OrphanedResource, error) {
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := &podSpecRefs{
+		configMaps: make(map[string]bool),
+		secrets:    make(map[string]bool),
+		pvcs:       make(map[string]bool),
+	}
+	// PVCs created from StatefulSet volumeClaimTemplates are named
+	// <template>-<statefulset>-<ordinal> and only appear in pod specs while
+	// the replica exists, so match them by prefix.
+	var claimTemplatePrefixes []string
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	for i := range pods.Items {
+		if err := refs.add(&pods.Items[i].Spec); err != nil {
+			return nil, err
+		}
+	}
+
+	deployments, err := client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for i := range deployments.Items {
+		if err := refs.add(&deployments.Items[i].Spec.Template.Spec); err != nil {
+			return nil, err
+		}
+	}
+
+	statefulSets, err := client.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+	for i := range statefulSets.Items {
+		sts := &statefulSets.Items[i]
+		if err := refs.add(&sts.Spec.Template.Spec); err != nil {
+			return nil, err
+		}
+		for _, tmpl := range sts.Spec.VolumeClaimTemplates {
+			claimTemplatePrefixes = append(claimTemplatePrefixes, tmpl.Name+"-"+sts.Name+"-")
+		}
+	}
+
+	daemonSets, err := client.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets: %w", err)
+	}
+	for i := range daemonSets.Items {
+		if err := refs.add(&daemonSets.Items[i].Spec.Template.Spec); err != nil {
+			return nil, err
+		}
+	}
+
+	cronJobs, err := client.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs: %w", err)
+	}
+	for i := range cronJobs.Items {
+		if err := refs.add(&cronJobs.Items[i].Spec.JobTemplate.Spec.Template.Spec); err != nil {
+			return nil, err
+		}
+	}
+
+	// ServiceAccounts can carry imagePullSecrets that pods pick up implicitly.
+	serviceAccounts, err := client.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list serviceaccounts: %w", err)
+	}
+	for _, sa := range serviceAccounts.Items {
+		for _, ps := range sa.ImagePullSecrets {
+			refs.secrets[ps.Name] = true
+		}
+		for _, s := range sa.Secrets {
+			refs.secrets[s.Name] = true
+		}
+	}
+
+	var orphans []OrphanedResource
+	addOrphan := func(kind DependencyKind, meta metav1.ObjectMeta, reason string) {
+		orphans = append(orphans, OrphanedResource{
+			Kind:      string(kind),
+			Name:      meta.Name,
+			Namespace: namespace,
+			Cluster:   contextName,
+			Age:       formatAge(meta.CreationTimestamp.Time),
+			Reason:    reason,
+		})
+	}
+
+	configMaps, err := client.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list configmaps: %w", err)
+	}
+	for _, cm := range configMaps.Items {
+		if cm.Name == kubeRootCAConfigMap || len(cm.OwnerReferences) > 0 || refs.configMaps[cm.Name] {
+			continue
+		}
+		addOrphan(DepConfigMap, cm.ObjectMeta, "not referenced by any pod or workload template")
+	}
+
+	secrets, err := client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+	for _, sec := range secrets.Items {
+		if isManagedSecret(&sec) || len(sec.OwnerReferences) > 0 || refs.secrets[sec.Name] {
+			continue
+		}
+		addOrphan(DepSecret, sec.ObjectMeta, "not referenced by any pod, workload template or service account")
+	}
+
+	pvcs, err := client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumeclaims: %w", err)
+	}
+	for _, pvc := range pvcs.Items {
+		if len(pvc.OwnerReferences) > 0 || refs.pvcs[pvc.Name] || hasAnyPrefix(pvc.Name, claimTemplatePrefixes) {
+			continue
+		}
+		addOrphan(DepPVC, pvc.ObjectMeta, "not mounted by any pod or workload template")
+	}
+
+	sort.Slice(orphans, func(i, j int) bool {
+		if orphans[i].Kind != orphans[j].Kind {
+			return orphans[i].Kind < orphans[j].Kind
+		}
+		return orphans[i].Name < orphans[j].Name
+	})
+	return orphans, nil
+}
+
+// isManagedSecret reports whether a Secret is created and consumed by the
+// control plane or a tool rather than referenced from a pod spec.
+func isManagedSecret(sec *corev1.Secret) bool {
+	switch sec.Type {
+	case corev1.SecretTypeServiceAccountToken, "helm.sh/release.v1", corev1.SecretTypeBootstrapToken:
+		return true
+	}
+	// Legacy auto-generated token secrets (pre-1.24) may lack the type when
+	// created by older tooling but always follow this naming scheme.
+	return strings.HasPrefix(sec.Name, "default-token-")
+}
+
+// hasAnyPrefix reports whether s starts with any of prefixes.
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
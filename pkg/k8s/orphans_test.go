@@ -0,0 +1,68 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestFindOrphanedResources_ConfigMaps(t *testing.T) {
+	m := &MultiClusterClient{
+		clients: make(map[string]kubernetes.Interface),
+	}
+
+	used := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: "apps"}}
+	unused := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "stale-config", Namespace: "apps"}}
+	rootCA := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: "apps"}}
+	saToken := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "builder-token-abcde", Namespace: "apps"},
+		Type:       corev1.SecretTypeServiceAccountToken,
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps"},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "web",
+						EnvFrom: []corev1.EnvFromSource{{
+							ConfigMapRef: &corev1.ConfigMapEnvSource{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"},
+							},
+						}},
+					}},
+				},
+			},
+		},
+	}
+
+	m.clients["test-cluster"] = k8sfake.NewSimpleClientset(used, unused, rootCA, saToken, deployment)
+
+	orphans, err := m.FindOrphanedResources(context.Background(), "test-cluster", "apps")
+	if err != nil {
+		t.Fatalf("FindOrphanedResources failed: %v", err)
+	}
+	if len(orphans) != 1 {
+		t.Fatalf("Expected 1 orphaned resource, got %d: %+v", len(orphans), orphans)
+	}
+	if orphans[0].Kind != "ConfigMap" || orphans[0].Name != "stale-config" {
+		t.Errorf("Expected ConfigMap/stale-config, got %s/%s", orphans[0].Kind, orphans[0].Name)
+	}
+	if orphans[0].Reason == "" {
+		t.Error("Expected a reason for the orphaned resource")
+	}
+}
+
+func TestFindOrphanedResources_RequiresNamespace(t *testing.T) {
+	m := &MultiClusterClient{
+		clients: make(map[string]kubernetes.Interface),
+	}
+	if _, err := m.FindOrphanedResources(context.Background(), "test-cluster", ""); err == nil {
+		t.Error("Expected error for empty namespace")
+	}
+}
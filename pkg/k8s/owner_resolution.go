@@ -0,0 +1,164 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubestellar/console/pkg/api/v1alpha1"
+)
+
+// maxOwnerDepth bounds the ownerReference walk so a reference cycle cannot
+// loop forever. Real chains (CronJob -> Job -> Pod) are at most a few deep.
+const maxOwnerDepth = 10
+
+// ownerKindGVRs maps the controller kinds resolveRootOwner walks through to
+// their resources. An owner of any other kind (e.g. an operator's custom
+// resource) ends the walk and is the root.
+var ownerKindGVRs = map[schema.GroupKind]schema.GroupVersionResource{
+	{Group: "apps", Kind: "ReplicaSet"}:  gvrReplicaSets,
+	{Group: "apps", Kind: "Deployment"}:  gvrDeployments,
+	{Group: "apps", Kind: "StatefulSet"}: gvrStatefulSets,
+	{Group: "apps", Kind: "DaemonSet"}:   gvrDaemonSets,
+	{Group: "batch", Kind: "Job"}:        {Group: "batch", Version: "v1", Resource: "jobs"},
+	{Group: "batch", Kind: "CronJob"}:    {Group: "batch", Version: "v1", Resource: "cronjobs"},
+}
+
+// ResolveRootOwner returns the top-most controller of obj, e.g. the
+// Deployment of a Pod owned by one of its ReplicaSets. An object without a
+// controller ownerReference is its own root.
+func (m *MultiClusterClient) ResolveRootOwner(ctx context.Context, contextName string, obj *unstructured.Unstructured) (_ v1alpha1.WorkloadOwner, err error) {
+	defer observeClusterRequest(contextName, "ResolveRootOwner", time.Now(), &err)
+	dynamicClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return v1alpha1.WorkloadOwner{}, err
+	}
+	return resolveRootOwner(ctx, dynamicClient, obj), nil
+}
+
+// resolveRootOwner follows the controller ownerReferences of obj upwards.
+// When an owner cannot be read (deleted, or no RBAC to get it) the walk
+// stops at that reference, which is still the best root known.
+func resolveRootOwner(ctx context.Context, client dynamic.Interface, obj *unstructured.Unstructured) v1alpha1.WorkloadOwner {
+	return newOwnerLookup(client).rootOwner(ctx, obj)
+}
+
+// ownerKey identifies an owner object within one cluster.
+type ownerKey struct {
+	resource  schema.GroupResource
+	namespace string
+	name      string
+}
+
+// ownerEntry is what an ownerLookup knows of an owner: its ownerReferences,
+// or that it could not be read.
+type ownerEntry struct {
+	refs  []metav1.OwnerReference
+	found bool
+}
+
+// ownerLookup caches the ownerReferences of owners for one listing, so a
+// ReplicaSet shared by many Pods is read once and owners that were listed
+// themselves are never read at all. Not safe for concurrent use.
+type ownerLookup struct {
+	client dynamic.Interface
+	owners map[ownerKey]ownerEntry
+}
+
+func newOwnerLookup(client dynamic.Interface) *ownerLookup {
+	return &ownerLookup{client: client, owners: make(map[ownerKey]ownerEntry)}
+}
+
+// add records the ownerReferences of every object in list, which holds
+// objects of resource gvr.
+func (o *ownerLookup) add(gvr schema.GroupVersionResource, list *unstructured.UnstructuredList) {
+	for i := range list.Items {
+		item := &list.Items[i]
+		key := ownerKey{resource: gvr.GroupResource(), namespace: item.GetNamespace(), name: item.GetName()}
+		o.owners[key] = ownerEntry{refs: item.GetOwnerReferences(), found: true}
+	}
+}
+
+// ownerRefs returns the ownerReferences of the named owner, reading it on
+// the first miss. A failed read is remembered too.
+func (o *ownerLookup) ownerRefs(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) ([]metav1.OwnerReference, bool) {
+	key := ownerKey{resource: gvr.GroupResource(), namespace: namespace, name: name}
+	if entry, ok := o.owners[key]; ok {
+		return entry.refs, entry.found
+	}
+	var entry ownerEntry
+	if owner, err := o.client.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+		entry = ownerEntry{refs: owner.GetOwnerReferences(), found: true}
+	}
+	o.owners[key] = entry
+	return entry.refs, entry.found
+}
+
+// rootOwner walks the controller ownerReferences of obj upwards.
+func (o *ownerLookup) rootOwner(ctx context.Context, obj *unstructured.Unstructured) v1alpha1.WorkloadOwner {
+	root := v1alpha1.WorkloadOwner{Kind: obj.GetKind(), Name: obj.GetName()}
+	refs := obj.GetOwnerReferences()
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		ref := controllerRef(refs)
+		if ref == nil {
+			break
+		}
+		root = v1alpha1.WorkloadOwner{Kind: ref.Kind, Name: ref.Name}
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			break
+		}
+		gvr, ok := ownerKindGVRs[schema.GroupKind{Group: gv.Group, Kind: ref.Kind}]
+		if !ok {
+			break
+		}
+		if refs, ok = o.ownerRefs(ctx, gvr, obj.GetNamespace(), ref.Name); !ok {
+			break
+		}
+	}
+	return root
+}
+
+// controllerRef returns the ownerReference marked as controller, or nil.
+func controllerRef(refs []metav1.OwnerReference) *metav1.OwnerReference {
+	for i := range refs {
+		if refs[i].Controller != nil && *refs[i].Controller {
+			return &refs[i]
+		}
+	}
+	return nil
+}
+
+// setRootOwners sets RootOwner on each workload parsed from list, which
+// holds objects of resource gvr, matching them up by namespace and name.
+// The listed objects are added to the lookup first so later kinds owned by
+// them resolve without reading them again.
+func (o *ownerLookup) setRootOwners(ctx context.Context, gvr schema.GroupVersionResource, list interface{}, workloads []v1alpha1.Workload) {
+	uList, ok := list.(*unstructured.UnstructuredList)
+	if !ok {
+		return
+	}
+	o.add(gvr, uList)
+	byKey := make(map[string]*unstructured.Unstructured, len(uList.Items))
+	for i := range uList.Items {
+		item := &uList.Items[i]
+		byKey[item.GetNamespace()+"/"+item.GetName()] = item
+	}
+	for i := range workloads {
+		w := &workloads[i]
+		item, ok := byKey[w.Namespace+"/"+w.Name]
+		if !ok {
+			continue
+		}
+		root := o.rootOwner(ctx, item)
+		if root.Kind == "" {
+			// List items may omit their kind; the workload type is the same.
+			root.Kind = string(w.Type)
+		}
+		w.RootOwner = &root
+	}
+}
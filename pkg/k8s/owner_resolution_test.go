@@ -0,0 +1,122 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kubestellar/console/pkg/api/v1alpha1"
+)
+
+// ownedObject builds an unstructured object controlled by ownerKind/ownerName.
+func ownedObject(apiVersion, kind, name, ownerAPIVersion, ownerKind, ownerName string) *unstructured.Unstructured {
+	meta := map[string]interface{}{"name": name, "namespace": "default"}
+	if ownerKind != "" {
+		meta["ownerReferences"] = []interface{}{
+			map[string]interface{}{
+				"apiVersion": ownerAPIVersion,
+				"kind":       ownerKind,
+				"name":       ownerName,
+				"uid":        ownerName + "-uid",
+				"controller": true,
+			},
+		}
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   meta,
+	}}
+}
+
+func TestResolveRootOwner_DeploymentChain(t *testing.T) {
+	deploy := ownedObject("apps/v1", "Deployment", "web", "", "", "")
+	rs := ownedObject("apps/v1", "ReplicaSet", "web-5d8f", "apps/v1", "Deployment", "web")
+	pod := ownedObject("v1", "Pod", "web-5d8f-x2k9", "apps/v1", "ReplicaSet", "web-5d8f")
+
+	gvrMap := buildTestGVRMap()
+	gvrMap[schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}] = "ReplicaSetList"
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrMap, deploy, rs)
+
+	want := v1alpha1.WorkloadOwner{Kind: "Deployment", Name: "web"}
+	for _, obj := range []*unstructured.Unstructured{pod, rs, deploy} {
+		got, err := m.ResolveRootOwner(context.Background(), "c1", obj)
+		if err != nil {
+			t.Fatalf("ResolveRootOwner(%s): %v", obj.GetName(), err)
+		}
+		if got != want {
+			t.Errorf("root owner of %s %s = %+v, want %+v", obj.GetKind(), obj.GetName(), got, want)
+		}
+	}
+}
+
+func TestResolveRootOwner_StopsAtUnknownOrMissingOwner(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), buildTestGVRMap())
+
+	tests := []struct {
+		name string
+		obj  *unstructured.Unstructured
+		want v1alpha1.WorkloadOwner
+	}{
+		{
+			"custom resource owner",
+			ownedObject("apps/v1", "StatefulSet", "db", "postgres.example.com/v1", "Cluster", "db"),
+			v1alpha1.WorkloadOwner{Kind: "Cluster", Name: "db"},
+		},
+		{
+			"deleted owner",
+			ownedObject("v1", "Pod", "web-abc", "apps/v1", "ReplicaSet", "web-gone"),
+			v1alpha1.WorkloadOwner{Kind: "ReplicaSet", Name: "web-gone"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.ResolveRootOwner(context.Background(), "c1", tt.obj)
+			if err != nil {
+				t.Fatalf("ResolveRootOwner: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("root owner = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListWorkloadsForCluster_RollsUpWithoutOwnerReads(t *testing.T) {
+	deploy := ownedObject("apps/v1", "Deployment", "web", "", "", "")
+	rs := ownedObject("apps/v1", "ReplicaSet", "web-5d8f", "apps/v1", "Deployment", "web")
+	pod := ownedObject("v1", "Pod", "web-5d8f-x2k9", "apps/v1", "ReplicaSet", "web-5d8f")
+	pod2 := ownedObject("v1", "Pod", "web-5d8f-q7m1", "apps/v1", "ReplicaSet", "web-5d8f")
+
+	gvrMap := buildTestGVRMap()
+	gvrMap[gvrReplicaSets] = "ReplicaSetList"
+	gvrMap[gvrWorkloadPods] = "PodList"
+	fakeDyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrMap, deploy, rs, pod, pod2)
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = fakeDyn
+
+	wls, err := m.ListWorkloadsForCluster(context.Background(), "c1", "default", "")
+	if err != nil {
+		t.Fatalf("ListWorkloadsForCluster: %v", err)
+	}
+	if len(wls) != 4 {
+		t.Fatalf("expected 4 workloads, got %d", len(wls))
+	}
+	want := v1alpha1.WorkloadOwner{Kind: "Deployment", Name: "web"}
+	for _, w := range wls {
+		if w.RootOwner == nil || *w.RootOwner != want {
+			t.Errorf("%s %s rootOwner = %v, want %+v", w.Type, w.Name, w.RootOwner, want)
+		}
+	}
+	for _, action := range fakeDyn.Actions() {
+		if action.GetVerb() == "get" {
+			t.Errorf("unexpected owner read of %s", action.GetResource().Resource)
+		}
+	}
+}
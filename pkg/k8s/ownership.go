@@ -0,0 +1,137 @@
+package k8s
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// ownershipKeysEnvVar overrides the keys ownership metadata is read from,
+// as comma-separated field:key pairs, e.g.
+// "owner:example.com/owner,team:example.com/team,team:squad". The keys given
+// for a field replace its defaults; fields not mentioned keep them.
+const ownershipKeysEnvVar = "K8S_OWNERSHIP_KEYS"
+
+// Ownership is who owns a workload, read from its annotations (and labels,
+// for keys such as app.kubernetes.io/part-of that are usually labels).
+type Ownership struct {
+	Owner   string `json:"owner,omitempty"`
+	Team    string `json:"team,omitempty"`
+	PartOf  string `json:"partOf,omitempty"`
+	Contact string `json:"contact,omitempty"`
+}
+
+// OwnershipKeys lists, per Ownership field, the annotation and label keys it
+// is read from. The first key present wins.
+type OwnershipKeys struct {
+	Owner   []string
+	Team    []string
+	PartOf  []string
+	Contact []string
+}
+
+// DefaultOwnershipKeys are the well-known keys used unless overridden with
+// K8S_OWNERSHIP_KEYS or SetOwnershipKeys.
+var DefaultOwnershipKeys = OwnershipKeys{
+	Owner:   []string{"owner"},
+	Team:    []string{"team"},
+	PartOf:  []string{"app.kubernetes.io/part-of"},
+	Contact: []string{"contact"},
+}
+
+// ExtractOwnership returns the ownership metadata found in annotations, then
+// labels, under keys, or nil when there is none.
+func ExtractOwnership(annotations, labels map[string]string, keys OwnershipKeys) *Ownership {
+	lookup := func(candidates []string) string {
+		for _, k := range candidates {
+			if v := strings.TrimSpace(annotations[k]); v != "" {
+				return v
+			}
+			if v := strings.TrimSpace(labels[k]); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+	o := Ownership{
+		Owner:   lookup(keys.Owner),
+		Team:    lookup(keys.Team),
+		PartOf:  lookup(keys.PartOf),
+		Contact: lookup(keys.Contact),
+	}
+	if o == (Ownership{}) {
+		return nil
+	}
+	return &o
+}
+
+// ownershipKeysFromEnv reads K8S_OWNERSHIP_KEYS on top of
+// DefaultOwnershipKeys. Malformed entries are logged and skipped.
+func ownershipKeysFromEnv() OwnershipKeys {
+	keys, err := ParseOwnershipKeys(os.Getenv(ownershipKeysEnvVar))
+	if err != nil {
+		slog.Warn("[Ownership] ignoring invalid entries", "env", ownershipKeysEnvVar, "error", err)
+	}
+	return keys
+}
+
+// ParseOwnershipKeys parses comma-separated field:key pairs (fields are
+// owner, team, partOf and contact) on top of DefaultOwnershipKeys. It returns
+// the keys parsed from the valid entries together with an error naming the
+// invalid ones.
+func ParseOwnershipKeys(raw string) (OwnershipKeys, error) {
+	overrides := make(map[string][]string)
+	var invalid []string
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		field, key, ok := strings.Cut(entry, ":")
+		field, key = strings.ToLower(strings.TrimSpace(field)), strings.TrimSpace(key)
+		switch {
+		case !ok || key == "":
+			invalid = append(invalid, entry)
+		case field == "owner" || field == "team" || field == "partof" || field == "contact":
+			overrides[field] = append(overrides[field], key)
+		default:
+			invalid = append(invalid, entry)
+		}
+	}
+
+	keys := DefaultOwnershipKeys
+	if v, ok := overrides["owner"]; ok {
+		keys.Owner = v
+	}
+	if v, ok := overrides["team"]; ok {
+		keys.Team = v
+	}
+	if v, ok := overrides["partof"]; ok {
+		keys.PartOf = v
+	}
+	if v, ok := overrides["contact"]; ok {
+		keys.Contact = v
+	}
+	if len(invalid) > 0 {
+		return keys, fmt.Errorf("invalid ownership key entries (want field:key): %s", strings.Join(invalid, ", "))
+	}
+	return keys, nil
+}
+
+// SetOwnershipKeys sets the keys Deployment.Ownership and Service.Ownership
+// are read from.
+func (m *MultiClusterClient) SetOwnershipKeys(keys OwnershipKeys) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ownershipKeys = &keys
+}
+
+func (m *MultiClusterClient) getOwnershipKeys() OwnershipKeys {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.ownershipKeys == nil {
+		return DefaultOwnershipKeys
+	}
+	return *m.ownershipKeys
+}
@@ -0,0 +1,65 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestExtractOwnership(t *testing.T) {
+	got := ExtractOwnership(
+		map[string]string{"owner": "alice", "team": " payments ", "contact": "#payments-oncall"},
+		map[string]string{"app.kubernetes.io/part-of": "checkout", "team": "ignored"},
+		DefaultOwnershipKeys,
+	)
+	want := Ownership{Owner: "alice", Team: "payments", PartOf: "checkout", Contact: "#payments-oncall"}
+	if got == nil || *got != want {
+		t.Errorf("ExtractOwnership = %+v, want %+v", got, want)
+	}
+
+	if got := ExtractOwnership(map[string]string{"description": "x"}, nil, DefaultOwnershipKeys); got != nil {
+		t.Errorf("ExtractOwnership without ownership keys = %+v, want nil", got)
+	}
+}
+
+func TestParseOwnershipKeys(t *testing.T) {
+	keys, err := ParseOwnershipKeys("owner:example.com/owner, owner:owner ,bogus,colour:red")
+	if err == nil {
+		t.Error("expected an error naming the invalid entries")
+	}
+	if len(keys.Owner) != 2 || keys.Owner[0] != "example.com/owner" || keys.Owner[1] != "owner" {
+		t.Errorf("Owner keys = %v, want the configured keys in order", keys.Owner)
+	}
+	if len(keys.Team) != 1 || keys.Team[0] != "team" {
+		t.Errorf("Team keys = %v, want the default", keys.Team)
+	}
+
+	got := ExtractOwnership(map[string]string{"example.com/owner": "bob", "owner": "alice"}, nil, keys)
+	if got == nil || got.Owner != "bob" {
+		t.Errorf("ExtractOwnership = %+v, want the first configured key to win", got)
+	}
+}
+
+func TestGetDeployments_Ownership(t *testing.T) {
+	m := &MultiClusterClient{
+		clients: map[string]kubernetes.Interface{"test-cluster": k8sfake.NewSimpleClientset(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name: "api", Namespace: "shop",
+				Annotations: map[string]string{"example.com/squad": "checkout-squad"},
+			}},
+		)},
+	}
+	m.SetOwnershipKeys(OwnershipKeys{Team: []string{"example.com/squad"}})
+
+	deployments, err := m.GetDeployments(context.Background(), "test-cluster", "shop")
+	if err != nil {
+		t.Fatalf("GetDeployments failed: %v", err)
+	}
+	if len(deployments) != 1 || deployments[0].Ownership == nil || deployments[0].Ownership.Team != "checkout-squad" {
+		t.Errorf("deployments = %+v, want Ownership.Team from the configured annotation", deployments)
+	}
+}
@@ -0,0 +1,225 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxPlacementCandidates caps the number of ranked candidates returned by
+// RecommendPlacement so a large fleet does not produce a multi-thousand
+// entry response for a single scheduling question.
+const maxPlacementCandidates = 20
+
+// ResourceRequest describes the resources a GPU job needs. GPUCount is
+// required; CPU and memory are optional and, when zero, do not constrain
+// placement (they still contribute headroom to the score).
+type ResourceRequest struct {
+	GPUCount      int      `json:"gpuCount"`
+	CPUMillicores int64    `json:"cpuMillicores,omitempty"`
+	MemoryBytes   int64    `json:"memoryBytes,omitempty"`
+	GPUType       string   `json:"gpuType,omitempty"`  // optional case-insensitive substring match on GPUNode.GPUType
+	Clusters      []string `json:"clusters,omitempty"` // optional restriction; empty means all healthy clusters
+}
+
+// PlacementCandidate is a single node that can fit a ResourceRequest,
+// together with the free capacity that remains on it today.
+type PlacementCandidate struct {
+	Cluster           string  `json:"cluster"`
+	Node              string  `json:"node"`
+	GPUType           string  `json:"gpuType"`
+	GPUCount          int     `json:"gpuCount"`
+	FreeGPUs          int     `json:"freeGPUs"`
+	FreeCPUMillicores int64   `json:"freeCpuMillicores"`
+	FreeMemoryBytes   int64   `json:"freeMemoryBytes"`
+	Score             float64 `json:"score"`
+	Tainted           bool    `json:"tainted,omitempty"` // node carries NoSchedule/NoExecute taints the job may need to tolerate
+}
+
+// PlacementRecommendation is the ranked result of RecommendPlacement.
+// SkippedClusters lists clusters that were offline or failed to respond so
+// the UI can explain why a cluster is absent from the ranking.
+type PlacementRecommendation struct {
+	Request         ResourceRequest      `json:"request"`
+	Candidates      []PlacementCandidate `json:"candidates"`
+	SkippedClusters []string             `json:"skippedClusters,omitempty"`
+}
+
+// RecommendPlacement scans GPU nodes across every healthy cluster and returns
+// the nodes that can fit req, ranked by remaining capacity. Offline clusters
+// (per the cached health state) are skipped without being probed.
+//
+// The score is the number of GPUs left free after placing the job plus the
+// fraction of CPU and memory headroom left on the node, so a node with more
+// spare GPUs always outranks one with fewer, and CPU/memory headroom breaks
+// ties between nodes with equal free GPUs.
+func (m *MultiClusterClient) RecommendPlacement(ctx context.Context, req ResourceRequest) (*PlacementRecommendation, error) {
+	if req.GPUCount <= 0 {
+		return nil, fmt.Errorf("gpuCount must be greater than zero")
+	}
+	if req.CPUMillicores < 0 || req.MemoryBytes < 0 {
+		return nil, fmt.Errorf("cpuMillicores and memoryBytes must not be negative")
+	}
+
+	healthy, offline, err := m.HealthyClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(req.Clusters))
+	for _, c := range req.Clusters {
+		wanted[c] = true
+	}
+
+	result := &PlacementRecommendation{Request: req, Candidates: []PlacementCandidate{}}
+	for _, cl := range offline {
+		if len(wanted) == 0 || wanted[cl.Name] {
+			result.SkippedClusters = append(result.SkippedClusters, cl.Name)
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, cl := range healthy {
+		if len(wanted) > 0 && !wanted[cl.Name] {
+			continue
+		}
+		wg.Add(1)
+		go func(clusterName string) {
+			defer wg.Done()
+			clusterCtx, cancel := context.WithTimeout(ctx, perClusterHealthTimeout)
+			defer cancel()
+			candidates, err := m.placementCandidatesForCluster(clusterCtx, clusterName, req)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				slog.Warn("[Placement] skipping cluster", "cluster", clusterName, "error", err)
+				result.SkippedClusters = append(result.SkippedClusters, clusterName)
+				return
+			}
+			result.Candidates = append(result.Candidates, candidates...)
+		}(cl.Name)
+	}
+	wg.Wait()
+
+	sort.Slice(result.Candidates, func(i, j int) bool {
+		a, b := result.Candidates[i], result.Candidates[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Cluster != b.Cluster {
+			return a.Cluster < b.Cluster
+		}
+		return a.Node < b.Node
+	})
+	if len(result.Candidates) > maxPlacementCandidates {
+		result.Candidates = result.Candidates[:maxPlacementCandidates]
+	}
+	sort.Strings(result.SkippedClusters)
+	return result, nil
+}
+
+// placementCandidatesForCluster returns the schedulable GPU nodes in a single
+// cluster that have enough free GPU, CPU and memory for req.
+func (m *MultiClusterClient) placementCandidatesForCluster(ctx context.Context, contextName string, req ResourceRequest) ([]PlacementCandidate, error) {
+	gpuNodes, pods, err := m.getGPUNodesWithPods(ctx, contextName)
+	if err != nil {
+		return nil, err
+	}
+	if len(gpuNodes) == 0 {
+		return nil, nil
+	}
+
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	nodeList, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	nodesByName := make(map[string]*corev1.Node, len(nodeList.Items))
+	for i := range nodeList.Items {
+		nodesByName[nodeList.Items[i].Name] = &nodeList.Items[i]
+	}
+
+	// Sum CPU/memory requests of pods that still hold their reservation.
+	cpuUsed := make(map[string]int64)
+	memUsed := make(map[string]int64)
+	if pods != nil {
+		for _, pod := range pods.Items {
+			if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			for _, c := range pod.Spec.Containers {
+				if cpu, ok := c.Resources.Requests[corev1.ResourceCPU]; ok {
+					cpuUsed[pod.Spec.NodeName] += cpu.MilliValue()
+				}
+				if mem, ok := c.Resources.Requests[corev1.ResourceMemory]; ok {
+					memUsed[pod.Spec.NodeName] += mem.Value()
+				}
+			}
+		}
+	}
+
+	var candidates []PlacementCandidate
+	for _, gn := range gpuNodes {
+		if req.GPUType != "" && !strings.Contains(strings.ToLower(gn.GPUType), strings.ToLower(req.GPUType)) {
+			continue
+		}
+		node, ok := nodesByName[gn.Name]
+		if !ok || node.Spec.Unschedulable || !isNodeReady(node) {
+			continue
+		}
+
+		freeGPUs := gn.GPUCount - gn.GPUAllocated
+		if freeGPUs < req.GPUCount {
+			continue
+		}
+
+		cpuAlloc := node.Status.Allocatable.Cpu().MilliValue()
+		memAlloc := node.Status.Allocatable.Memory().Value()
+		freeCPU := cpuAlloc - cpuUsed[gn.Name]
+		freeMem := memAlloc - memUsed[gn.Name]
+		if freeCPU < req.CPUMillicores || freeMem < req.MemoryBytes {
+			continue
+		}
+
+		score := float64(freeGPUs - req.GPUCount)
+		if cpuAlloc > 0 {
+			score += float64(freeCPU-req.CPUMillicores) / float64(cpuAlloc)
+		}
+		if memAlloc > 0 {
+			score += float64(freeMem-req.MemoryBytes) / float64(memAlloc)
+		}
+
+		candidates = append(candidates, PlacementCandidate{
+			Cluster:           contextName,
+			Node:              gn.Name,
+			GPUType:           gn.GPUType,
+			GPUCount:          gn.GPUCount,
+			FreeGPUs:          freeGPUs,
+			FreeCPUMillicores: freeCPU,
+			FreeMemoryBytes:   freeMem,
+			Score:             score,
+			Tainted:           len(gn.Taints) > 0,
+		})
+	}
+	return candidates, nil
+}
+
+// isNodeReady reports whether the node's Ready condition is True.
+func isNodeReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// placementTestNode builds a Ready NVIDIA GPU node with the given GPU count.
+func placementTestNode(name string, gpus string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100"},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				"nvidia.com/gpu":      resource.MustParse(gpus),
+				corev1.ResourceCPU:    resource.MustParse("32"),
+				corev1.ResourceMemory: resource.MustParse("128Gi"),
+			},
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+// placementTestPod builds a running pod on node that requests gpus GPUs.
+func placementTestPod(name, node string, gpus string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{{
+				Name: "train",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						"nvidia.com/gpu":   resource.MustParse(gpus),
+						corev1.ResourceCPU: resource.MustParse("4"),
+					},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestRecommendPlacement_RanksByHeadroom(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "busy", "idle", "full")
+
+	// busy: 8 GPUs, 6 allocated -> 2 free
+	m.InjectClient("busy", fake.NewSimpleClientset(
+		placementTestNode("busy-gpu-0", "8"),
+		placementTestPod("job-a", "busy-gpu-0", "6"),
+	))
+	// idle: 8 GPUs, 1 allocated -> 7 free
+	m.InjectClient("idle", fake.NewSimpleClientset(
+		placementTestNode("idle-gpu-0", "8"),
+		placementTestPod("job-b", "idle-gpu-0", "1"),
+	))
+	// full: 4 GPUs, 4 allocated -> 0 free, must not be recommended
+	m.InjectClient("full", fake.NewSimpleClientset(
+		placementTestNode("full-gpu-0", "4"),
+		placementTestPod("job-c", "full-gpu-0", "4"),
+	))
+
+	rec, err := m.RecommendPlacement(context.Background(), ResourceRequest{
+		GPUCount:      2,
+		CPUMillicores: 2000,
+	})
+	require.NoError(t, err)
+	require.Len(t, rec.Candidates, 2)
+
+	assert.Equal(t, "idle", rec.Candidates[0].Cluster)
+	assert.Equal(t, "idle-gpu-0", rec.Candidates[0].Node)
+	assert.Equal(t, 7, rec.Candidates[0].FreeGPUs)
+	assert.Equal(t, int64(28000), rec.Candidates[0].FreeCPUMillicores)
+	assert.Equal(t, "busy", rec.Candidates[1].Cluster)
+	assert.Equal(t, 2, rec.Candidates[1].FreeGPUs)
+	assert.Greater(t, rec.Candidates[0].Score, rec.Candidates[1].Score)
+}
+
+func TestRecommendPlacement_SkipsCordonedAndNotReadyNodes(t *testing.T) {
+	m := &MultiClusterClient{}
+	injectTestClusters(m, "c1")
+
+	cordoned := placementTestNode("cordoned", "8")
+	cordoned.Spec.Unschedulable = true
+	notReady := placementTestNode("not-ready", "8")
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+	m.InjectClient("c1", fake.NewSimpleClientset(cordoned, notReady, placementTestNode("ok", "1")))
+
+	rec, err := m.RecommendPlacement(context.Background(), ResourceRequest{GPUCount: 1})
+	require.NoError(t, err)
+	require.Len(t, rec.Candidates, 1)
+	assert.Equal(t, "ok", rec.Candidates[0].Node)
+}
+
+func TestRecommendPlacement_RejectsInvalidRequest(t *testing.T) {
+	m := &MultiClusterClient{}
+	_, err := m.RecommendPlacement(context.Background(), ResourceRequest{GPUCount: 0})
+	assert.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package k8s
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// containerLogLine is one log line of a container, as merged by
+// mergeContainerLogs.
+type containerLogLine struct {
+	container string
+	text      string
+	at        time.Time
+}
+
+// GetPodLogsAllContainers returns the logs of every container of a pod,
+// each line prefixed with "[<container>] ". tailLines and limitBytes apply
+// to each container; truncated reports whether any container's log was
+// longer than limitBytes. timestamps asks the kubelet to prefix every line
+// with its RFC 3339 time. When every line starts with such a timestamp (the
+// kubelet's, or one the application logs) the lines are interleaved in time
+// order; otherwise each container's lines follow the previous container's,
+// in spec order. A container whose logs cannot be read (e.g. it has not
+// started yet) is left out rather than failing the call, unless no
+// container's logs could be read at all.
+func (m *MultiClusterClient) GetPodLogsAllContainers(ctx context.Context, contextName, namespace, pod string, tailLines, limitBytes int64, timestamps bool) (_ string, truncated bool, err error) {
+	defer observeClusterRequest(contextName, "GetPodLogsAllContainers", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return "", false, err
+	}
+
+	p, err := client.CoreV1().Pods(namespace).Get(ctx, pod, metav1.GetOptions{})
+	if err != nil {
+		return "", false, err
+	}
+
+	var lines []containerLogLine
+	var firstErr error
+	read := 0
+	for _, c := range p.Spec.Containers {
+		opts := &corev1.PodLogOptions{Container: c.Name, Timestamps: timestamps}
+		if tailLines > 0 {
+			opts.TailLines = &tailLines
+		}
+		if limitBytes > 0 {
+			// One byte over the limit tells a log of exactly limitBytes
+			// apart from a longer one, as in GetPodLogs.
+			requested := limitBytes + 1
+			opts.LimitBytes = &requested
+		}
+		raw, err := client.CoreV1().Pods(namespace).GetLogs(pod, opts).DoRaw(ctx)
+		if err != nil {
+			slog.Warn("[GetPodLogsAllContainers] failed to read container logs", "cluster", contextName, "namespace", namespace, "pod", pod, "container", c.Name, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		read++
+		if limitBytes > 0 && int64(len(raw)) > limitBytes {
+			raw = raw[:limitBytes]
+			truncated = true
+		}
+		for _, text := range strings.Split(strings.TrimRight(string(raw), "\n"), "\n") {
+			if text != "" {
+				lines = append(lines, containerLogLine{container: c.Name, text: text})
+			}
+		}
+	}
+
+	if read == 0 && firstErr != nil {
+		return "", false, firstErr
+	}
+	return mergeContainerLogs(lines), truncated, nil
+}
+
+// mergeContainerLogs renders lines with their container prefixes, sorted by
+// leading timestamp when every line has one.
+func mergeContainerLogs(lines []containerLogLine) string {
+	timestamped := len(lines) > 0
+	for i := range lines {
+		ts, _, _ := strings.Cut(lines[i].text, " ")
+		at, err := time.Parse(time.RFC3339Nano, ts)
+		if err != nil {
+			timestamped = false
+			break
+		}
+		lines[i].at = at
+	}
+	if timestamped {
+		sort.SliceStable(lines, func(i, j int) bool { return lines[i].at.Before(lines[j].at) })
+	}
+
+	var b strings.Builder
+	for _, l := range lines {
+		b.WriteString("[")
+		b.WriteString(l.container)
+		b.WriteString("] ")
+		b.WriteString(l.text)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetPodLogsAllContainers_PrefixesContainers(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "app"},
+			{Name: "istio-proxy"},
+		}},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = k8sfake.NewSimpleClientset(pod)
+
+	logs, truncated, err := m.GetPodLogsAllContainers(context.Background(), "c1", "default", "web", 50, 0, false)
+	if err != nil {
+		t.Fatalf("GetPodLogsAllContainers: %v", err)
+	}
+	if truncated {
+		t.Error("expected untruncated logs without a byte limit")
+	}
+	// The fake clientset returns "fake logs" for every container.
+	want := "[app] fake logs\n[istio-proxy] fake logs\n"
+	if logs != want {
+		t.Errorf("logs = %q, want %q", logs, want)
+	}
+}
+
+func TestGetPodLogsAllContainers_LimitsEachContainer(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "app"},
+			{Name: "istio-proxy"},
+		}},
+	}
+
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = k8sfake.NewSimpleClientset(pod)
+
+	logs, truncated, err := m.GetPodLogsAllContainers(context.Background(), "c1", "default", "web", 0, 4, true)
+	if err != nil {
+		t.Fatalf("GetPodLogsAllContainers: %v", err)
+	}
+	if !truncated {
+		t.Error("expected truncated to be set")
+	}
+	if want := "[app] fake\n[istio-proxy] fake\n"; logs != want {
+		t.Errorf("logs = %q, want %q", logs, want)
+	}
+}
+
+func TestGetPodLogsAllContainers_PodNotFound(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = k8sfake.NewSimpleClientset()
+
+	if _, _, err := m.GetPodLogsAllContainers(context.Background(), "c1", "default", "missing", 0, 0, false); err == nil {
+		t.Fatal("expected error for missing pod")
+	}
+}
+
+func TestMergeContainerLogs_SortsByTimestamp(t *testing.T) {
+	lines := []containerLogLine{
+		{container: "app", text: "2024-05-01T10:00:00.000000001Z started"},
+		{container: "app", text: "2024-05-01T10:00:03Z ready"},
+		{container: "sidecar", text: "2024-05-01T10:00:01Z proxy up"},
+		{container: "sidecar", text: "2024-05-01T10:00:02Z config loaded"},
+	}
+	got := strings.Split(strings.TrimSuffix(mergeContainerLogs(lines), "\n"), "\n")
+	want := []string{
+		"[app] 2024-05-01T10:00:00.000000001Z started",
+		"[sidecar] 2024-05-01T10:00:01Z proxy up",
+		"[sidecar] 2024-05-01T10:00:02Z config loaded",
+		"[app] 2024-05-01T10:00:03Z ready",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("merged = %q, want %q", got, want)
+	}
+}
+
+func TestMergeContainerLogs_KeepsOrderWithoutTimestamps(t *testing.T) {
+	lines := []containerLogLine{
+		{container: "app", text: "2024-05-01T10:00:03Z ready"},
+		{container: "sidecar", text: "no timestamp here"},
+	}
+	want := "[app] 2024-05-01T10:00:03Z ready\n[sidecar] no timestamp here\n"
+	if got := mergeContainerLogs(lines); got != want {
+		t.Errorf("merged = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,129 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// gvrPodMetrics is the metrics-server PodMetrics resource. It is read through
+// the dynamic client so the console does not need the k8s.io/metrics module.
+var gvrPodMetrics = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+
+// Sort keys accepted by GetTopPods.
+const (
+	TopPodsSortByCPU    = "cpu"
+	TopPodsSortByMemory = "memory"
+)
+
+// ErrMetricsUnavailable is returned when the cluster does not serve the
+// metrics.k8s.io API, which usually means metrics-server is not installed.
+var ErrMetricsUnavailable = errors.New("metrics API not available (is metrics-server installed?)")
+
+// ErrInvalidTopPodsSort is returned for a sortBy other than TopPodsSortByCPU
+// or TopPodsSortByMemory.
+var ErrInvalidTopPodsSort = errors.New("invalid sortBy")
+
+// PodMetric is the current resource usage of a pod, summed over its
+// containers, as reported by metrics-server.
+type PodMetric struct {
+	Name          string `json:"name"`
+	Namespace     string `json:"namespace"`
+	Cluster       string `json:"cluster,omitempty"`
+	CPUMillicores int64  `json:"cpuMillicores"`
+	MemoryBytes   int64  `json:"memoryBytes"`
+	Timestamp     string `json:"timestamp,omitempty"`
+}
+
+// GetTopPods returns the limit pods in namespace (all namespaces when empty)
+// using the most CPU or memory, highest first, like `kubectl top pods
+// --sort-by`. sortBy defaults to TopPodsSortByCPU; limit <= 0 returns all
+// pods. Returns ErrMetricsUnavailable when metrics-server is absent.
+func (m *MultiClusterClient) GetTopPods(ctx context.Context, contextName, namespace, sortBy string, limit int) (_ []PodMetric, err error) {
+	defer observeClusterRequest(contextName, "GetTopPods", time.Now(), &err)
+	if sortBy == "" {
+		sortBy = TopPodsSortByCPU
+	}
+	if sortBy != TopPodsSortByCPU && sortBy != TopPodsSortByMemory {
+		return nil, fmt.Errorf("%w %q: must be %q or %q", ErrInvalidTopPodsSort, sortBy, TopPodsSortByCPU, TopPodsSortByMemory)
+	}
+	dynClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := dynClient.Resource(gvrPodMetrics).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// NotFound: the metrics.k8s.io API isn't registered. ServiceUnavailable:
+		// the APIService exists but metrics-server isn't serving it.
+		if apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err) {
+			return nil, fmt.Errorf("%w: %v", ErrMetricsUnavailable, err)
+		}
+		return nil, err
+	}
+
+	metrics := make([]PodMetric, 0, len(list.Items))
+	for _, item := range list.Items {
+		pm := PodMetric{
+			Name:      item.GetName(),
+			Namespace: item.GetNamespace(),
+			Cluster:   contextName,
+		}
+		pm.Timestamp, _, _ = unstructured.NestedString(item.Object, "timestamp")
+		containers, _, _ := unstructured.NestedSlice(item.Object, "containers")
+		for _, c := range containers {
+			cm, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			usage, _ := cm["usage"].(map[string]interface{})
+			if q, ok := parseUsageQuantity(usage, "cpu"); ok {
+				pm.CPUMillicores += q.MilliValue()
+			}
+			if q, ok := parseUsageQuantity(usage, "memory"); ok {
+				pm.MemoryBytes += q.Value()
+			}
+		}
+		metrics = append(metrics, pm)
+	}
+
+	sort.SliceStable(metrics, func(i, j int) bool {
+		a, b := metrics[i], metrics[j]
+		if sortBy == TopPodsSortByMemory {
+			if a.MemoryBytes != b.MemoryBytes {
+				return a.MemoryBytes > b.MemoryBytes
+			}
+		} else if a.CPUMillicores != b.CPUMillicores {
+			return a.CPUMillicores > b.CPUMillicores
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	if limit > 0 && len(metrics) > limit {
+		metrics = metrics[:limit]
+	}
+	return metrics, nil
+}
+
+// parseUsageQuantity reads usage[key] as a resource quantity.
+func parseUsageQuantity(usage map[string]interface{}, key string) (resource.Quantity, bool) {
+	s, ok := usage[key].(string)
+	if !ok {
+		return resource.Quantity{}, false
+	}
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return resource.Quantity{}, false
+	}
+	return q, true
+}
@@ -0,0 +1,86 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func podMetricsObject(name, cpu, memory string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "metrics.k8s.io/v1beta1",
+		"kind":       "PodMetrics",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "default"},
+		"timestamp":  "2026-01-01T00:00:00Z",
+		"containers": []interface{}{
+			map[string]interface{}{"name": "main", "usage": map[string]interface{}{"cpu": cpu, "memory": memory}},
+		},
+	}}
+}
+
+func TestGetTopPods_SortsByMemoryAndLimits(t *testing.T) {
+	listKinds := map[schema.GroupVersionResource]string{gvrPodMetrics: "PodMetricsList"}
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds)
+	// PodMetrics is served as "pods", which the tracker cannot guess from the kind.
+	for _, obj := range []*unstructured.Unstructured{
+		podMetricsObject("small", "900m", "64Mi"),
+		podMetricsObject("large", "10m", "2Gi"),
+		podMetricsObject("medium", "250m", "512Mi"),
+	} {
+		if err := dyn.Tracker().Create(gvrPodMetrics, obj, "default"); err != nil {
+			t.Fatalf("seed %s: %v", obj.GetName(), err)
+		}
+	}
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = dyn
+
+	top, err := m.GetTopPods(context.Background(), "c1", "default", TopPodsSortByMemory, 2)
+	if err != nil {
+		t.Fatalf("GetTopPods: %v", err)
+	}
+	if len(top) != 2 {
+		t.Fatalf("got %d pods, want limit of 2", len(top))
+	}
+	if top[0].Name != "large" || top[1].Name != "medium" {
+		t.Errorf("order = [%s %s], want [large medium]", top[0].Name, top[1].Name)
+	}
+	if top[0].MemoryBytes != 2<<30 || top[0].CPUMillicores != 10 {
+		t.Errorf("large = %+v, want 2Gi / 10m", top[0])
+	}
+
+	byCPU, err := m.GetTopPods(context.Background(), "c1", "default", "", 1)
+	if err != nil {
+		t.Fatalf("GetTopPods: %v", err)
+	}
+	if len(byCPU) != 1 || byCPU[0].Name != "small" {
+		t.Errorf("top by cpu = %+v, want small", byCPU)
+	}
+}
+
+func TestGetTopPods_InvalidSort(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	if _, err := m.GetTopPods(context.Background(), "c1", "", "disk", 10); !errors.Is(err, ErrInvalidTopPodsSort) {
+		t.Errorf("err = %v, want ErrInvalidTopPodsSort", err)
+	}
+}
+
+func TestGetTopPods_MetricsServerUnavailable(t *testing.T) {
+	listKinds := map[schema.GroupVersionResource]string{gvrPodMetrics: "PodMetricsList"}
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds)
+	dyn.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewServiceUnavailable("the server is currently unable to handle the request")
+	})
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = dyn
+
+	if _, err := m.GetTopPods(context.Background(), "c1", "", TopPodsSortByCPU, 10); !errors.Is(err, ErrMetricsUnavailable) {
+		t.Errorf("err = %v, want ErrMetricsUnavailable", err)
+	}
+}
@@ -0,0 +1,451 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Cause categories reported in PendingDiagnosis.Causes.
+const (
+	PendingCauseNoNodes               = "NoNodes"
+	PendingCauseUnschedulable         = "NodesUnschedulable"
+	PendingCauseTaints                = "UntoleratedTaints"
+	PendingCauseNodeSelector          = "NodeSelectorMismatch"
+	PendingCauseInsufficientResources = "InsufficientResources"
+	PendingCauseVolume                = "UnboundPersistentVolumeClaim"
+)
+
+// Per-node reasons, worded like the scheduler's FailedScheduling message so
+// they read the same as `kubectl describe pod`.
+const (
+	nodeReasonUnschedulable = "node(s) were unschedulable"
+	nodeReasonSelector      = "node(s) didn't match Pod's node affinity/selector"
+	nodeReasonTaintFmt      = "node(s) had untolerated taint {%s}"
+	nodeReasonInsufficient  = "Insufficient "
+)
+
+// PendingDiagnosis explains why a pod has not been scheduled.
+type PendingDiagnosis struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Pending   bool   `json:"pending"`
+	// Reason is a human-readable summary, e.g.
+	// "0/3 nodes are available: 3 Insufficient cpu".
+	Reason string   `json:"reason"`
+	Causes []string `json:"causes"`
+	// Requests is the pod's effective resource request (largest of the init
+	// containers and the sum of the app containers), per resource.
+	Requests   map[string]string `json:"requests,omitempty"`
+	NodesTotal int               `json:"nodesTotal"`
+	// NodeReasons counts the nodes rejected for each reason.
+	NodeReasons map[string]int `json:"nodeReasons,omitempty"`
+	// UnboundPVCs lists claims the pod mounts that are missing or not yet
+	// bound (WaitForFirstConsumer claims are excluded; they bind on schedule).
+	UnboundPVCs []string `json:"unboundPVCs,omitempty"`
+	// SchedulerMessages are the pod's FailedScheduling event messages,
+	// newest first.
+	SchedulerMessages []string `json:"schedulerMessages,omitempty"`
+}
+
+// DiagnosePendingPod classifies why a pod is stuck Pending by replaying the
+// scheduler's main filters (unschedulable nodes, taints, node selector and
+// required affinity, resource fit) against the cluster's nodes, and by
+// checking the pod's PersistentVolumeClaims. The scheduler's own
+// FailedScheduling events are returned alongside as supporting data.
+func (m *MultiClusterClient) DiagnosePendingPod(ctx context.Context, contextName, namespace, podName string) (*PendingDiagnosis, error) {
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	diag := &PendingDiagnosis{
+		Cluster:   contextName,
+		Namespace: namespace,
+		Pod:       podName,
+		Causes:    []string{},
+	}
+	if pod.Status.Phase != corev1.PodPending {
+		diag.Reason = fmt.Sprintf("Pod is not pending (phase %s)", pod.Status.Phase)
+		return diag, nil
+	}
+	if pod.Spec.NodeName != "" {
+		diag.Reason = fmt.Sprintf("Pod is scheduled to node %s and is waiting on its containers, not the scheduler", pod.Spec.NodeName)
+		return diag, nil
+	}
+	diag.Pending = true
+
+	requests := podEffectiveRequests(pod)
+	if len(requests) > 0 {
+		diag.Requests = make(map[string]string, len(requests))
+		for name, qty := range requests {
+			diag.Requests[string(name)] = qty.String()
+		}
+	}
+
+	if events, err := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Pod,involvedObject.name=" + podName,
+	}); err == nil {
+		diag.SchedulerMessages = failedSchedulingMessages(events.Items, pod)
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	diag.NodesTotal = len(nodes.Items)
+
+	used, err := requestedByNode(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	causes := make(map[string]bool)
+	diag.NodeReasons = make(map[string]int)
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		for _, reason := range nodeFitFailures(pod, node, requests, used[node.Name], causes) {
+			diag.NodeReasons[reason]++
+		}
+	}
+	if diag.NodesTotal == 0 {
+		causes[PendingCauseNoNodes] = true
+	}
+
+	diag.UnboundPVCs = unboundPodClaims(ctx, client, pod)
+	if len(diag.UnboundPVCs) > 0 {
+		causes[PendingCauseVolume] = true
+	}
+
+	for cause := range causes {
+		diag.Causes = append(diag.Causes, cause)
+	}
+	sort.Strings(diag.Causes)
+	diag.Reason = pendingReasonSummary(diag)
+	return diag, nil
+}
+
+// podEffectiveRequests mirrors the scheduler's request calculation: the
+// larger of the biggest init container and the sum of the app containers,
+// plus pod overhead.
+func podEffectiveRequests(pod *corev1.Pod) corev1.ResourceList {
+	total := corev1.ResourceList{}
+	for _, c := range pod.Spec.Containers {
+		for name, qty := range c.Resources.Requests {
+			sum := total[name]
+			sum.Add(qty)
+			total[name] = sum
+		}
+	}
+	for _, c := range pod.Spec.InitContainers {
+		for name, qty := range c.Resources.Requests {
+			if cur, ok := total[name]; !ok || qty.Cmp(cur) > 0 {
+				total[name] = qty.DeepCopy()
+			}
+		}
+	}
+	for name, qty := range pod.Spec.Overhead {
+		sum := total[name]
+		sum.Add(qty)
+		total[name] = sum
+	}
+	return total
+}
+
+// requestedByNode sums the requests of every non-terminal pod already bound
+// to a node.
+func requestedByNode(ctx context.Context, client kubernetes.Interface) (map[string]corev1.ResourceList, error) {
+	list, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]corev1.ResourceList)
+	for i := range list.Items {
+		p := &list.Items[i]
+		if p.Spec.NodeName == "" || p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		nodeUsed := used[p.Spec.NodeName]
+		if nodeUsed == nil {
+			nodeUsed = corev1.ResourceList{}
+			used[p.Spec.NodeName] = nodeUsed
+		}
+		for name, qty := range podEffectiveRequests(p) {
+			sum := nodeUsed[name]
+			sum.Add(qty)
+			nodeUsed[name] = sum
+		}
+	}
+	return used, nil
+}
+
+// nodeFitFailures returns why pod cannot land on node, in scheduler filter
+// order, stopping at the first failing filter like the scheduler does.
+// Matching cause categories are recorded in causes.
+func nodeFitFailures(pod *corev1.Pod, node *corev1.Node, requests, used corev1.ResourceList, causes map[string]bool) []string {
+	if node.Spec.Unschedulable && !toleratesUnschedulable(pod.Spec.Tolerations) {
+		causes[PendingCauseUnschedulable] = true
+		return []string{nodeReasonUnschedulable}
+	}
+
+	if taint := firstUntoleratedTaint(node.Spec.Taints, pod.Spec.Tolerations); taint != nil {
+		causes[PendingCauseTaints] = true
+		return []string{fmt.Sprintf(nodeReasonTaintFmt, taintDisplay(taint))}
+	}
+
+	if !podMatchesNodeSelector(pod, node) {
+		causes[PendingCauseNodeSelector] = true
+		return []string{nodeReasonSelector}
+	}
+
+	var insufficient []string
+	for name, want := range requests {
+		if want.IsZero() {
+			continue
+		}
+		allocatable, ok := node.Status.Allocatable[name]
+		if !ok {
+			insufficient = append(insufficient, nodeReasonInsufficient+string(name))
+			continue
+		}
+		free := allocatable.DeepCopy()
+		if u, ok := used[name]; ok {
+			free.Sub(u)
+		}
+		if want.Cmp(free) > 0 {
+			insufficient = append(insufficient, nodeReasonInsufficient+string(name))
+		}
+	}
+	if len(insufficient) > 0 {
+		causes[PendingCauseInsufficientResources] = true
+		sort.Strings(insufficient)
+	}
+	return insufficient
+}
+
+// toleratesUnschedulable reports whether the pod tolerates the
+// node.kubernetes.io/unschedulable taint the scheduler treats cordoned
+// nodes as carrying.
+func toleratesUnschedulable(tolerations []corev1.Toleration) bool {
+	taint := corev1.Taint{Key: corev1.TaintNodeUnschedulable, Effect: corev1.TaintEffectNoSchedule}
+	return firstUntoleratedTaint([]corev1.Taint{taint}, tolerations) == nil
+}
+
+// firstUntoleratedTaint returns the first NoSchedule/NoExecute taint none of
+// the tolerations match, or nil.
+func firstUntoleratedTaint(taints []corev1.Taint, tolerations []corev1.Toleration) *corev1.Taint {
+	for i := range taints {
+		taint := &taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for j := range tolerations {
+			if tolerationMatchesTaint(&tolerations[j], taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return taint
+		}
+	}
+	return nil
+}
+
+// tolerationMatchesTaint implements the Equal/Exists toleration semantics.
+func tolerationMatchesTaint(t *corev1.Toleration, taint *corev1.Taint) bool {
+	if t.Effect != "" && t.Effect != taint.Effect {
+		return false
+	}
+	if t.Key != "" && t.Key != taint.Key {
+		return false
+	}
+	switch t.Operator {
+	case corev1.TolerationOpExists:
+		return true
+	case "", corev1.TolerationOpEqual:
+		// An empty key only matches everything with operator Exists.
+		return t.Key != "" && t.Value == taint.Value
+	default:
+		return false
+	}
+}
+
+func taintDisplay(taint *corev1.Taint) string {
+	if taint.Value == "" {
+		return taint.Key
+	}
+	return taint.Key + ": " + taint.Value
+}
+
+// podMatchesNodeSelector checks spec.nodeSelector and the required node
+// affinity terms (terms are ORed, expressions within a term ANDed).
+func podMatchesNodeSelector(pod *corev1.Pod, node *corev1.Node) bool {
+	nodeLabels := labels.Set(node.Labels)
+	if len(pod.Spec.NodeSelector) > 0 && !labels.SelectorFromSet(pod.Spec.NodeSelector).Matches(nodeLabels) {
+		return false
+	}
+
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for _, term := range terms {
+		if nodeSelectorTermMatches(term, node, nodeLabels) {
+			return true
+		}
+	}
+	return len(terms) == 0
+}
+
+// nodeSelectorOperators maps node selector operators onto label selector
+// operators.
+var nodeSelectorOperators = map[corev1.NodeSelectorOperator]selection.Operator{
+	corev1.NodeSelectorOpIn:           selection.In,
+	corev1.NodeSelectorOpNotIn:        selection.NotIn,
+	corev1.NodeSelectorOpExists:       selection.Exists,
+	corev1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	corev1.NodeSelectorOpGt:           selection.GreaterThan,
+	corev1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+func nodeSelectorTermMatches(term corev1.NodeSelectorTerm, node *corev1.Node, nodeLabels labels.Set) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	for _, expr := range term.MatchExpressions {
+		op, ok := nodeSelectorOperators[expr.Operator]
+		if !ok {
+			return false
+		}
+		req, err := labels.NewRequirement(expr.Key, op, expr.Values)
+		if err != nil || !req.Matches(nodeLabels) {
+			return false
+		}
+	}
+	// metadata.name is the only field the scheduler supports in matchFields.
+	for _, field := range term.MatchFields {
+		if field.Key != metav1.ObjectNameField {
+			return false
+		}
+		op, ok := nodeSelectorOperators[field.Operator]
+		if !ok {
+			return false
+		}
+		req, err := labels.NewRequirement(metav1.ObjectNameField, op, field.Values)
+		if err != nil || !req.Matches(labels.Set{metav1.ObjectNameField: node.Name}) {
+			return false
+		}
+	}
+	return true
+}
+
+// failedSchedulingMessages returns the pod's FailedScheduling event
+// messages, newest first. Events are filtered client-side as well since not
+// every API server (or fake) honours the field selector.
+func failedSchedulingMessages(events []corev1.Event, pod *corev1.Pod) []string {
+	var matched []corev1.Event
+	for _, e := range events {
+		if e.Reason != "FailedScheduling" || e.InvolvedObject.Name != pod.Name {
+			continue
+		}
+		if e.InvolvedObject.UID != "" && pod.UID != "" && e.InvolvedObject.UID != pod.UID {
+			continue
+		}
+		matched = append(matched, e)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return EffectiveEventTime(&matched[i]).After(EffectiveEventTime(&matched[j]))
+	})
+	msgs := make([]string, 0, len(matched))
+	for _, e := range matched {
+		msgs = append(msgs, e.Message)
+	}
+	return msgs
+}
+
+// unboundPodClaims returns the pod's PVCs that are missing or Pending,
+// ignoring claims whose StorageClass binds on first consumer.
+func unboundPodClaims(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod) []string {
+	var unbound []string
+	for _, vol := range pod.Spec.Volumes {
+		if vol.PersistentVolumeClaim == nil {
+			continue
+		}
+		name := vol.PersistentVolumeClaim.ClaimName
+		pvc, err := client.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				unbound = append(unbound, name+" (not found)")
+			}
+			continue
+		}
+		if pvc.Status.Phase == corev1.ClaimBound {
+			continue
+		}
+		if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+			sc, err := client.StorageV1().StorageClasses().Get(ctx, *pvc.Spec.StorageClassName, metav1.GetOptions{})
+			if err == nil && sc.VolumeBindingMode != nil && *sc.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+				continue
+			}
+		}
+		unbound = append(unbound, name)
+	}
+	return unbound
+}
+
+// pendingReasonSummary renders the diagnosis as one sentence in the
+// scheduler's "0/N nodes are available: ..." form.
+func pendingReasonSummary(diag *PendingDiagnosis) string {
+	var parts []string
+	if len(diag.UnboundPVCs) > 0 {
+		parts = append(parts, "PersistentVolumeClaim not bound: "+strings.Join(diag.UnboundPVCs, ", "))
+	}
+	if diag.NodesTotal == 0 {
+		parts = append(parts, "no nodes in the cluster")
+		return strings.Join(parts, "; ")
+	}
+
+	reasons := make([]string, 0, len(diag.NodeReasons))
+	for r := range diag.NodeReasons {
+		reasons = append(reasons, r)
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		ci, cj := diag.NodeReasons[reasons[i]], diag.NodeReasons[reasons[j]]
+		if ci != cj {
+			return ci > cj
+		}
+		return reasons[i] < reasons[j]
+	})
+	if len(reasons) > 0 {
+		counted := make([]string, 0, len(reasons))
+		for _, r := range reasons {
+			counted = append(counted, fmt.Sprintf("%d %s", diag.NodeReasons[r], r))
+		}
+		parts = append(parts, fmt.Sprintf("0/%d nodes are available: %s", diag.NodesTotal, strings.Join(counted, ", ")))
+	}
+
+	if len(parts) == 0 {
+		if len(diag.SchedulerMessages) > 0 {
+			return diag.SchedulerMessages[0]
+		}
+		return "At least one node fits the pod; it may be waiting for the scheduler or blocked by pod affinity or topology spread constraints"
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,117 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func pendingTestNode(name, cpu string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"kubernetes.io/hostname": name}},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse("32Gi"),
+			},
+		},
+	}
+}
+
+func pendingTestPod(cpu string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "big", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+	}
+}
+
+func diagnoseWith(t *testing.T, objs ...runtime.Object) *PendingDiagnosis {
+	t.Helper()
+	m := &MultiClusterClient{}
+	m.InjectClient("c1", fake.NewSimpleClientset(objs...))
+	diag, err := m.DiagnosePendingPod(context.Background(), "c1", "default", "big")
+	require.NoError(t, err)
+	return diag
+}
+
+func TestDiagnosePendingPod_InsufficientCPU(t *testing.T) {
+	event := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "big.1", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "big", Namespace: "default"},
+		Reason:         "FailedScheduling",
+		Message:        "0/2 nodes are available: 2 Insufficient cpu.",
+	}
+	diag := diagnoseWith(t, pendingTestNode("n1", "8"), pendingTestNode("n2", "16"), pendingTestPod("64"), event)
+
+	assert.True(t, diag.Pending)
+	assert.Equal(t, []string{PendingCauseInsufficientResources}, diag.Causes)
+	assert.Equal(t, "0/2 nodes are available: 2 Insufficient cpu", diag.Reason)
+	assert.Equal(t, 2, diag.NodeReasons["Insufficient cpu"])
+	assert.Equal(t, "64", diag.Requests["cpu"])
+	assert.Equal(t, []string{"0/2 nodes are available: 2 Insufficient cpu."}, diag.SchedulerMessages)
+}
+
+func TestDiagnosePendingPod_CountsExistingRequests(t *testing.T) {
+	running := pendingTestPod("6")
+	running.Name = "running"
+	running.Spec.NodeName = "n1"
+	running.Status.Phase = corev1.PodRunning
+
+	diag := diagnoseWith(t, pendingTestNode("n1", "8"), running, pendingTestPod("4"))
+	assert.Contains(t, diag.Reason, "1 Insufficient cpu")
+}
+
+func TestDiagnosePendingPod_SelectorAndTaints(t *testing.T) {
+	tainted := pendingTestNode("gpu", "64")
+	tainted.Spec.Taints = []corev1.Taint{{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule}}
+	plain := pendingTestNode("cpu", "64")
+
+	pod := pendingTestPod("1")
+	pod.Spec.NodeSelector = map[string]string{"pool": "batch"}
+
+	diag := diagnoseWith(t, tainted, plain, pod)
+	assert.Equal(t, []string{PendingCauseNodeSelector, PendingCauseTaints}, diag.Causes)
+	assert.Equal(t, 1, diag.NodeReasons["node(s) had untolerated taint {nvidia.com/gpu: present}"])
+	assert.Equal(t, 1, diag.NodeReasons["node(s) didn't match Pod's node affinity/selector"])
+
+	// Tolerating the taint leaves only the selector mismatch on both nodes.
+	pod.Spec.Tolerations = []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}}
+	diag = diagnoseWith(t, tainted, plain, pod)
+	assert.Equal(t, []string{PendingCauseNodeSelector}, diag.Causes)
+	assert.Equal(t, 2, diag.NodeReasons["node(s) didn't match Pod's node affinity/selector"])
+}
+
+func TestDiagnosePendingPod_MissingPVC(t *testing.T) {
+	pod := pendingTestPod("1")
+	pod.Spec.Volumes = []corev1.Volume{{
+		Name:         "data",
+		VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"}},
+	}}
+	diag := diagnoseWith(t, pendingTestNode("n1", "8"), pod)
+	assert.Equal(t, []string{PendingCauseVolume}, diag.Causes)
+	assert.Equal(t, []string{"data (not found)"}, diag.UnboundPVCs)
+}
+
+func TestDiagnosePendingPod_NotPending(t *testing.T) {
+	pod := pendingTestPod("1")
+	pod.Spec.NodeName = "n1"
+	pod.Status.Phase = corev1.PodRunning
+	diag := diagnoseWith(t, pendingTestNode("n1", "8"), pod)
+	assert.False(t, diag.Pending)
+	assert.Empty(t, diag.Causes)
+}
@@ -0,0 +1,60 @@
+package k8s
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// resourceListStrings renders a container's requests or limits as
+// resource name to quantity string, e.g. {"cpu": "250m", "memory": "128Mi"}.
+// It returns nil for an empty list so the JSON field is omitted.
+func resourceListStrings(rl corev1.ResourceList) map[string]string {
+	if len(rl) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(rl))
+	for name, q := range rl {
+		out[string(name)] = q.String()
+	}
+	return out
+}
+
+// qosComputeResources are the resources the QoS class is derived from.
+var qosComputeResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// podQOSClass returns the pod's QoS class, as reported in its status or,
+// when the status does not carry one yet, derived from the container specs
+// with the kubelet's rules:
+//   - BestEffort: no container sets a CPU or memory request or limit;
+//   - Guaranteed: every container sets CPU and memory limits, and any
+//     request it sets equals the limit;
+//   - Burstable: everything else.
+func podQOSClass(pod *corev1.Pod) corev1.PodQOSClass {
+	if pod.Status.QOSClass != "" {
+		return pod.Status.QOSClass
+	}
+	containers := make([]corev1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	containers = append(containers, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+
+	anySet := false
+	guaranteed := true
+	for _, c := range containers {
+		for _, name := range qosComputeResources {
+			req, hasReq := c.Resources.Requests[name]
+			limit, hasLimit := c.Resources.Limits[name]
+			if (hasReq && !req.IsZero()) || (hasLimit && !limit.IsZero()) {
+				anySet = true
+			}
+			if !hasLimit || limit.IsZero() || (hasReq && req.Cmp(limit) != 0) {
+				guaranteed = false
+			}
+		}
+	}
+	switch {
+	case !anySet:
+		return corev1.PodQOSBestEffort
+	case guaranteed:
+		return corev1.PodQOSGuaranteed
+	}
+	return corev1.PodQOSBurstable
+}
@@ -0,0 +1,194 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Probe handler types reported in ContainerProbeSummary.
+const (
+	ProbeTypeHTTPGet   = "httpGet"
+	ProbeTypeTCPSocket = "tcpSocket"
+	ProbeTypeExec      = "exec"
+	ProbeTypeGRPC      = "grpc"
+)
+
+// probeFailureEventReason is the reason the kubelet records on events for
+// failed liveness, readiness and startup probes.
+const probeFailureEventReason = "Unhealthy"
+
+// ContainerProbeSummary describes the probes configured on one container of
+// a workload's pod template and whether its pods suggest they are failing.
+// The probe fields hold the probe's handler type, or are empty when that
+// probe is not configured.
+type ContainerProbeSummary struct {
+	Container string `json:"container"`
+	Liveness  string `json:"liveness,omitempty"`
+	Readiness string `json:"readiness,omitempty"`
+	Startup   string `json:"startup,omitempty"`
+	Pods      int    `json:"pods"`
+	NotReady  int    `json:"notReady"`
+	Restarts  int32  `json:"restarts"`
+	// SuspectedFailure is set when container statuses or recent Unhealthy
+	// events point at a probe; Reasons says which.
+	SuspectedFailure bool     `json:"suspectedFailure"`
+	Reasons          []string `json:"reasons,omitempty"`
+}
+
+// probeType returns the handler type of probe, or "" when it is nil.
+func probeType(probe *corev1.Probe) string {
+	switch {
+	case probe == nil:
+		return ""
+	case probe.HTTPGet != nil:
+		return ProbeTypeHTTPGet
+	case probe.TCPSocket != nil:
+		return ProbeTypeTCPSocket
+	case probe.Exec != nil:
+		return ProbeTypeExec
+	case probe.GRPC != nil:
+		return ProbeTypeGRPC
+	default:
+		return "unknown"
+	}
+}
+
+// summarizeProbes reads the probe specs from workload's pod template and
+// correlates them with the status of the workload's pods and any recent
+// Unhealthy events. A readiness probe is suspected when a running container
+// is not ready, a startup probe when a running container has not started,
+// and a liveness probe when the container has restarted; an Unhealthy event
+// for the container is always reported. Pod and event lookups are best
+// effort: when they fail the probe specs are still returned along with the
+// error.
+func (m *MultiClusterClient) summarizeProbes(ctx context.Context, cluster, namespace string, workload *unstructured.Unstructured) ([]ContainerProbeSummary, error) {
+	templateObj, found, err := unstructured.NestedMap(workload.Object, "spec", "template")
+	if err != nil || !found {
+		return nil, nil
+	}
+	var template corev1.PodTemplateSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateObj, &template); err != nil {
+		return nil, fmt.Errorf("failed to decode pod template: %w", err)
+	}
+
+	summaries := make([]ContainerProbeSummary, 0, len(template.Spec.Containers))
+	index := make(map[string]int, len(template.Spec.Containers))
+	for _, c := range template.Spec.Containers {
+		index[c.Name] = len(summaries)
+		summaries = append(summaries, ContainerProbeSummary{
+			Container: c.Name,
+			Liveness:  probeType(c.LivenessProbe),
+			Readiness: probeType(c.ReadinessProbe),
+			Startup:   probeType(c.StartupProbe),
+		})
+	}
+
+	selectorObj, found, _ := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if !found {
+		return summaries, nil
+	}
+	var labelSelector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorObj, &labelSelector); err != nil {
+		return summaries, fmt.Errorf("failed to decode selector: %w", err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return summaries, fmt.Errorf("invalid selector: %w", err)
+	}
+
+	client, err := m.GetClient(cluster)
+	if err != nil {
+		return summaries, err
+	}
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return summaries, err
+	}
+
+	podNames := make(map[string]bool, len(pods.Items))
+	runningNotReady := make([]int, len(summaries))
+	runningNotStarted := make([]int, len(summaries))
+	for _, pod := range pods.Items {
+		podNames[pod.Name] = true
+		for _, cs := range pod.Status.ContainerStatuses {
+			i, ok := index[cs.Name]
+			if !ok {
+				continue
+			}
+			s := &summaries[i]
+			s.Pods++
+			s.Restarts += cs.RestartCount
+			if !cs.Ready {
+				s.NotReady++
+			}
+			if cs.State.Running == nil {
+				continue
+			}
+			if !cs.Ready {
+				runningNotReady[i]++
+			}
+			if cs.Started != nil && !*cs.Started {
+				runningNotStarted[i]++
+			}
+		}
+	}
+	for i := range summaries {
+		s := &summaries[i]
+		if s.Readiness != "" && runningNotReady[i] > 0 {
+			s.flag(fmt.Sprintf("readiness probe: %d/%d running pods not ready", runningNotReady[i], s.Pods))
+		}
+		if s.Startup != "" && runningNotStarted[i] > 0 {
+			s.flag(fmt.Sprintf("startup probe: %d/%d running pods not started", runningNotStarted[i], s.Pods))
+		}
+		if s.Liveness != "" && s.Restarts > 0 {
+			s.flag(fmt.Sprintf("liveness probe: %d restarts across %d pods", s.Restarts, s.Pods))
+		}
+	}
+
+	events, err := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Pod,reason=" + probeFailureEventReason,
+	})
+	if err != nil {
+		return summaries, err
+	}
+	seen := make(map[string]bool)
+	for _, event := range events.Items {
+		if event.Reason != probeFailureEventReason || !podNames[event.InvolvedObject.Name] {
+			continue
+		}
+		i, ok := index[containerFromFieldPath(event.InvolvedObject.FieldPath)]
+		if !ok {
+			continue
+		}
+		// Repeated failures are usually distinct events with the same message
+		// on different pods; report each message once per container.
+		key := fmt.Sprintf("%d/%s", i, event.Message)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		summaries[i].flag(event.Message)
+	}
+	return summaries, nil
+}
+
+func (s *ContainerProbeSummary) flag(reason string) {
+	s.SuspectedFailure = true
+	s.Reasons = append(s.Reasons, reason)
+}
+
+// containerFromFieldPath extracts the container name from an event's
+// involvedObject.fieldPath, e.g. "spec.containers{web}".
+func containerFromFieldPath(fieldPath string) string {
+	const prefix = "spec.containers{"
+	if !strings.HasPrefix(fieldPath, prefix) || !strings.HasSuffix(fieldPath, "}") {
+		return ""
+	}
+	return fieldPath[len(prefix) : len(fieldPath)-1]
+}
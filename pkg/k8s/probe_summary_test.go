@@ -0,0 +1,116 @@
+package k8s
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMonitorWorkload_FlagsFailingReadinessProbe(t *testing.T) {
+	deployObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "web"}},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "web"}},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "web",
+							"image": "nginx",
+							"readinessProbe": map[string]interface{}{
+								"httpGet": map[string]interface{}{"path": "/healthz", "port": int64(8080)},
+							},
+						},
+						map[string]interface{}{"name": "sidecar", "image": "envoy"},
+					},
+				},
+			},
+		},
+	}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-abc", Namespace: "default", Labels: map[string]string{"app": "web"}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "web", Ready: false, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{Name: "sidecar", Ready: true, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	}
+	event := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "web-abc.1", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-abc", FieldPath: "spec.containers{web}"},
+		Reason:         probeFailureEventReason,
+		Message:        "Readiness probe failed: HTTP probe failed with statuscode: 503",
+	}
+
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), buildTestGVRMap(), deployObj)
+	m.clients["c1"] = k8sfake.NewSimpleClientset(pod, event)
+
+	res, err := m.MonitorWorkload(context.Background(), "c1", "default", "web")
+	if err != nil {
+		t.Fatalf("MonitorWorkload: %v", err)
+	}
+	if len(res.Probes) != 2 {
+		t.Fatalf("got %d probe summaries, want 2: %+v", len(res.Probes), res.Probes)
+	}
+	web, sidecar := res.Probes[0], res.Probes[1]
+	if web.Readiness != ProbeTypeHTTPGet || web.Liveness != "" {
+		t.Errorf("web probes = %+v, want readiness httpGet only", web)
+	}
+	if !web.SuspectedFailure || web.NotReady != 1 {
+		t.Errorf("web summary = %+v, want a suspected readiness failure", web)
+	}
+	if !strings.Contains(strings.Join(web.Reasons, "\n"), "statuscode: 503") {
+		t.Errorf("web reasons = %v, want the Unhealthy event message", web.Reasons)
+	}
+	if sidecar.SuspectedFailure {
+		t.Errorf("sidecar without probes should not be flagged: %+v", sidecar)
+	}
+
+	var found bool
+	for _, issue := range res.Issues {
+		if strings.HasPrefix(issue.ID, "issue-probe-") && strings.Contains(issue.Title, "web") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected a probe issue for container web, got %+v", res.Issues)
+	}
+}
+
+func TestProbeType(t *testing.T) {
+	tests := []struct {
+		probe *corev1.Probe
+		want  string
+	}{
+		{nil, ""},
+		{&corev1.Probe{ProbeHandler: corev1.ProbeHandler{HTTPGet: &corev1.HTTPGetAction{Port: intstr.FromInt32(80)}}}, ProbeTypeHTTPGet},
+		{&corev1.Probe{ProbeHandler: corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{}}}, ProbeTypeTCPSocket},
+		{&corev1.Probe{ProbeHandler: corev1.ProbeHandler{Exec: &corev1.ExecAction{}}}, ProbeTypeExec},
+		{&corev1.Probe{ProbeHandler: corev1.ProbeHandler{GRPC: &corev1.GRPCAction{}}}, ProbeTypeGRPC},
+	}
+	for _, tt := range tests {
+		if got := probeType(tt.probe); got != tt.want {
+			t.Errorf("probeType(%+v) = %q, want %q", tt.probe, got, tt.want)
+		}
+	}
+	if got := containerFromFieldPath("spec.containers{web}"); got != "web" {
+		t.Errorf("containerFromFieldPath = %q, want web", got)
+	}
+	if got := containerFromFieldPath("spec.initContainers{init}"); got != "" {
+		t.Errorf("containerFromFieldPath(init) = %q, want empty", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -1031,8 +1032,33 @@ func (m *MultiClusterClient) CreateNamespace(ctx context.Context, contextName, n
 	}, nil
 }
 
-// DeleteNamespace deletes a namespace from a cluster
-func (m *MultiClusterClient) DeleteNamespace(ctx context.Context, contextName, name string) error {
+// ErrProtectedNamespace is returned by DeleteNamespace when asked to delete a
+// namespace the cluster itself depends on without the force flag.
+var ErrProtectedNamespace = errors.New("refusing to delete protected namespace")
+
+// protectedNamespaces are namespaces whose deletion breaks the cluster (or
+// every workload that never set a namespace), so DeleteNamespace requires an
+// explicit force to remove them.
+var protectedNamespaces = map[string]bool{
+	"default":         true,
+	"kube-system":     true,
+	"kube-public":     true,
+	"kube-node-lease": true,
+}
+
+// IsProtectedNamespace reports whether name is guarded against deletion.
+func IsProtectedNamespace(name string) bool {
+	return protectedNamespaces[name]
+}
+
+// DeleteNamespace deletes a namespace from a cluster. Protected namespaces
+// (default, kube-system, kube-public, kube-node-lease) are rejected with
+// ErrProtectedNamespace unless force is set.
+func (m *MultiClusterClient) DeleteNamespace(ctx context.Context, contextName, name string, force bool) error {
+	if !force && IsProtectedNamespace(name) {
+		return fmt.Errorf("%w %q", ErrProtectedNamespace, name)
+	}
+
 	client, err := m.GetClient(contextName)
 	if err != nil {
 		return err
@@ -0,0 +1,143 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubestellar/console/pkg/models"
+)
+
+// maxConcurrentCanIChecks bounds the SelfSubjectAccessReviews issued in
+// parallel by CanIDeployBundle against a single cluster.
+const maxConcurrentCanIChecks = 5
+
+// canIDeployVerb is the verb checked for every resource in a bundle. Deploy
+// creates missing resources and updates console-managed ones; create is the
+// permission a first deploy cannot do without.
+const canIDeployVerb = "create"
+
+// DeniedPermission is one permission a deploy needs on the target cluster
+// but the caller's credentials do not have.
+type DeniedPermission struct {
+	Kind      string `json:"kind"`
+	Group     string `json:"group"`
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Verb      string `json:"verb"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// CanI reports whether the kubeconfig identity for contextName may perform
+// verb on group/resource in namespace (empty namespace = cluster scope),
+// using a SelfSubjectAccessReview.
+func (m *MultiClusterClient) CanI(ctx context.Context, contextName, namespace string, verb, group, resource string) (bool, error) {
+	result, err := m.CheckCanI(ctx, contextName, models.CanIRequest{
+		Verb:      verb,
+		Group:     group,
+		Resource:  resource,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.Allowed, nil
+}
+
+// canICheck is one distinct (GVR, namespace) pair to review.
+type canICheck struct {
+	kind      string
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+// CanIDeployBundle checks that the caller may create the workload and every
+// dependency of bundle on contextName. Namespaced resources are checked in
+// namespace; cluster-scoped dependencies (ClusterRoles, CRDs, ...) at cluster
+// scope. Returns the denied permissions, sorted, or an empty slice when
+// everything is allowed. Any failed review fails the whole check, since a
+// partial answer would read as "allowed".
+func (m *MultiClusterClient) CanIDeployBundle(ctx context.Context, contextName, namespace string, bundle *DependencyBundle) ([]DeniedPermission, error) {
+	if bundle == nil {
+		return nil, fmt.Errorf("no dependency bundle given")
+	}
+
+	seen := make(map[string]bool)
+	var checks []canICheck
+	add := func(kind string, gvr schema.GroupVersionResource, ns string) {
+		key := gvr.Group + "/" + gvr.Resource + "/" + ns
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		checks = append(checks, canICheck{kind: kind, gvr: gvr, namespace: ns})
+	}
+
+	if bundle.Workload != nil {
+		kind := bundle.Workload.GetKind()
+		if gvr, ok := workloadGVRByKind[kind]; ok {
+			add(kind, gvr, namespace)
+		}
+	}
+	for _, dep := range bundle.Dependencies {
+		ns := ""
+		if dep.Namespace != "" {
+			ns = namespace
+		}
+		add(string(dep.Kind), dep.GVR, ns)
+	}
+
+	var mu sync.Mutex
+	denied := make([]DeniedPermission, 0)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentCanIChecks)
+	for _, check := range checks {
+		g.Go(func() error {
+			result, err := m.CheckCanI(gctx, contextName, models.CanIRequest{
+				Verb:      canIDeployVerb,
+				Group:     check.gvr.Group,
+				Resource:  check.gvr.Resource,
+				Namespace: check.namespace,
+			})
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", canIDeployVerb, check.gvr.Resource, err)
+			}
+			if !result.Allowed {
+				mu.Lock()
+				denied = append(denied, DeniedPermission{
+					Kind:      check.kind,
+					Group:     check.gvr.Group,
+					Resource:  check.gvr.Resource,
+					Namespace: check.namespace,
+					Verb:      canIDeployVerb,
+					Reason:    result.Reason,
+				})
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(denied, func(i, j int) bool {
+		if denied[i].Group != denied[j].Group {
+			return denied[i].Group < denied[j].Group
+		}
+		return denied[i].Resource < denied[j].Resource
+	})
+	return denied, nil
+}
+
+// workloadGVRByKind maps the workload kinds DeployWorkload supports to
+// their GVRs.
+var workloadGVRByKind = map[string]schema.GroupVersionResource{
+	"Deployment":  gvrDeployments,
+	"StatefulSet": gvrStatefulSets,
+	"DaemonSet":   gvrDaemonSets,
+}
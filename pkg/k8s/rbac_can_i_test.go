@@ -0,0 +1,110 @@
+package k8s
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	authv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newCanIFakeClient returns a clientset whose SelfSubjectAccessReviews deny
+// any resource in denied and allow everything else. Every reviewed
+// "resource/namespace" pair is recorded in reviewed.
+func newCanIFakeClient(denied map[string]bool, reviewed *[]string, mu *sync.Mutex) *fake.Clientset {
+	fakeCS := fake.NewSimpleClientset()
+	fakeCS.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authv1.SelfSubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		if reviewed != nil {
+			mu.Lock()
+			*reviewed = append(*reviewed, attrs.Resource+"/"+attrs.Namespace)
+			mu.Unlock()
+		}
+		status := authv1.SubjectAccessReviewStatus{Allowed: !denied[attrs.Resource]}
+		if !status.Allowed {
+			status.Reason = "no RBAC policy matched"
+		}
+		return true, &authv1.SelfSubjectAccessReview{Status: status}, nil
+	})
+	return fakeCS
+}
+
+func TestCanI(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = newCanIFakeClient(map[string]bool{"secrets": true}, nil, nil)
+
+	allowed, err := m.CanI(context.Background(), "c1", "default", "get", "", "configmaps")
+	if err != nil {
+		t.Fatalf("CanI: %v", err)
+	}
+	if !allowed {
+		t.Error("expected configmaps to be allowed")
+	}
+
+	allowed, err = m.CanI(context.Background(), "c1", "default", "get", "", "secrets")
+	if err != nil {
+		t.Fatalf("CanI: %v", err)
+	}
+	if allowed {
+		t.Error("expected secrets to be denied")
+	}
+}
+
+func TestCanIDeployBundle(t *testing.T) {
+	var mu sync.Mutex
+	var reviewed []string
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = newCanIFakeClient(map[string]bool{"secrets": true, "clusterroles": true}, &reviewed, &mu)
+
+	workload := &unstructured.Unstructured{}
+	workload.SetKind("Deployment")
+	bundle := &DependencyBundle{
+		Workload: workload,
+		Dependencies: []Dependency{
+			{Kind: DepConfigMap, Name: "cfg", Namespace: "src", GVR: gvrConfigMaps},
+			{Kind: DepSecret, Name: "a", Namespace: "src", GVR: gvrSecrets},
+			{Kind: DepSecret, Name: "b", Namespace: "src", GVR: gvrSecrets},
+			{Kind: DepClusterRole, Name: "reader", GVR: gvrClusterRoles},
+		},
+	}
+
+	denied, err := m.CanIDeployBundle(context.Background(), "c1", "target", bundle)
+	if err != nil {
+		t.Fatalf("CanIDeployBundle: %v", err)
+	}
+
+	// deployments, configmaps, secrets (deduplicated) and clusterroles.
+	if len(reviewed) != 4 {
+		t.Errorf("reviewed %v, want 4 distinct checks", reviewed)
+	}
+	if len(denied) != 2 {
+		t.Fatalf("denied = %+v, want secrets and clusterroles", denied)
+	}
+	if denied[0].Resource != "secrets" || denied[0].Namespace != "target" || denied[0].Verb != "create" {
+		t.Errorf("denied[0] = %+v, want create secrets in target", denied[0])
+	}
+	if denied[1].Resource != "clusterroles" || denied[1].Namespace != "" || denied[1].Kind != "ClusterRole" {
+		t.Errorf("denied[1] = %+v, want cluster-scoped clusterroles", denied[1])
+	}
+	if denied[0].Reason == "" {
+		t.Error("expected the review reason to be carried through")
+	}
+}
+
+func TestCanIDeployBundle_AllAllowed(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = newCanIFakeClient(nil, nil, nil)
+
+	denied, err := m.CanIDeployBundle(context.Background(), "c1", "default", &DependencyBundle{})
+	if err != nil {
+		t.Fatalf("CanIDeployBundle: %v", err)
+	}
+	if denied == nil || len(denied) != 0 {
+		t.Errorf("denied = %#v, want empty non-nil slice", denied)
+	}
+}
@@ -2,6 +2,7 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/kubestellar/console/pkg/models"
@@ -172,7 +173,7 @@ func TestRBAC_DeleteNamespace(t *testing.T) {
 	fakeCS := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}})
 	m.clients["c1"] = fakeCS
 
-	err := m.DeleteNamespace(context.Background(), "c1", "ns1")
+	err := m.DeleteNamespace(context.Background(), "c1", "ns1", false)
 	if err != nil {
 		t.Fatalf("DeleteNamespace failed: %v", err)
 	}
@@ -183,6 +184,33 @@ func TestRBAC_DeleteNamespace(t *testing.T) {
 	}
 }
 
+func TestRBAC_DeleteNamespace_ProtectedGuard(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	fakeCS := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}})
+	m.clients["c1"] = fakeCS
+
+	err := m.DeleteNamespace(context.Background(), "c1", "kube-system", false)
+	if !errors.Is(err, ErrProtectedNamespace) {
+		t.Fatalf("Expected ErrProtectedNamespace, got %v", err)
+	}
+	if _, err := fakeCS.CoreV1().Namespaces().Get(context.Background(), "kube-system", metav1.GetOptions{}); err != nil {
+		t.Errorf("Protected namespace should not be deleted: %v", err)
+	}
+}
+
+func TestRBAC_DeleteNamespace_Forced(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	fakeCS := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	m.clients["c1"] = fakeCS
+
+	if err := m.DeleteNamespace(context.Background(), "c1", "default", true); err != nil {
+		t.Fatalf("Forced DeleteNamespace failed: %v", err)
+	}
+	if _, err := fakeCS.CoreV1().Namespaces().Get(context.Background(), "default", metav1.GetOptions{}); err == nil {
+		t.Error("Namespace should be deleted when forced")
+	}
+}
+
 func TestGetAllClusterPermissions(t *testing.T) {
 	m, _ := NewMultiClusterClient("")
 	m.rawConfig = &api.Config{Contexts: map[string]*api.Context{
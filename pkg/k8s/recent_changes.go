@@ -0,0 +1,184 @@
+package k8s
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ChangeEntry is a resource created or modified within a GetRecentChanges
+// window.
+type ChangeEntry struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Cluster   string `json:"cluster"`
+	// ChangedAt is the latest managedFields time, or the creation time when
+	// that is later or no managedFields entry carries a time.
+	ChangedAt string `json:"changedAt"`
+	// Manager is the field manager of the latest change, e.g.
+	// "kube-controller-manager" or "kubectl-client-side-apply".
+	Manager string `json:"manager,omitempty"`
+	// Operation is the latest change's managedFields operation, Apply or
+	// Update.
+	Operation string `json:"operation,omitempty"`
+	// Created is true when the resource itself was created in the window.
+	Created bool `json:"created"`
+}
+
+// SkippedKind is a kind GetRecentChanges could not list on a cluster, such
+// as one the caller is not allowed to list.
+type SkippedKind struct {
+	Cluster string `json:"cluster"`
+	Kind    string `json:"kind"`
+	Reason  string `json:"reason"`
+}
+
+// recentChangeKind lists the objects of one kind for GetRecentChanges.
+type recentChangeKind struct {
+	kind string
+	list func(ctx context.Context, client kubernetes.Interface, namespace string) ([]metav1.Object, error)
+}
+
+// recentChangeKinds is the core set of kinds GetRecentChanges scans.
+var recentChangeKinds = []recentChangeKind{
+	{"Deployment", func(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.Object, error) {
+		list, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return metaObjects(list.Items), nil
+	}},
+	{"StatefulSet", func(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.Object, error) {
+		list, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return metaObjects(list.Items), nil
+	}},
+	{"DaemonSet", func(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.Object, error) {
+		list, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return metaObjects(list.Items), nil
+	}},
+	{"Service", func(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.Object, error) {
+		list, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return metaObjects(list.Items), nil
+	}},
+	{"ConfigMap", func(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.Object, error) {
+		list, err := client.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return metaObjects(list.Items), nil
+	}},
+	{"Ingress", func(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.Object, error) {
+		list, err := client.NetworkingV1().Ingresses(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return metaObjects(list.Items), nil
+	}},
+}
+
+// metaObjects returns pointers to items as metav1.Objects.
+func metaObjects[T any, PT interface {
+	*T
+	metav1.Object
+}](items []T) []metav1.Object {
+	objs := make([]metav1.Object, 0, len(items))
+	for i := range items {
+		objs = append(objs, PT(&items[i]))
+	}
+	return objs
+}
+
+// GetRecentChanges returns the Deployments, StatefulSets, DaemonSets,
+// Services, ConfigMaps and Ingresses in namespace (every namespace when
+// empty) created or modified within the last since, newest first. A
+// modification is a managedFields entry timestamped within the window.
+// Kinds the caller is forbidden to list are left out and returned as
+// skipped rather than failing the whole scan.
+func (m *MultiClusterClient) GetRecentChanges(ctx context.Context, contextName, namespace string, since time.Duration) (_ []ChangeEntry, skipped []SkippedKind, err error) {
+	defer observeClusterRequest(contextName, "GetRecentChanges", time.Now(), &err)
+	client, err := m.GetClient(contextName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cutoff := time.Now().Add(-since)
+	type timedChange struct {
+		entry ChangeEntry
+		at    time.Time
+	}
+	var found []timedChange
+	for _, k := range recentChangeKinds {
+		objs, err := k.list(ctx, client, namespace)
+		if apierrors.IsForbidden(err) {
+			skipped = append(skipped, SkippedKind{Cluster: contextName, Kind: k.kind, Reason: err.Error()})
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, obj := range objs {
+			entry, at, ok := recentChange(obj, cutoff)
+			if !ok {
+				continue
+			}
+			entry.Kind = k.kind
+			entry.Cluster = contextName
+			found = append(found, timedChange{entry, at})
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool { return found[i].at.After(found[j].at) })
+	changes := make([]ChangeEntry, 0, len(found))
+	for _, f := range found {
+		changes = append(changes, f.entry)
+	}
+	return changes, skipped, nil
+}
+
+// recentChange reports whether obj was created or modified at or after
+// cutoff, returning its entry (without Kind and Cluster) and change time.
+func recentChange(obj metav1.Object, cutoff time.Time) (ChangeEntry, time.Time, bool) {
+	var latest *metav1.ManagedFieldsEntry
+	for i, mf := range obj.GetManagedFields() {
+		if mf.Time == nil {
+			continue
+		}
+		if latest == nil || mf.Time.After(latest.Time.Time) {
+			latest = &obj.GetManagedFields()[i]
+		}
+	}
+
+	created := obj.GetCreationTimestamp().Time
+	at := created
+	entry := ChangeEntry{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Created:   !created.IsZero() && !created.Before(cutoff),
+	}
+	if latest != nil && latest.Time.After(created) {
+		at = latest.Time.Time
+	}
+	if latest != nil {
+		entry.Manager = latest.Manager
+		entry.Operation = string(latest.Operation)
+	}
+	if at.IsZero() || at.Before(cutoff) {
+		return ChangeEntry{}, time.Time{}, false
+	}
+	entry.ChangedAt = at.Format(time.RFC3339)
+	return entry, at, true
+}
@@ -0,0 +1,93 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestGetRecentChanges(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) metav1.Time { return metav1.NewTime(now.Add(-d)) }
+	managed := func(manager string, at metav1.Time) []metav1.ManagedFieldsEntry {
+		return []metav1.ManagedFieldsEntry{
+			{Manager: "kubectl-create", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: now.Add(-30 * 24 * time.Hour)}},
+			{Manager: manager, Operation: metav1.ManagedFieldsOperationApply, Time: &at},
+		}
+	}
+
+	recent := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Name: "api", Namespace: "default",
+		CreationTimestamp: ago(30 * 24 * time.Hour),
+		ManagedFields:     managed("argocd-controller", ago(10*time.Minute)),
+	}}
+	old := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Name: "legacy", Namespace: "default",
+		CreationTimestamp: ago(30 * 24 * time.Hour),
+		ManagedFields:     managed("helm", ago(48*time.Hour)),
+	}}
+	created := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name: "settings", Namespace: "default",
+		CreationTimestamp: ago(5 * time.Minute),
+	}}
+
+	m := &MultiClusterClient{clients: map[string]kubernetes.Interface{
+		"c1": k8sfake.NewSimpleClientset(recent, old, created),
+	}}
+	changes, skipped, err := m.GetRecentChanges(context.Background(), "c1", "", time.Hour)
+	if err != nil {
+		t.Fatalf("GetRecentChanges: %v", err)
+	}
+	if len(skipped) != 0 {
+		t.Errorf("skipped = %+v, want none", skipped)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("got %d changes, want 2: %+v", len(changes), changes)
+	}
+
+	// Newest first: the ConfigMap was created after the Deployment changed.
+	if c := changes[0]; c.Kind != "ConfigMap" || c.Name != "settings" || !c.Created {
+		t.Errorf("changes[0] = %+v, want created ConfigMap settings", c)
+	}
+	c := changes[1]
+	if c.Kind != "Deployment" || c.Name != "api" || c.Cluster != "c1" {
+		t.Errorf("changes[1] = %+v, want Deployment api on c1", c)
+	}
+	if c.Manager != "argocd-controller" || c.Operation != "Apply" || c.Created {
+		t.Errorf("changes[1] manager = %q, operation = %q, created = %v; want argocd-controller, Apply, false",
+			c.Manager, c.Operation, c.Created)
+	}
+}
+
+func TestGetRecentChanges_SkipsForbiddenKind(t *testing.T) {
+	created := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name: "settings", Namespace: "default",
+		CreationTimestamp: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+	}}
+	fakeClient := k8sfake.NewSimpleClientset(created)
+	fakeClient.PrependReactor("list", "services", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "services"}, "", nil)
+	})
+	m := &MultiClusterClient{clients: map[string]kubernetes.Interface{"c1": fakeClient}}
+
+	changes, skipped, err := m.GetRecentChanges(context.Background(), "c1", "", time.Hour)
+	if err != nil {
+		t.Fatalf("GetRecentChanges: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Name != "settings" {
+		t.Errorf("changes = %+v, want the ConfigMap settings", changes)
+	}
+	if len(skipped) != 1 || skipped[0].Kind != "Service" || skipped[0].Cluster != "c1" {
+		t.Errorf("skipped = %+v, want Service on c1", skipped)
+	}
+}
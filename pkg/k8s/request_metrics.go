@@ -0,0 +1,73 @@
+package k8s
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	// clusterRequestsTotal counts MultiClusterClient calls per cluster.
+	clusterRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kc_cluster_requests_total",
+			Help: "Total Kubernetes API operations issued by the console, by cluster and operation",
+		},
+		[]string{"cluster", "operation"},
+	)
+
+	// clusterRequestErrors counts failed calls, classified with classifyError
+	// (timeout, ratelimit, auth, network, certificate, config, unknown).
+	clusterRequestErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kc_cluster_request_errors_total",
+			Help: "Total failed Kubernetes API operations, by cluster, operation and error type",
+		},
+		[]string{"cluster", "operation", "error_type"},
+	)
+
+	// clusterRequestDuration tracks end-to-end latency of each operation,
+	// including any client-side filtering done after the API call.
+	clusterRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kc_cluster_request_duration_seconds",
+			Help:    "Duration of Kubernetes API operations, by cluster and operation",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 12), // 10ms .. ~20s
+		},
+		[]string{"cluster", "operation"},
+	)
+
+	requestMetricsInit sync.Once
+)
+
+// InitClusterRequestMetrics registers the per-cluster request metrics with
+// the default Prometheus registry. Safe to call more than once.
+func InitClusterRequestMetrics() {
+	requestMetricsInit.Do(func() {
+		prometheus.MustRegister(clusterRequestsTotal)
+		prometheus.MustRegister(clusterRequestErrors)
+		prometheus.MustRegister(clusterRequestDuration)
+	})
+}
+
+// MetricsHandler returns the Prometheus HTTP handler with the per-cluster
+// request metrics registered.
+func MetricsHandler() http.Handler {
+	InitClusterRequestMetrics()
+	return promhttp.Handler()
+}
+
+// observeClusterRequest records one operation against a cluster. It is
+// meant to be deferred at the top of a method with a named error result:
+//
+//	defer observeClusterRequest(contextName, "GetPods", time.Now(), &err)
+func observeClusterRequest(cluster, operation string, start time.Time, errp *error) {
+	clusterRequestsTotal.WithLabelValues(cluster, operation).Inc()
+	clusterRequestDuration.WithLabelValues(cluster, operation).Observe(time.Since(start).Seconds())
+	if errp != nil && *errp != nil {
+		clusterRequestErrors.WithLabelValues(cluster, operation, classifyError((*errp).Error())).Inc()
+	}
+}
@@ -0,0 +1,90 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// scrapeMetric gathers the default registry and returns the metric in family
+// name whose labels include all of want, or nil.
+func scrapeMetric(t *testing.T, name string, want map[string]string) *dto.Metric {
+	t.Helper()
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	for _, mf := range families {
+		if mf.GetName() != name {
+			continue
+		}
+		for _, metric := range mf.GetMetric() {
+			matched := 0
+			for _, lp := range metric.GetLabel() {
+				if v, ok := want[lp.GetName()]; ok && v == lp.GetValue() {
+					matched++
+				}
+			}
+			if matched == len(want) {
+				return metric
+			}
+		}
+	}
+	return nil
+}
+
+func TestRequestMetrics_GetPodsIncrementsCounters(t *testing.T) {
+	InitClusterRequestMetrics()
+
+	m, _ := NewMultiClusterClient("")
+	injectTestClusters(m, "metrics-cluster")
+	m.InjectClient("metrics-cluster", fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"},
+	}))
+
+	labels := map[string]string{"cluster": "metrics-cluster", "operation": "GetPods"}
+	before := 0.0
+	if metric := scrapeMetric(t, "kc_cluster_requests_total", labels); metric != nil {
+		before = metric.GetCounter().GetValue()
+	}
+
+	if _, err := m.GetPods(context.Background(), "metrics-cluster", "default"); err != nil {
+		t.Fatalf("GetPods: %v", err)
+	}
+
+	metric := scrapeMetric(t, "kc_cluster_requests_total", labels)
+	if metric == nil {
+		t.Fatal("kc_cluster_requests_total not exported for metrics-cluster/GetPods")
+	}
+	if got := metric.GetCounter().GetValue(); got != before+1 {
+		t.Errorf("request count = %v, want %v", got, before+1)
+	}
+	hist := scrapeMetric(t, "kc_cluster_request_duration_seconds", labels)
+	if hist == nil || hist.GetHistogram().GetSampleCount() == 0 {
+		t.Error("expected a duration sample for metrics-cluster/GetPods")
+	}
+	if scrapeMetric(t, "kc_cluster_request_errors_total", labels) != nil {
+		t.Error("successful GetPods should not record an error")
+	}
+}
+
+func TestRequestMetrics_ErrorsClassified(t *testing.T) {
+	InitClusterRequestMetrics()
+
+	err := errors.New("context deadline exceeded")
+	observeClusterRequest("slow-cluster", "GetNodes", time.Now(), &err)
+
+	metric := scrapeMetric(t, "kc_cluster_request_errors_total", map[string]string{
+		"cluster": "slow-cluster", "operation": "GetNodes", "error_type": "timeout",
+	})
+	if metric == nil || metric.GetCounter().GetValue() < 1 {
+		t.Error("expected a timeout error for slow-cluster/GetNodes")
+	}
+}
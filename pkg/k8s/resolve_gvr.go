@@ -0,0 +1,90 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/restmapper"
+)
+
+// ErrUnknownKind is returned by ResolveGVR when no served resource matches.
+var ErrUnknownKind = errors.New("unknown kind or resource")
+
+// AmbiguousKindError is returned by ResolveGVR when the input matches
+// resources in more than one API group, e.g. "event" (v1 and events.k8s.io).
+// Candidates holds the preferred version of each match; qualifying the
+// input with the group ("events.events.k8s.io") picks one.
+type AmbiguousKindError struct {
+	Input      string
+	Candidates []schema.GroupVersionResource
+}
+
+func (e *AmbiguousKindError) Error() string {
+	names := make([]string, 0, len(e.Candidates))
+	for _, c := range e.Candidates {
+		names = append(names, c.String())
+	}
+	return fmt.Sprintf("%q is ambiguous: matches %s", e.Input, strings.Join(names, "; "))
+}
+
+// ResolveGVR resolves a friendly kind or resource name to the GVR served by
+// contextName: a kind ("Deployment"), its lower-case singular
+// ("deployment"), plural ("deployments") or short name ("deploy"), each
+// optionally qualified with its group ("deployments.apps"). The cluster's
+// preferred version is chosen. Resolution uses the cached RESTMapper; a miss
+// refreshes it once, so CRDs installed since the cache was filled resolve.
+// Refreshes are rate-limited per cluster (see mapperResetInterval).
+func (m *MultiClusterClient) ResolveGVR(ctx context.Context, contextName, kindOrResource string) (_ schema.GroupVersionResource, err error) {
+	defer observeClusterRequest(contextName, "ResolveGVR", time.Now(), &err)
+	input := strings.TrimSpace(kindOrResource)
+	if input == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("%w: empty name", ErrUnknownKind)
+	}
+	if err := ctx.Err(); err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	dc, err := m.discoveryCacheFor(contextName)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	mapper := restmapper.NewShortcutExpander(dc.mapper, dc.discovery, func(warning string) {
+		slog.Debug("[ResolveGVR] shortcut warning", "cluster", contextName, "warning", warning)
+	})
+
+	partial := schema.ParseGroupResource(strings.ToLower(input)).WithVersion("")
+	matches, err := mapper.ResourcesFor(partial)
+	if apimeta.IsNoMatchError(err) && dc.resetMapper() {
+		matches, err = mapper.ResourcesFor(partial)
+	}
+	if apimeta.IsNoMatchError(err) || (err == nil && len(matches) == 0) {
+		return schema.GroupVersionResource{}, fmt.Errorf("%w: %q in cluster %s", ErrUnknownKind, input, contextName)
+	}
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+
+	// matches lists every served version; keep the preferred one per group.
+	var candidates []schema.GroupVersionResource
+	seen := make(map[string]bool)
+	for _, gvr := range matches {
+		if seen[gvr.Group] {
+			continue
+		}
+		seen[gvr.Group] = true
+		preferred, err := mapper.ResourceFor(schema.GroupVersionResource{Group: gvr.Group, Resource: gvr.Resource})
+		if err != nil {
+			preferred = gvr
+		}
+		candidates = append(candidates, preferred)
+	}
+	if len(candidates) > 1 {
+		return schema.GroupVersionResource{}, &AmbiguousKindError{Input: input, Candidates: candidates}
+	}
+	return candidates[0], nil
+}
@@ -0,0 +1,99 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func newResolveGVRTestClient() *MultiClusterClient {
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{
+			{Name: "events", SingularName: "event", Kind: "Event", Namespaced: true, ShortNames: []string{"ev"}},
+			{Name: "pods", SingularName: "pod", Kind: "Pod", Namespaced: true, ShortNames: []string{"po"}},
+		}},
+		{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{
+			{Name: "deployments", SingularName: "deployment", Kind: "Deployment", Namespaced: true, ShortNames: []string{"deploy"}},
+			{Name: "deployments/scale", Kind: "Scale", Namespaced: true},
+		}},
+		{GroupVersion: "events.k8s.io/v1", APIResources: []metav1.APIResource{
+			{Name: "events", SingularName: "event", Kind: "Event", Namespaced: true, ShortNames: []string{"ev"}},
+		}},
+	}
+	m, _ := NewMultiClusterClient("")
+	m.SetClient("c1", clientset)
+	return m
+}
+
+func TestResolveGVR_FriendlyNames(t *testing.T) {
+	m := newResolveGVRTestClient()
+	deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	tests := []struct {
+		input string
+		want  schema.GroupVersionResource
+	}{
+		{"deploy", deployments},
+		{"Deployment", deployments},
+		{"deployment", deployments},
+		{"deployments", deployments},
+		{"deployments.apps", deployments},
+		{"po", schema.GroupVersionResource{Version: "v1", Resource: "pods"}},
+		{"events.events.k8s.io", schema.GroupVersionResource{Group: "events.k8s.io", Version: "v1", Resource: "events"}},
+	}
+	for _, tt := range tests {
+		got, err := m.ResolveGVR(context.Background(), "c1", tt.input)
+		if err != nil {
+			t.Errorf("ResolveGVR(%q): %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ResolveGVR(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestResolveGVR_Ambiguous(t *testing.T) {
+	m := newResolveGVRTestClient()
+
+	_, err := m.ResolveGVR(context.Background(), "c1", "Event")
+	var ambiguous *AmbiguousKindError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("error = %v, want AmbiguousKindError", err)
+	}
+	groups := map[string]bool{}
+	for _, c := range ambiguous.Candidates {
+		groups[c.Group] = true
+	}
+	if len(ambiguous.Candidates) != 2 || !groups[""] || !groups["events.k8s.io"] {
+		t.Errorf("candidates = %v, want core and events.k8s.io events", ambiguous.Candidates)
+	}
+}
+
+func TestResolveGVR_Unknown(t *testing.T) {
+	m := newResolveGVRTestClient()
+
+	if _, err := m.ResolveGVR(context.Background(), "c1", "frobnicator"); !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("error = %v, want ErrUnknownKind", err)
+	}
+}
+
+func TestResolveGVR_UnknownDoesNotRediscoverEachTime(t *testing.T) {
+	m := newResolveGVRTestClient()
+	clientset := m.clients["c1"].(*k8sfake.Clientset)
+
+	if _, err := m.ResolveGVR(context.Background(), "c1", "frobnicator"); !errors.Is(err, ErrUnknownKind) {
+		t.Fatalf("error = %v, want ErrUnknownKind", err)
+	}
+	calls := len(clientset.Actions())
+	if _, err := m.ResolveGVR(context.Background(), "c1", "frobnicator"); !errors.Is(err, ErrUnknownKind) {
+		t.Fatalf("error = %v, want ErrUnknownKind", err)
+	}
+	if got := len(clientset.Actions()) - calls; got != 0 {
+		t.Errorf("second miss made %d discovery calls, want 0", got)
+	}
+}
@@ -0,0 +1,48 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ErrInvalidPropagation is returned by ParseDeletionPropagation for a cascade
+// value other than foreground, background or orphan.
+var ErrInvalidPropagation = errors.New("invalid cascade")
+
+// ParseDeletionPropagation maps kubectl's --cascade values (foreground,
+// background, orphan; case-insensitive) to a propagation policy. An empty
+// value means background, kubectl's default.
+func ParseDeletionPropagation(cascade string) (metav1.DeletionPropagation, error) {
+	switch strings.ToLower(cascade) {
+	case "", "background":
+		return metav1.DeletePropagationBackground, nil
+	case "foreground":
+		return metav1.DeletePropagationForeground, nil
+	case "orphan":
+		return metav1.DeletePropagationOrphan, nil
+	default:
+		return "", fmt.Errorf("%w %q: must be foreground, background or orphan", ErrInvalidPropagation, cascade)
+	}
+}
+
+// DeleteResource deletes any resource by GVR with the given propagation
+// policy: Foreground waits for dependents to be removed before the owner
+// goes, Background deletes the owner and lets the garbage collector clean up
+// dependents, and Orphan leaves dependents in place. namespace is ignored
+// for cluster-scoped resources.
+func (m *MultiClusterClient) DeleteResource(ctx context.Context, contextName, namespace string, gvr schema.GroupVersionResource, name string, propagation metav1.DeletionPropagation) (err error) {
+	defer observeClusterRequest(contextName, "DeleteResource", time.Now(), &err)
+	dynamicClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return err
+	}
+	return dynamicClient.Resource(gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+}
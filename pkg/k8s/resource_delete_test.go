@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestDeleteResource_PropagationPolicies(t *testing.T) {
+	for _, cascade := range []string{"foreground", "background", "orphan"} {
+		t.Run(cascade, func(t *testing.T) {
+			m := newResourceYAMLTestClient(t, testYAMLDeployment())
+			ctx := context.Background()
+
+			propagation, err := ParseDeletionPropagation(cascade)
+			if err != nil {
+				t.Fatalf("ParseDeletionPropagation: %v", err)
+			}
+			if err := m.DeleteResource(ctx, "c1", "default", deploymentsGVR, "web", propagation); err != nil {
+				t.Fatalf("DeleteResource: %v", err)
+			}
+
+			dyn, _ := m.GetDynamicClient("c1")
+			if _, err := dyn.Resource(deploymentsGVR).Namespace("default").Get(ctx, "web", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+				t.Errorf("deployment still present: err = %v", err)
+			}
+
+			var sent *metav1.DeletionPropagation
+			for _, action := range dyn.(*dynfake.FakeDynamicClient).Actions() {
+				if del, ok := action.(k8stesting.DeleteAction); ok {
+					sent = del.GetDeleteOptions().PropagationPolicy
+				}
+			}
+			if sent == nil || *sent != propagation {
+				t.Errorf("propagation sent = %v, want %s", sent, propagation)
+			}
+		})
+	}
+}
+
+func TestParseDeletionPropagation(t *testing.T) {
+	if p, err := ParseDeletionPropagation(""); err != nil || p != metav1.DeletePropagationBackground {
+		t.Errorf("empty cascade = %q, %v; want Background", p, err)
+	}
+	if p, err := ParseDeletionPropagation("Foreground"); err != nil || p != metav1.DeletePropagationForeground {
+		t.Errorf("Foreground = %q, %v", p, err)
+	}
+	if _, err := ParseDeletionPropagation("cascade"); !errors.Is(err, ErrInvalidPropagation) {
+		t.Errorf("err = %v, want ErrInvalidPropagation", err)
+	}
+}
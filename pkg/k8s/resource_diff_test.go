@@ -0,0 +1,55 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestDiffResource_ReportsChangedReplicas(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+
+	deployment := func(replicas int32, resourceVersion string, ready int32) *appsv1.Deployment {
+		d := diffTestDeployment("web", "nginx:1.25", replicas)
+		d.TypeMeta = metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}
+		d.ResourceVersion = resourceVersion
+		d.Status.ReadyReplicas = ready
+		return d
+	}
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["east"] = dynfake.NewSimpleDynamicClient(scheme, deployment(2, "100", 2))
+	m.dynamicClients["west"] = dynfake.NewSimpleDynamicClient(scheme, deployment(5, "7", 1))
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	diff, err := m.DiffResource(context.Background(), "east", "west", gvr, "apps", "web")
+	require.NoError(t, err)
+
+	assert.False(t, diff.Identical)
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	require.Len(t, diff.Changed, 1, "status and resourceVersion must be stripped before diffing")
+	assert.Equal(t, "spec.replicas", diff.Changed[0].Path)
+	assert.EqualValues(t, 2, diff.Changed[0].A)
+	assert.EqualValues(t, 5, diff.Changed[0].B)
+}
+
+func TestDiffResource_MissingResource(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["east"] = dynfake.NewSimpleDynamicClient(scheme)
+	m.dynamicClients["west"] = dynfake.NewSimpleDynamicClient(scheme)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	_, err := m.DiffResource(context.Background(), "east", "west", gvr, "apps", "web")
+	require.Error(t, err)
+}
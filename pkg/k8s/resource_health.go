@@ -3,6 +3,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -73,6 +74,7 @@ type WorkloadMonitorResult struct {
 	Resources []MonitoredResource `json:"resources"`
 	Issues    []MonitorIssue      `json:"issues"`
 	Warnings  []string            `json:"warnings"`
+	Probes    []ContainerProbeSummary `json:"probes"`
 }
 
 // kindToCategory maps a dependency kind to its category
@@ -337,6 +339,21 @@ func (m *MultiClusterClient) MonitorWorkload(
 		}
 	}
 
+	// Summarize probes; a stuck rollout is often a failing probe
+	probes, probeErr := m.summarizeProbes(ctx, cluster, namespace, bundle.Workload)
+	if probeErr != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("probe summary incomplete: %v", probeErr))
+	}
+	result.Probes = probes
+	if result.Probes == nil {
+		result.Probes = []ContainerProbeSummary{}
+	}
+	for _, p := range result.Probes {
+		if p.SuspectedFailure {
+			result.Issues = append(result.Issues, createProbeIssue(result, p, now))
+		}
+	}
+
 	// Calculate overall status
 	result.Status = calculateOverallStatus(result.Resources)
 
@@ -395,6 +412,30 @@ func createIssue(mr MonitoredResource, now string) MonitorIssue {
 	}
 }
 
+// createProbeIssue generates a MonitorIssue for a container whose probes
+// look like they are failing
+func createProbeIssue(result *WorkloadMonitorResult, p ContainerProbeSummary, now string) MonitorIssue {
+	mr := MonitoredResource{
+		ID:          fmt.Sprintf("%s/%s/%s", result.Kind, result.Namespace, result.Workload),
+		Kind:        result.Kind,
+		Name:        result.Workload,
+		Namespace:   result.Namespace,
+		Cluster:     result.Cluster,
+		Status:      HealthStatusDegraded,
+		Category:    CategoryWorkload,
+		Message:     strings.Join(p.Reasons, "; "),
+		LastChecked: now,
+	}
+	return MonitorIssue{
+		ID:          fmt.Sprintf("issue-probe-%s/%s", mr.ID, p.Container),
+		Resource:    mr,
+		Severity:    "warning",
+		Title:       fmt.Sprintf("Container %s probes may be failing", p.Container),
+		Description: mr.Message,
+		DetectedAt:  now,
+	}
+}
+
 // calculateOverallStatus determines overall health from all resources
 func calculateOverallStatus(resources []MonitoredResource) ResourceHealthStatus {
 	hasUnhealthy := false
@@ -0,0 +1,115 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// maxAnnotationsSize mirrors the API server's limit on the combined size of
+// all annotation keys and values on one object.
+const maxAnnotationsSize = 256 * 1024
+
+// ErrInvalidMetadata is returned by PatchLabels and PatchAnnotations when a
+// key or value violates Kubernetes naming constraints. The request never
+// reaches the API server in that case.
+var ErrInvalidMetadata = errors.New("invalid metadata")
+
+// ValidateLabelPatch checks label keys and values against the Kubernetes
+// qualified-name and label-value rules. nil values (deletions) only need a
+// valid key.
+func ValidateLabelPatch(labels map[string]*string) error {
+	if len(labels) == 0 {
+		return fmt.Errorf("%w: no labels given", ErrInvalidMetadata)
+	}
+	for _, key := range sortedPatchKeys(labels) {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("%w: label key %q: %s", ErrInvalidMetadata, key, strings.Join(errs, "; "))
+		}
+		if v := labels[key]; v != nil {
+			if errs := validation.IsValidLabelValue(*v); len(errs) > 0 {
+				return fmt.Errorf("%w: label %q value %q: %s", ErrInvalidMetadata, key, *v, strings.Join(errs, "; "))
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateAnnotationPatch checks annotation keys against the qualified-name
+// rule and bounds the size of the submitted values. Annotation values are
+// otherwise free-form.
+func ValidateAnnotationPatch(annotations map[string]*string) error {
+	if len(annotations) == 0 {
+		return fmt.Errorf("%w: no annotations given", ErrInvalidMetadata)
+	}
+	total := 0
+	for _, key := range sortedPatchKeys(annotations) {
+		if errs := validation.IsQualifiedName(strings.ToLower(key)); len(errs) > 0 {
+			return fmt.Errorf("%w: annotation key %q: %s", ErrInvalidMetadata, key, strings.Join(errs, "; "))
+		}
+		total += len(key)
+		if v := annotations[key]; v != nil {
+			total += len(*v)
+		}
+	}
+	if total > maxAnnotationsSize {
+		return fmt.Errorf("%w: annotations exceed %d bytes", ErrInvalidMetadata, maxAnnotationsSize)
+	}
+	return nil
+}
+
+// PatchLabels adds, updates or removes labels on any resource. A nil value
+// deletes the label; keys not mentioned are left untouched. A JSON merge
+// patch is used so the call works for custom resources, which do not support
+// strategic merge.
+func (m *MultiClusterClient) PatchLabels(ctx context.Context, contextName, namespace string, gvr schema.GroupVersionResource, name string, labels map[string]*string) error {
+	if err := ValidateLabelPatch(labels); err != nil {
+		return err
+	}
+	return m.patchMetadataField(ctx, contextName, namespace, gvr, name, "labels", labels)
+}
+
+// PatchAnnotations is the annotation counterpart of PatchLabels.
+func (m *MultiClusterClient) PatchAnnotations(ctx context.Context, contextName, namespace string, gvr schema.GroupVersionResource, name string, annotations map[string]*string) error {
+	if err := ValidateAnnotationPatch(annotations); err != nil {
+		return err
+	}
+	return m.patchMetadataField(ctx, contextName, namespace, gvr, name, "annotations", annotations)
+}
+
+// patchMetadataField sends {"metadata":{field: values}} as a JSON merge patch.
+func (m *MultiClusterClient) patchMetadataField(ctx context.Context, contextName, namespace string, gvr schema.GroupVersionResource, name, field string, values map[string]*string) error {
+	dynamicClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return err
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{field: values},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = dynamicClient.Resource(gvr).Namespace(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{
+		FieldManager: ConsoleFieldManager,
+	})
+	return err
+}
+
+func sortedPatchKeys(m map[string]*string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
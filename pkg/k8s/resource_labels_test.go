@@ -0,0 +1,102 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestPatchLabels_AddAndRemove(t *testing.T) {
+	m := newResourceYAMLTestClient(t, testYAMLDeployment())
+	ctx := context.Background()
+
+	err := m.PatchLabels(ctx, "c1", "default", deploymentsGVR, "web", map[string]*string{
+		"team":                    strPtr("payments"),
+		"example.com/cost-center": strPtr("cc-42"),
+	})
+	if err != nil {
+		t.Fatalf("PatchLabels add: %v", err)
+	}
+
+	dyn, _ := m.GetDynamicClient("c1")
+	obj, err := dyn.Resource(deploymentsGVR).Namespace("default").Get(ctx, "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	labels := obj.GetLabels()
+	if labels["team"] != "payments" || labels["example.com/cost-center"] != "cc-42" {
+		t.Errorf("labels not added: %v", labels)
+	}
+	if labels["app"] != "web" {
+		t.Errorf("existing label should be untouched: %v", labels)
+	}
+
+	if err := m.PatchLabels(ctx, "c1", "default", deploymentsGVR, "web", map[string]*string{"team": nil}); err != nil {
+		t.Fatalf("PatchLabels remove: %v", err)
+	}
+	obj, _ = dyn.Resource(deploymentsGVR).Namespace("default").Get(ctx, "web", metav1.GetOptions{})
+	labels = obj.GetLabels()
+	if _, ok := labels["team"]; ok {
+		t.Errorf("label team should be removed: %v", labels)
+	}
+	if labels["example.com/cost-center"] != "cc-42" || labels["app"] != "web" {
+		t.Errorf("other labels should survive removal: %v", labels)
+	}
+}
+
+func TestPatchAnnotations(t *testing.T) {
+	m := newResourceYAMLTestClient(t, testYAMLDeployment())
+	ctx := context.Background()
+
+	note := "free-form value: spaces, punctuation & all"
+	if err := m.PatchAnnotations(ctx, "c1", "default", deploymentsGVR, "web", map[string]*string{"example.com/note": &note}); err != nil {
+		t.Fatalf("PatchAnnotations: %v", err)
+	}
+	dyn, _ := m.GetDynamicClient("c1")
+	obj, _ := dyn.Resource(deploymentsGVR).Namespace("default").Get(ctx, "web", metav1.GetOptions{})
+	if got := obj.GetAnnotations()["example.com/note"]; got != note {
+		t.Errorf("annotation = %q, want %q", got, note)
+	}
+}
+
+func TestValidateLabelPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]*string
+		wantErr bool
+	}{
+		{"valid", map[string]*string{"app.kubernetes.io/name": strPtr("web")}, false},
+		{"delete", map[string]*string{"team": nil}, false},
+		{"empty value", map[string]*string{"team": strPtr("")}, false},
+		{"empty", map[string]*string{}, true},
+		{"bad key", map[string]*string{"bad key": strPtr("x")}, true},
+		{"bad prefix", map[string]*string{"Not_A_Domain/name": strPtr("x")}, true},
+		{"bad value", map[string]*string{"team": strPtr("has spaces")}, true},
+		{"value too long", map[string]*string{"team": strPtr(strings.Repeat("a", 64))}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLabelPatch(tt.labels)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateLabelPatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidMetadata) {
+				t.Errorf("error should wrap ErrInvalidMetadata: %v", err)
+			}
+		})
+	}
+}
+
+func TestPatchLabels_InvalidNeverReachesCluster(t *testing.T) {
+	// No clients configured: an invalid patch must fail validation first.
+	m := &MultiClusterClient{}
+	err := m.PatchLabels(context.Background(), "c1", "default", deploymentsGVR, "web", map[string]*string{"bad key": strPtr("x")})
+	if !errors.Is(err, ErrInvalidMetadata) {
+		t.Errorf("expected ErrInvalidMetadata, got %v", err)
+	}
+}
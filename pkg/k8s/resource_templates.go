@@ -0,0 +1,250 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ErrUnknownTemplate is returned for a template name that is not built in.
+var ErrUnknownTemplate = errors.New("unknown resource template")
+
+// ErrInvalidTemplateParams is returned when template parameters are missing
+// or malformed.
+var ErrInvalidTemplateParams = errors.New("invalid template parameters")
+
+// Kinds of template parameter values, which decide how a value is validated.
+const (
+	templateParamDNSLabel = "dns-label"
+	// templateParamNamespace is a dns-label that must not name a system
+	// namespace such as kube-system.
+	templateParamNamespace = "namespace"
+	// templateParamSeconds is a whole number of seconds between 1 and
+	// maxTemplateSeconds.
+	templateParamSeconds = "seconds"
+	templateParamString  = "string"
+)
+
+// maxTemplateParamLength caps free-form parameter values such as URLs.
+const maxTemplateParamLength = 2048
+
+// maxTemplateSeconds caps duration parameters so a debug pod cannot be left
+// running for more than a day.
+const maxTemplateSeconds = 24 * 60 * 60
+
+// TemplateParam describes one parameter of a ResourceTemplate.
+type TemplateParam struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+	Default     string `json:"default,omitempty"`
+	// Kind is dns-label, namespace, seconds or string.
+	Kind string `json:"kind"`
+}
+
+// ResourceTemplate is a built-in manifest users can instantiate with
+// CreateFromTemplate.
+type ResourceTemplate struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Params      []TemplateParam `json:"params"`
+
+	gvr  schema.GroupVersionResource
+	body *template.Template
+}
+
+// templateFuncs are available to built-in templates. quote renders a value
+// as a JSON string, which is also a valid YAML scalar, so free-form values
+// cannot break out of the field they are substituted into.
+var templateFuncs = template.FuncMap{
+	"quote": func(s string) (string, error) {
+		out, err := json.Marshal(s)
+		return string(out), err
+	},
+}
+
+var nameParam = TemplateParam{Name: "name", Description: "Name of the created resource", Required: true, Kind: templateParamDNSLabel}
+var namespaceParam = TemplateParam{Name: "namespace", Description: "Namespace to create the resource in", Required: true, Kind: templateParamNamespace}
+
+// builtinTemplates are the templates offered by CreateFromTemplate, keyed by
+// name. Every rendered resource is labelled with the template it came from
+// so it can be found and cleaned up later. Images are fixed by the template
+// and pods are left to the scheduler, so a template cannot run an arbitrary
+// image or bypass scheduling onto a chosen node.
+var builtinTemplates = map[string]*ResourceTemplate{
+	"netshoot-debug-pod": {
+		Name:        "netshoot-debug-pod",
+		Description: "A pod running nicolaka/netshoot for network debugging",
+		Params: []TemplateParam{
+			nameParam,
+			namespaceParam,
+			{Name: "duration", Description: "Seconds the pod stays up", Default: "3600", Kind: templateParamSeconds},
+		},
+		gvr: schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+		body: template.Must(template.New("netshoot-debug-pod").Funcs(templateFuncs).Option("missingkey=error").Parse(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: {{ .name }}
+  namespace: {{ .namespace }}
+  labels:
+    app.kubernetes.io/managed-by: kubestellar-console
+    kubestellar.io/template: netshoot-debug-pod
+spec:
+  restartPolicy: Never
+  activeDeadlineSeconds: {{ .duration }}
+  containers:
+  - name: netshoot
+    image: nicolaka/netshoot:latest
+    command: ["sleep", {{ quote .duration }}]
+`)),
+	},
+	"curl-job": {
+		Name:        "curl-job",
+		Description: "A one-shot job that curls a URL from inside the cluster",
+		Params: []TemplateParam{
+			nameParam,
+			namespaceParam,
+			{Name: "url", Description: "URL to request", Required: true, Kind: templateParamString},
+		},
+		gvr: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"},
+		body: template.Must(template.New("curl-job").Funcs(templateFuncs).Option("missingkey=error").Parse(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: {{ .name }}
+  namespace: {{ .namespace }}
+  labels:
+    app.kubernetes.io/managed-by: kubestellar-console
+    kubestellar.io/template: curl-job
+spec:
+  backoffLimit: 0
+  ttlSecondsAfterFinished: 600
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: curl
+        image: curlimages/curl:latest
+        args: ["-sS", "-v", {{ quote .url }}]
+`)),
+	},
+}
+
+// ListResourceTemplates returns the built-in templates sorted by name.
+func ListResourceTemplates() []ResourceTemplate {
+	templates := make([]ResourceTemplate, 0, len(builtinTemplates))
+	for _, tmpl := range builtinTemplates {
+		templates = append(templates, *tmpl)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates
+}
+
+// RenderResourceTemplate validates params against the named template, fills
+// in defaults and returns the rendered manifest. Unknown params are rejected
+// so typos do not silently fall back to defaults.
+func RenderResourceTemplate(templateName string, params map[string]string) ([]byte, error) {
+	tmpl, ok := builtinTemplates[templateName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, templateName)
+	}
+	values, err := tmpl.resolveParams(params)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.body.Execute(&buf, values); err != nil {
+		return nil, fmt.Errorf("render template %s: %w", templateName, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// resolveParams returns params with defaults applied, after checking that
+// every required param is set and every value is well-formed.
+func (t *ResourceTemplate) resolveParams(params map[string]string) (map[string]string, error) {
+	known := make(map[string]bool, len(t.Params))
+	values := make(map[string]string, len(t.Params))
+	for _, p := range t.Params {
+		known[p.Name] = true
+		value := strings.TrimSpace(params[p.Name])
+		if value == "" {
+			value = p.Default
+		}
+		if value == "" {
+			if p.Required {
+				return nil, fmt.Errorf("%w: %s is required", ErrInvalidTemplateParams, p.Name)
+			}
+			values[p.Name] = ""
+			continue
+		}
+		if err := validateTemplateParam(p, value); err != nil {
+			return nil, err
+		}
+		values[p.Name] = value
+	}
+	for name := range params {
+		if !known[name] {
+			return nil, fmt.Errorf("%w: unknown parameter %s", ErrInvalidTemplateParams, name)
+		}
+	}
+	return values, nil
+}
+
+// validateTemplateParam checks value against the kind of p.
+func validateTemplateParam(p TemplateParam, value string) error {
+	var errs []string
+	switch p.Kind {
+	case templateParamDNSLabel:
+		errs = validation.IsDNS1123Label(value)
+	case templateParamNamespace:
+		errs = validation.IsDNS1123Label(value)
+		if len(errs) == 0 && isSystemNamespace(value) {
+			errs = []string{"system namespaces are not allowed"}
+		}
+	case templateParamSeconds:
+		if n, err := strconv.Atoi(value); err != nil || n < 1 || n > maxTemplateSeconds {
+			errs = []string{fmt.Sprintf("must be a whole number of seconds between 1 and %d", maxTemplateSeconds)}
+		}
+	default:
+		if len(value) > maxTemplateParamLength {
+			errs = []string{fmt.Sprintf("must be at most %d characters", maxTemplateParamLength)}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %s: %s", ErrInvalidTemplateParams, p.Name, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// CreateFromTemplate renders the named built-in template with params and
+// creates the resulting resource in contextName. It fails if a resource of
+// the same name already exists, rather than overwriting it.
+func (m *MultiClusterClient) CreateFromTemplate(ctx context.Context, contextName string, templateName string, params map[string]string) error {
+	rendered, err := RenderResourceTemplate(templateName, params)
+	if err != nil {
+		return err
+	}
+	obj, err := decodeSingleManifest(rendered)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return err
+	}
+	gvr := builtinTemplates[templateName].gvr
+	_, err = dynamicClient.Resource(gvr).Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{FieldManager: ConsoleFieldManager})
+	return err
+}
@@ -0,0 +1,86 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestCreateFromTemplate_DebugPod(t *testing.T) {
+	podsGVR := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	dynClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{podsGVR: "PodList"})
+	m := &MultiClusterClient{dynamicClients: map[string]dynamic.Interface{"c1": dynClient}}
+	ctx := context.Background()
+
+	err := m.CreateFromTemplate(ctx, "c1", "netshoot-debug-pod", map[string]string{
+		"name":      "debug-1",
+		"namespace": "ops",
+	})
+	require.NoError(t, err)
+
+	pod, err := dynClient.Resource(podsGVR).Namespace("ops").Get(ctx, "debug-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "netshoot-debug-pod", pod.GetLabels()["kubestellar.io/template"])
+	deadline, _, _ := unstructured.NestedFieldNoCopy(pod.Object, "spec", "activeDeadlineSeconds")
+	assert.EqualValues(t, 3600, deadline, "duration falls back to its default")
+	containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", "containers")
+	require.Len(t, containers, 1)
+	container := containers[0].(map[string]interface{})
+	assert.Equal(t, "nicolaka/netshoot:latest", container["image"])
+	assert.Equal(t, []interface{}{"sleep", "3600"}, container["command"])
+
+	err = m.CreateFromTemplate(ctx, "c1", "netshoot-debug-pod", map[string]string{"name": "debug-1", "namespace": "ops"})
+	assert.Error(t, err, "creating over an existing resource fails")
+}
+
+func TestRenderResourceTemplate_ValidatesParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		params   map[string]string
+		want     error
+	}{
+		{"unknown template", "nope", nil, ErrUnknownTemplate},
+		{"missing required", "curl-job", map[string]string{"name": "probe", "namespace": "ops"}, ErrInvalidTemplateParams},
+		{"invalid name", "curl-job", map[string]string{"name": "Bad_Name", "namespace": "ops", "url": "http://svc"}, ErrInvalidTemplateParams},
+		{"unknown param", "curl-job", map[string]string{"name": "probe", "namespace": "ops", "url": "http://svc", "replicas": "3"}, ErrInvalidTemplateParams},
+		{"image is not a param", "curl-job", map[string]string{"name": "probe", "namespace": "ops", "url": "http://svc", "image": "evil/miner"}, ErrInvalidTemplateParams},
+		{"node is not a param", "netshoot-debug-pod", map[string]string{"name": "debug", "namespace": "ops", "node": "control-plane"}, ErrInvalidTemplateParams},
+		{"system namespace", "netshoot-debug-pod", map[string]string{"name": "debug", "namespace": "kube-system"}, ErrInvalidTemplateParams},
+		{"non-numeric duration", "netshoot-debug-pod", map[string]string{"name": "debug", "namespace": "ops", "duration": "abc"}, ErrInvalidTemplateParams},
+		{"duration over a day", "netshoot-debug-pod", map[string]string{"name": "debug", "namespace": "ops", "duration": "86401"}, ErrInvalidTemplateParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RenderResourceTemplate(tt.template, tt.params)
+			assert.True(t, errors.Is(err, tt.want), "got %v, want %v", err, tt.want)
+		})
+	}
+}
+
+func TestRenderResourceTemplate_QuotesFreeFormValues(t *testing.T) {
+	rendered, err := RenderResourceTemplate("curl-job", map[string]string{
+		"name":      "probe",
+		"namespace": "ops",
+		"url":       "http://svc\"]\n    privileged: true",
+	})
+	require.NoError(t, err)
+
+	obj, err := decodeSingleManifest(rendered)
+	require.NoError(t, err)
+	containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	require.Len(t, containers, 1)
+	container := containers[0].(map[string]interface{})
+	assert.NotContains(t, container, "privileged")
+	assert.Equal(t, []interface{}{"-sS", "-v", "http://svc\"]\n    privileged: true"}, container["args"])
+}
@@ -0,0 +1,256 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConsoleFieldManager identifies the console in managedFields for
+	// server-side apply requests.
+	ConsoleFieldManager = "kubestellar-console"
+	// yamlDecoderBufferSize is the read-ahead used when sniffing whether an
+	// applied document is YAML or JSON.
+	yamlDecoderBufferSize = 4096
+)
+
+// ErrInvalidManifest is returned by ApplyResourceYAML when the submitted
+// document cannot be applied as-is (malformed, incomplete or an unknown kind).
+var ErrInvalidManifest = errors.New("invalid manifest")
+
+// builtinResourceGVRs maps the friendly resource types used by the drilldown
+// views (singular, lower-case kind) to their GVR. Plural resource names are
+// accepted too; see LookupResourceType.
+var builtinResourceGVRs = map[string]schema.GroupVersionResource{
+	"pod":                     {Version: "v1", Resource: "pods"},
+	"service":                 {Version: "v1", Resource: "services"},
+	"configmap":               {Version: "v1", Resource: "configmaps"},
+	"secret":                  {Version: "v1", Resource: "secrets"},
+	"serviceaccount":          {Version: "v1", Resource: "serviceaccounts"},
+	"persistentvolumeclaim":   {Version: "v1", Resource: "persistentvolumeclaims"},
+	"persistentvolume":        {Version: "v1", Resource: "persistentvolumes"},
+	"namespace":               {Version: "v1", Resource: "namespaces"},
+	"node":                    {Version: "v1", Resource: "nodes"},
+	"deployment":              {Group: "apps", Version: "v1", Resource: "deployments"},
+	"replicaset":              {Group: "apps", Version: "v1", Resource: "replicasets"},
+	"statefulset":             {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	"daemonset":               {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	"job":                     {Group: "batch", Version: "v1", Resource: "jobs"},
+	"cronjob":                 {Group: "batch", Version: "v1", Resource: "cronjobs"},
+	"ingress":                 {Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+	"networkpolicy":           {Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"},
+	"horizontalpodautoscaler": {Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"},
+	"poddisruptionbudget":     {Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"},
+	"role":                    {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"},
+	"rolebinding":             {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"},
+	"clusterrole":             {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"},
+	"clusterrolebinding":      {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"},
+}
+
+// LookupResourceType resolves a friendly built-in resource type such as
+// "Deployment", "deployment" or "deployments" to its GVR.
+func LookupResourceType(resourceType string) (schema.GroupVersionResource, bool) {
+	key := strings.ToLower(resourceType)
+	if gvr, ok := builtinResourceGVRs[key]; ok {
+		return gvr, true
+	}
+	for _, gvr := range builtinResourceGVRs {
+		if gvr.Resource == key {
+			return gvr, true
+		}
+	}
+	return schema.GroupVersionResource{}, false
+}
+
+// ParseGVR parses a resource reference in either slash form
+// ("apps/v1/deployments", "v1/pods") or kubectl's dotted form
+// ("deployments.v1.apps", "pods.v1"). The dotted form is what URL path
+// segments use since it contains no slashes.
+func ParseGVR(s string) (schema.GroupVersionResource, error) {
+	if s == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("resource is required")
+	}
+	if strings.Contains(s, "/") {
+		parts := strings.Split(s, "/")
+		switch len(parts) {
+		case 2:
+			if parts[0] != "" && parts[1] != "" {
+				return schema.GroupVersionResource{Version: parts[0], Resource: parts[1]}, nil
+			}
+		case 3:
+			if parts[1] != "" && parts[2] != "" {
+				return schema.GroupVersionResource{Group: parts[0], Version: parts[1], Resource: parts[2]}, nil
+			}
+		}
+		return schema.GroupVersionResource{}, fmt.Errorf("invalid resource %q: expected group/version/resource", s)
+	}
+
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("invalid resource %q: expected resource.version[.group]", s)
+	}
+	gvr := schema.GroupVersionResource{Resource: parts[0], Version: parts[1]}
+	if len(parts) == 3 {
+		gvr.Group = parts[2]
+	}
+	return gvr, nil
+}
+
+// cleanResourceForExport strips server-populated fields so an object can be
+// edited and re-applied: managedFields, resourceVersion, uid,
+// creationTimestamp, generation, selfLink and status.
+func cleanResourceForExport(obj *unstructured.Unstructured) {
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "generation", "selfLink"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+}
+
+// GetResourceYAML returns a single resource as YAML with server-populated
+// fields removed, suitable for editing and passing back to
+// ApplyResourceYAML. namespace is ignored for cluster-scoped resources.
+func (m *MultiClusterClient) GetResourceYAML(ctx context.Context, contextName, namespace string, gvr schema.GroupVersionResource, name string) ([]byte, error) {
+	dynamicClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	cleanResourceForExport(obj)
+
+	return yaml.Marshal(obj.Object)
+}
+
+// ApplyResourceYAML server-side applies a single YAML (or JSON) manifest as
+// the console field manager, taking ownership of conflicting fields the way
+// `kubectl apply --server-side --force-conflicts` does. The resource type is
+// resolved through discovery so CRDs work as well as built-in kinds.
+func (m *MultiClusterClient) ApplyResourceYAML(ctx context.Context, contextName string, yamlBytes []byte) error {
+	obj, err := decodeSingleManifest(yamlBytes)
+	if err != nil {
+		return err
+	}
+	if err := validateManifestIdentity(obj); err != nil {
+		return err
+	}
+	return m.applyManifest(ctx, contextName, obj, false)
+}
+
+// validateManifestIdentity checks that obj names its type and itself.
+func validateManifestIdentity(obj *unstructured.Unstructured) error {
+	if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+		return fmt.Errorf("%w: manifest must set apiVersion and kind", ErrInvalidManifest)
+	}
+	if obj.GetName() == "" {
+		return fmt.Errorf("%w: manifest must set metadata.name", ErrInvalidManifest)
+	}
+	return nil
+}
+
+// applyManifest resolves obj's resource through cached discovery and
+// server-side applies it with forced ownership. obj is modified in place.
+// With dryRun the apiserver validates and admits the request without
+// persisting it.
+func (m *MultiClusterClient) applyManifest(ctx context.Context, contextName string, obj *unstructured.Unstructured, dryRun bool) error {
+	disc, err := m.cachedDiscovery(contextName)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return err
+	}
+
+	gvk := obj.GroupVersionKind()
+	resources, err := serverResourcesForGroupVersion(disc, gvk.GroupVersion().String())
+	if isDiscoveryNotFound(err) {
+		return fmt.Errorf("%w: apiVersion %s is not served in cluster %s", ErrInvalidManifest, gvk.GroupVersion(), contextName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to discover %s: %w", gvk.GroupVersion(), err)
+	}
+	var resource *metav1.APIResource
+	for i := range resources.APIResources {
+		r := &resources.APIResources[i]
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			resource = r
+			break
+		}
+	}
+	if resource == nil {
+		return fmt.Errorf("%w: kind %s is not served by %s in cluster %s", ErrInvalidManifest, gvk.Kind, gvk.GroupVersion(), contextName)
+	}
+	gvr := gvk.GroupVersion().WithResource(resource.Name)
+
+	namespace := obj.GetNamespace()
+	if resource.Namespaced && namespace == "" {
+		return fmt.Errorf("%w: manifest must set metadata.namespace for namespaced kind %s", ErrInvalidManifest, gvk.Kind)
+	}
+	if !resource.Namespaced {
+		namespace = ""
+		obj.SetNamespace("")
+	}
+
+	// A stale resourceVersion would turn the apply into an optimistic-lock
+	// conflict, and status is ignored by apply anyway.
+	cleanResourceForExport(obj)
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+
+	force := true
+	patchOpts := metav1.PatchOptions{
+		FieldManager: ConsoleFieldManager,
+		Force:        &force,
+	}
+	if dryRun {
+		patchOpts.DryRun = []string{metav1.DryRunAll}
+	}
+	_, err = dynamicClient.Resource(gvr).Namespace(namespace).Patch(ctx, obj.GetName(), types.ApplyPatchType, data, patchOpts)
+	return err
+}
+
+// decodeSingleManifest decodes exactly one YAML or JSON document.
+func decodeSingleManifest(data []byte) (*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), yamlDecoderBufferSize)
+
+	obj := &unstructured.Unstructured{}
+	if err := decoder.Decode(&obj.Object); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: manifest is empty", ErrInvalidManifest)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidManifest, err)
+	}
+	if obj.Object == nil {
+		return nil, fmt.Errorf("%w: manifest is empty", ErrInvalidManifest)
+	}
+
+	// Trailing "---" separators decode as empty documents; anything else is
+	// a second resource.
+	for {
+		var extra map[string]interface{}
+		err := decoder.Decode(&extra)
+		if errors.Is(err, io.EOF) {
+			return obj, nil
+		}
+		if err != nil || len(extra) > 0 {
+			return nil, fmt.Errorf("%w: manifest must contain exactly one document", ErrInvalidManifest)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+)
+
+var deploymentsGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+// newResourceYAMLTestClient returns a client for cluster "c1" whose dynamic
+// client holds objs and whose discovery serves apps/v1 Deployments.
+func newResourceYAMLTestClient(t *testing.T, objs ...runtime.Object) *MultiClusterClient {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add apps scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add core scheme: %v", err)
+	}
+
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "deployments", Kind: "Deployment", Namespaced: true},
+			{Name: "deployments/scale", Kind: "Scale", Namespaced: true},
+		},
+	}}
+
+	// The fake tracker implements apply as a strategic merge patch, which
+	// cannot handle unstructured objects; emulate it with a JSON merge patch.
+	dynClient := dynfake.NewSimpleDynamicClient(scheme, objs...)
+	dynClient.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		if patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		existing, err := dynClient.Tracker().Get(patch.GetResource(), patch.GetNamespace(), patch.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		original, err := json.Marshal(existing)
+		if err != nil {
+			return true, nil, err
+		}
+		merged, err := jsonpatch.MergePatch(original, patch.GetPatch())
+		if err != nil {
+			return true, nil, err
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(merged); err != nil {
+			return true, nil, err
+		}
+		return true, obj, dynClient.Tracker().Update(patch.GetResource(), obj, patch.GetNamespace())
+	})
+
+	return &MultiClusterClient{
+		clients:        map[string]kubernetes.Interface{"c1": clientset},
+		dynamicClients: map[string]dynamic.Interface{"c1": dynClient},
+	}
+}
+
+func testYAMLDeployment() *appsv1.Deployment {
+	replicas := int32(2)
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web",
+			Namespace:       "default",
+			ResourceVersion: "42",
+			UID:             "abc-123",
+			Labels:          map[string]string{"app": "web"},
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "web", Image: "nginx:1.25"}},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{ReadyReplicas: 2},
+	}
+}
+
+func TestGetResourceYAML_StripsServerFields(t *testing.T) {
+	m := newResourceYAMLTestClient(t, testYAMLDeployment())
+
+	out, err := m.GetResourceYAML(context.Background(), "c1", "default", deploymentsGVR, "web")
+	if err != nil {
+		t.Fatalf("GetResourceYAML: %v", err)
+	}
+
+	var obj map[string]interface{}
+	if err := yaml.Unmarshal(out, &obj); err != nil {
+		t.Fatalf("output is not valid YAML: %v\n%s", err, out)
+	}
+	if _, ok := obj["status"]; ok {
+		t.Error("status should be stripped")
+	}
+	meta := obj["metadata"].(map[string]interface{})
+	for _, field := range []string{"managedFields", "resourceVersion", "uid"} {
+		if _, ok := meta[field]; ok {
+			t.Errorf("metadata.%s should be stripped", field)
+		}
+	}
+	if meta["name"] != "web" || obj["kind"] != "Deployment" {
+		t.Errorf("unexpected identity in YAML:\n%s", out)
+	}
+	if !strings.Contains(string(out), "image: nginx:1.25") {
+		t.Errorf("spec should be preserved:\n%s", out)
+	}
+}
+
+func TestGetResourceYAML_NotFound(t *testing.T) {
+	m := newResourceYAMLTestClient(t)
+
+	if _, err := m.GetResourceYAML(context.Background(), "c1", "default", deploymentsGVR, "missing"); err == nil {
+		t.Fatal("expected error for missing resource")
+	}
+}
+
+func TestResourceYAML_RoundTripDeployment(t *testing.T) {
+	m := newResourceYAMLTestClient(t, testYAMLDeployment())
+	ctx := context.Background()
+
+	out, err := m.GetResourceYAML(ctx, "c1", "default", deploymentsGVR, "web")
+	if err != nil {
+		t.Fatalf("GetResourceYAML: %v", err)
+	}
+	edited := strings.Replace(string(out), "replicas: 2", "replicas: 5", 1)
+	if edited == string(out) {
+		t.Fatalf("expected replicas: 2 in YAML:\n%s", out)
+	}
+
+	if err := m.ApplyResourceYAML(ctx, "c1", []byte(edited)); err != nil {
+		t.Fatalf("ApplyResourceYAML: %v", err)
+	}
+
+	after, err := m.GetResourceYAML(ctx, "c1", "default", deploymentsGVR, "web")
+	if err != nil {
+		t.Fatalf("GetResourceYAML after apply: %v", err)
+	}
+	if !strings.Contains(string(after), "replicas: 5") {
+		t.Errorf("expected applied replicas to round-trip:\n%s", after)
+	}
+	if !strings.Contains(string(after), "image: nginx:1.25") {
+		t.Errorf("unchanged fields should survive apply:\n%s", after)
+	}
+}
+
+func TestApplyResourceYAML_Rejects(t *testing.T) {
+	m := newResourceYAMLTestClient(t, testYAMLDeployment())
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{"empty", "", "empty"},
+		{"missing kind", "apiVersion: apps/v1\nmetadata:\n  name: web\n", "apiVersion and kind"},
+		{"missing name", "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  namespace: default\n", "metadata.name"},
+		{"missing namespace", "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n", "metadata.namespace"},
+		{"unknown kind", "apiVersion: apps/v1\nkind: Widget\nmetadata:\n  name: w\n  namespace: default\n", "not served"},
+		{"multiple documents", "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: a\n---\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: b\n", "exactly one"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.ApplyResourceYAML(ctx, "c1", []byte(tt.yaml))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ApplyResourceYAML() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGVR(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    schema.GroupVersionResource
+		wantErr bool
+	}{
+		{in: "apps/v1/deployments", want: deploymentsGVR},
+		{in: "v1/pods", want: schema.GroupVersionResource{Version: "v1", Resource: "pods"}},
+		{in: "deployments.v1.apps", want: deploymentsGVR},
+		{in: "ingresses.v1.networking.k8s.io", want: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}},
+		{in: "pods.v1", want: schema.GroupVersionResource{Version: "v1", Resource: "pods"}},
+		{in: "", wantErr: true},
+		{in: "pods", wantErr: true},
+		{in: "a/b/c/d", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseGVR(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseGVR(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseGVR(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLookupResourceType(t *testing.T) {
+	for _, in := range []string{"Deployment", "deployment", "deployments"} {
+		if got, ok := LookupResourceType(in); !ok || got != deploymentsGVR {
+			t.Errorf("LookupResourceType(%q) = %v, %v", in, got, ok)
+		}
+	}
+	if _, ok := LookupResourceType("widget"); ok {
+		t.Error("unknown type should not resolve")
+	}
+}
@@ -0,0 +1,124 @@
+package k8s
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Values reported in PodIssue.RestartTrend.
+const (
+	RestartTrendStable     = "stable"
+	RestartTrendIncreasing = "increasing"
+)
+
+const (
+	// restartTrendWindow is how far back restarts are counted when deciding
+	// whether a pod's restart count is climbing.
+	restartTrendWindow = 15 * time.Minute
+	// restartTrackerMaxPods caps how many pods the tracker remembers; the
+	// least recently observed pod is evicted first.
+	restartTrackerMaxPods = 5000
+	// restartTrackerMaxSamples caps the samples kept per pod so frequent
+	// polling cannot grow an entry without bound inside the window.
+	restartTrackerMaxSamples = 32
+)
+
+type restartSample struct {
+	at    time.Time
+	count int
+}
+
+type restartEntry struct {
+	key     string
+	samples []restartSample // oldest first
+}
+
+// restartTracker remembers the restart counts seen for each pod across
+// successive GetPods/FindPodIssues calls so a climbing count can be told
+// apart from an old, stable one. Entries are kept in an LRU keyed by
+// cluster/namespace/pod.
+type restartTracker struct {
+	mu      sync.Mutex
+	window  time.Duration
+	maxPods int
+	order   *list.List // front = most recently observed
+	entries map[string]*list.Element
+}
+
+func newRestartTracker() *restartTracker {
+	return &restartTracker{
+		window:  restartTrendWindow,
+		maxPods: restartTrackerMaxPods,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func restartTrackerKey(cluster, namespace, pod string) string {
+	return cluster + "/" + namespace + "/" + pod
+}
+
+// observe records count for key at time at and returns the trend together
+// with the number of restarts since the oldest sample still inside the
+// window. A count lower than the last one means the pod was recreated under
+// the same name, so its history starts over.
+func (t *restartTracker) observe(key string, count int, at time.Time) (string, int) {
+	if t == nil {
+		return RestartTrendStable, 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var entry *restartEntry
+	if el, ok := t.entries[key]; ok {
+		t.order.MoveToFront(el)
+		entry = el.Value.(*restartEntry)
+	} else {
+		entry = &restartEntry{key: key}
+		t.entries[key] = t.order.PushFront(entry)
+		for t.order.Len() > t.maxPods {
+			oldest := t.order.Back()
+			t.order.Remove(oldest)
+			delete(t.entries, oldest.Value.(*restartEntry).key)
+		}
+	}
+
+	if n := len(entry.samples); n > 0 && count < entry.samples[n-1].count {
+		entry.samples = entry.samples[:0]
+	}
+	entry.samples = append(entry.samples, restartSample{at: at, count: count})
+
+	// Drop samples that fell out of the window, but keep the newest one at or
+	// before the cutoff as the baseline the window is measured against.
+	cutoff := at.Add(-t.window)
+	drop := 0
+	for drop+1 < len(entry.samples) && !entry.samples[drop+1].at.After(cutoff) {
+		drop++
+	}
+	if extra := len(entry.samples) - drop - restartTrackerMaxSamples; extra > 0 {
+		drop += extra
+	}
+	entry.samples = entry.samples[drop:]
+
+	inWindow := count - entry.samples[0].count
+	if inWindow > 0 {
+		return RestartTrendIncreasing, inWindow
+	}
+	return RestartTrendStable, 0
+}
+
+// podRestartCount sums restarts across init and regular containers, the
+// same total FindPodIssues reports.
+func podRestartCount(pod *corev1.Pod) int {
+	restarts := 0
+	for _, cs := range pod.Status.InitContainerStatuses {
+		restarts += int(cs.RestartCount)
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		restarts += int(cs.RestartCount)
+	}
+	return restarts
+}
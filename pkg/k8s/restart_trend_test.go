@@ -0,0 +1,87 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestFindPodIssues_RestartTrendIncreasing(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "api",
+				RestartCount: 6,
+				State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}},
+		},
+	}
+	clientset := k8sfake.NewSimpleClientset(pod)
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = clientset
+	ctx := context.Background()
+
+	first, err := m.FindPodIssues(ctx, "c1", "default")
+	if err != nil {
+		t.Fatalf("FindPodIssues: %v", err)
+	}
+	if len(first) != 1 || first[0].RestartTrend != RestartTrendStable {
+		t.Fatalf("first scan = %+v, want one stable issue", first)
+	}
+
+	pod.Status.ContainerStatuses[0].RestartCount = 9
+	if _, err := clientset.CoreV1().Pods("default").Update(ctx, pod, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("update pod: %v", err)
+	}
+
+	second, err := m.FindPodIssues(ctx, "c1", "default")
+	if err != nil {
+		t.Fatalf("FindPodIssues: %v", err)
+	}
+	if len(second) != 1 {
+		t.Fatalf("second scan = %+v, want one issue", second)
+	}
+	if second[0].RestartTrend != RestartTrendIncreasing || second[0].RestartsInWindow != 3 {
+		t.Errorf("trend = %q (+%d), want increasing (+3)", second[0].RestartTrend, second[0].RestartsInWindow)
+	}
+}
+
+func TestRestartTracker_WindowAndRecreate(t *testing.T) {
+	tr := newRestartTracker()
+	start := time.Now()
+
+	tr.observe("c/ns/p", 2, start)
+	if trend, n := tr.observe("c/ns/p", 4, start.Add(time.Minute)); trend != RestartTrendIncreasing || n != 2 {
+		t.Errorf("within window = %q (+%d), want increasing (+2)", trend, n)
+	}
+	// No restarts for longer than the window: the pod has settled.
+	if trend, _ := tr.observe("c/ns/p", 4, start.Add(restartTrendWindow+2*time.Minute)); trend != RestartTrendStable {
+		t.Errorf("after quiet window = %q, want stable", trend)
+	}
+	// A lower count means the pod was recreated; history starts over.
+	if trend, _ := tr.observe("c/ns/p", 0, start.Add(restartTrendWindow+3*time.Minute)); trend != RestartTrendStable {
+		t.Errorf("after recreate = %q, want stable", trend)
+	}
+}
+
+func TestRestartTracker_EvictsLeastRecentlyObserved(t *testing.T) {
+	tr := newRestartTracker()
+	tr.maxPods = 3
+	now := time.Now()
+	for i := 0; i < 4; i++ {
+		tr.observe(fmt.Sprintf("c/ns/p%d", i), 1, now)
+	}
+	if len(tr.entries) != 3 || tr.order.Len() != 3 {
+		t.Fatalf("tracker holds %d entries, want 3", len(tr.entries))
+	}
+	if _, ok := tr.entries["c/ns/p0"]; ok {
+		t.Error("oldest pod should have been evicted")
+	}
+}
@@ -0,0 +1,106 @@
+package k8s
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// retryAttemptsEnvVar overrides defaultRetryAttempts. 1 disables retries.
+	retryAttemptsEnvVar = "K8S_RETRY_ATTEMPTS"
+	// defaultRetryAttempts is the total number of tries (not extra retries)
+	// for a core List call that hits a transient error.
+	defaultRetryAttempts = 3
+	// retryMaxDelay caps the exponential backoff between attempts.
+	retryMaxDelay = 2 * time.Second
+)
+
+// retryBaseDelay is the wait before the second attempt; each later attempt
+// doubles it. A variable so tests can shorten it.
+var retryBaseDelay = 200 * time.Millisecond
+
+// retryableErrorTypes are the classifyError types worth another attempt.
+// Auth and certificate failures are never retried: they will not fix
+// themselves and retrying exec credential plugins spams the user (#3158).
+var retryableErrorTypes = map[string]bool{
+	"timeout":   true,
+	"network":   true,
+	"ratelimit": true,
+}
+
+// retryAttemptsFromEnv reads K8S_RETRY_ATTEMPTS, falling back to
+// defaultRetryAttempts when unset or invalid.
+func retryAttemptsFromEnv() int {
+	raw := strings.TrimSpace(os.Getenv(retryAttemptsEnvVar))
+	if raw == "" {
+		return defaultRetryAttempts
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 {
+		return defaultRetryAttempts
+	}
+	return n
+}
+
+// SetRetryAttempts sets how many times core List calls are tried when they
+// fail with a transient error. Values below 1 are treated as 1 (no retry).
+func (m *MultiClusterClient) SetRetryAttempts(attempts int) {
+	if attempts < 1 {
+		attempts = 1
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retryAttempts = attempts
+}
+
+func (m *MultiClusterClient) getRetryAttempts() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.retryAttempts < 1 {
+		return 1
+	}
+	return m.retryAttempts
+}
+
+// isRetryableError reports whether err is worth another attempt. API status
+// errors are checked by reason; anything else falls back to classifyError
+// on the message.
+func isRetryableError(err error) bool {
+	if apierrors.IsTooManyRequests(err) || apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) {
+		return true
+	}
+	return retryableErrorTypes[classifyError(err.Error())]
+}
+
+// withRetry calls fn up to attempts times, backing off exponentially between
+// tries, as long as fn fails with a retryable error. It always returns fn's
+// last error, including when ctx ends while waiting for the next attempt.
+func withRetry(ctx context.Context, attempts int, fn func() error) error {
+	delay := retryBaseDelay
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if attempt >= attempts || !isRetryableError(err) {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		delay *= 2
+		if delay > retryMaxDelay {
+			delay = retryMaxDelay
+		}
+	}
+}
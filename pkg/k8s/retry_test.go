@@ -0,0 +1,105 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// shortRetryDelay keeps backoff waits negligible for the duration of a test.
+func shortRetryDelay(t *testing.T) {
+	t.Helper()
+	prev := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = prev })
+}
+
+func TestGetPods_RetriesTransientErrors(t *testing.T) {
+	shortRetryDelay(t)
+
+	m, _ := NewMultiClusterClient("")
+	m.SetRetryAttempts(3)
+	client := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"},
+	})
+	calls := 0
+	client.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		calls++
+		if calls <= 2 {
+			return true, nil, errors.New("read tcp 10.0.0.1:443: connection reset by peer")
+		}
+		return false, nil, nil
+	})
+	m.clients["retry-cluster"] = client
+
+	pods, err := m.GetPods(context.Background(), "retry-cluster", "default")
+	if err != nil {
+		t.Fatalf("GetPods should succeed after transient errors, got %v", err)
+	}
+	if len(pods) != 1 {
+		t.Errorf("expected 1 pod, got %d", len(pods))
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 list attempts, got %d", calls)
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	shortRetryDelay(t)
+
+	tests := []struct {
+		name      string
+		err       error
+		attempts  int
+		wantCalls int
+	}{
+		{"timeout retried", errors.New("context deadline exceeded"), 3, 3},
+		{"ratelimit retried", errors.New("the server has received too many requests"), 2, 2},
+		{"network retried", errors.New("dial tcp: connection refused"), 3, 3},
+		{"auth not retried", errors.New("Unauthorized"), 3, 1},
+		{"certificate not retried", errors.New("x509: certificate signed by unknown authority"), 3, 1},
+		{"single attempt", errors.New("i/o timeout"), 1, 1},
+		{"typed 429 retried", apierrors.NewTooManyRequests("slow down", 1), 2, 2},
+		{"bare 429 in message not retried", errors.New("pod web-429 is invalid"), 3, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := withRetry(context.Background(), tt.attempts, func() error {
+				calls++
+				return tt.err
+			})
+			if err == nil {
+				t.Fatal("expected the last error to be returned")
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestWithRetry_StopsOnContextCancel(t *testing.T) {
+	prev := retryBaseDelay
+	retryBaseDelay = time.Hour
+	defer func() { retryBaseDelay = prev }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := withRetry(ctx, 5, func() error {
+		calls++
+		cancel()
+		return errors.New("i/o timeout")
+	})
+	if err == nil || calls != 1 {
+		t.Errorf("expected one call and an error after cancel, got calls=%d err=%v", calls, err)
+	}
+}
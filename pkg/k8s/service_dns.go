@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// clusterDomainEnvVar overrides defaultClusterDomain for clusters whose
+	// kubelets run with a custom --cluster-domain.
+	clusterDomainEnvVar = "K8S_CLUSTER_DOMAIN"
+	// defaultClusterDomain is the kubelet's default cluster DNS domain.
+	defaultClusterDomain = "cluster.local"
+)
+
+// clusterDomainFromEnv reads K8S_CLUSTER_DOMAIN, falling back to
+// defaultClusterDomain when unset.
+func clusterDomainFromEnv() string {
+	domain := strings.Trim(strings.TrimSpace(os.Getenv(clusterDomainEnvVar)), ".")
+	if domain == "" {
+		return defaultClusterDomain
+	}
+	return domain
+}
+
+// SetClusterDomain sets the DNS domain used for Service.DNSNames. An empty
+// value restores defaultClusterDomain.
+func (m *MultiClusterClient) SetClusterDomain(domain string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clusterDomain = strings.Trim(domain, ".")
+}
+
+func (m *MultiClusterClient) getClusterDomain() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.clusterDomain == "" {
+		return defaultClusterDomain
+	}
+	return m.clusterDomain
+}
+
+// serviceDNSNames returns the in-cluster DNS names of svc: its
+// <name>.<namespace>.svc.<domain> record and, for headless services, the
+// per-pod pattern *.<name>.<namespace>.svc.<domain>, where * is the pod's
+// hostname (the pod name for StatefulSet pods).
+func serviceDNSNames(svc *corev1.Service, clusterDomain string) []string {
+	fqdn := svc.Name + "." + svc.Namespace + ".svc." + clusterDomain
+	names := []string{fqdn}
+	if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		names = append(names, "*."+fqdn)
+	}
+	return names
+}
@@ -0,0 +1,47 @@
+package k8s
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestServiceDNSNames(t *testing.T) {
+	clusterIP := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "shop"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.96.0.10"},
+	}
+	if got, want := serviceDNSNames(clusterIP, "cluster.local"), []string{"api.shop.svc.cluster.local"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ClusterIP names = %v, want %v", got, want)
+	}
+
+	headless := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "data"},
+		Spec:       corev1.ServiceSpec{ClusterIP: corev1.ClusterIPNone},
+	}
+	want := []string{"db.data.svc.corp.internal", "*.db.data.svc.corp.internal"}
+	if got := serviceDNSNames(headless, "corp.internal"); !reflect.DeepEqual(got, want) {
+		t.Errorf("headless names = %v, want %v", got, want)
+	}
+}
+
+func TestGetServices_DNSNamesUseClusterDomain(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	m.clients["c1"] = k8sfake.NewSimpleClientset(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "shop"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.96.0.10"},
+	})
+	m.SetClusterDomain("example.test.")
+
+	services, err := m.GetServices(context.Background(), "c1", "shop")
+	if err != nil {
+		t.Fatalf("GetServices: %v", err)
+	}
+	if len(services) != 1 || !reflect.DeepEqual(services[0].DNSNames, []string{"api.shop.svc.example.test"}) {
+		t.Errorf("services = %+v, want api.shop.svc.example.test", services)
+	}
+}
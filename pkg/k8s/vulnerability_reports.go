@@ -0,0 +1,136 @@
+package k8s
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// gvrVulnerabilityReports is the Trivy operator's per-container scan result.
+var gvrVulnerabilityReports = schema.GroupVersionResource{Group: "aquasecurity.github.io", Version: "v1alpha1", Resource: "vulnerabilityreports"}
+
+// Labels the Trivy operator puts on each VulnerabilityReport to name the
+// workload and container that was scanned.
+const (
+	trivyLabelResourceKind      = "trivy-operator.resource.kind"
+	trivyLabelResourceName      = "trivy-operator.resource.name"
+	trivyLabelResourceNamespace = "trivy-operator.resource.namespace"
+)
+
+// VulnReport is the vulnerability count for one workload, summed over the
+// VulnerabilityReports of its containers.
+type VulnReport struct {
+	Cluster   string   `json:"cluster"`
+	Namespace string   `json:"namespace"`
+	Kind      string   `json:"kind"`
+	Name      string   `json:"name"`
+	Images    []string `json:"images"`
+	Critical  int64    `json:"critical"`
+	High      int64    `json:"high"`
+	Medium    int64    `json:"medium"`
+	Low       int64    `json:"low"`
+	Unknown   int64    `json:"unknown,omitempty"`
+}
+
+// GetVulnerabilityReports returns per-workload vulnerability counts read
+// from Trivy operator VulnerabilityReports in namespace (all namespaces when
+// empty), most critical first. Returns an empty list, not an error, when the
+// Trivy CRDs are not installed.
+func (m *MultiClusterClient) GetVulnerabilityReports(ctx context.Context, contextName, namespace string) (_ []VulnReport, err error) {
+	defer observeClusterRequest(contextName, "GetVulnerabilityReports", time.Now(), &err)
+	dynClient, err := m.GetDynamicClient(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := dynClient.Resource(gvrVulnerabilityReports).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) || isNoMatchError(err) {
+			return []VulnReport{}, nil
+		}
+		return nil, err
+	}
+
+	byWorkload := make(map[string]*VulnReport)
+	var order []string
+	for i := range list.Items {
+		item := &list.Items[i]
+		labels := item.GetLabels()
+		kind, name := labels[trivyLabelResourceKind], labels[trivyLabelResourceName]
+		ns := labels[trivyLabelResourceNamespace]
+		if ns == "" {
+			ns = item.GetNamespace()
+		}
+		if kind == "" || name == "" {
+			// Not written by the operator; report it under its own name.
+			kind, name = "VulnerabilityReport", item.GetName()
+		}
+
+		key := ns + "/" + kind + "/" + name
+		report, ok := byWorkload[key]
+		if !ok {
+			report = &VulnReport{Cluster: contextName, Namespace: ns, Kind: kind, Name: name, Images: []string{}}
+			byWorkload[key] = report
+			order = append(order, key)
+		}
+
+		if image := vulnReportImage(item.Object); image != "" {
+			report.Images = append(report.Images, image)
+		}
+		summary, _, _ := unstructured.NestedMap(item.Object, "report", "summary")
+		report.Critical += summaryCount(summary, "criticalCount")
+		report.High += summaryCount(summary, "highCount")
+		report.Medium += summaryCount(summary, "mediumCount")
+		report.Low += summaryCount(summary, "lowCount")
+		report.Unknown += summaryCount(summary, "unknownCount")
+	}
+
+	reports := make([]VulnReport, 0, len(order))
+	for _, key := range order {
+		reports = append(reports, *byWorkload[key])
+	}
+	sort.SliceStable(reports, func(i, j int) bool {
+		if reports[i].Critical != reports[j].Critical {
+			return reports[i].Critical > reports[j].Critical
+		}
+		return reports[i].High > reports[j].High
+	})
+	return reports, nil
+}
+
+// vulnReportImage rebuilds the scanned image reference from report.registry
+// and report.artifact.
+func vulnReportImage(obj map[string]interface{}) string {
+	repo, _, _ := unstructured.NestedString(obj, "report", "artifact", "repository")
+	if repo == "" {
+		return ""
+	}
+	if server, _, _ := unstructured.NestedString(obj, "report", "registry", "server"); server != "" {
+		repo = server + "/" + repo
+	}
+	if tag, _, _ := unstructured.NestedString(obj, "report", "artifact", "tag"); tag != "" {
+		return repo + ":" + tag
+	}
+	if digest, _, _ := unstructured.NestedString(obj, "report", "artifact", "digest"); digest != "" {
+		return repo + "@" + digest
+	}
+	return repo
+}
+
+// summaryCount reads an integer count from a report summary. Counts decode
+// as int64 from the API but as float64 from JSON fixtures.
+func summaryCount(summary map[string]interface{}, key string) int64 {
+	switch v := summary[key].(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,86 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func vulnReportObject(name, container string, critical, high int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "aquasecurity.github.io/v1alpha1",
+		"kind":       "VulnerabilityReport",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "shop",
+			"labels": map[string]interface{}{
+				trivyLabelResourceKind:          "ReplicaSet",
+				trivyLabelResourceName:          "checkout-5d9c",
+				trivyLabelResourceNamespace:     "shop",
+				"trivy-operator.container.name": container,
+			},
+		},
+		"report": map[string]interface{}{
+			"registry": map[string]interface{}{"server": "index.docker.io"},
+			"artifact": map[string]interface{}{"repository": "library/" + container, "tag": "1.2"},
+			"summary": map[string]interface{}{
+				"criticalCount": critical,
+				"highCount":     high,
+				"mediumCount":   int64(4),
+				"lowCount":      int64(7),
+				"unknownCount":  int64(0),
+			},
+		},
+	}}
+}
+
+func TestGetVulnerabilityReports_AggregatesPerWorkload(t *testing.T) {
+	listKinds := map[schema.GroupVersionResource]string{gvrVulnerabilityReports: "VulnerabilityReportList"}
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["c1"] = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds,
+		vulnReportObject("replicaset-checkout-5d9c-app", "app", 2, 5),
+		vulnReportObject("replicaset-checkout-5d9c-proxy", "proxy", 1, 0),
+	)
+
+	reports, err := m.GetVulnerabilityReports(context.Background(), "c1", "shop")
+	if err != nil {
+		t.Fatalf("GetVulnerabilityReports: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("reports = %+v, want one per workload", reports)
+	}
+	r := reports[0]
+	if r.Kind != "ReplicaSet" || r.Name != "checkout-5d9c" || r.Namespace != "shop" || r.Cluster != "c1" {
+		t.Errorf("workload ref = %+v", r)
+	}
+	if r.Critical != 3 || r.High != 5 || r.Medium != 8 || r.Low != 14 {
+		t.Errorf("counts = %d/%d/%d/%d, want 3/5/8/14", r.Critical, r.High, r.Medium, r.Low)
+	}
+	if len(r.Images) != 2 || r.Images[0] != "index.docker.io/library/app:1.2" {
+		t.Errorf("images = %v", r.Images)
+	}
+}
+
+func TestGetVulnerabilityReports_CRDMissing(t *testing.T) {
+	m, _ := NewMultiClusterClient("")
+	listKinds := map[schema.GroupVersionResource]string{gvrVulnerabilityReports: "VulnerabilityReportList"}
+	dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds)
+	dyn.PrependReactor("list", "vulnerabilityreports", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(gvrVulnerabilityReports.GroupResource(), "")
+	})
+	m.dynamicClients["c1"] = dyn
+
+	reports, err := m.GetVulnerabilityReports(context.Background(), "c1", "")
+	if err != nil {
+		t.Fatalf("GetVulnerabilityReports: %v", err)
+	}
+	if len(reports) != 0 {
+		t.Errorf("reports = %+v, want empty", reports)
+	}
+}
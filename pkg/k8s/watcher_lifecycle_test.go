@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -328,3 +329,105 @@ func TestMultiClusterClient_OnWatchError_CallbackWired(t *testing.T) {
 		t.Fatal("onWatchError callback was not invoked on reload failure")
 	}
 }
+
+// TestMultiClusterClient_SubscribeReload_FansOut verifies every subscriber
+// is signalled on reload, the legacy SetOnReload callback still fires, and
+// an unsubscribed channel is closed and no longer notified.
+func TestMultiClusterClient_SubscribeReload_FansOut(t *testing.T) {
+	path := writeTempKubeconfig(t)
+	m, err := NewMultiClusterClient(path)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+
+	callbackFired := make(chan struct{}, 1)
+	m.SetOnReload(func() { callbackFired <- struct{}{} })
+	first, unsubFirst := m.SubscribeReload()
+	second, unsubSecond := m.SubscribeReload()
+	defer unsubSecond()
+
+	m.reloadAndNotify()
+
+	for name, ch := range map[string]<-chan struct{}{"first": first, "second": second, "SetOnReload": callbackFired} {
+		select {
+		case <-ch:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("%s subscriber was not notified of the reload", name)
+		}
+	}
+
+	unsubFirst()
+	unsubFirst() // idempotent
+	if _, open := <-first; open {
+		t.Error("unsubscribed channel should be closed")
+	}
+
+	m.reloadAndNotify()
+	select {
+	case <-second:
+	case <-time.After(2 * time.Second):
+		t.Fatal("remaining subscriber was not notified of the second reload")
+	}
+}
+
+// TestMultiClusterClient_AtomicSave_SingleReloadAndWatchSurvives simulates an
+// editor's atomic save (write temp file, rename over the kubeconfig, write
+// again) and verifies the burst is coalesced into exactly one reload and that
+// the file watch is re-established on the new inode.
+func TestMultiClusterClient_AtomicSave_SingleReloadAndWatchSurvives(t *testing.T) {
+	path := writeTempKubeconfig(t)
+	original, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read kubeconfig: %v", err)
+	}
+	m, err := NewMultiClusterClient(path)
+	if err != nil {
+		t.Fatalf("NewMultiClusterClient: %v", err)
+	}
+	var reloads atomic.Int32
+	m.SetOnReload(func() { reloads.Add(1) })
+	if err := m.StartWatching(); err != nil {
+		t.Fatalf("StartWatching: %v", err)
+	}
+	defer m.StopWatching()
+
+	// Wait for the burst's debounce to fire plus slack for reloadAndNotify.
+	settle := clusterEventDebounce * 3
+
+	tmp := path + ".swp"
+	if err := os.WriteFile(tmp, original, 0600); err != nil {
+		t.Fatalf("write temp file: %v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatalf("rename over kubeconfig: %v", err)
+	}
+	if err := os.WriteFile(path, original, 0600); err != nil {
+		t.Fatalf("write after rename: %v", err)
+	}
+	time.Sleep(settle)
+	if got := reloads.Load(); got != 1 {
+		t.Fatalf("reloads after atomic save = %d, want exactly 1", got)
+	}
+
+	m.mu.RLock()
+	watched := m.watcher.WatchList()
+	m.mu.RUnlock()
+	found := false
+	for _, p := range watched {
+		if p == path {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("kubeconfig file watch lost after rename; watching %v", watched)
+	}
+
+	// A later in-place edit must still be detected.
+	if err := os.WriteFile(path, original, 0600); err != nil {
+		t.Fatalf("second write: %v", err)
+	}
+	time.Sleep(settle)
+	if got := reloads.Load(); got != 2 {
+		t.Errorf("reloads after second edit = %d, want 2", got)
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"log/slog"
 	"math"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -61,6 +63,16 @@ var (
 		Version:  "v1",
 		Resource: "daemonsets",
 	}
+	gvrReplicaSets = schema.GroupVersionResource{
+		Group:    "apps",
+		Version:  "v1",
+		Resource: "replicasets",
+	}
+	gvrWorkloadPods = schema.GroupVersionResource{
+		Group:    "",
+		Version:  "v1",
+		Resource: "pods",
+	}
 	gvrNodes = schema.GroupVersionResource{
 		Group:    "",
 		Version:  "v1",
@@ -141,6 +153,9 @@ func (m *MultiClusterClient) ListWorkloadsForCluster(ctx context.Context, contex
 	}
 
 	workloads := make([]v1alpha1.Workload, 0)
+	// One owner lookup for the whole listing, so owners listed here or read
+	// once are not fetched again for every object they own.
+	owners := newOwnerLookup(dynamicClient)
 
 	// isKindNotRegistered reports whether an error means "this kind simply
 	// isn't registered on this cluster" (benign skip), as opposed to a real
@@ -172,6 +187,7 @@ func (m *MultiClusterClient) ListWorkloadsForCluster(ctx context.Context, contex
 			}
 		} else {
 			parsed := m.parseDeploymentsAsWorkloads(deployments, contextName)
+			owners.setRootOwners(ctx, gvrDeployments, deployments, parsed)
 			workloads = append(workloads, parsed...)
 		}
 	}
@@ -191,6 +207,7 @@ func (m *MultiClusterClient) ListWorkloadsForCluster(ctx context.Context, contex
 			}
 		} else {
 			parsed := m.parseStatefulSetsAsWorkloads(statefulsets, contextName)
+			owners.setRootOwners(ctx, gvrStatefulSets, statefulsets, parsed)
 			workloads = append(workloads, parsed...)
 		}
 	}
@@ -210,6 +227,47 @@ func (m *MultiClusterClient) ListWorkloadsForCluster(ctx context.Context, contex
 			}
 		} else {
 			parsed := m.parseDaemonSetsAsWorkloads(daemonsets, contextName)
+			owners.setRootOwners(ctx, gvrDaemonSets, daemonsets, parsed)
+			workloads = append(workloads, parsed...)
+		}
+	}
+
+	// List ReplicaSets and Pods, which roll up to their controller through
+	// RootOwner so the UI can group them under it.
+	if workloadType == "" || workloadType == "ReplicaSet" {
+		var replicasets interface{}
+		var listErr error
+		if namespace == "" {
+			replicasets, listErr = dynamicClient.Resource(gvrReplicaSets).List(ctx, metav1.ListOptions{})
+		} else {
+			replicasets, listErr = dynamicClient.Resource(gvrReplicaSets).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		}
+		if listErr != nil {
+			if !isKindNotRegistered(listErr) {
+				return nil, fmt.Errorf("list replicasets on %s: %w", contextName, listErr)
+			}
+		} else {
+			parsed := m.parseReplicaSetsAsWorkloads(replicasets, contextName)
+			owners.setRootOwners(ctx, gvrReplicaSets, replicasets, parsed)
+			workloads = append(workloads, parsed...)
+		}
+	}
+
+	if workloadType == "" || workloadType == "Pod" {
+		var pods interface{}
+		var listErr error
+		if namespace == "" {
+			pods, listErr = dynamicClient.Resource(gvrWorkloadPods).List(ctx, metav1.ListOptions{})
+		} else {
+			pods, listErr = dynamicClient.Resource(gvrWorkloadPods).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		}
+		if listErr != nil {
+			if !isKindNotRegistered(listErr) {
+				return nil, fmt.Errorf("list pods on %s: %w", contextName, listErr)
+			}
+		} else {
+			parsed := m.parsePodsAsWorkloads(pods, contextName)
+			owners.setRootOwners(ctx, gvrWorkloadPods, pods, parsed)
 			workloads = append(workloads, parsed...)
 		}
 	}
@@ -488,6 +546,118 @@ func (m *MultiClusterClient) parseDaemonSetsAsWorkloads(list interface{}, contex
 	return workloads
 }
 
+// parseReplicaSetsAsWorkloads parses replicasets from unstructured list
+func (m *MultiClusterClient) parseReplicaSetsAsWorkloads(list interface{}, contextName string) []v1alpha1.Workload {
+	workloads := make([]v1alpha1.Workload, 0)
+
+	uList, ok := list.(*unstructured.UnstructuredList)
+	if !ok {
+		return workloads
+	}
+
+	for i := range uList.Items {
+		item := &uList.Items[i]
+		w := v1alpha1.Workload{
+			Name:           item.GetName(),
+			Namespace:      item.GetNamespace(),
+			Type:           v1alpha1.WorkloadTypeReplicaSet,
+			Labels:         item.GetLabels(),
+			CreatedAt:      item.GetCreationTimestamp().Time,
+			TargetClusters: []string{contextName},
+			Status:         v1alpha1.WorkloadStatusUnknown,
+		}
+
+		if replicas, ok, _ := unstructured.NestedInt64(item.Object, "spec", "replicas"); ok {
+			w.Replicas = safeInt32(replicas)
+		}
+		if ready, ok, _ := unstructured.NestedInt64(item.Object, "status", "readyReplicas"); ok {
+			w.ReadyReplicas = safeInt32(ready)
+		}
+		switch {
+		case w.Replicas == 0:
+			// A Deployment's old ReplicaSets are scaled to zero on purpose.
+			w.Status = v1alpha1.WorkloadStatusRunning
+		case w.ReadyReplicas == w.Replicas:
+			w.Status = v1alpha1.WorkloadStatusRunning
+		case w.ReadyReplicas > 0:
+			w.Status = v1alpha1.WorkloadStatusDegraded
+		default:
+			w.Status = v1alpha1.WorkloadStatusPending
+		}
+
+		w.Deployments = []v1alpha1.ClusterDeployment{{
+			Cluster:       contextName,
+			Status:        w.Status,
+			Replicas:      w.Replicas,
+			ReadyReplicas: w.ReadyReplicas,
+			LastUpdated:   time.Now(),
+		}}
+
+		workloads = append(workloads, w)
+	}
+
+	return workloads
+}
+
+// parsePodsAsWorkloads parses pods from unstructured list. A pod is one
+// replica, ready when its Ready condition is true.
+func (m *MultiClusterClient) parsePodsAsWorkloads(list interface{}, contextName string) []v1alpha1.Workload {
+	workloads := make([]v1alpha1.Workload, 0)
+
+	uList, ok := list.(*unstructured.UnstructuredList)
+	if !ok {
+		return workloads
+	}
+
+	for i := range uList.Items {
+		item := &uList.Items[i]
+		w := v1alpha1.Workload{
+			Name:           item.GetName(),
+			Namespace:      item.GetNamespace(),
+			Type:           v1alpha1.WorkloadTypePod,
+			Labels:         item.GetLabels(),
+			CreatedAt:      item.GetCreationTimestamp().Time,
+			TargetClusters: []string{contextName},
+			Replicas:       1,
+		}
+
+		if containers, ok, _ := unstructured.NestedSlice(item.Object, "spec", "containers"); ok && len(containers) > 0 {
+			if container, ok := containers[0].(map[string]interface{}); ok {
+				w.Image, _ = container["image"].(string)
+			}
+		}
+		conditions, _, _ := unstructured.NestedSlice(item.Object, "status", "conditions")
+		for _, cRaw := range conditions {
+			if cond, ok := cRaw.(map[string]interface{}); ok && cond["type"] == "Ready" && cond["status"] == "True" {
+				w.ReadyReplicas = 1
+			}
+		}
+		phase, _, _ := unstructured.NestedString(item.Object, "status", "phase")
+		switch phase {
+		case "Running", "Succeeded":
+			w.Status = v1alpha1.WorkloadStatusRunning
+		case "Pending":
+			w.Status = v1alpha1.WorkloadStatusPending
+		case "Failed":
+			w.Status = v1alpha1.WorkloadStatusFailed
+		default:
+			w.Status = v1alpha1.WorkloadStatusUnknown
+		}
+
+		w.Deployments = []v1alpha1.ClusterDeployment{{
+			Cluster:       contextName,
+			Status:        w.Status,
+			Replicas:      w.Replicas,
+			ReadyReplicas: w.ReadyReplicas,
+			LastUpdated:   time.Now(),
+		}}
+
+		workloads = append(workloads, w)
+	}
+
+	return workloads
+}
+
 // GetWorkload gets a specific workload by namespaced name.
 //
 // Previously this made a full ListWorkloadsForCluster call (listing ALL
@@ -625,6 +795,63 @@ func (m *MultiClusterClient) ResolveWorkloadDependencies(
 type DeployOptions struct {
 	DeployedBy string
 	GroupName  string
+	// OnProgress, if set, is called as each dependency and the workload move
+	// through applying -> applied/failed on each target cluster. Target
+	// clusters are deployed in parallel, so it must be safe for concurrent use.
+	OnProgress func(v1alpha1.DeployProgress)
+	// Atomic makes the deploy all-or-nothing: if any target cluster fails,
+	// every resource this deploy created (on all clusters) is deleted again
+	// in reverse apply order. Updated or skipped pre-existing resources are
+	// never deleted. The outcome is reported in DeployResponse.Rollback.
+	Atomic bool
+	// ImageOverrides maps container name to the image to deploy instead of
+	// the source image (e.g. to swap registry or tag when promoting between
+	// environments). Applies to containers and initContainers; every name
+	// must exist in the workload's pod template.
+	ImageOverrides map[string]string
+	// TargetNamespace deploys into this namespace on the targets instead of
+	// the source namespace. Every namespaced manifest in the bundle, and
+	// RoleBinding subjects pointing at ServiceAccounts in the source
+	// namespace, are rewritten to it.
+	TargetNamespace string
+	// CreateNamespace creates the target namespace when it is missing,
+	// copying the source namespace's labels. Without it, a missing
+	// TargetNamespace fails the deploy on that cluster; deploys into the
+	// source namespace keep creating it with console labels only.
+	CreateNamespace bool
+	// StripAnnotations lists annotations removed from every deployed
+	// manifest, e.g. "kubectl.kubernetes.io/last-applied-configuration". An
+	// entry ending in "/" removes every annotation with that prefix. The
+	// console's own kubestellar.io annotations are added afterwards and are
+	// never stripped.
+	StripAnnotations []string
+	// ClearNodeName clears spec.nodeName of Pods and pod templates, so the
+	// target cluster's scheduler places them instead of binding them to a
+	// source-cluster node.
+	ClearNodeName bool
+	// StripNodeSelector removes spec.nodeSelector of Pods and pod templates,
+	// for targets whose nodes do not carry the source cluster's labels.
+	StripNodeSelector bool
+	// StripFinalizers removes metadata.finalizers.
+	StripFinalizers bool
+	// KeepStatus keeps the source object's status instead of removing it.
+	KeepStatus bool
+}
+
+// ErrTargetNamespaceMissing is reported for a target cluster that lacks the
+// requested TargetNamespace when CreateNamespace is not set.
+var ErrTargetNamespaceMissing = errors.New("target namespace does not exist")
+
+// ErrInvalidImageOverride is returned by DeployWorkload when an image
+// override names a container the workload does not have or sets an empty
+// image. Nothing is applied in that case.
+var ErrInvalidImageOverride = errors.New("invalid image override")
+
+// reportProgress forwards p to OnProgress when one is configured.
+func (o *DeployOptions) reportProgress(p v1alpha1.DeployProgress) {
+	if o != nil && o.OnProgress != nil {
+		o.OnProgress(p)
+	}
 }
 
 // DeployWorkload fetches a workload manifest from the source cluster and applies it to target clusters
@@ -676,8 +903,25 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 		}
 	}
 
+	// Resolve where the bundle lands on the targets.
+	targetNamespace := namespace
+	if opts.TargetNamespace != "" {
+		targetNamespace = opts.TargetNamespace
+	}
+	createNamespace := opts.CreateNamespace || opts.TargetNamespace == ""
+	var namespaceLabels map[string]string
+	if opts.CreateNamespace {
+		if srcNS, nsErr := sourceClient.Resource(gvrNamespaces).Get(ctx, namespace, metav1.GetOptions{}); nsErr == nil {
+			namespaceLabels = srcNS.GetLabels()
+		} else {
+			slog.Warn("[deploy] could not read source namespace labels", "namespace", namespace, "error", nsErr)
+		}
+	}
+	deps := retargetDependencies(bundle.Dependencies, namespace, targetNamespace)
+
 	// 3. Clean the workload manifest for cross-cluster apply
 	cleanedObj := cleanManifestForDeploy(sourceObj, sourceCluster, opts)
+	cleanedObj.SetNamespace(targetNamespace)
 
 	// Override replicas if specified
 	if replicas > 0 {
@@ -686,6 +930,12 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 		}
 	}
 
+	if opts != nil && len(opts.ImageOverrides) > 0 {
+		if err := applyImageOverrides(cleanedObj, opts.ImageOverrides); err != nil {
+			return nil, err
+		}
+	}
+
 	// 4. Apply to each target cluster in parallel
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -695,14 +945,31 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 	// error, not just the last one to finish (#10257).
 	errs := make([]error, 0)
 	allDepResults := make([]v1alpha1.DeployedDep, 0)
+	// Copy so per-cluster compatibility warnings don't alias bundle.Warnings.
+	warnings := append([]string(nil), bundle.Warnings...)
+	// Resources touched per cluster, in apply order, for atomic rollback.
+	touched := make(map[string][]deployTouched)
+	depGVRs := make(map[string]Dependency, len(deps))
+	for _, dep := range deps {
+		depGVRs[string(dep.Kind)+"/"+dep.Name] = dep
+	}
 
 	for _, target := range targetClusters {
 		wg.Add(1)
 		go func(targetCluster string) {
 			defer wg.Done()
 
+			workloadProgress := func(status, action string, err error) {
+				p := v1alpha1.DeployProgress{Cluster: targetCluster, Kind: sourceObj.GetKind(), Name: name, Status: status, Action: action}
+				if err != nil {
+					p.Error = err.Error()
+				}
+				opts.reportProgress(p)
+			}
+
 			targetClient, err := m.GetDynamicClient(targetCluster)
 			if err != nil {
+				workloadProgress(v1alpha1.DeployProgressFailed, "", err)
 				mu.Lock()
 				failed = append(failed, targetCluster)
 				errs = append(errs, fmt.Errorf("cluster %s: %w", targetCluster, err))
@@ -713,20 +980,54 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 			clusterCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
 			defer cancel()
 
+			// Preflight: surface API versions the target does not serve
+			// (e.g. autoscaling/v2 on an old cluster) before applying.
+			compat, compatErr := m.CheckDeployCompatibility(clusterCtx, targetCluster, bundle)
+			if compatErr != nil {
+				slog.Warn("[deploy] compatibility check failed", "cluster", targetCluster, "error", compatErr)
+			}
+			if len(compat) > 0 {
+				mu.Lock()
+				for _, cw := range compat {
+					warnings = append(warnings, cw.Message)
+				}
+				mu.Unlock()
+			}
+
 			// 4a. Ensure namespace exists on target
-			nsErr := m.ensureNamespace(clusterCtx, targetClient, namespace, opts)
+			nsErr := m.ensureNamespace(clusterCtx, targetClient, targetNamespace, namespaceLabels, createNamespace, opts)
+			if errors.Is(nsErr, ErrTargetNamespaceMissing) {
+				workloadProgress(v1alpha1.DeployProgressFailed, "", nsErr)
+				mu.Lock()
+				failed = append(failed, targetCluster)
+				errs = append(errs, fmt.Errorf("cluster %s: %w", targetCluster, nsErr))
+				mu.Unlock()
+				return
+			}
 			if nsErr != nil {
 				slog.Warn("[deploy] namespace ensure failed", "cluster", targetCluster, "error", nsErr)
 			}
 
 			// 4b. Apply dependencies in order before the workload
-			depResults := applyDependencies(clusterCtx, targetClient, bundle.Dependencies)
+			depResults := applyDependencies(clusterCtx, targetClient, targetCluster, deps, opts)
 			mu.Lock()
 			allDepResults = append(allDepResults, depResults...)
+			for _, dr := range depResults {
+				if dr.Action == "failed" {
+					continue
+				}
+				dep := depGVRs[dr.Kind+"/"+dr.Name]
+				touched[targetCluster] = append(touched[targetCluster], deployTouched{
+					gvr: dep.GVR,
+					resource: v1alpha1.DeployedResource{
+						Cluster: targetCluster, Kind: dr.Kind, Namespace: dep.Namespace, Name: dr.Name, Action: dr.Action,
+					},
+				})
+			}
 			
 			// Check if any dependency failed to deploy
-			hasFailedDep := false
-			for _, dr := range depResults {
+			var failedDep *v1alpha1.DeployedDep
+			for i, dr := range depResults {
 				if dr.Action == "failed" {
 					failed = append(failed, targetCluster)
 					if dr.Error != "" {
@@ -734,21 +1035,24 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 					} else {
 						errs = append(errs, fmt.Errorf("cluster %s: dependency %s/%s failed", targetCluster, dr.Kind, dr.Name))
 					}
-					hasFailedDep = true
+					failedDep = &depResults[i]
 					break // Record one failure reason per cluster to avoid spam
 				}
 			}
 			mu.Unlock()
 			
-			if hasFailedDep {
+			if failedDep != nil {
+				workloadProgress(v1alpha1.DeployProgressFailed, "", fmt.Errorf("not applied: dependency %s/%s failed", failedDep.Kind, failedDep.Name))
 				return // Abort workload deployment for this cluster if deps failed
 			}
 
 			// 4c. Apply the workload itself
+			workloadProgress(v1alpha1.DeployProgressApplying, "", nil)
 			objCopy := cleanedObj.DeepCopy()
 			normalizeImageNames(objCopy)
 
-			_, err = targetClient.Resource(sourceGVR).Namespace(namespace).Create(clusterCtx, objCopy, metav1.CreateOptions{})
+			workloadAction := "created"
+			_, err = targetClient.Resource(sourceGVR).Namespace(targetNamespace).Create(clusterCtx, objCopy, metav1.CreateOptions{})
 			if err != nil {
 				// If already exists, try update. Only fall through to Update when
 				// Get succeeds; if Get itself fails with a non-NotFound error (e.g.
@@ -756,8 +1060,9 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 				// errors so the operator can see the real cause (#6501). Previously
 				// a Get-level network error was silently replaced with the Create
 				// error message, masking the root failure.
-				existing, getErr := targetClient.Resource(sourceGVR).Namespace(namespace).Get(clusterCtx, name, metav1.GetOptions{})
+				existing, getErr := targetClient.Resource(sourceGVR).Namespace(targetNamespace).Get(clusterCtx, name, metav1.GetOptions{})
 				if getErr != nil {
+					workloadProgress(v1alpha1.DeployProgressFailed, "", err)
 					mu.Lock()
 					failed = append(failed, targetCluster)
 					if apierrors.IsNotFound(getErr) {
@@ -773,17 +1078,26 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 					return
 				}
 				objCopy.SetResourceVersion(existing.GetResourceVersion())
-				_, err = targetClient.Resource(sourceGVR).Namespace(namespace).Update(clusterCtx, objCopy, metav1.UpdateOptions{})
+				_, err = targetClient.Resource(sourceGVR).Namespace(targetNamespace).Update(clusterCtx, objCopy, metav1.UpdateOptions{})
 				if err != nil {
+					workloadProgress(v1alpha1.DeployProgressFailed, "", err)
 					mu.Lock()
 					failed = append(failed, targetCluster)
 					errs = append(errs, fmt.Errorf("cluster %s: update failed: %w", targetCluster, err))
 					mu.Unlock()
 					return
 				}
+				workloadAction = "updated"
 			}
+			workloadProgress(v1alpha1.DeployProgressApplied, workloadAction, nil)
 
 			mu.Lock()
+			touched[targetCluster] = append(touched[targetCluster], deployTouched{
+				gvr: sourceGVR,
+				resource: v1alpha1.DeployedResource{
+					Cluster: targetCluster, Kind: sourceObj.GetKind(), Namespace: targetNamespace, Name: name, Action: workloadAction,
+				},
+			})
 			deployed = append(deployed, targetCluster)
 			mu.Unlock()
 		}(target)
@@ -812,7 +1126,12 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 		DeployedTo:     deployed,
 		FailedClusters: failed,
 		Dependencies:   dedupedDeps,
-		Warnings:       bundle.Warnings,
+		Warnings:       warnings,
+	}
+	if opts.Atomic && len(failed) > 0 {
+		resp.Rollback = m.rollbackDeploy(ctx, touched)
+		// Nothing from this deploy remains on the clusters that had succeeded.
+		resp.DeployedTo = []string{}
 	}
 
 	depSummary := ""
@@ -821,7 +1140,10 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 	}
 
 	if len(failed) == 0 {
-		resp.Message = fmt.Sprintf("Deployed %s/%s to %d cluster(s)%s", namespace, name, len(deployed), depSummary)
+		resp.Message = fmt.Sprintf("Deployed %s/%s to %d cluster(s)%s", targetNamespace, name, len(deployed), depSummary)
+	} else if resp.Rollback != nil {
+		resp.Message = fmt.Sprintf("Atomic deploy failed and was rolled back (%d removed, %d left in place): %v",
+			len(resp.Rollback.RolledBack), len(resp.Rollback.LeftInPlace), errors.Join(errs...))
 	} else if len(deployed) > 0 {
 		resp.Message = fmt.Sprintf("Partially deployed: %d succeeded, %d failed%s: %v", len(deployed), len(failed), depSummary, errors.Join(errs...))
 	} else {
@@ -831,9 +1153,72 @@ func (m *MultiClusterClient) DeployWorkload(ctx context.Context, sourceCluster,
 	return resp, nil
 }
 
-// ensureNamespace creates the namespace on the target cluster if it doesn't exist
+// deployRollbackTimeout bounds the cleanup of a failed atomic deploy. It is
+// independent of the deploy's own context, which may already have expired.
+const deployRollbackTimeout = 60 * time.Second
+
+// deployTouched is one resource a deploy created, updated or skipped on a
+// target cluster, kept in apply order for atomic rollback.
+type deployTouched struct {
+	gvr      schema.GroupVersionResource
+	resource v1alpha1.DeployedResource
+}
+
+// rollbackDeploy deletes, in reverse apply order, every resource a failed
+// atomic deploy created. Resources it only updated or skipped existed before
+// the deploy and are reported as left in place, as are resources whose
+// delete fails. The namespace is never removed.
+func (m *MultiClusterClient) rollbackDeploy(ctx context.Context, touched map[string][]deployTouched) *v1alpha1.DeployRollback {
+	rollback := &v1alpha1.DeployRollback{
+		RolledBack:  make([]v1alpha1.DeployedResource, 0),
+		LeftInPlace: make([]v1alpha1.DeployedResource, 0),
+	}
+	rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deployRollbackTimeout)
+	defer cancel()
+
+	clusters := make([]string, 0, len(touched))
+	for cluster := range touched {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+
+	for _, cluster := range clusters {
+		items := touched[cluster]
+		client, clientErr := m.GetDynamicClient(cluster)
+		for i := len(items) - 1; i >= 0; i-- {
+			item := items[i]
+			if item.resource.Action != "created" {
+				rollback.LeftInPlace = append(rollback.LeftInPlace, item.resource)
+				continue
+			}
+			err := clientErr
+			if err == nil {
+				var resource dynamic.ResourceInterface = client.Resource(item.gvr)
+				if item.resource.Namespace != "" {
+					resource = client.Resource(item.gvr).Namespace(item.resource.Namespace)
+				}
+				propagation := metav1.DeletePropagationBackground
+				err = resource.Delete(rollbackCtx, item.resource.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+			}
+			if err != nil && !apierrors.IsNotFound(err) {
+				slog.Error("[deploy] rollback delete failed", "cluster", cluster, "kind", item.resource.Kind, "name", item.resource.Name, "error", err)
+				item.resource.Error = err.Error()
+				rollback.LeftInPlace = append(rollback.LeftInPlace, item.resource)
+				continue
+			}
+			slog.Info("[deploy] rolled back", "cluster", cluster, "kind", item.resource.Kind, "name", item.resource.Name)
+			rollback.RolledBack = append(rollback.RolledBack, item.resource)
+		}
+	}
+	return rollback
+}
+
+// ensureNamespace creates the namespace on the target cluster if it doesn't
+// exist, carrying over copyLabels (the source namespace's labels) next to
+// the console labels. With create false a missing namespace is reported as
+// ErrTargetNamespaceMissing instead.
 func (m *MultiClusterClient) ensureNamespace(
-	ctx context.Context, client dynamic.Interface, namespace string, opts *DeployOptions,
+	ctx context.Context, client dynamic.Interface, namespace string, copyLabels map[string]string, create bool, opts *DeployOptions,
 ) error {
 	_, err := client.Resource(gvrNamespaces).Get(ctx, namespace, metav1.GetOptions{})
 	if err == nil {
@@ -842,23 +1227,31 @@ func (m *MultiClusterClient) ensureNamespace(
 	if !apierrors.IsNotFound(err) {
 		return fmt.Errorf("failed to check namespace %s: %w", namespace, err)
 	}
+	if !create {
+		return fmt.Errorf("%w: %s", ErrTargetNamespaceMissing, namespace)
+	}
+	labels := make(map[string]string, len(copyLabels)+2)
+	for k, v := range copyLabels {
+		// Set by the apiserver from the name; the source value would be wrong.
+		if k == corev1.LabelMetadataName {
+			continue
+		}
+		labels[k] = v
+	}
+	labels["kubestellar.io/managed-by"] = "kubestellar-console"
+	if opts != nil && opts.DeployedBy != "" {
+		labels["kubestellar.io/deployed-by"] = opts.DeployedBy
+	}
 	nsObj := &unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": "v1",
 			"kind":       "Namespace",
 			"metadata": map[string]interface{}{
 				"name": namespace,
-				"labels": map[string]interface{}{
-					"kubestellar.io/managed-by": "kubestellar-console",
-				},
 			},
 		},
 	}
-	if opts != nil && opts.DeployedBy != "" {
-		labels := nsObj.GetLabels()
-		labels["kubestellar.io/deployed-by"] = opts.DeployedBy
-		nsObj.SetLabels(labels)
-	}
+	nsObj.SetLabels(labels)
 	_, err = client.Resource(gvrNamespaces).Create(ctx, nsObj, metav1.CreateOptions{})
 	if err != nil && apierrors.IsAlreadyExists(err) {
 		return nil
@@ -866,10 +1259,54 @@ func (m *MultiClusterClient) ensureNamespace(
 	return err
 }
 
+// retargetDependencies returns deps with every namespaced resource moved
+// from namespace `from` to `to`. ServiceAccount subjects of (Cluster)RoleBindings
+// that point at `from` are moved too, so the copied RBAC still grants the
+// copied ServiceAccount. deps itself is not modified.
+func retargetDependencies(deps []Dependency, from, to string) []Dependency {
+	if from == to {
+		return deps
+	}
+	out := make([]Dependency, len(deps))
+	for i, dep := range deps {
+		out[i] = dep
+		if dep.Object == nil {
+			if dep.Namespace != "" {
+				out[i].Namespace = to
+			}
+			continue
+		}
+		obj := dep.Object.DeepCopy()
+		if dep.Namespace != "" {
+			out[i].Namespace = to
+			obj.SetNamespace(to)
+		}
+		if dep.Kind == DepRoleBinding || dep.Kind == DepClusterRoleBinding {
+			subjects, _, _ := unstructured.NestedSlice(obj.Object, "subjects")
+			for _, sub := range subjects {
+				subject, ok := sub.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if subject["kind"] == "ServiceAccount" && subject["namespace"] == from {
+					subject["namespace"] = to
+				}
+			}
+			if len(subjects) > 0 {
+				_ = unstructured.SetNestedSlice(obj.Object, subjects, "subjects")
+			}
+		}
+		out[i].Object = obj
+	}
+	return out
+}
+
 // applyDependencies applies each dependency to the target cluster.
 // Uses skip-if-exists logic: skips user-managed resources, updates console-managed ones.
+// A failed dependency does not stop the rest from being applied; each one is
+// reported to opts.OnProgress as it is applied.
 func applyDependencies(
-	ctx context.Context, client dynamic.Interface, deps []Dependency,
+	ctx context.Context, client dynamic.Interface, cluster string, deps []Dependency, opts *DeployOptions,
 ) []v1alpha1.DeployedDep {
 	results := make([]v1alpha1.DeployedDep, 0, len(deps))
 	for _, dep := range deps {
@@ -881,6 +1318,7 @@ func applyDependencies(
 			Kind: string(dep.Kind),
 			Name: dep.Name,
 		}
+		opts.reportProgress(v1alpha1.DeployProgress{Cluster: cluster, Kind: result.Kind, Name: result.Name, Status: v1alpha1.DeployProgressApplying})
 
 		objCopy := dep.Object.DeepCopy()
 		var resource dynamic.ResourceInterface
@@ -900,6 +1338,7 @@ func applyDependencies(
 				result.Action = "skipped"
 				results = append(results, result)
 				slog.Info("[deploy] skipped (not console-managed)", "kind", dep.Kind, "name", dep.Name)
+				opts.reportProgress(depProgress(cluster, result))
 				continue
 			}
 			// Console-managed — update
@@ -932,11 +1371,26 @@ func applyDependencies(
 		}
 
 		results = append(results, result)
+		opts.reportProgress(depProgress(cluster, result))
 	}
 	return results
 }
 
-// cleanManifestForDeploy strips cluster-specific metadata and adds console labels
+// depProgress converts a finished DeployedDep into its applied/failed
+// progress event.
+func depProgress(cluster string, dr v1alpha1.DeployedDep) v1alpha1.DeployProgress {
+	p := v1alpha1.DeployProgress{Cluster: cluster, Kind: dr.Kind, Name: dr.Name, Status: v1alpha1.DeployProgressApplied, Action: dr.Action}
+	if dr.Action == "failed" {
+		p.Status = v1alpha1.DeployProgressFailed
+		p.Action = ""
+		p.Error = dr.Error
+	}
+	return p
+}
+
+// cleanManifestForDeploy strips cluster-specific metadata and adds console
+// labels. opts' Strip*, ClearNodeName and KeepStatus fields adjust what is
+// stripped.
 func cleanManifestForDeploy(obj *unstructured.Unstructured, sourceCluster string, opts *DeployOptions) *unstructured.Unstructured {
 	clean := obj.DeepCopy()
 
@@ -949,11 +1403,25 @@ func cleanManifestForDeploy(obj *unstructured.Unstructured, sourceCluster string
 	clean.SetCreationTimestamp(metav1.Time{})
 
 	// Remove status
-	delete(clean.Object, "status")
+	if !opts.KeepStatus {
+		delete(clean.Object, "status")
+	}
 
 	// Remove owner references (cluster-specific)
 	clean.SetOwnerReferences(nil)
 
+	if opts.StripFinalizers {
+		clean.SetFinalizers(nil)
+	}
+	if podSpec := podSpecOf(clean); podSpec != nil {
+		if opts.ClearNodeName {
+			delete(podSpec, "nodeName")
+		}
+		if opts.StripNodeSelector {
+			delete(podSpec, "nodeSelector")
+		}
+	}
+
 	// Add console labels
 	labels := clean.GetLabels()
 	if labels == nil {
@@ -973,6 +1441,11 @@ func cleanManifestForDeploy(obj *unstructured.Unstructured, sourceCluster string
 	if annotations == nil {
 		annotations = make(map[string]string)
 	}
+	for key := range annotations {
+		if annotationStripped(key, opts.StripAnnotations) {
+			delete(annotations, key)
+		}
+	}
 	annotations["kubestellar.io/deploy-timestamp"] = time.Now().UTC().Format(time.RFC3339)
 	annotations["kubestellar.io/source-cluster"] = sourceCluster
 	clean.SetAnnotations(annotations)
@@ -980,6 +1453,40 @@ func cleanManifestForDeploy(obj *unstructured.Unstructured, sourceCluster string
 	return clean
 }
 
+// annotationStripped reports whether key matches an entry of strip: equal to
+// it, or prefixed by it when the entry ends in "/".
+func annotationStripped(key string, strip []string) bool {
+	for _, s := range strip {
+		if key == s || (strings.HasSuffix(s, "/") && strings.HasPrefix(key, s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// podSpecOf returns the pod spec of a Pod, or of the pod template of a
+// workload or CronJob, as a mutable map into obj; nil when obj has none.
+func podSpecOf(obj *unstructured.Unstructured) map[string]interface{} {
+	var path []string
+	switch obj.GetKind() {
+	case "Pod":
+		path = []string{"spec"}
+	case "CronJob":
+		path = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	default:
+		path = []string{"spec", "template", "spec"}
+	}
+	spec := obj.Object
+	for _, field := range path {
+		next, ok := spec[field].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		spec = next
+	}
+	return spec
+}
+
 // normalizeImageNames converts short image names to fully-qualified for CRI-O compatibility
 func normalizeImageNames(obj *unstructured.Unstructured) {
 	spec, ok := obj.Object["spec"].(map[string]interface{})
@@ -1029,6 +1536,53 @@ func normalizeImageNames(obj *unstructured.Unstructured) {
 	}
 }
 
+// applyImageOverrides sets the image of each container (or init container)
+// named in overrides. All names are validated before anything is changed.
+func applyImageOverrides(obj *unstructured.Unstructured, overrides map[string]string) error {
+	names := make(map[string]bool)
+	for _, field := range []string{"containers", "initContainers"} {
+		list, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", field)
+		for _, c := range list {
+			if container, ok := c.(map[string]interface{}); ok {
+				if name, ok := container["name"].(string); ok {
+					names[name] = true
+				}
+			}
+		}
+	}
+
+	for name, image := range overrides {
+		if !names[name] {
+			return fmt.Errorf("%w: workload %s has no container %q", ErrInvalidImageOverride, obj.GetName(), name)
+		}
+		if strings.TrimSpace(image) == "" {
+			return fmt.Errorf("%w: empty image for container %q", ErrInvalidImageOverride, name)
+		}
+	}
+
+	// NestedSlice returns deep copies, so write the modified lists back.
+	for _, field := range []string{"containers", "initContainers"} {
+		list, found, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", field)
+		if !found {
+			continue
+		}
+		for _, c := range list {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := container["name"].(string)
+			if image, ok := overrides[name]; ok {
+				container["image"] = image
+			}
+		}
+		if err := unstructured.SetNestedSlice(obj.Object, list, "spec", "template", "spec", field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // normalizeImageRef converts short Docker Hub names to fully-qualified
 // e.g. "nginx:1.27" → "docker.io/library/nginx:1.27"
 // e.g. "myorg/myimage:v1" → "docker.io/myorg/myimage:v1"
@@ -2,8 +2,10 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -15,6 +17,8 @@ import (
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kubestellar/console/pkg/api/v1alpha1"
 )
 
 func TestResolveWorkloadDependencies(t *testing.T) {
@@ -415,6 +419,12 @@ func TestListWorkloadsForCluster(t *testing.T) {
 			if len(wls) != tt.wantCount {
 				t.Errorf("Expected %d workloads, got %d", tt.wantCount, len(wls))
 			}
+			for _, w := range wls {
+				want := v1alpha1.WorkloadOwner{Kind: string(w.Type), Name: w.Name}
+				if w.RootOwner == nil || *w.RootOwner != want {
+					t.Errorf("%s rootOwner = %v, want itself", w.Name, w.RootOwner)
+				}
+			}
 		})
 	}
 }
@@ -631,3 +641,548 @@ func TestDeployWorkloadWithFailingDependency(t *testing.T) {
 		t.Errorf("Expected error message to contain %q, got: %s", "simulated admission webhook failure for secret", resp.Message)
 	}
 }
+
+func TestDeployWorkload_ReportsProgressPerDependency(t *testing.T) {
+	deployObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "dep1",
+				"namespace": "default",
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "c1", "image": "nginx"},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{"name": "cfg", "configMap": map[string]interface{}{"name": "cm1"}},
+							map[string]interface{}{"name": "sec", "secret": map[string]interface{}{"secretName": "sec1"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	cmObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1", "kind": "ConfigMap",
+		"metadata": map[string]interface{}{"name": "cm1", "namespace": "default"},
+		"data":     map[string]interface{}{"k": "v"},
+	}}
+	secObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1", "kind": "Secret",
+		"metadata": map[string]interface{}{"name": "sec1", "namespace": "default"},
+		"data":     map[string]interface{}{},
+	}}
+
+	scheme := runtime.NewScheme()
+	gvrMap := buildTestGVRMap()
+	sourceClient := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap, deployObj, cmObj, secObj)
+	okTarget := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap)
+	badTarget := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap)
+	badTarget.PrependReactor("create", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("simulated secret failure")
+	})
+
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["src"] = sourceClient
+	m.dynamicClients["ok"] = okTarget
+	m.dynamicClients["bad"] = badTarget
+
+	var mu sync.Mutex
+	statuses := make(map[string][]string) // "cluster/Kind/name" -> statuses in order
+	opts := &DeployOptions{DeployedBy: "test-user", OnProgress: func(p v1alpha1.DeployProgress) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := p.Cluster + "/" + p.Kind + "/" + p.Name
+		statuses[key] = append(statuses[key], p.Status)
+	}}
+
+	resp, err := m.DeployWorkload(context.Background(), "src", "default", "dep1", []string{"ok", "bad"}, 0, opts)
+	if err != nil {
+		t.Fatalf("DeployWorkload: %v", err)
+	}
+	if resp.Success {
+		t.Error("expected partial failure")
+	}
+
+	want := map[string][]string{
+		"ok/ConfigMap/cm1":    {"applying", "applied"},
+		"ok/Secret/sec1":      {"applying", "applied"},
+		"ok/Deployment/dep1":  {"applying", "applied"},
+		"bad/ConfigMap/cm1":   {"applying", "applied"},
+		"bad/Secret/sec1":     {"applying", "failed"},
+		"bad/Deployment/dep1": {"failed"},
+	}
+	for key, wantStatuses := range want {
+		got := statuses[key]
+		if strings.Join(got, ",") != strings.Join(wantStatuses, ",") {
+			t.Errorf("%s statuses = %v, want %v", key, got, wantStatuses)
+		}
+	}
+	if len(statuses) != len(want) {
+		t.Errorf("unexpected progress items: %v", statuses)
+	}
+}
+
+func TestDeployWorkload_AtomicRollsBackCreatedResources(t *testing.T) {
+	deployObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "dep1", "namespace": "default"},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "c1", "image": "nginx"},
+						},
+						"volumes": []interface{}{
+							map[string]interface{}{"name": "cfg", "configMap": map[string]interface{}{"name": "cm1"}},
+							map[string]interface{}{"name": "sec", "secret": map[string]interface{}{"secretName": "sec1"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	newCM := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap",
+			"metadata": map[string]interface{}{"name": "cm1", "namespace": "default"},
+		}}
+	}
+	secObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1", "kind": "Secret",
+		"metadata": map[string]interface{}{"name": "sec1", "namespace": "default"},
+	}}
+
+	scheme := runtime.NewScheme()
+	gvrMap := buildTestGVRMap()
+	sourceClient := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap, deployObj, newCM(), secObj)
+	// "ok" already has a user-managed cm1, so the deploy skips it; the
+	// secret and workload are created and must be rolled back.
+	okTarget := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap, newCM())
+	// On "bad" cm1 is created first, then the second resource (the secret)
+	// fails; cm1 must be deleted again.
+	badTarget := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap)
+	badTarget.PrependReactor("create", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("simulated secret failure")
+	})
+
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["src"] = sourceClient
+	m.dynamicClients["ok"] = okTarget
+	m.dynamicClients["bad"] = badTarget
+
+	resp, err := m.DeployWorkload(context.Background(), "src", "default", "dep1", []string{"ok", "bad"}, 0,
+		&DeployOptions{DeployedBy: "test-user", Atomic: true})
+	if err != nil {
+		t.Fatalf("DeployWorkload: %v", err)
+	}
+	if resp.Success {
+		t.Fatal("expected failure")
+	}
+	if resp.Rollback == nil {
+		t.Fatal("expected rollback report for atomic deploy")
+	}
+	if len(resp.DeployedTo) != 0 {
+		t.Errorf("DeployedTo = %v, want none after rollback", resp.DeployedTo)
+	}
+
+	ctx := context.Background()
+	cmGVR := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	secGVR := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	depGVR := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+	if _, err := badTarget.Resource(cmGVR).Namespace("default").Get(ctx, "cm1", metav1.GetOptions{}); err == nil {
+		t.Error("cm1 created on bad cluster should have been deleted")
+	}
+	if _, err := okTarget.Resource(secGVR).Namespace("default").Get(ctx, "sec1", metav1.GetOptions{}); err == nil {
+		t.Error("sec1 created on ok cluster should have been deleted")
+	}
+	if _, err := okTarget.Resource(depGVR).Namespace("default").Get(ctx, "dep1", metav1.GetOptions{}); err == nil {
+		t.Error("dep1 created on ok cluster should have been deleted")
+	}
+	if _, err := okTarget.Resource(cmGVR).Namespace("default").Get(ctx, "cm1", metav1.GetOptions{}); err != nil {
+		t.Errorf("pre-existing cm1 on ok cluster must be left in place: %v", err)
+	}
+
+	rolledBack := make([]string, 0)
+	for _, r := range resp.Rollback.RolledBack {
+		rolledBack = append(rolledBack, r.Cluster+"/"+r.Kind+"/"+r.Name)
+	}
+	// Per cluster, deletions run in reverse apply order.
+	wantRolledBack := []string{"bad/ConfigMap/cm1", "ok/Deployment/dep1", "ok/Secret/sec1"}
+	if strings.Join(rolledBack, ",") != strings.Join(wantRolledBack, ",") {
+		t.Errorf("RolledBack = %v, want %v", rolledBack, wantRolledBack)
+	}
+	if len(resp.Rollback.LeftInPlace) != 1 || resp.Rollback.LeftInPlace[0].Cluster != "ok" ||
+		resp.Rollback.LeftInPlace[0].Name != "cm1" || resp.Rollback.LeftInPlace[0].Action != "skipped" {
+		t.Errorf("LeftInPlace = %+v, want only the skipped cm1 on ok", resp.Rollback.LeftInPlace)
+	}
+}
+
+func TestDeployWorkload_NonAtomicLeavesPartialState(t *testing.T) {
+	deployObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "dep1", "namespace": "default"},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{map[string]interface{}{"name": "c1", "image": "nginx"}},
+						"volumes": []interface{}{
+							map[string]interface{}{"name": "cfg", "configMap": map[string]interface{}{"name": "cm1"}},
+							map[string]interface{}{"name": "sec", "secret": map[string]interface{}{"secretName": "sec1"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	cmObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1", "kind": "ConfigMap",
+		"metadata": map[string]interface{}{"name": "cm1", "namespace": "default"},
+	}}
+	secObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1", "kind": "Secret",
+		"metadata": map[string]interface{}{"name": "sec1", "namespace": "default"},
+	}}
+
+	scheme := runtime.NewScheme()
+	gvrMap := buildTestGVRMap()
+	badTarget := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap)
+	badTarget.PrependReactor("create", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("simulated secret failure")
+	})
+	m, _ := NewMultiClusterClient("")
+	m.dynamicClients["src"] = fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap, deployObj, cmObj, secObj)
+	m.dynamicClients["bad"] = badTarget
+
+	resp, err := m.DeployWorkload(context.Background(), "src", "default", "dep1", []string{"bad"}, 0, &DeployOptions{DeployedBy: "test-user"})
+	if err != nil {
+		t.Fatalf("DeployWorkload: %v", err)
+	}
+	if resp.Rollback != nil {
+		t.Errorf("non-atomic deploy should not roll back, got %+v", resp.Rollback)
+	}
+	cmGVR := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	if _, err := badTarget.Resource(cmGVR).Namespace("default").Get(context.Background(), "cm1", metav1.GetOptions{}); err != nil {
+		t.Errorf("cm1 should be left in place without atomic: %v", err)
+	}
+}
+
+func TestDeployWorkload_ImageOverrides(t *testing.T) {
+	deployObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"initContainers": []interface{}{
+							map[string]interface{}{"name": "migrate", "image": "registry.staging.example.com/migrate:1.0"},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{"name": "app", "image": "registry.staging.example.com/web:1.0"},
+							map[string]interface{}{"name": "sidecar", "image": "registry.staging.example.com/proxy:1.0"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	newClient := func(t *testing.T) (*MultiClusterClient, *fake.FakeDynamicClient) {
+		t.Helper()
+		scheme := runtime.NewScheme()
+		gvrMap := buildTestGVRMap()
+		sourceClient := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap, deployObj.DeepCopy())
+		targetClient := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap)
+
+		m, _ := NewMultiClusterClient("")
+		m.rawConfig = &api.Config{Contexts: map[string]*api.Context{
+			"staging": {Cluster: "staging"},
+			"prod":    {Cluster: "prod"},
+		}}
+		m.dynamicClients["staging"] = sourceClient
+		m.dynamicClients["prod"] = targetClient
+		return m, targetClient
+	}
+
+	t.Run("rewrites named containers", func(t *testing.T) {
+		m, targetClient := newClient(t)
+		opts := &DeployOptions{DeployedBy: "test-user", ImageOverrides: map[string]string{
+			"app":     "registry.prod.example.com/web:1.0",
+			"migrate": "registry.prod.example.com/migrate:1.0",
+		}}
+		resp, err := m.DeployWorkload(context.Background(), "staging", "default", "web", []string{"prod"}, 0, opts)
+		if err != nil {
+			t.Fatalf("DeployWorkload: %v", err)
+		}
+		if !resp.Success {
+			t.Fatalf("deploy failed: %s", resp.Message)
+		}
+
+		gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+		got, err := targetClient.Resource(gvr).Namespace("default").Get(context.Background(), "web", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("get target deployment: %v", err)
+		}
+		images := map[string]string{}
+		for _, field := range []string{"containers", "initContainers"} {
+			list, _, _ := unstructured.NestedSlice(got.Object, "spec", "template", "spec", field)
+			for _, c := range list {
+				container := c.(map[string]interface{})
+				images[container["name"].(string)] = container["image"].(string)
+			}
+		}
+		want := map[string]string{
+			"app":     "registry.prod.example.com/web:1.0",
+			"migrate": "registry.prod.example.com/migrate:1.0",
+			"sidecar": "registry.staging.example.com/proxy:1.0",
+		}
+		for name, image := range want {
+			if images[name] != image {
+				t.Errorf("container %s image = %q, want %q", name, images[name], image)
+			}
+		}
+	})
+
+	t.Run("unknown container is rejected", func(t *testing.T) {
+		m, targetClient := newClient(t)
+		opts := &DeployOptions{ImageOverrides: map[string]string{"worker": "registry.prod.example.com/worker:1.0"}}
+		_, err := m.DeployWorkload(context.Background(), "staging", "default", "web", []string{"prod"}, 0, opts)
+		if !errors.Is(err, ErrInvalidImageOverride) {
+			t.Fatalf("error = %v, want ErrInvalidImageOverride", err)
+		}
+		for _, action := range targetClient.Actions() {
+			if action.GetVerb() == "create" {
+				t.Errorf("nothing should be applied on an invalid override, saw %s %s", action.GetVerb(), action.GetResource().Resource)
+			}
+		}
+	})
+}
+
+func TestDeployWorkload_StripOptions(t *testing.T) {
+	deployObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":       "web",
+			"namespace":  "default",
+			"finalizers": []interface{}{"example.com/protect"},
+			"annotations": map[string]interface{}{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				"deployment.kubernetes.io/revision":                "7",
+				"argocd.argoproj.io/sync-wave":                     "1",
+				"argocd.argoproj.io/tracking-id":                   "web",
+				"team":                                             "payments",
+			},
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"nodeName":     "staging-node-1",
+					"nodeSelector": map[string]interface{}{"pool": "staging"},
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "nginx:1.25"},
+					},
+				},
+			},
+		},
+	}}
+
+	scheme := runtime.NewScheme()
+	gvrMap := buildTestGVRMap()
+	sourceClient := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap, deployObj)
+	targetClient := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap)
+
+	m, _ := NewMultiClusterClient("")
+	m.rawConfig = &api.Config{Contexts: map[string]*api.Context{
+		"staging": {Cluster: "staging"},
+		"prod":    {Cluster: "prod"},
+	}}
+	m.dynamicClients["staging"] = sourceClient
+	m.dynamicClients["prod"] = targetClient
+
+	opts := &DeployOptions{
+		DeployedBy: "test-user",
+		StripAnnotations: []string{
+			"kubectl.kubernetes.io/last-applied-configuration",
+			"deployment.kubernetes.io/revision",
+			"argocd.argoproj.io/",
+		},
+		ClearNodeName:   true,
+		StripFinalizers: true,
+	}
+	resp, err := m.DeployWorkload(context.Background(), "staging", "default", "web", []string{"prod"}, 0, opts)
+	if err != nil {
+		t.Fatalf("DeployWorkload: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("deploy failed: %s", resp.Message)
+	}
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	got, err := targetClient.Resource(gvr).Namespace("default").Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get target deployment: %v", err)
+	}
+
+	annotations := got.GetAnnotations()
+	for _, key := range []string{
+		"kubectl.kubernetes.io/last-applied-configuration",
+		"deployment.kubernetes.io/revision",
+		"argocd.argoproj.io/sync-wave",
+		"argocd.argoproj.io/tracking-id",
+	} {
+		if _, ok := annotations[key]; ok {
+			t.Errorf("annotation %s should have been stripped", key)
+		}
+	}
+	if annotations["team"] != "payments" {
+		t.Errorf("annotation team = %q, want it kept", annotations["team"])
+	}
+	if annotations["kubestellar.io/source-cluster"] != "staging" {
+		t.Errorf("console annotation source-cluster = %q, want staging", annotations["kubestellar.io/source-cluster"])
+	}
+	if len(got.GetFinalizers()) != 0 {
+		t.Errorf("finalizers = %v, want none", got.GetFinalizers())
+	}
+	if _, found, _ := unstructured.NestedString(got.Object, "spec", "template", "spec", "nodeName"); found {
+		t.Error("nodeName should have been cleared")
+	}
+	if sel, _, _ := unstructured.NestedStringMap(got.Object, "spec", "template", "spec", "nodeSelector"); sel["pool"] != "staging" {
+		t.Errorf("nodeSelector = %v, want it kept without StripNodeSelector", sel)
+	}
+}
+
+func TestDeployWorkload_TargetNamespaceCreated(t *testing.T) {
+	deployObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "staging"},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{"name": "app", "image": "nginx"}},
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "cfg", "configMap": map[string]interface{}{"name": "web-config"}},
+					},
+				},
+			},
+		},
+	}}
+	cmObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1", "kind": "ConfigMap",
+		"metadata": map[string]interface{}{"name": "web-config", "namespace": "staging"},
+		"data":     map[string]interface{}{"k": "v"},
+	}}
+	nsObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1", "kind": "Namespace",
+		"metadata": map[string]interface{}{"name": "staging", "labels": map[string]interface{}{
+			"team":                        "web",
+			"kubernetes.io/metadata.name": "staging",
+		}},
+	}}
+
+	scheme := runtime.NewScheme()
+	gvrMap := buildTestGVRMap()
+	newClient := func() (*MultiClusterClient, *fake.FakeDynamicClient) {
+		target := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap)
+		m, _ := NewMultiClusterClient("")
+		m.dynamicClients["src"] = fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrMap, deployObj.DeepCopy(), cmObj.DeepCopy(), nsObj.DeepCopy())
+		m.dynamicClients["tgt"] = target
+		return m, target
+	}
+	ctx := context.Background()
+
+	t.Run("createNamespace", func(t *testing.T) {
+		m, target := newClient()
+		opts := &DeployOptions{DeployedBy: "test-user", TargetNamespace: "prod", CreateNamespace: true}
+		resp, err := m.DeployWorkload(ctx, "src", "staging", "web", []string{"tgt"}, 0, opts)
+		if err != nil {
+			t.Fatalf("DeployWorkload: %v", err)
+		}
+		if !resp.Success {
+			t.Fatalf("deploy failed: %s", resp.Message)
+		}
+
+		ns, err := target.Resource(gvrNamespaces).Get(ctx, "prod", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("target namespace not created: %v", err)
+		}
+		labels := ns.GetLabels()
+		if labels["team"] != "web" {
+			t.Errorf("source namespace labels should be copied, got %v", labels)
+		}
+		if labels["kubernetes.io/metadata.name"] == "staging" {
+			t.Errorf("metadata.name label must not be copied from the source: %v", labels)
+		}
+		if labels["kubestellar.io/managed-by"] != "kubestellar-console" {
+			t.Errorf("namespace should carry console labels, got %v", labels)
+		}
+
+		if _, err := target.Resource(gvrDeployments).Namespace("prod").Get(ctx, "web", metav1.GetOptions{}); err != nil {
+			t.Errorf("deployment should land in prod: %v", err)
+		}
+		if _, err := target.Resource(gvrConfigMaps).Namespace("prod").Get(ctx, "web-config", metav1.GetOptions{}); err != nil {
+			t.Errorf("configmap should land in prod: %v", err)
+		}
+		if _, err := target.Resource(gvrDeployments).Namespace("staging").Get(ctx, "web", metav1.GetOptions{}); err == nil {
+			t.Error("nothing should be created in the source namespace on the target")
+		}
+	})
+
+	t.Run("missing namespace without createNamespace", func(t *testing.T) {
+		m, target := newClient()
+		opts := &DeployOptions{DeployedBy: "test-user", TargetNamespace: "prod"}
+		resp, err := m.DeployWorkload(ctx, "src", "staging", "web", []string{"tgt"}, 0, opts)
+		if err != nil {
+			t.Fatalf("DeployWorkload: %v", err)
+		}
+		if resp.Success || len(resp.FailedClusters) != 1 {
+			t.Fatalf("expected tgt to fail, got %+v", resp)
+		}
+		if !strings.Contains(resp.Message, "target namespace does not exist") {
+			t.Errorf("message should explain the missing namespace: %s", resp.Message)
+		}
+		if _, err := target.Resource(gvrNamespaces).Get(ctx, "prod", metav1.GetOptions{}); err == nil {
+			t.Error("namespace must not be created without createNamespace")
+		}
+	})
+}
+
+func TestRetargetDependencies_RewritesBindingSubjects(t *testing.T) {
+	rb := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "rbac.authorization.k8s.io/v1", "kind": "RoleBinding",
+		"metadata": map[string]interface{}{"name": "web", "namespace": "staging"},
+		"subjects": []interface{}{
+			map[string]interface{}{"kind": "ServiceAccount", "name": "web", "namespace": "staging"},
+			map[string]interface{}{"kind": "ServiceAccount", "name": "ci", "namespace": "tools"},
+		},
+	}}
+	deps := []Dependency{{Kind: DepRoleBinding, Name: "web", Namespace: "staging", GVR: gvrRoleBindings, Object: rb}}
+
+	out := retargetDependencies(deps, "staging", "prod")
+
+	if out[0].Namespace != "prod" || out[0].Object.GetNamespace() != "prod" {
+		t.Errorf("binding not moved: %s / %s", out[0].Namespace, out[0].Object.GetNamespace())
+	}
+	subjects, _, _ := unstructured.NestedSlice(out[0].Object.Object, "subjects")
+	if ns := subjects[0].(map[string]interface{})["namespace"]; ns != "prod" {
+		t.Errorf("source-namespace subject = %v, want prod", ns)
+	}
+	if ns := subjects[1].(map[string]interface{})["namespace"]; ns != "tools" {
+		t.Errorf("other-namespace subject = %v, want tools", ns)
+	}
+	if rb.GetNamespace() != "staging" {
+		t.Error("input dependency must not be modified")
+	}
+}
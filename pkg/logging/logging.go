@@ -0,0 +1,76 @@
+// Package logging builds the process-wide slog handler for the console and
+// kc-agent from environment variables.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const (
+	// FormatEnvVar selects the output format: "json" or "text".
+	FormatEnvVar = "LOG_FORMAT"
+	// LevelEnvVar selects the minimum level: "debug", "info", "warn" or "error".
+	LevelEnvVar = "LOG_LEVEL"
+
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Options controls the handler returned by NewHandler.
+type Options struct {
+	Format string
+	Level  slog.Level
+}
+
+// DefaultOptions returns the historical defaults: human-readable debug
+// output in dev mode, JSON at info level otherwise.
+func DefaultOptions(devMode bool) Options {
+	if devMode {
+		return Options{Format: FormatText, Level: slog.LevelDebug}
+	}
+	return Options{Format: FormatJSON, Level: slog.LevelInfo}
+}
+
+// OptionsFromEnv starts from DefaultOptions(devMode) and applies LOG_FORMAT
+// and LOG_LEVEL. An invalid value keeps the default for that setting and is
+// reported in the returned error so the caller can log it once the handler
+// is installed.
+func OptionsFromEnv(devMode bool) (Options, error) {
+	opts := DefaultOptions(devMode)
+	var errs []string
+
+	if raw := strings.ToLower(strings.TrimSpace(os.Getenv(FormatEnvVar))); raw != "" {
+		switch raw {
+		case FormatJSON, FormatText:
+			opts.Format = raw
+		default:
+			errs = append(errs, fmt.Sprintf("invalid %s %q (want json or text)", FormatEnvVar, raw))
+		}
+	}
+	if raw := strings.TrimSpace(os.Getenv(LevelEnvVar)); raw != "" {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(raw)); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid %s %q (want debug, info, warn or error)", LevelEnvVar, raw))
+		} else {
+			opts.Level = level
+		}
+	}
+
+	if len(errs) > 0 {
+		return opts, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return opts, nil
+}
+
+// NewHandler returns a text or JSON slog handler writing to w.
+func NewHandler(w io.Writer, opts Options) slog.Handler {
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level}
+	if opts.Format == FormatText {
+		return slog.NewTextHandler(w, handlerOpts)
+	}
+	return slog.NewJSONHandler(w, handlerOpts)
+}
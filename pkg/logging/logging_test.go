@@ -0,0 +1,96 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestOptionsFromEnv_Defaults(t *testing.T) {
+	t.Setenv(FormatEnvVar, "")
+	t.Setenv(LevelEnvVar, "")
+
+	opts, err := OptionsFromEnv(false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Format != FormatJSON || opts.Level != slog.LevelInfo {
+		t.Errorf("prod defaults = %+v", opts)
+	}
+
+	opts, err = OptionsFromEnv(true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Format != FormatText || opts.Level != slog.LevelDebug {
+		t.Errorf("dev defaults = %+v", opts)
+	}
+}
+
+func TestOptionsFromEnv_Overrides(t *testing.T) {
+	t.Setenv(FormatEnvVar, "JSON")
+	t.Setenv(LevelEnvVar, "warn")
+
+	opts, err := OptionsFromEnv(true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Format != FormatJSON || opts.Level != slog.LevelWarn {
+		t.Errorf("got %+v, want json/warn", opts)
+	}
+}
+
+func TestOptionsFromEnv_InvalidKeepsDefaults(t *testing.T) {
+	t.Setenv(FormatEnvVar, "xml")
+	t.Setenv(LevelEnvVar, "loud")
+
+	opts, err := OptionsFromEnv(false)
+	if err == nil {
+		t.Fatal("expected error for invalid values")
+	}
+	if !strings.Contains(err.Error(), FormatEnvVar) || !strings.Contains(err.Error(), LevelEnvVar) {
+		t.Errorf("error should name both variables: %v", err)
+	}
+	if opts != DefaultOptions(false) {
+		t.Errorf("got %+v, want defaults", opts)
+	}
+}
+
+func TestNewHandler_JSONFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(&buf, Options{Format: FormatJSON, Level: slog.LevelDebug}))
+
+	logger.Debug("[k8s] listing pods", "cluster", "prod-east")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("output is not JSON: %v (%s)", err, buf.String())
+	}
+	if entry["level"] != "DEBUG" {
+		t.Errorf("level = %v, want DEBUG", entry["level"])
+	}
+	if entry["msg"] != "[k8s] listing pods" {
+		t.Errorf("msg = %v", entry["msg"])
+	}
+	if entry["cluster"] != "prod-east" {
+		t.Errorf("cluster = %v, want prod-east", entry["cluster"])
+	}
+}
+
+func TestNewHandler_LevelFilters(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(&buf, Options{Format: FormatText, Level: slog.LevelWarn}))
+
+	logger.Info("dropped")
+	logger.Warn("kept", "cluster", "c1")
+
+	out := buf.String()
+	if strings.Contains(out, "dropped") {
+		t.Errorf("info line should be filtered at warn level: %s", out)
+	}
+	if !strings.Contains(out, "level=WARN") || !strings.Contains(out, "cluster=c1") {
+		t.Errorf("unexpected text output: %s", out)
+	}
+}
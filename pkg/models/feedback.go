@@ -83,6 +83,23 @@ type PRFeedback struct {
 	CreatedAt        time.Time    `json:"created_at"`
 }
 
+// FeatureVote is a user's upvote on a feature request. FeatureRequestID is
+// the queue item ID: a local request UUID, or "gh-<repo>-<number>" for
+// requests listed straight from GitHub.
+type FeatureVote struct {
+	ID               uuid.UUID `json:"id"`
+	FeatureRequestID string    `json:"feature_request_id"`
+	UserID           uuid.UUID `json:"user_id"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// VoteSummary is the vote count of a feature request and whether a given
+// user has voted for it
+type VoteSummary struct {
+	Count int  `json:"vote_count"`
+	Voted bool `json:"voted"`
+}
+
 // Notification represents a notification for a user
 type Notification struct {
 	ID               uuid.UUID        `json:"id"`
@@ -96,6 +113,20 @@ type Notification struct {
 	CreatedAt        time.Time        `json:"created_at"`
 }
 
+// NotificationPreferences are a user's choices for delivering feature
+// request notifications outside the console. In-app notifications are
+// always created.
+type NotificationPreferences struct {
+	UserID uuid.UUID `json:"user_id"`
+	// WebhookURL receives a JSON POST for each delivered notification.
+	// Empty disables webhook delivery.
+	WebhookURL string `json:"webhook_url"`
+	// EmailEnabled sends delivered notifications to the user's email address
+	// when the server has SMTP configured.
+	EmailEnabled bool       `json:"email_enabled"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
 // TargetRepo identifies which GitHub repository an issue should be created in
 type TargetRepo string
 
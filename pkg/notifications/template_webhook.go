@@ -0,0 +1,81 @@
+package notifications
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"text/template"
+	"time"
+)
+
+// TemplateWebhookNotifier POSTs alerts rendered with an operator-supplied
+// text/template, for receivers that expect their own JSON shape. The
+// template is executed with the Alert; the URL is validated like
+// WebhookNotifier's.
+type TemplateWebhookNotifier struct {
+	URL        string
+	Template   *template.Template
+	HTTPClient *http.Client
+}
+
+// NewTemplateWebhookNotifier validates webhookURL and parses tmpl, failing
+// fast on either. The template is also rendered once with a sample alert so
+// references to fields Alert lacks are reported here.
+func NewTemplateWebhookNotifier(webhookURL, tmpl string) (*TemplateWebhookNotifier, error) {
+	base, err := NewWebhookNotifier(webhookURL)
+	if err != nil {
+		return nil, err
+	}
+	t, err := template.New("webhook").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook template: %w", err)
+	}
+	if err := t.Execute(io.Discard, Alert{FiredAt: time.Now()}); err != nil {
+		return nil, fmt.Errorf("invalid webhook template: %w", err)
+	}
+	return &TemplateWebhookNotifier{URL: base.URL, Template: t, HTTPClient: base.HTTPClient}, nil
+}
+
+// Send renders the alert and POSTs it to the webhook URL.
+func (w *TemplateWebhookNotifier) Send(alert Alert) error {
+	var body bytes.Buffer
+	if err := w.Template.Execute(&body, alert); err != nil {
+		return fmt.Errorf("failed to render webhook template: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", w.URL, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "KubeStellar-Console-Webhook/1.0")
+
+	resp, err := w.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer func() {
+		// Drain body so the underlying TCP connection can be reused.
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Test sends a synthetic alert to verify configuration.
+func (w *TemplateWebhookNotifier) Test() error {
+	return w.Send(Alert{
+		ID:       "test-alert",
+		RuleID:   "test-rule",
+		RuleName: "KubeStellar Console Test Alert",
+		Severity: SeverityInfo,
+		Status:   "test",
+		Message:  "This is a test notification from KubeStellar Console",
+		FiredAt:  time.Now(),
+	})
+}
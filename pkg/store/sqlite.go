@@ -77,6 +77,12 @@ var ErrDashboardCardLimitReached = errors.New("dashboard card limit reached")
 // Handlers should map this error to HTTP 429 Too Many Requests.
 var ErrDailyBonusUnavailable = errors.New("daily bonus already claimed within cooldown window")
 
+// ErrFeatureRequestRateLimited is returned by CreateFeatureRequestWithinRate
+// when the user already created the maximum number of feature requests in
+// the rate window. Handlers should map this error to HTTP 429 Too Many
+// Requests.
+var ErrFeatureRequestRateLimited = errors.New("feature request rate limit reached")
+
 // MinCoinBalance is the floor for user coin balances. Negative increments
 // are clamped to this value so buggy clients cannot drive balances below
 // zero. Exported so handlers and tests can reference the same constant.
@@ -404,6 +410,16 @@ func (s *SQLiteStore) migrate() error {
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);
 
+	-- Upvotes on feature requests, one per user per request. feature_request_id
+	-- is the queue item ID, which is not always a local feature_requests row.
+	CREATE TABLE IF NOT EXISTS feature_votes (
+		id TEXT PRIMARY KEY,
+		feature_request_id TEXT NOT NULL,
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(feature_request_id, user_id)
+	);
+
 	-- User notifications for feature request status updates
 	CREATE TABLE IF NOT EXISTS notifications (
 		id TEXT PRIMARY KEY,
@@ -417,11 +433,20 @@ func (s *SQLiteStore) migrate() error {
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);
 
+	-- Per-user delivery of feature request notifications outside the console
+	CREATE TABLE IF NOT EXISTS notification_preferences (
+		user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+		webhook_url TEXT NOT NULL DEFAULT '',
+		email_enabled INTEGER NOT NULL DEFAULT 0,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
 	CREATE INDEX IF NOT EXISTS idx_feature_requests_user ON feature_requests(user_id);
 	CREATE INDEX IF NOT EXISTS idx_feature_requests_status ON feature_requests(status);
 	CREATE INDEX IF NOT EXISTS idx_feature_requests_issue ON feature_requests(github_issue_number);
 	CREATE INDEX IF NOT EXISTS idx_feature_requests_pr ON feature_requests(pr_number);
 	CREATE INDEX IF NOT EXISTS idx_pr_feedback_request ON pr_feedback(feature_request_id);
+	CREATE INDEX IF NOT EXISTS idx_feature_votes_request ON feature_votes(feature_request_id);
 	CREATE INDEX IF NOT EXISTS idx_notifications_user ON notifications(user_id, read);
 
 	-- GPU reservations
@@ -552,6 +577,22 @@ func (s *SQLiteStore) migrate() error {
 	CREATE INDEX IF NOT EXISTS idx_ce_cluster_time ON cluster_events(cluster_name, last_seen DESC);
 	CREATE INDEX IF NOT EXISTS idx_ce_uid ON cluster_events(event_uid);
 
+	-- Display name, tags (JSON array) and environment per kubeconfig context.
+	CREATE TABLE IF NOT EXISTS cluster_metadata (
+		context TEXT PRIMARY KEY,
+		display_name TEXT NOT NULL DEFAULT '',
+		tags TEXT NOT NULL DEFAULT '[]',
+		environment TEXT NOT NULL DEFAULT '',
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Benchmark report UIDs marked as comparison baselines.
+	CREATE TABLE IF NOT EXISTS benchmark_baselines (
+		uid TEXT PRIMARY KEY,
+		marked_by TEXT,
+		marked_at DATETIME NOT NULL
+	);
+
 	-- OAuth credentials persisted by the GitHub App Manifest one-click flow.
 	-- Single-row table (CHECK constraint) so only one app registration exists.
 	CREATE TABLE IF NOT EXISTS oauth_credentials (
@@ -587,6 +628,12 @@ func (s *SQLiteStore) migrate() error {
 		// persisted — the column, INSERT, and SELECT all omitted it, causing
 		// webhook/close/update operations to route docs issues to the wrong repo.
 		"ALTER TABLE feature_requests ADD COLUMN target_repo TEXT NOT NULL DEFAULT 'console'",
+		// Structured audit targets and outcomes for mutating operations.
+		"ALTER TABLE audit_log ADD COLUMN cluster TEXT NOT NULL DEFAULT ''",
+		"ALTER TABLE audit_log ADD COLUMN namespace TEXT NOT NULL DEFAULT ''",
+		"ALTER TABLE audit_log ADD COLUMN kind TEXT NOT NULL DEFAULT ''",
+		"ALTER TABLE audit_log ADD COLUMN name TEXT NOT NULL DEFAULT ''",
+		"ALTER TABLE audit_log ADD COLUMN outcome TEXT NOT NULL DEFAULT ''",
 	}
 	for i, migration := range migrations {
 		if _, err := s.db.ExecContext(ctx, migration); err != nil {
@@ -615,6 +662,11 @@ func (s *SQLiteStore) migrate() error {
 	return nil
 }
 
+// Ping verifies the database connection is usable.
+func (s *SQLiteStore) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
 // Close closes the database connection
 func (s *SQLiteStore) Close() error {
 	return s.db.Close()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -31,6 +32,44 @@ func (s *SQLiteStore) CreateFeatureRequest(ctx context.Context, request *models.
 	return err
 }
 
+// CreateFeatureRequestWithinRate inserts request only when its user created
+// fewer than maxRequests feature requests at or after since, counted the way
+// GetUserFeatureRequestTimesSince does. The count and the insert are one
+// statement, as in CreateCardWithLimit, so concurrent submissions cannot all
+// pass the check before any of them lands. Returns
+// ErrFeatureRequestRateLimited when the user is at the limit.
+func (s *SQLiteStore) CreateFeatureRequestWithinRate(ctx context.Context, request *models.FeatureRequest, since time.Time, maxRequests int) error {
+	if request.ID == uuid.Nil {
+		request.ID = uuid.New()
+	}
+	request.CreatedAt = time.Now()
+	if request.Status == "" {
+		request.Status = models.RequestStatusOpen
+	}
+	if request.TargetRepo != models.TargetRepoConsole && request.TargetRepo != models.TargetRepoDocs {
+		request.TargetRepo = models.TargetRepoConsole
+	}
+
+	result, err := s.db.ExecContext(ctx, `INSERT INTO feature_requests (id, user_id, title, description, request_type, target_repo, github_issue_number, status, pr_number, pr_url, copilot_session_url, netlify_preview_url, created_at)
+		SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+		WHERE (SELECT COUNT(*) FROM feature_requests WHERE user_id = ? AND created_at >= ? AND NOT (status = ? AND github_issue_number IS NULL)) < ?`,
+		request.ID.String(), request.UserID.String(), request.Title, request.Description, string(request.RequestType),
+		string(request.TargetRepo), request.GitHubIssueNumber, string(request.Status),
+		request.PRNumber, nullString(request.PRURL), nullString(request.CopilotSessionURL), nullString(request.NetlifyPreviewURL), request.CreatedAt,
+		request.UserID.String(), since, string(models.RequestStatusClosed), maxRequests)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrFeatureRequestRateLimited
+	}
+	return nil
+}
+
 func (s *SQLiteStore) GetFeatureRequest(ctx context.Context, id uuid.UUID) (*models.FeatureRequest, error) {
 	row := s.db.QueryRowContext(ctx, `SELECT id, user_id, title, description, request_type, target_repo, github_issue_number, status, pr_number, pr_url, copilot_session_url, netlify_preview_url, closed_by_user, latest_comment, created_at, updated_at FROM feature_requests WHERE id = ?`, id.String())
 	return s.scanFeatureRequest(row)
@@ -71,6 +110,63 @@ func (s *SQLiteStore) GetUserFeatureRequests(ctx context.Context, userID uuid.UU
 	return requests, rows.Err()
 }
 
+// SearchFeatureRequests returns one page of a user's feature requests
+// matching filter, newest first. Query is matched with LIKE against title
+// and description, so it is case-insensitive for ASCII; LIKE wildcards in
+// it are matched literally.
+func (s *SQLiteStore) SearchFeatureRequests(ctx context.Context, filter FeatureRequestFilter) ([]models.FeatureRequest, error) {
+	lim := resolvePageLimit(filter.Limit, defaultPageLimit)
+	off := resolvePageOffset(filter.Offset)
+
+	clauses := []string{"user_id = ?"}
+	args := []interface{}{filter.UserID.String()}
+	if q := strings.TrimSpace(filter.Query); q != "" {
+		pattern := "%" + escapeLikePattern(q) + "%"
+		clauses = append(clauses, `(title LIKE ? ESCAPE '\' OR description LIKE ? ESCAPE '\')`)
+		args = append(args, pattern, pattern)
+	}
+	if filter.Status != "" {
+		clauses = append(clauses, "status = ?")
+		args = append(args, string(filter.Status))
+	}
+	if filter.Type != "" {
+		clauses = append(clauses, "request_type = ?")
+		args = append(args, string(filter.Type))
+	}
+	if len(filter.ExcludeStatuses) > 0 {
+		placeholders := make([]string, len(filter.ExcludeStatuses))
+		for i, status := range filter.ExcludeStatuses {
+			placeholders[i] = "?"
+			args = append(args, string(status))
+		}
+		clauses = append(clauses, "status NOT IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	args = append(args, lim, off)
+
+	query := `SELECT id, user_id, title, description, request_type, target_repo, github_issue_number, status, pr_number, pr_url, copilot_session_url, netlify_preview_url, closed_by_user, latest_comment, created_at, updated_at FROM feature_requests WHERE ` +
+		strings.Join(clauses, " AND ") + ` ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?`
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []models.FeatureRequest
+	for rows.Next() {
+		r, err := s.scanFeatureRequestRow(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *r)
+	}
+	return requests, rows.Err()
+}
+
+// escapeLikePattern escapes LIKE wildcards in s for use with ESCAPE '\'.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
 // CountUserPendingFeatureRequests returns how many of the user's submissions
 // are still untriaged (open or needs_triage). #10174
 func (s *SQLiteStore) CountUserPendingFeatureRequests(ctx context.Context, userID uuid.UUID) (int, error) {
@@ -82,6 +178,29 @@ func (s *SQLiteStore) CountUserPendingFeatureRequests(ctx context.Context, userI
 	return count, err
 }
 
+// GetUserFeatureRequestTimesSince returns the creation times, oldest first,
+// of the user's feature requests created at or after since. Failed
+// submissions (closed without a GitHub issue) do not count.
+func (s *SQLiteStore) GetUserFeatureRequestTimesSince(ctx context.Context, userID uuid.UUID, since time.Time) ([]time.Time, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT created_at FROM feature_requests WHERE user_id = ? AND created_at >= ? AND NOT (status = ? AND github_issue_number IS NULL) ORDER BY created_at ASC LIMIT ?`,
+		userID.String(), since, string(models.RequestStatusClosed), maxSQLLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var times []time.Time
+	for rows.Next() {
+		var createdAt time.Time
+		if err := rows.Scan(&createdAt); err != nil {
+			return nil, err
+		}
+		times = append(times, createdAt)
+	}
+	return times, rows.Err()
+}
+
 // GetAllFeatureRequests returns a single page of feature_requests, newest
 // first. Callers that need to walk the full table must page by passing
 // successive offsets; this function never returns more than `limit` rows
@@ -315,6 +434,67 @@ func (s *SQLiteStore) GetPRFeedback(ctx context.Context, featureRequestID uuid.U
 	return feedbacks, rows.Err()
 }
 
+// Feature vote methods
+
+// voteSummaryBatchSize bounds the IN list of one GetVoteSummaries query so a
+// long queue stays under SQLite's bound-parameter limit.
+const voteSummaryBatchSize = 500
+
+func (s *SQLiteStore) CreateVote(ctx context.Context, vote *models.FeatureVote) error {
+	if vote.ID == uuid.Nil {
+		vote.ID = uuid.New()
+	}
+	vote.CreatedAt = time.Now()
+
+	_, err := s.db.ExecContext(ctx, `INSERT OR IGNORE INTO feature_votes (id, feature_request_id, user_id, created_at) VALUES (?, ?, ?, ?)`,
+		vote.ID.String(), vote.FeatureRequestID, vote.UserID.String(), vote.CreatedAt)
+	return err
+}
+
+func (s *SQLiteStore) DeleteVote(ctx context.Context, featureRequestID string, userID uuid.UUID) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM feature_votes WHERE feature_request_id = ? AND user_id = ?`,
+		featureRequestID, userID.String())
+	return err
+}
+
+func (s *SQLiteStore) CountVotes(ctx context.Context, featureRequestID string) (int, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM feature_votes WHERE feature_request_id = ?`, featureRequestID).Scan(&count)
+	return count, err
+}
+
+func (s *SQLiteStore) GetVoteSummaries(ctx context.Context, userID uuid.UUID, featureRequestIDs []string) (map[string]models.VoteSummary, error) {
+	summaries := make(map[string]models.VoteSummary)
+	for start := 0; start < len(featureRequestIDs); start += voteSummaryBatchSize {
+		batch := featureRequestIDs[start:min(start+voteSummaryBatchSize, len(featureRequestIDs))]
+		args := make([]any, 0, len(batch)+1)
+		args = append(args, userID.String())
+		for _, id := range batch {
+			args = append(args, id)
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(batch)), ",")
+		rows, err := s.db.QueryContext(ctx, `SELECT feature_request_id, COUNT(*), MAX(user_id = ?) FROM feature_votes WHERE feature_request_id IN (`+placeholders+`) GROUP BY feature_request_id`, args...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var id string
+			var summary models.VoteSummary
+			if err := rows.Scan(&id, &summary.Count, &summary.Voted); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			summaries[id] = summary
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return summaries, nil
+}
+
 // Notification methods
 
 func (s *SQLiteStore) CreateNotification(ctx context.Context, notification *models.Notification) error {
@@ -414,3 +594,31 @@ func (s *SQLiteStore) MarkAllNotificationsRead(ctx context.Context, userID uuid.
 	_, err := s.db.ExecContext(ctx, `UPDATE notifications SET read = 1 WHERE user_id = ?`, userID.String())
 	return err
 }
+
+func (s *SQLiteStore) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (*models.NotificationPreferences, error) {
+	prefs := models.NotificationPreferences{UserID: userID}
+	var emailEnabled int
+	var updatedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, `SELECT webhook_url, email_enabled, updated_at FROM notification_preferences WHERE user_id = ?`,
+		userID.String()).Scan(&prefs.WebhookURL, &emailEnabled, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	prefs.EmailEnabled = emailEnabled != 0
+	if updatedAt.Valid {
+		prefs.UpdatedAt = &updatedAt.Time
+	}
+	return &prefs, nil
+}
+
+func (s *SQLiteStore) SaveNotificationPreferences(ctx context.Context, prefs *models.NotificationPreferences) error {
+	now := time.Now()
+	prefs.UpdatedAt = &now
+	_, err := s.db.ExecContext(ctx, `INSERT INTO notification_preferences (user_id, webhook_url, email_enabled, updated_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET webhook_url = excluded.webhook_url, email_enabled = excluded.email_enabled, updated_at = excluded.updated_at`,
+		prefs.UserID.String(), prefs.WebhookURL, boolToInt(prefs.EmailEnabled), now)
+	return err
+}
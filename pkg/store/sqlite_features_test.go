@@ -1,7 +1,10 @@
 package store
 
 import (
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -100,6 +103,52 @@ func TestFeatureRequestCRUD(t *testing.T) {
 	})
 }
 
+func TestSearchFeatureRequests(t *testing.T) {
+	s := newTestStore(t)
+	user := createTestUser(t, s, "gh-search", "searchuser")
+	other := createTestUser(t, s, "gh-search-2", "searchuser2")
+
+	create := func(userID uuid.UUID, title, description string, requestType models.RequestType, status models.RequestStatus) {
+		t.Helper()
+		req := &models.FeatureRequest{UserID: userID, Title: title, Description: description, RequestType: requestType}
+		require.NoError(t, s.CreateFeatureRequest(ctx, req))
+		require.NoError(t, s.UpdateFeatureRequestStatus(ctx, req.ID, status))
+	}
+	create(user.ID, "Dark mode for dashboards", "Please", models.RequestTypeFeature, models.RequestStatusFixReady)
+	create(user.ID, "Crash on login", "dashboard goes blank", models.RequestTypeBug, models.RequestStatusFixReady)
+	create(user.ID, "Dashboard export", "CSV", models.RequestTypeFeature, models.RequestStatusClosed)
+	create(user.ID, "Untriaged dashboard idea", "", models.RequestTypeFeature, models.RequestStatusNeedsTriage)
+	create(user.ID, "100%_literal", "", models.RequestTypeFeature, models.RequestStatusFixReady)
+	create(other.ID, "Dashboard for someone else", "", models.RequestTypeFeature, models.RequestStatusFixReady)
+
+	titles := func(filter FeatureRequestFilter) []string {
+		t.Helper()
+		got, err := s.SearchFeatureRequests(ctx, filter)
+		require.NoError(t, err)
+		out := make([]string, 0, len(got))
+		for _, r := range got {
+			out = append(out, r.Title)
+		}
+		return out
+	}
+
+	// Substring (case-insensitive, title or description) combined with status
+	require.ElementsMatch(t, []string{"Dark mode for dashboards", "Crash on login"},
+		titles(FeatureRequestFilter{UserID: user.ID, Query: "DASHBOARD", Status: models.RequestStatusFixReady}))
+
+	// Adding a type narrows further
+	require.ElementsMatch(t, []string{"Crash on login"},
+		titles(FeatureRequestFilter{UserID: user.ID, Query: "dashboard", Status: models.RequestStatusFixReady, Type: models.RequestTypeBug}))
+
+	// Excluded statuses are hidden even when they match the query
+	require.ElementsMatch(t, []string{"Dark mode for dashboards", "Crash on login", "Dashboard export"},
+		titles(FeatureRequestFilter{UserID: user.ID, Query: "dashboard", ExcludeStatuses: []models.RequestStatus{models.RequestStatusOpen, models.RequestStatusNeedsTriage}}))
+
+	// LIKE wildcards in the query match literally
+	require.Equal(t, []string{"100%_literal"}, titles(FeatureRequestFilter{UserID: user.ID, Query: "%_"}))
+	require.Empty(t, titles(FeatureRequestFilter{UserID: user.ID, Query: "0__l"}))
+}
+
 func TestPRFeedbackCRUD(t *testing.T) {
 	s := newTestStore(t)
 	user := createTestUser(t, s, "gh-feedback", "feedbackuser")
@@ -127,6 +176,33 @@ func TestPRFeedbackCRUD(t *testing.T) {
 	})
 }
 
+func TestFeatureVotes(t *testing.T) {
+	s := newTestStore(t)
+	alice := createTestUser(t, s, "gh-vote-alice", "alice")
+	bob := createTestUser(t, s, "gh-vote-bob", "bob")
+	const requestID = "gh-console-42"
+
+	require.NoError(t, s.CreateVote(ctx, &models.FeatureVote{FeatureRequestID: requestID, UserID: alice.ID}))
+	// A second vote by the same user is ignored.
+	require.NoError(t, s.CreateVote(ctx, &models.FeatureVote{FeatureRequestID: requestID, UserID: alice.ID}))
+	require.NoError(t, s.CreateVote(ctx, &models.FeatureVote{FeatureRequestID: requestID, UserID: bob.ID}))
+
+	count, err := s.CountVotes(ctx, requestID)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	summaries, err := s.GetVoteSummaries(ctx, alice.ID, []string{requestID, "gh-docs-7"})
+	require.NoError(t, err)
+	require.Equal(t, models.VoteSummary{Count: 2, Voted: true}, summaries[requestID])
+	require.NotContains(t, summaries, "gh-docs-7")
+
+	require.NoError(t, s.DeleteVote(ctx, requestID, alice.ID))
+	require.NoError(t, s.DeleteVote(ctx, requestID, alice.ID))
+	summaries, err = s.GetVoteSummaries(ctx, alice.ID, []string{requestID})
+	require.NoError(t, err)
+	require.Equal(t, models.VoteSummary{Count: 1, Voted: false}, summaries[requestID])
+}
+
 func TestNotificationCRUD(t *testing.T) {
 	s := newTestStore(t)
 	user := createTestUser(t, s, "gh-notif", "notifuser")
@@ -163,3 +239,84 @@ func TestNotificationCRUD(t *testing.T) {
 		require.Equal(t, 0, count)
 	})
 }
+
+func TestNotificationPreferences(t *testing.T) {
+	s := newTestStore(t)
+	user := createTestUser(t, s, "gh-prefs", "prefsuser")
+
+	prefs, err := s.GetNotificationPreferences(ctx, user.ID)
+	require.NoError(t, err)
+	require.Nil(t, prefs)
+
+	require.NoError(t, s.SaveNotificationPreferences(ctx, &models.NotificationPreferences{
+		UserID: user.ID, WebhookURL: "https://hooks.example.com/a", EmailEnabled: true,
+	}))
+	require.NoError(t, s.SaveNotificationPreferences(ctx, &models.NotificationPreferences{
+		UserID: user.ID, WebhookURL: "https://hooks.example.com/b",
+	}))
+
+	prefs, err = s.GetNotificationPreferences(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, prefs)
+	require.Equal(t, "https://hooks.example.com/b", prefs.WebhookURL)
+	require.False(t, prefs.EmailEnabled)
+	require.NotNil(t, prefs.UpdatedAt)
+}
+
+func TestGetUserFeatureRequestTimesSince(t *testing.T) {
+	s := newTestStore(t)
+	user := createTestUser(t, s, "gh-rate", "rateuser")
+	since := time.Now().Add(-time.Minute)
+
+	issue := 7
+	submitted := &models.FeatureRequest{UserID: user.ID, Title: "Submitted", RequestType: models.RequestTypeFeature, GitHubIssueNumber: &issue}
+	require.NoError(t, s.CreateFeatureRequest(ctx, submitted))
+	failed := &models.FeatureRequest{UserID: user.ID, Title: "Failed", RequestType: models.RequestTypeFeature}
+	require.NoError(t, s.CreateFeatureRequest(ctx, failed))
+	require.NoError(t, s.CloseFeatureRequest(ctx, failed.ID, false))
+
+	times, err := s.GetUserFeatureRequestTimesSince(ctx, user.ID, since)
+	require.NoError(t, err)
+	require.Len(t, times, 1, "requests closed without a GitHub issue are not counted")
+
+	times, err = s.GetUserFeatureRequestTimesSince(ctx, user.ID, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Empty(t, times)
+}
+
+func TestCreateFeatureRequestWithinRate_ConcurrentSubmissions(t *testing.T) {
+	s := newTestStore(t)
+	user := createTestUser(t, s, "gh-burst", "burstuser")
+	since := time.Now().Add(-time.Hour)
+
+	const maxRequests = 3
+	const submissions = 10
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	created, limited := 0, 0
+	for i := 0; i < submissions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := &models.FeatureRequest{UserID: user.ID, Title: "Burst", RequestType: models.RequestTypeFeature}
+			err := s.CreateFeatureRequestWithinRate(ctx, req, since, maxRequests)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				created++
+			case errors.Is(err, ErrFeatureRequestRateLimited):
+				limited++
+			default:
+				t.Errorf("CreateFeatureRequestWithinRate: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, maxRequests, created)
+	require.Equal(t, submissions-maxRequests, limited)
+	times, err := s.GetUserFeatureRequestTimesSince(ctx, user.ID, since)
+	require.NoError(t, err)
+	require.Len(t, times, maxRequests)
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -47,6 +48,141 @@ func (s *SQLiteStore) ListClusterGroups(ctx context.Context) (map[string][]byte,
 	return groups, rows.Err()
 }
 
+// ---------------------------------------------------------------------------
+// Cluster Metadata
+// ---------------------------------------------------------------------------
+
+// maxClusterMetadata is the upper bound on cluster metadata rows returned.
+const maxClusterMetadata = 1000
+
+// clusterMetadataColumns is the column list scanned by scanClusterMetadataRow.
+const clusterMetadataColumns = `context, display_name, tags, environment, COALESCE(updated_at, '')`
+
+// GetClusterMetadata returns the metadata of contextName, or nil when none
+// has been saved.
+func (s *SQLiteStore) GetClusterMetadata(ctx context.Context, contextName string) (*ClusterMetadata, error) {
+	row := s.db.QueryRowContext(ctx,
+		`SELECT `+clusterMetadataColumns+` FROM cluster_metadata WHERE context = ?`, contextName)
+	meta, err := scanClusterMetadataRow(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// ListClusterMetadata returns every context's metadata, ordered by context.
+func (s *SQLiteStore) ListClusterMetadata(ctx context.Context) ([]ClusterMetadata, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+clusterMetadataColumns+` FROM cluster_metadata ORDER BY context LIMIT ?`, maxClusterMetadata)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var metas []ClusterMetadata
+	for rows.Next() {
+		meta, err := scanClusterMetadataRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		metas = append(metas, *meta)
+	}
+	return metas, rows.Err()
+}
+
+// SaveClusterMetadata upserts meta, replacing all of its fields.
+func (s *SQLiteStore) SaveClusterMetadata(ctx context.Context, meta *ClusterMetadata) error {
+	tags := meta.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	tagsJSON, err := json.Marshal(tags)
+	if err != nil {
+		return fmt.Errorf("marshal cluster tags: %w", err)
+	}
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO cluster_metadata (context, display_name, tags, environment, updated_at)
+		 VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP)
+		 ON CONFLICT(context) DO UPDATE SET display_name = excluded.display_name, tags = excluded.tags,
+		 environment = excluded.environment, updated_at = CURRENT_TIMESTAMP`,
+		meta.Context, meta.DisplayName, string(tagsJSON), meta.Environment,
+	)
+	return err
+}
+
+// DeleteClusterMetadata removes the metadata of contextName.
+func (s *SQLiteStore) DeleteClusterMetadata(ctx context.Context, contextName string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM cluster_metadata WHERE context = ?`, contextName)
+	return err
+}
+
+// scanClusterMetadataRow decodes one clusterMetadataColumns row; tags are
+// stored as a JSON array.
+func scanClusterMetadataRow(row interface {
+	Scan(dest ...any) error
+}) (*ClusterMetadata, error) {
+	var meta ClusterMetadata
+	var tagsJSON string
+	if err := row.Scan(&meta.Context, &meta.DisplayName, &tagsJSON, &meta.Environment, &meta.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(tagsJSON), &meta.Tags); err != nil {
+		return nil, fmt.Errorf("unmarshal tags of cluster %s: %w", meta.Context, err)
+	}
+	return &meta, nil
+}
+
+// ---------------------------------------------------------------------------
+// Benchmark Baselines
+// ---------------------------------------------------------------------------
+
+// maxBenchmarkBaselines is the upper bound on baselines returned.
+const maxBenchmarkBaselines = 200
+
+// benchmarkBaselineTimeLayout is fixed-width so marked_at sorts as text
+// (RFC3339Nano trims trailing zeros and would not).
+const benchmarkBaselineTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// SetBenchmarkBaseline marks the benchmark report uid as a baseline.
+func (s *SQLiteStore) SetBenchmarkBaseline(ctx context.Context, uid, markedBy string) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO benchmark_baselines (uid, marked_by, marked_at) VALUES (?, ?, ?)
+		 ON CONFLICT(uid) DO UPDATE SET marked_by = excluded.marked_by, marked_at = excluded.marked_at`,
+		uid, markedBy, time.Now().UTC().Format(benchmarkBaselineTimeLayout),
+	)
+	return err
+}
+
+// DeleteBenchmarkBaseline unmarks the benchmark report uid.
+func (s *SQLiteStore) DeleteBenchmarkBaseline(ctx context.Context, uid string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM benchmark_baselines WHERE uid = ?`, uid)
+	return err
+}
+
+// ListBenchmarkBaselines returns the baselines, most recently marked first.
+func (s *SQLiteStore) ListBenchmarkBaselines(ctx context.Context) ([]BenchmarkBaseline, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT uid, COALESCE(marked_by, ''), marked_at FROM benchmark_baselines ORDER BY marked_at DESC LIMIT ?`,
+		maxBenchmarkBaselines)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var baselines []BenchmarkBaseline
+	for rows.Next() {
+		var b BenchmarkBaseline
+		if err := rows.Scan(&b.UID, &b.MarkedBy, &b.MarkedAt); err != nil {
+			return nil, err
+		}
+		baselines = append(baselines, b)
+	}
+	return baselines, rows.Err()
+}
+
 // ---------------------------------------------------------------------------
 // Audit Log (#8670 Phase 3)
 // ---------------------------------------------------------------------------
@@ -58,11 +194,21 @@ const maxAuditQueryLimit = 200
 // defaultAuditQueryLimit is used when the caller passes 0 for limit.
 const defaultAuditQueryLimit = 50
 
+// auditEntryColumns is the column list scanned by ListAuditEntries.
+const auditEntryColumns = `id, timestamp, user_id, action, cluster, namespace, kind, name, outcome, COALESCE(detail, '')`
+
 // InsertAuditLog appends an audit entry to the audit_log table.
 func (s *SQLiteStore) InsertAuditLog(ctx context.Context, userID, action, detail string) error {
+	return s.InsertAuditEntry(ctx, AuditEntry{UserID: userID, Action: action, Detail: detail})
+}
+
+// InsertAuditEntry appends a structured audit entry to the audit_log table.
+func (s *SQLiteStore) InsertAuditEntry(ctx context.Context, entry AuditEntry) error {
 	_, err := s.db.ExecContext(ctx,
-		`INSERT INTO audit_log (timestamp, user_id, action, detail) VALUES (?, ?, ?, ?)`,
-		time.Now().UTC().Format(time.RFC3339), userID, action, detail,
+		`INSERT INTO audit_log (timestamp, user_id, action, cluster, namespace, kind, name, outcome, detail)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		time.Now().UTC().Format(time.RFC3339), entry.UserID, entry.Action,
+		entry.Cluster, entry.Namespace, entry.Kind, entry.Name, entry.Outcome, entry.Detail,
 	)
 	return err
 }
@@ -71,30 +217,45 @@ func (s *SQLiteStore) InsertAuditLog(ctx context.Context, userID, action, detail
 // action strings disable the corresponding filter. Limit is clamped to
 // maxAuditQueryLimit.
 func (s *SQLiteStore) QueryAuditLogs(ctx context.Context, limit int, userID, action string) ([]AuditEntry, error) {
+	return s.ListAuditEntries(ctx, AuditFilter{UserID: userID, Action: action, Limit: limit})
+}
+
+// ListAuditEntries returns a page of audit entries, newest first. Limit is
+// clamped to maxAuditQueryLimit; Offset skips that many matching entries.
+func (s *SQLiteStore) ListAuditEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	limit := filter.Limit
 	if limit <= 0 {
 		limit = defaultAuditQueryLimit
 	}
 	if limit > maxAuditQueryLimit {
 		limit = maxAuditQueryLimit
 	}
+	offset := filter.Offset
+	if offset < 0 {
+		offset = 0
+	}
 
-	query := `SELECT id, timestamp, user_id, action, COALESCE(detail, '') FROM audit_log`
+	query := `SELECT ` + auditEntryColumns + ` FROM audit_log`
 	args := make([]interface{}, 0)
 	clauses := make([]string, 0)
 
-	if userID != "" {
+	if filter.UserID != "" {
 		clauses = append(clauses, "user_id = ?")
-		args = append(args, userID)
+		args = append(args, filter.UserID)
 	}
-	if action != "" {
+	if filter.Action != "" {
 		clauses = append(clauses, "action = ?")
-		args = append(args, action)
+		args = append(args, filter.Action)
+	}
+	if filter.Cluster != "" {
+		clauses = append(clauses, "cluster = ?")
+		args = append(args, filter.Cluster)
 	}
 	if len(clauses) > 0 {
 		query += " WHERE " + strings.Join(clauses, " AND ")
 	}
-	query += " ORDER BY id DESC LIMIT ?"
-	args = append(args, limit)
+	query += " ORDER BY id DESC LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
 
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -105,7 +266,8 @@ func (s *SQLiteStore) QueryAuditLogs(ctx context.Context, limit int, userID, act
 	entries := make([]AuditEntry, 0)
 	for rows.Next() {
 		var e AuditEntry
-		if err := rows.Scan(&e.ID, &e.Timestamp, &e.UserID, &e.Action, &e.Detail); err != nil {
+		if err := rows.Scan(&e.ID, &e.Timestamp, &e.UserID, &e.Action,
+			&e.Cluster, &e.Namespace, &e.Kind, &e.Name, &e.Outcome, &e.Detail); err != nil {
 			return nil, err
 		}
 		entries = append(entries, e)
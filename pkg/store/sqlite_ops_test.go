@@ -31,6 +31,71 @@ func TestClusterGroupCRUD(t *testing.T) {
 	})
 }
 
+func TestClusterMetadataRoundTrip(t *testing.T) {
+	s := newTestStore(t)
+
+	meta, err := s.GetClusterMetadata(ctx, "kind-dev")
+	require.NoError(t, err)
+	require.Nil(t, meta)
+
+	require.NoError(t, s.SaveClusterMetadata(ctx, &ClusterMetadata{
+		Context:     "kind-dev",
+		DisplayName: "Dev (kind)",
+		Tags:        []string{"team-a", "gpu"},
+		Environment: "dev",
+	}))
+	require.NoError(t, s.SaveClusterMetadata(ctx, &ClusterMetadata{Context: "aks-prod", Environment: "prod"}))
+
+	meta, err = s.GetClusterMetadata(ctx, "kind-dev")
+	require.NoError(t, err)
+	require.NotNil(t, meta)
+	require.Equal(t, "Dev (kind)", meta.DisplayName)
+	require.Equal(t, []string{"team-a", "gpu"}, meta.Tags)
+	require.Equal(t, "dev", meta.Environment)
+	require.NotEmpty(t, meta.UpdatedAt)
+
+	// Saving again replaces every field.
+	require.NoError(t, s.SaveClusterMetadata(ctx, &ClusterMetadata{Context: "kind-dev", DisplayName: "Dev"}))
+	metas, err := s.ListClusterMetadata(ctx)
+	require.NoError(t, err)
+	require.Len(t, metas, 2)
+	require.Equal(t, "aks-prod", metas[0].Context)
+	require.Empty(t, metas[0].Tags)
+	require.Equal(t, "kind-dev", metas[1].Context)
+	require.Equal(t, "Dev", metas[1].DisplayName)
+	require.Empty(t, metas[1].Tags)
+	require.Empty(t, metas[1].Environment)
+
+	require.NoError(t, s.DeleteClusterMetadata(ctx, "kind-dev"))
+	meta, err = s.GetClusterMetadata(ctx, "kind-dev")
+	require.NoError(t, err)
+	require.Nil(t, meta)
+}
+
+func TestBenchmarkBaselines(t *testing.T) {
+	s := newTestStore(t)
+
+	require.NoError(t, s.SetBenchmarkBaseline(ctx, "run-a", "alice"))
+	require.NoError(t, s.SetBenchmarkBaseline(ctx, "run-b", "bob"))
+	baselines, err := s.ListBenchmarkBaselines(ctx)
+	require.NoError(t, err)
+	require.Len(t, baselines, 2)
+	require.Equal(t, "run-b", baselines[0].UID) // most recently marked first
+
+	// Re-marking moves a baseline to the front.
+	require.NoError(t, s.SetBenchmarkBaseline(ctx, "run-a", "carol"))
+	baselines, err = s.ListBenchmarkBaselines(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "run-a", baselines[0].UID)
+	require.Equal(t, "carol", baselines[0].MarkedBy)
+
+	require.NoError(t, s.DeleteBenchmarkBaseline(ctx, "run-a"))
+	baselines, err = s.ListBenchmarkBaselines(ctx)
+	require.NoError(t, err)
+	require.Len(t, baselines, 1)
+	require.Equal(t, "run-b", baselines[0].UID)
+}
+
 func TestAuditLogCRUD(t *testing.T) {
 	s := newTestStore(t)
 	userID := uuid.New().String()
@@ -51,6 +116,28 @@ func TestAuditLogCRUD(t *testing.T) {
 		require.Len(t, logs, 1)
 		require.Equal(t, "LOGIN", logs[0].Action)
 	})
+
+	t.Run("ListAuditEntries returns targets and pages by offset", func(t *testing.T) {
+		for _, name := range []string{"api", "worker"} {
+			require.NoError(t, s.InsertAuditEntry(ctx, AuditEntry{
+				UserID: userID, Action: "scale_workload", Cluster: "east", Namespace: "default",
+				Kind: "Deployment", Name: name, Outcome: "success",
+			}))
+		}
+
+		page, err := s.ListAuditEntries(ctx, AuditFilter{Cluster: "east", Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		require.Equal(t, "worker", page[0].Name)
+		require.Equal(t, "default", page[0].Namespace)
+		require.Equal(t, "Deployment", page[0].Kind)
+		require.Equal(t, "success", page[0].Outcome)
+
+		page, err = s.ListAuditEntries(ctx, AuditFilter{Cluster: "east", Limit: 1, Offset: 1})
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		require.Equal(t, "api", page[0].Name)
+	})
 }
 
 func TestClusterEventsCRUD(t *testing.T) {
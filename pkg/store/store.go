@@ -46,16 +46,37 @@ type UserTokenUsage struct {
 }
 
 // AuditEntry represents a single row in the audit_log table (#8670 Phase 3).
+// Cluster, Namespace, Kind and Name identify the object a mutating operation
+// acted on, and Outcome records whether it succeeded; entries written through
+// InsertAuditLog leave them empty.
 type AuditEntry struct {
 	ID        int64  `json:"id"`
 	Timestamp string `json:"timestamp"`
 	UserID    string `json:"user_id"`
 	Action    string `json:"action"`
+	Cluster   string `json:"cluster,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Outcome   string `json:"outcome,omitempty"`
 	Detail    string `json:"detail,omitempty"`
 }
 
+// AuditFilter selects a page of audit entries for ListAuditEntries. Empty
+// fields disable the corresponding filter.
+type AuditFilter struct {
+	UserID  string
+	Action  string
+	Cluster string
+	Limit   int
+	Offset  int
+}
+
 // Store defines the interface for data persistence
 type Store interface {
+	// Ping verifies the database is reachable.
+	Ping(ctx context.Context) error
+
 	// Users
 	GetUser(ctx context.Context, id uuid.UUID) (*models.User, error)
 	GetUserByGitHubID(ctx context.Context, githubID string) (*models.User, error)
@@ -126,6 +147,10 @@ type Store interface {
 
 	// Feature Requests
 	CreateFeatureRequest(ctx context.Context, request *models.FeatureRequest) error
+	// CreateFeatureRequestWithinRate atomically checks the user's feature
+	// requests created at or after since against maxRequests and inserts
+	// request. Returns ErrFeatureRequestRateLimited when at the limit.
+	CreateFeatureRequestWithinRate(ctx context.Context, request *models.FeatureRequest, since time.Time, maxRequests int) error
 	GetFeatureRequest(ctx context.Context, id uuid.UUID) (*models.FeatureRequest, error)
 	GetFeatureRequestByIssueNumber(ctx context.Context, issueNumber int) (*models.FeatureRequest, error)
 	GetFeatureRequestByPRNumber(ctx context.Context, prNumber int) (*models.FeatureRequest, error)
@@ -136,6 +161,13 @@ type Store interface {
 	// requests that are still untriaged (status = open or needs_triage).
 	// #10174: lets the handler tell the frontend about pending submissions.
 	CountUserPendingFeatureRequests(ctx context.Context, userID uuid.UUID) (int, error)
+	// GetUserFeatureRequestTimesSince returns the creation times, oldest
+	// first, of the user's feature requests created at or after since. Requests
+	// closed before they got a GitHub issue (failed submissions) are skipped.
+	GetUserFeatureRequestTimesSince(ctx context.Context, userID uuid.UUID, since time.Time) ([]time.Time, error)
+	// SearchFeatureRequests returns a user's feature requests matching filter,
+	// newest first. Pass 0 for filter.Limit to use the store default.
+	SearchFeatureRequests(ctx context.Context, filter FeatureRequestFilter) ([]models.FeatureRequest, error)
 	// GetAllFeatureRequests returns the global feature-request table, newest first.
 	// #6602: limit/offset required; admin dashboard uses a smaller default (100)
 	// because this is hit on every dashboard load. Pass 0 for limit to use the
@@ -152,6 +184,16 @@ type Store interface {
 	CreatePRFeedback(ctx context.Context, feedback *models.PRFeedback) error
 	GetPRFeedback(ctx context.Context, featureRequestID uuid.UUID) ([]models.PRFeedback, error)
 
+	// Feature Votes
+	// CreateVote records vote; voting twice for the same request is a no-op.
+	CreateVote(ctx context.Context, vote *models.FeatureVote) error
+	// DeleteVote removes userID's vote, if any, from featureRequestID.
+	DeleteVote(ctx context.Context, featureRequestID string, userID uuid.UUID) error
+	CountVotes(ctx context.Context, featureRequestID string) (int, error)
+	// GetVoteSummaries returns the vote count and userID's vote state for each
+	// of featureRequestIDs that has at least one vote.
+	GetVoteSummaries(ctx context.Context, userID uuid.UUID, featureRequestIDs []string) (map[string]models.VoteSummary, error)
+
 	// Notifications
 	CreateNotification(ctx context.Context, notification *models.Notification) error
 	GetUserNotifications(ctx context.Context, userID uuid.UUID, limit int) ([]models.Notification, error)
@@ -161,6 +203,9 @@ type Store interface {
 	// notification as read. Use MarkNotificationReadByUser instead.
 	MarkNotificationReadByUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
 	MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) error
+	// GetNotificationPreferences returns nil when the user has not saved any.
+	GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (*models.NotificationPreferences, error)
+	SaveNotificationPreferences(ctx context.Context, prefs *models.NotificationPreferences) error
 
 	// GPU Reservations
 	CreateGPUReservation(ctx context.Context, reservation *models.GPUReservation) error
@@ -267,6 +312,11 @@ type Store interface {
 	// parameters are optional (empty string = no filter). Limit is clamped
 	// to maxAuditQueryLimit internally.
 	QueryAuditLogs(ctx context.Context, limit int, userID, action string) ([]AuditEntry, error)
+	// InsertAuditEntry appends a structured audit entry; ID and Timestamp
+	// are assigned by the store.
+	InsertAuditEntry(ctx context.Context, entry AuditEntry) error
+	// ListAuditEntries returns a page of audit entries, newest first.
+	ListAuditEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
 
 	// Cluster Groups — persistent storage for cluster group definitions so they
 	// survive server restarts (#7013). The in-memory map is the runtime cache;
@@ -275,6 +325,16 @@ type Store interface {
 	DeleteClusterGroup(ctx context.Context, name string) error
 	ListClusterGroups(ctx context.Context) (map[string][]byte, error)
 
+	// Cluster Metadata — display name, tags and environment shown for a
+	// kubeconfig context in place of its often cryptic name.
+	// GetClusterMetadata returns nil when the context has none.
+	GetClusterMetadata(ctx context.Context, contextName string) (*ClusterMetadata, error)
+	// ListClusterMetadata returns every context's metadata, ordered by context.
+	ListClusterMetadata(ctx context.Context) ([]ClusterMetadata, error)
+	// SaveClusterMetadata upserts meta, replacing all of its fields.
+	SaveClusterMetadata(ctx context.Context, meta *ClusterMetadata) error
+	DeleteClusterMetadata(ctx context.Context, contextName string) error
+
 	// Cluster Events — cross-cluster event journal (#9967 Phase 1).
 	// InsertOrUpdateEvent upserts an event keyed by event_uid.
 	InsertOrUpdateEvent(ctx context.Context, event ClusterEvent) error
@@ -283,6 +343,14 @@ type Store interface {
 	// SweepOldEvents deletes events older than retentionDays. Returns rows deleted.
 	SweepOldEvents(ctx context.Context, retentionDays int) (int64, error)
 
+	// Benchmark Baselines — benchmark report UIDs marked as the reference
+	// other reports are compared against. Marking an already-marked UID
+	// refreshes its marker and time.
+	SetBenchmarkBaseline(ctx context.Context, uid, markedBy string) error
+	DeleteBenchmarkBaseline(ctx context.Context, uid string) error
+	// ListBenchmarkBaselines returns the baselines, most recently marked first.
+	ListBenchmarkBaselines(ctx context.Context) ([]BenchmarkBaseline, error)
+
 	// Lifecycle
 	Close() error
 }
@@ -304,6 +372,35 @@ type ClusterEvent struct {
 	RecordedAt         string `json:"recorded_at,omitempty"`
 }
 
+// ClusterMetadata is the user-managed presentation of a cluster context.
+type ClusterMetadata struct {
+	Context     string   `json:"context"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Environment string   `json:"environment,omitempty"` // dev, stage or prod
+	UpdatedAt   string   `json:"updatedAt,omitempty"`
+}
+
+// BenchmarkBaseline records a benchmark report marked as a baseline.
+type BenchmarkBaseline struct {
+	UID      string `json:"uid"`
+	MarkedBy string `json:"marked_by,omitempty"`
+	MarkedAt string `json:"marked_at"`
+}
+
+// FeatureRequestFilter controls which feature requests SearchFeatureRequests
+// returns. Empty fields match everything.
+type FeatureRequestFilter struct {
+	UserID uuid.UUID
+	Query  string // case-insensitive substring of title or description
+	Status models.RequestStatus
+	Type   models.RequestType
+	// ExcludeStatuses hides requests in these statuses.
+	ExcludeStatuses []models.RequestStatus
+	Limit           int
+	Offset          int
+}
+
 // TimelineFilter controls which events QueryTimeline returns.
 type TimelineFilter struct {
 	Cluster   string
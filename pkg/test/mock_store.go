@@ -15,6 +15,8 @@ type MockStore struct {
 	mock.Mock
 }
 
+func (m *MockStore) Ping(ctx context.Context) error { return nil }
+
 func (m *MockStore) GetUser(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
@@ -188,6 +190,10 @@ func (m *MockStore) CreateFeatureRequest(ctx context.Context, request *models.Fe
 	args := m.Called(request)
 	return args.Error(0)
 }
+func (m *MockStore) CreateFeatureRequestWithinRate(ctx context.Context, request *models.FeatureRequest, since time.Time, maxRequests int) error {
+	args := m.Called(request, maxRequests)
+	return args.Error(0)
+}
 func (m *MockStore) GetFeatureRequest(ctx context.Context, id uuid.UUID) (*models.FeatureRequest, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
@@ -220,6 +226,16 @@ func (m *MockStore) CountUserPendingFeatureRequests(ctx context.Context, userID
 	args := m.Called(userID)
 	return args.Int(0), args.Error(1)
 }
+func (m *MockStore) GetUserFeatureRequestTimesSince(ctx context.Context, userID uuid.UUID, since time.Time) ([]time.Time, error) {
+	return nil, nil
+}
+func (m *MockStore) SearchFeatureRequests(ctx context.Context, filter store.FeatureRequestFilter) ([]models.FeatureRequest, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.FeatureRequest), args.Error(1)
+}
 func (m *MockStore) GetAllFeatureRequests(ctx context.Context, limit, offset int) ([]models.FeatureRequest, error) {
 	return nil, nil
 }
@@ -247,6 +263,17 @@ func (m *MockStore) GetPRFeedback(ctx context.Context, featureRequestID uuid.UUI
 	return nil, nil
 }
 
+func (m *MockStore) CreateVote(ctx context.Context, vote *models.FeatureVote) error { return nil }
+func (m *MockStore) DeleteVote(ctx context.Context, featureRequestID string, userID uuid.UUID) error {
+	return nil
+}
+func (m *MockStore) CountVotes(ctx context.Context, featureRequestID string) (int, error) {
+	return 0, nil
+}
+func (m *MockStore) GetVoteSummaries(ctx context.Context, userID uuid.UUID, featureRequestIDs []string) (map[string]models.VoteSummary, error) {
+	return nil, nil
+}
+
 func (m *MockStore) CreateNotification(ctx context.Context, notification *models.Notification) error {
 	args := m.Called(notification)
 	return args.Error(0)
@@ -257,6 +284,12 @@ func (m *MockStore) GetUserNotifications(ctx context.Context, userID uuid.UUID,
 func (m *MockStore) GetUnreadNotificationCount(ctx context.Context, userID uuid.UUID) (int, error)        { return 0, nil }
 func (m *MockStore) MarkNotificationReadByUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) error { return nil }
 func (m *MockStore) MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) error                 { return nil }
+func (m *MockStore) GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (*models.NotificationPreferences, error) {
+	return nil, nil
+}
+func (m *MockStore) SaveNotificationPreferences(ctx context.Context, prefs *models.NotificationPreferences) error {
+	return nil
+}
 
 func (m *MockStore) CreateGPUReservation(ctx context.Context, reservation *models.GPUReservation) error { return nil }
 func (m *MockStore) CreateGPUReservationWithCapacity(ctx context.Context, reservation *models.GPUReservation, capacity int) error {
@@ -504,6 +537,18 @@ func (m *MockStore) QueryAuditLogs(_ context.Context, limit int, userID, action
 	return args.Get(0).([]store.AuditEntry), args.Error(1)
 }
 
+func (m *MockStore) InsertAuditEntry(_ context.Context, _ store.AuditEntry) error {
+	return nil
+}
+
+func (m *MockStore) ListAuditEntries(_ context.Context, filter store.AuditFilter) ([]store.AuditEntry, error) {
+	args := m.Called(filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]store.AuditEntry), args.Error(1)
+}
+
 func (m *MockStore) InsertOrUpdateEvent(_ context.Context, _ store.ClusterEvent) error {
 	return nil
 }
@@ -520,4 +565,48 @@ func (m *MockStore) SweepOldEvents(_ context.Context, _ int) (int64, error) {
 	return 0, nil
 }
 
+func (m *MockStore) GetClusterMetadata(_ context.Context, contextName string) (*store.ClusterMetadata, error) {
+	args := m.Called(contextName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*store.ClusterMetadata), args.Error(1)
+}
+
+func (m *MockStore) ListClusterMetadata(_ context.Context) ([]store.ClusterMetadata, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]store.ClusterMetadata), args.Error(1)
+}
+
+func (m *MockStore) SaveClusterMetadata(_ context.Context, meta *store.ClusterMetadata) error {
+	args := m.Called(meta)
+	return args.Error(0)
+}
+
+func (m *MockStore) DeleteClusterMetadata(_ context.Context, contextName string) error {
+	args := m.Called(contextName)
+	return args.Error(0)
+}
+
+func (m *MockStore) SetBenchmarkBaseline(_ context.Context, uid, markedBy string) error {
+	args := m.Called(uid, markedBy)
+	return args.Error(0)
+}
+
+func (m *MockStore) DeleteBenchmarkBaseline(_ context.Context, uid string) error {
+	args := m.Called(uid)
+	return args.Error(0)
+}
+
+func (m *MockStore) ListBenchmarkBaselines(_ context.Context) ([]store.BenchmarkBaseline, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]store.BenchmarkBaseline), args.Error(1)
+}
+
 func (m *MockStore) Close() error { return nil }
@@ -48,6 +48,10 @@ export interface FeatureRequest {
   closed_by_user?: boolean
   created_at: string
   updated_at?: string
+  /** Upvotes from other users (queue items only) */
+  vote_count?: number
+  /** True if the current user has upvoted this request (queue items only) */
+  voted?: boolean
   /** Number of screenshots successfully uploaded to GitHub (only in create response) */
   screenshots_uploaded?: number
   /** Number of screenshots that failed to upload (only in create response) */
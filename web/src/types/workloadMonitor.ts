@@ -77,6 +77,28 @@ export interface MonitorIssue {
   detectedAt: string
 }
 
+/** Probe configuration and suspected failures for one container */
+export interface ContainerProbeSummary {
+  /** Container name from the pod template */
+  container: string
+  /** Liveness probe handler type (httpGet, tcpSocket, exec, grpc), absent if none */
+  liveness?: string
+  /** Readiness probe handler type, absent if none */
+  readiness?: string
+  /** Startup probe handler type, absent if none */
+  startup?: string
+  /** Number of pods reporting this container */
+  pods: number
+  /** Pods where this container is not ready */
+  notReady: number
+  /** Total restarts across pods */
+  restarts: number
+  /** Whether statuses or Unhealthy events point at a failing probe */
+  suspectedFailure: boolean
+  /** Why the probe is suspected */
+  reasons?: string[]
+}
+
 // ============================================================================
 // Monitor API Response
 // ============================================================================
@@ -99,6 +121,8 @@ export interface WorkloadMonitorResponse {
   issues: MonitorIssue[]
   /** Non-critical warnings from the resolution process */
   warnings: string[]
+  /** Probe summary per container of the workload's pod template */
+  probes?: ContainerProbeSummary[]
 }
 
 // ============================================================================